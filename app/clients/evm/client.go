@@ -18,9 +18,12 @@ package evm
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
+	"net/http"
+	"net/url"
 	"syscall"
 	"time"
 
@@ -28,11 +31,14 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/tracing"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/retry"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // Used as a maximum amount of retries that need to be done when executing
@@ -42,6 +48,9 @@ const (
 	retryAfterTimer  = 10 * time.Second
 )
 
+// ErrNoWebSocketUrl is returned when subscribing to new heads without a configured WebSocket endpoint
+var ErrNoWebSocketUrl = errors.New("no WebSocket url configured")
+
 // Client EVM JSON RPC Client
 type Client struct {
 	config config.Evm
@@ -53,16 +62,23 @@ type Client struct {
 // NewClient creates new instance of an EVM client
 func NewClient(c config.Evm, chainId uint64) *Client {
 	logger := config.GetLoggerFor(fmt.Sprintf("EVM Client"))
-	if c.BlockConfirmations < 1 {
-		logger.Fatalf("BlockConfirmations should be a positive number")
+	switch c.Finality {
+	case "":
+		if c.BlockConfirmations < 1 {
+			logger.Fatalf("BlockConfirmations should be a positive number")
+		}
+	case config.SafeFinality, config.FinalizedFinality:
+	default:
+		logger.Fatalf("Finality should be either [%s] or [%s], got [%s]", config.SafeFinality, config.FinalizedFinality, c.Finality)
 	}
 
-	var client client.Core
-	client, err := ethclient.Dial(c.NodeUrl)
+	rpcClient, err := rpc.DialOptions(context.Background(), c.NodeUrl,
+		rpc.WithHTTPClient(&http.Client{Transport: tracing.Transport("evm", nil, rpcAttributes)}))
 	if err != nil {
 		logger.Fatalf("Failed to initialize Client with Chain Id [%v]. Error [%s]", chainId, err)
 	}
 
+	var client client.Core = ethclient.NewClient(rpcClient)
 	return &Client{
 		c,
 		client,
@@ -70,6 +86,26 @@ func NewClient(c config.Evm, chainId uint64) *Client {
 		chainId,
 	}
 }
+
+// rpcAttributes returns the JSON RPC method of the request. Batch requests are recorded without a method
+func rpcAttributes(req *http.Request) []attribute.KeyValue {
+	if req.GetBody == nil {
+		return nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil
+	}
+	defer body.Close()
+
+	var call struct {
+		Method string `json:"method"`
+	}
+	if json.NewDecoder(body).Decode(&call) != nil {
+		return nil
+	}
+	return []attribute.KeyValue{attribute.String("rpc.method", call.Method)}
+}
 func (ec *Client) GetChainID() uint64 {
 	return ec.chainId
 }
@@ -176,13 +212,26 @@ func (ec *Client) GetPrivateKey() string {
 	return ec.config.PrivateKey
 }
 
+func (ec *Client) Nodes() map[string]client.EVM {
+	return map[string]client.EVM{nodeHost(ec.config.NodeUrl): ec}
+}
+
+// nodeHost returns the host of the node URL, leaving out any API key in its path or query
+func nodeHost(nodeUrl string) string {
+	u, err := url.Parse(nodeUrl)
+	if err != nil || u.Host == "" {
+		return "unknown"
+	}
+	return u.Host
+}
+
 func (ec Client) BlockConfirmations() uint64 {
 	return ec.config.BlockConfirmations
 }
 
 // RetryBlockNumber returns the most recent block number
 // Uses a retry mechanism in case the filter query is stuck
-func (ec Client) RetryBlockNumber() (uint64, error) {
+func (ec Client) RetryBlockNumber(ctx context.Context) (uint64, error) {
 	blockNumberFunc := func(ctx context.Context) retry.Result {
 		block, err := ec.BlockNumber(ctx)
 		return retry.Result{
@@ -191,7 +240,7 @@ func (ec Client) RetryBlockNumber() (uint64, error) {
 		}
 	}
 
-	result, err := service.Retry(blockNumberFunc, executionRetries)
+	result, err := service.Retry(ctx, blockNumberFunc, executionRetries)
 	if err != nil {
 		ec.logger.Warnf("Error in [RetryBlockNumber] Retry [%s]", err)
 		return 0, err
@@ -205,9 +254,62 @@ func (ec Client) RetryBlockNumber() (uint64, error) {
 	return block, nil
 }
 
+// RetryConfirmedBlockNumber returns the most recent block number considered final.
+// It is the `safe` or `finalized` block of the node if finality is configured, otherwise the latest block minus the block confirmations
+func (ec Client) RetryConfirmedBlockNumber(ctx context.Context) (uint64, error) {
+	var tag rpc.BlockNumber
+	switch ec.config.Finality {
+	case config.SafeFinality:
+		tag = rpc.SafeBlockNumber
+	case config.FinalizedFinality:
+		tag = rpc.FinalizedBlockNumber
+	default:
+		block, err := ec.RetryBlockNumber(ctx)
+		if err != nil {
+			return 0, err
+		}
+		if block < ec.config.BlockConfirmations {
+			return 0, nil
+		}
+		return block - ec.config.BlockConfirmations, nil
+	}
+
+	header, err := ec.RetryHeaderByNumber(ctx, big.NewInt(tag.Int64()))
+	if err != nil {
+		return 0, err
+	}
+
+	return header.Number.Uint64(), nil
+}
+
+// RetryHeaderByNumber returns the header of the given block
+// Uses a retry mechanism in case the query is stuck
+func (ec Client) RetryHeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	headerByNumberFunc := func(ctx context.Context) retry.Result {
+		header, err := ec.HeaderByNumber(ctx, number)
+		return retry.Result{
+			Value: header,
+			Error: err,
+		}
+	}
+
+	result, err := service.Retry(ctx, headerByNumberFunc, executionRetries)
+	if err != nil {
+		ec.logger.Warnf("Error in [RetryHeaderByNumber] Retry [%s]", err)
+		return nil, err
+	}
+
+	header, ok := result.(*types.Header)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast header [%v]", result)
+	}
+
+	return header, nil
+}
+
 // RetryFilterLogs returns the logs from the input query
 // Uses a retry mechanism in case the filter query is stuck
-func (ec Client) RetryFilterLogs(query ethereum.FilterQuery) ([]types.Log, error) {
+func (ec Client) RetryFilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
 	filterLogsFunc := func(ctx context.Context) retry.Result {
 		logs, err := ec.FilterLogs(ctx, query)
 		return retry.Result{
@@ -216,7 +318,7 @@ func (ec Client) RetryFilterLogs(query ethereum.FilterQuery) ([]types.Log, error
 		}
 	}
 
-	result, err := service.Retry(filterLogsFunc, executionRetries)
+	result, err := service.Retry(ctx, filterLogsFunc, executionRetries)
 	if err != nil {
 		ec.logger.Warnf("Error in [RetryFilterLogs] Retry [%s]", err)
 		return nil, err
@@ -230,6 +332,38 @@ func (ec Client) RetryFilterLogs(query ethereum.FilterQuery) ([]types.Log, error
 	return logs, nil
 }
 
+// SubscribeNewHead subscribes to new chain heads over the configured WebSocket endpoint.
+// A dedicated connection is dialed for each subscription and closed once it is unsubscribed
+func (ec *Client) SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error) {
+	if ec.config.WsUrl == "" {
+		return nil, ErrNoWebSocketUrl
+	}
+
+	wsClient, err := ethclient.DialContext(ctx, ec.config.WsUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription, err := wsClient.SubscribeNewHead(ctx, ch)
+	if err != nil {
+		wsClient.Close()
+		return nil, err
+	}
+
+	return &headSubscription{Subscription: subscription, client: wsClient}, nil
+}
+
+// headSubscription closes the WebSocket connection of the subscription once it is unsubscribed
+type headSubscription struct {
+	ethereum.Subscription
+	client *ethclient.Client
+}
+
+func (s *headSubscription) Unsubscribe() {
+	s.Subscription.Unsubscribe()
+	s.client.Close()
+}
+
 func (ec *Client) WaitForConfirmations(raw types.Log) error {
 	target := raw.BlockNumber + ec.config.BlockConfirmations
 	for {
@@ -261,8 +395,9 @@ func (ec *Client) WaitForConfirmations(raw types.Log) error {
 	}
 }
 
-func (ec *Client) RetryTransactionByHash(hash common.Hash) (*types.Transaction, error) {
+func (ec *Client) RetryTransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, error) {
 	res, err := service.Retry(
+		ctx,
 		func(ctx context.Context) retry.Result {
 			tx, _, err := ec.TransactionByHash(ctx, hash)
 			if err != nil {
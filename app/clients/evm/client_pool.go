@@ -45,10 +45,12 @@ func NewClientPool(c config.EvmPool, chainId uint64) *ClientPool {
 		configEvm := config.Evm{
 			BlockConfirmations: c.BlockConfirmations,
 			NodeUrl:            nodeURL,
+			WsUrl:              c.WsUrl,
 			PrivateKey:         c.PrivateKey,
 			StartBlock:         c.StartBlock,
 			PollingInterval:    c.PollingInterval,
 			MaxLogsBlocks:      c.MaxLogsBlocks,
+			Finality:           c.Finality,
 		}
 		clients = append(clients, NewClient(configEvm, chainId))
 		clientsConfigs = append(clientsConfigs, configEvm)
@@ -267,9 +269,9 @@ func (cp *ClientPool) ValidateContractDeployedAt(contractAddress string) (*commo
 	return result.(*common.Address), nil
 }
 
-func (cp *ClientPool) RetryBlockNumber() (uint64, error) {
+func (cp *ClientPool) RetryBlockNumber(ctx context.Context) (uint64, error) {
 	operation := func(c client.EVM) (interface{}, error) {
-		return c.RetryBlockNumber()
+		return c.RetryBlockNumber(ctx)
 	}
 
 	result, err := cp.retryOperation(operation)
@@ -280,9 +282,35 @@ func (cp *ClientPool) RetryBlockNumber() (uint64, error) {
 	return result.(uint64), nil
 }
 
-func (cp *ClientPool) RetryFilterLogs(query ethereum.FilterQuery) ([]types.Log, error) {
+func (cp *ClientPool) RetryConfirmedBlockNumber(ctx context.Context) (uint64, error) {
 	operation := func(c client.EVM) (interface{}, error) {
-		return c.RetryFilterLogs(query)
+		return c.RetryConfirmedBlockNumber(ctx)
+	}
+
+	result, err := cp.retryOperation(operation)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.(uint64), nil
+}
+
+func (cp *ClientPool) RetryHeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	operation := func(c client.EVM) (interface{}, error) {
+		return c.RetryHeaderByNumber(ctx, number)
+	}
+
+	result, err := cp.retryOperation(operation)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*types.Header), nil
+}
+
+func (cp *ClientPool) RetryFilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	operation := func(c client.EVM) (interface{}, error) {
+		return c.RetryFilterLogs(ctx, query)
 	}
 
 	result, err := cp.retryOperation(operation)
@@ -293,6 +321,19 @@ func (cp *ClientPool) RetryFilterLogs(query ethereum.FilterQuery) ([]types.Log,
 	return result.([]types.Log), nil
 }
 
+func (cp *ClientPool) SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error) {
+	operation := func(c client.EVM) (interface{}, error) {
+		return c.SubscribeNewHead(ctx, ch)
+	}
+
+	result, err := cp.retryOperation(operation)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(ethereum.Subscription), nil
+}
+
 func (cp *ClientPool) WaitForTransactionReceipt(hash common.Hash) (*types.Receipt, error) {
 	operation := func(c client.EVM) (interface{}, error) {
 		return c.WaitForTransactionReceipt(hash)
@@ -306,9 +347,9 @@ func (cp *ClientPool) WaitForTransactionReceipt(hash common.Hash) (*types.Receip
 	return result.(*types.Receipt), nil
 }
 
-func (cp *ClientPool) RetryTransactionByHash(hash common.Hash) (*types.Transaction, error) {
+func (cp *ClientPool) RetryTransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, error) {
 	operation := func(c client.EVM) (interface{}, error) {
-		return c.RetryTransactionByHash(hash)
+		return c.RetryTransactionByHash(ctx, hash)
 	}
 
 	result, err := cp.retryOperation(operation)
@@ -350,6 +391,19 @@ func (cp *ClientPool) GetPrivateKey() string {
 	return cp.clients[0].GetPrivateKey()
 }
 
+func (cp *ClientPool) Nodes() map[string]client.EVM {
+	nodes := make(map[string]client.EVM, len(cp.clients))
+	for i, c := range cp.clients {
+		host := nodeHost(cp.clientsConfigs[i].NodeUrl)
+		if _, exists := nodes[host]; exists {
+			// Nodes of the same provider, using different API keys
+			host = fmt.Sprintf("%s-%d", host, i)
+		}
+		nodes[host] = c
+	}
+	return nodes
+}
+
 func (cp *ClientPool) BlockConfirmations() uint64 {
 	return cp.clients[0].BlockConfirmations()
 }
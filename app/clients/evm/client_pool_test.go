@@ -180,6 +180,24 @@ func TestClientPool_GetPrivateKey(t *testing.T) {
 	assert.Equal(t, c.config.PrivateKey, cp.GetPrivateKey())
 }
 
+func TestClientPool_Nodes(t *testing.T) {
+	setupCP()
+	other := &Client{logger: c.logger}
+	cp.clients = append(cp.clients, other, c)
+	cp.clientsConfigs = []config.Evm{
+		{NodeUrl: "https://eth-mainnet.g.alchemy.com/v2/some-api-key"},
+		{NodeUrl: "https://mainnet.infura.io/v3/some-api-key"},
+		{NodeUrl: "https://eth-mainnet.g.alchemy.com/v2/other-api-key"},
+	}
+
+	expected := map[string]client.EVM{
+		"eth-mainnet.g.alchemy.com":   c,
+		"mainnet.infura.io":           other,
+		"eth-mainnet.g.alchemy.com-2": c,
+	}
+	assert.Equal(t, expected, cp.Nodes())
+}
+
 func TestClientPool_WaitForConfirmations(t *testing.T) {
 	setupCP()
 
@@ -279,6 +297,20 @@ func TestClientPool_HeaderByNumber(t *testing.T) {
 	mocks.MEVMCoreClient.AssertNumberOfCalls(t, "HeaderByNumber", 3)
 }
 
+func TestClientPool_RetryHeaderByNumber(t *testing.T) {
+	setupCP()
+	number := big.NewInt(1)
+	header := &types.Header{}
+	mocks.MEVMCoreClient.On("HeaderByNumber", mock.Anything, number).Return((*types.Header)(nil), errors.New("error")).Once().
+		On("HeaderByNumber", mock.Anything, number).Return(header, nil).Once()
+
+	res, err := cp.RetryHeaderByNumber(context.TODO(), number)
+
+	assert.NoError(t, err)
+	assert.Equal(t, header, res)
+	mocks.MEVMCoreClient.AssertNumberOfCalls(t, "HeaderByNumber", 2)
+}
+
 func TestClientPool_SuggestGasPrice(t *testing.T) {
 	setupCP()
 	ctx := context.TODO()
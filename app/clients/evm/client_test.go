@@ -23,11 +23,15 @@ import (
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"go.opentelemetry.io/otel/attribute"
 	"math/big"
+	"net/http"
+	"strings"
 	"testing"
 	"time"
 )
@@ -196,6 +200,12 @@ func Test_GetPrivateKey(t *testing.T) {
 	assert.Equal(t, c.config.PrivateKey, c.GetPrivateKey())
 }
 
+func Test_Nodes(t *testing.T) {
+	setup()
+	c.config.NodeUrl = "https://mainnet.infura.io/v3/some-api-key"
+	assert.Equal(t, map[string]client.EVM{"mainnet.infura.io": c}, c.Nodes())
+}
+
 func Test_WaitForConfirmations(t *testing.T) {
 	setup()
 
@@ -261,3 +271,64 @@ func Test_WaitForConfirmations_BlockNumberFails(t *testing.T) {
 	assert.NotNil(t, err)
 	mocks.MEVMCoreClient.AssertNotCalled(t, "TransactionReceipt", context.Background(), mock.Anything)
 }
+
+func Test_RetryConfirmedBlockNumber(t *testing.T) {
+	setup()
+	c.config.BlockConfirmations = 5
+	mocks.MEVMCoreClient.On("BlockNumber", mock.Anything).Return(uint64(20), nil)
+
+	block, err := c.RetryConfirmedBlockNumber(context.Background())
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(15), block)
+}
+
+func Test_RetryConfirmedBlockNumber_BelowConfirmations(t *testing.T) {
+	setup()
+	c.config.BlockConfirmations = 5
+	mocks.MEVMCoreClient.On("BlockNumber", mock.Anything).Return(uint64(3), nil)
+
+	block, err := c.RetryConfirmedBlockNumber(context.Background())
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), block)
+}
+
+func Test_RetryConfirmedBlockNumber_Finalized(t *testing.T) {
+	setup()
+	c.config.Finality = config.FinalizedFinality
+	mocks.MEVMCoreClient.On("HeaderByNumber", mock.Anything, big.NewInt(-3)).Return(&types.Header{Number: big.NewInt(12)}, nil)
+
+	block, err := c.RetryConfirmedBlockNumber(context.Background())
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(12), block)
+}
+
+func Test_RetryConfirmedBlockNumber_Fails(t *testing.T) {
+	setup()
+	c.config.Finality = config.SafeFinality
+	mocks.MEVMCoreClient.On("HeaderByNumber", mock.Anything, big.NewInt(-4)).Return(nil, errors.New("some-error"))
+
+	block, err := c.RetryConfirmedBlockNumber(context.Background())
+
+	assert.NotNil(t, err)
+	assert.Equal(t, uint64(0), block)
+}
+
+func Test_SubscribeNewHead_NoWebSocketUrl(t *testing.T) {
+	setup()
+
+	subscription, err := c.SubscribeNewHead(context.Background(), make(chan *types.Header))
+
+	assert.Equal(t, ErrNoWebSocketUrl, err)
+	assert.Nil(t, subscription)
+}
+
+func Test_RpcAttributes(t *testing.T) {
+	call, _ := http.NewRequest(http.MethodPost, "http://localhost:8545", strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"eth_getLogs","params":[]}`))
+	batch, _ := http.NewRequest(http.MethodPost, "http://localhost:8545", strings.NewReader(`[{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"}]`))
+
+	assert.Equal(t, []attribute.KeyValue{attribute.String("rpc.method", "eth_getLogs")}, rpcAttributes(call))
+	assert.Nil(t, rpcAttributes(batch))
+}
@@ -17,11 +17,16 @@
 package hedera
 
 import (
+	"context"
 	"fmt"
 	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/keystore"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/tracing"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // Node struct holding the hedera.Client. Used to interact with Hedera consensus nodes
@@ -59,12 +64,7 @@ func NewNodeClient(cfg config.Hedera) *Node {
 		log.Fatalf("Invalid Operator AccountId provided: [%s]", cfg.Operator.AccountId)
 	}
 
-	privateKey, err := hedera.PrivateKeyFromString(cfg.Operator.PrivateKey)
-	if err != nil {
-		log.Fatalf("Invalid Operator PrivateKey provided: [%s]", cfg.Operator.PrivateKey)
-	}
-
-	client.SetOperator(accID, privateKey)
+	client.SetOperator(accID, operatorKey(cfg.Operator))
 
 	return &Node{
 		client:   client,
@@ -73,13 +73,35 @@ func NewNodeClient(cfg config.Hedera) *Node {
 	}
 }
 
+// operatorKey loads the operator key from its keystore if configured, otherwise parses the plain text private key
+func operatorKey(cfg config.Operator) hedera.PrivateKey {
+	if cfg.Keystore.Path != "" {
+		password, err := keystore.Password(cfg.Keystore.PasswordEnv, cfg.Keystore.PasswordFile)
+		if err != nil {
+			log.Fatalf("Failed to read password of Operator Keystore [%s]. Error: [%s]", cfg.Keystore.Path, err)
+		}
+
+		privateKey, err := keystore.LoadHederaKey(cfg.Keystore.Path, password)
+		if err != nil {
+			log.Fatalf("Failed to load Operator Keystore [%s]. Error: [%s]", cfg.Keystore.Path, err)
+		}
+		return privateKey
+	}
+
+	privateKey, err := hedera.PrivateKeyFromString(cfg.PrivateKey)
+	if err != nil {
+		log.Fatalf("Invalid Operator PrivateKey provided: [%s]", cfg.PrivateKey)
+	}
+	return privateKey
+}
+
 // GetClient returns the hedera.Client
 func (hc Node) GetClient() *hedera.Client {
 	return hc.client
 }
 
 // SubmitScheduledTokenMintTransaction creates a token mint transaction and submits it as a scheduled mint transaction
-func (hc Node) SubmitScheduledTokenMintTransaction(tokenID hedera.TokenID, amount int64, payerAccountID hedera.AccountID, memo string) (*hedera.TransactionResponse, error) {
+func (hc Node) SubmitScheduledTokenMintTransaction(ctx context.Context, tokenID hedera.TokenID, amount int64, payerAccountID hedera.AccountID, memo string) (*hedera.TransactionResponse, error) {
 	tokenMintTx := hedera.NewTokenMintTransaction().
 		SetTokenID(tokenID).
 		SetAmount(uint64(amount)).
@@ -97,11 +119,11 @@ func (hc Node) SubmitScheduledTokenMintTransaction(tokenID hedera.TokenID, amoun
 		return nil, err
 	}
 
-	return hc.submitScheduledTransaction(signedTransaction, payerAccountID, memo)
+	return hc.submitScheduledTransaction(ctx, signedTransaction, payerAccountID, memo)
 }
 
 // SubmitScheduledTokenBurnTransaction creates a token burn transaction and submits it as a scheduled burn transaction
-func (hc Node) SubmitScheduledTokenBurnTransaction(tokenID hedera.TokenID, amount int64, payerAccountID hedera.AccountID, memo string) (*hedera.TransactionResponse, error) {
+func (hc Node) SubmitScheduledTokenBurnTransaction(ctx context.Context, tokenID hedera.TokenID, amount int64, payerAccountID hedera.AccountID, memo string) (*hedera.TransactionResponse, error) {
 	tokenBurnTx := hedera.NewTokenBurnTransaction().
 		SetTokenID(tokenID).
 		SetAmount(uint64(amount)).
@@ -118,12 +140,55 @@ func (hc Node) SubmitScheduledTokenBurnTransaction(tokenID hedera.TokenID, amoun
 		return nil, err
 	}
 
-	return hc.submitScheduledTransaction(signedTransaction, payerAccountID, memo)
+	return hc.submitScheduledTransaction(ctx, signedTransaction, payerAccountID, memo)
+}
+
+// SubmitScheduledNftMintTransaction creates a token mint transaction of a single NFT with the given metadata and submits it as a scheduled mint transaction
+func (hc Node) SubmitScheduledNftMintTransaction(ctx context.Context, tokenID hedera.TokenID, metadata []byte, payerAccountID hedera.AccountID, memo string) (*hedera.TransactionResponse, error) {
+	tokenMintTx := hedera.NewTokenMintTransaction().
+		SetTokenID(tokenID).
+		SetMetadata(metadata).
+		SetMaxRetry(hc.maxRetry)
+
+	tx, err := tokenMintTx.FreezeWith(hc.GetClient())
+	if err != nil {
+		return nil, err
+	}
+
+	hc.logger.Debugf("[%s] - Signing transaction with ID: [%s] and Node Account IDs: %v", memo, tx.GetTransactionID().String(), tx.GetNodeAccountIDs())
+	signedTransaction, err := tx.
+		SignWithOperator(hc.GetClient())
+	if err != nil {
+		return nil, err
+	}
+
+	return hc.submitScheduledTransaction(ctx, signedTransaction, payerAccountID, memo)
+}
+
+// SubmitScheduledNftBurnTransaction creates a token burn transaction of a single NFT and submits it as a scheduled burn transaction
+func (hc Node) SubmitScheduledNftBurnTransaction(ctx context.Context, nftID hedera.NftID, payerAccountID hedera.AccountID, memo string) (*hedera.TransactionResponse, error) {
+	tokenBurnTx := hedera.NewTokenBurnTransaction().
+		SetTokenID(nftID.TokenID).
+		SetSerialNumber(nftID.SerialNumber).
+		SetMaxRetry(hc.maxRetry)
+	tx, err := tokenBurnTx.FreezeWith(hc.GetClient())
+	if err != nil {
+		return nil, err
+	}
+
+	hc.logger.Debugf("[%s] - Signing transaction with ID: [%s] and Node Account IDs: %v", memo, tx.GetTransactionID().String(), tx.GetNodeAccountIDs())
+	signedTransaction, err := tx.
+		SignWithOperator(hc.GetClient())
+	if err != nil {
+		return nil, err
+	}
+
+	return hc.submitScheduledTransaction(ctx, signedTransaction, payerAccountID, memo)
 }
 
 // SubmitTopicConsensusMessage submits the provided message bytes to the
 // specified HCS `topicId`
-func (hc Node) SubmitTopicConsensusMessage(topicId hedera.TopicID, message []byte) (*hedera.TransactionID, error) {
+func (hc Node) SubmitTopicConsensusMessage(ctx context.Context, topicId hedera.TopicID, message []byte) (*hedera.TransactionID, error) {
 	tx, err := hedera.NewTopicMessageSubmitTransaction().
 		SetTopicID(topicId).
 		SetMessage(message).
@@ -139,20 +204,23 @@ func (hc Node) SubmitTopicConsensusMessage(topicId hedera.TopicID, message []byt
 		tx.GetNodeAccountIDs(),
 	)
 
+	span := hc.startSpan(ctx, "TopicMessageSubmitTransaction", attribute.String("hedera.topic.id", topicId.String()))
 	response, err := tx.Execute(hc.GetClient())
 
 	if err != nil {
+		endSpan(span, response, err)
 		return nil, err
 	}
 
 	_, err = hc.checkTransactionReceipt(response)
+	endSpan(span, response, err)
 
 	return &response.TransactionID, err
 
 }
 
 // SubmitScheduleSign submits a ScheduleSign transaction for a given ScheduleID
-func (hc Node) SubmitScheduleSign(scheduleID hedera.ScheduleID) (*hedera.TransactionResponse, error) {
+func (hc Node) SubmitScheduleSign(ctx context.Context, scheduleID hedera.ScheduleID) (*hedera.TransactionResponse, error) {
 	tx, err := hedera.NewScheduleSignTransaction().
 		SetScheduleID(scheduleID).
 		SetMaxRetry(hc.maxRetry).
@@ -167,13 +235,16 @@ func (hc Node) SubmitScheduleSign(scheduleID hedera.ScheduleID) (*hedera.Transac
 		tx.GetScheduleID(),
 		tx.GetNodeAccountIDs(),
 	)
+	span := hc.startSpan(ctx, "ScheduleSignTransaction", attribute.String("hedera.schedule.id", scheduleID.String()))
 	response, err := tx.Execute(hc.GetClient())
+	endSpan(span, response, err)
 
 	return &response, err
 }
 
 // SubmitScheduledTokenTransferTransaction creates a token transfer transaction and submits it as a scheduled transaction
 func (hc Node) SubmitScheduledTokenTransferTransaction(
+	ctx context.Context,
 	tokenID hedera.TokenID,
 	transfers []transfer.Hedera,
 	payerAccountID hedera.AccountID,
@@ -185,11 +256,12 @@ func (hc Node) SubmitScheduledTokenTransferTransaction(
 		transferTransaction.AddTokenTransfer(tokenID, t.AccountID, t.Amount)
 	}
 
-	return hc.submitScheduledTransferTransaction(payerAccountID, memo, transferTransaction)
+	return hc.submitScheduledTransferTransaction(ctx, payerAccountID, memo, transferTransaction)
 }
 
 // SubmitScheduledHbarTransferTransaction creates an hbar transfer transaction and submits it as a scheduled transaction
 func (hc Node) SubmitScheduledHbarTransferTransaction(
+	ctx context.Context,
 	transfers []transfer.Hedera,
 	payerAccountID hedera.AccountID,
 	memo string) (*hedera.TransactionResponse, error) {
@@ -200,10 +272,11 @@ func (hc Node) SubmitScheduledHbarTransferTransaction(
 		transferTransaction.AddHbarTransfer(t.AccountID, hedera.HbarFromTinybar(t.Amount))
 	}
 
-	return hc.submitScheduledTransferTransaction(payerAccountID, memo, transferTransaction)
+	return hc.submitScheduledTransferTransaction(ctx, payerAccountID, memo, transferTransaction)
 }
 
 func (hc Node) SubmitScheduledNftTransferTransaction(
+	ctx context.Context,
 	nftID hedera.NftID,
 	payerAccount hedera.AccountID,
 	sender hedera.AccountID,
@@ -214,7 +287,7 @@ func (hc Node) SubmitScheduledNftTransferTransaction(
 		NewTransferTransaction().
 		AddApprovedNftTransfer(nftID, sender, receiving, approved)
 
-	return hc.submitScheduledTransferTransaction(payerAccount, memo, transferTransaction)
+	return hc.submitScheduledTransferTransaction(ctx, payerAccount, memo, transferTransaction)
 }
 
 func (hc Node) TransactionReceiptQuery(transactionID hedera.TransactionID, nodeAccIds []hedera.AccountID) (hedera.TransactionReceipt, error) {
@@ -225,7 +298,15 @@ func (hc Node) TransactionReceiptQuery(transactionID hedera.TransactionID, nodeA
 		Execute(hc.GetClient())
 }
 
+func (hc Node) Ping() error {
+	_, err := hedera.NewAccountBalanceQuery().
+		SetAccountID(hc.GetClient().GetOperatorAccountID()).
+		Execute(hc.GetClient())
+	return err
+}
+
 func (hc Node) SubmitScheduledNftApproveTransaction(
+	ctx context.Context,
 	payer hedera.AccountID,
 	memo string,
 	nftId hedera.NftID,
@@ -234,10 +315,10 @@ func (hc Node) SubmitScheduledNftApproveTransaction(
 	tx := hedera.NewAccountAllowanceApproveTransaction().
 		ApproveTokenNftAllowance(nftId, owner, spender)
 
-	return hc.submitScheduledAllowTransaction(payer, memo, tx)
+	return hc.submitScheduledAllowTransaction(ctx, payer, memo, tx)
 }
 
-func (hc Node) submitScheduledAllowTransaction(payer hedera.AccountID, memo string, tx *hedera.AccountAllowanceApproveTransaction) (*hedera.TransactionResponse, error) {
+func (hc Node) submitScheduledAllowTransaction(ctx context.Context, payer hedera.AccountID, memo string, tx *hedera.AccountAllowanceApproveTransaction) (*hedera.TransactionResponse, error) {
 	tx, err := tx.FreezeWith(hc.GetClient())
 	if err != nil {
 		return nil, err
@@ -248,11 +329,11 @@ func (hc Node) submitScheduledAllowTransaction(payer hedera.AccountID, memo stri
 		return nil, err
 	}
 
-	return hc.submitScheduledTransaction(signedTx, payer, memo)
+	return hc.submitScheduledTransaction(ctx, signedTx, payer, memo)
 }
 
 // submitScheduledTransferTransaction freezes the input transaction, signs with operator and submits it
-func (hc Node) submitScheduledTransferTransaction(payerAccountID hedera.AccountID, memo string, tx *hedera.TransferTransaction) (*hedera.TransactionResponse, error) {
+func (hc Node) submitScheduledTransferTransaction(ctx context.Context, payerAccountID hedera.AccountID, memo string, tx *hedera.TransferTransaction) (*hedera.TransactionResponse, error) {
 	tx, err := tx.FreezeWith(hc.GetClient())
 	if err != nil {
 		return nil, err
@@ -265,10 +346,10 @@ func (hc Node) submitScheduledTransferTransaction(payerAccountID hedera.AccountI
 		return nil, err
 	}
 
-	return hc.submitScheduledTransaction(signedTransaction, payerAccountID, memo)
+	return hc.submitScheduledTransaction(ctx, signedTransaction, payerAccountID, memo)
 }
 
-func (hc Node) submitScheduledTransaction(signedTransaction hedera.ITransaction, payerAccountID hedera.AccountID, memo string) (*hedera.TransactionResponse, error) {
+func (hc Node) submitScheduledTransaction(ctx context.Context, signedTransaction hedera.ITransaction, payerAccountID hedera.AccountID, memo string) (*hedera.TransactionResponse, error) {
 	scheduledTx, err := hedera.NewScheduleCreateTransaction().
 		SetScheduledTransaction(signedTransaction)
 	if err != nil {
@@ -278,11 +359,29 @@ func (hc Node) submitScheduledTransaction(signedTransaction hedera.ITransaction,
 		SetPayerAccountID(payerAccountID).
 		SetScheduleMemo(memo)
 
+	span := hc.startSpan(ctx, "ScheduleCreateTransaction", attribute.String("hedera.schedule.memo", memo))
 	response, err := scheduledTx.Execute(hc.GetClient())
+	endSpan(span, response, err)
 
 	return &response, err
 }
 
+// startSpan starts a span for the submission of a transaction of the given type to the consensus nodes
+func (hc Node) startSpan(ctx context.Context, transactionType string, attributes ...attribute.KeyValue) trace.Span {
+	_, span := tracing.Continue(ctx, "hedera "+transactionType,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attributes...))
+	return span
+}
+
+// endSpan ends the span of the submission, recording the ID of the transaction and the node, which it was submitted to
+func endSpan(span trace.Span, response hedera.TransactionResponse, err error) {
+	span.SetAttributes(
+		attribute.String("hedera.transaction.id", response.TransactionID.String()),
+		attribute.String("hedera.node.id", response.NodeID.String()))
+	tracing.End(span, err)
+}
+
 func (hc Node) checkTransactionReceipt(txResponse hedera.TransactionResponse) (*hedera.TransactionReceipt, error) {
 	receipt, err := txResponse.GetReceipt(hc.client)
 	if err != nil {
@@ -17,6 +17,7 @@
 package mirror_node
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -34,10 +35,14 @@ import (
 	httpHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/http"
 	mirrorNodeHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/mirror-node"
 	timestampHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/timestamp"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/tracing"
 	mirrorNodeModel "github.com/limechain/hedera-eth-bridge-validator/app/model/mirror-node"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/shopspring/decimal"
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var (
@@ -63,6 +68,8 @@ func NewClient(mirrorNode config.MirrorNode) *Client {
 	retryClient.RetryMax = rp.MaxRetry
 	retryClient.RetryWaitMax = time.Duration(rp.MaxWait) * time.Second
 	retryClient.RetryWaitMin = time.Duration(rp.MinWait) * time.Second
+	// Every attempt of a request, made as part of a trace, is recorded as a span
+	retryClient.HTTPClient.Transport = tracing.Transport("mirror-node", retryClient.HTTPClient.Transport, pathAttributes)
 
 	return &Client{
 		mirrorAPIAddress:             mirrorNode.ApiAddress,
@@ -130,6 +137,21 @@ func (c Client) GetAccountCreditTransactionsAfterTimestamp(accountId hedera.Acco
 	return c.GetAccountCreditTransactionsAfterTimestampString(accountId, timestampHelper.String(from))
 }
 
+// GetLatestAccountCreditTransaction returns the latest incoming Transfer for the specified account or nil if there is none
+func (c Client) GetLatestAccountCreditTransaction(accountId hedera.AccountID) (*transaction.Transaction, error) {
+	transactionsDownloadQuery := fmt.Sprintf("?account.id=%s&type=credit&result=success&order=desc&limit=1&transactiontype=cryptotransfer",
+		accountId.String())
+	response, err := c.getTransactionsByQuery(transactionsDownloadQuery)
+	if err != nil {
+		return nil, err
+	}
+	if len(response.Transactions) == 0 {
+		return nil, nil
+	}
+
+	return &response.Transactions[0], nil
+}
+
 // GetAccountCreditTransactionsBetween returns all incoming Transfers for the specified account between timestamp `from` and `to` excluded
 func (c Client) GetAccountCreditTransactionsBetween(accountId hedera.AccountID, from, to int64) ([]transaction.Transaction, error) {
 	transactions, err := c.GetAccountCreditTransactionsAfterTimestamp(accountId, from)
@@ -399,6 +421,9 @@ func (c Client) GetAccount(accountID string) (*account.AccountsResponse, error)
 	if e != nil {
 		return nil, e
 	}
+	if httpResponse.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("%w: account [%s]", client.ErrNotFound, accountID)
+	}
 	if httpResponse.StatusCode >= 400 {
 		return nil, fmt.Errorf(`failed to execute query: [%s]. Error: [%s]`, query, query)
 	}
@@ -492,16 +517,21 @@ func (c Client) query(query, entityID string) bool {
 }
 
 // WaitForTransaction Polls the transaction at intervals. Depending on the
-// result, the corresponding `onSuccess` and `onFailure` functions are called
-func (c Client) WaitForTransaction(txId string, onSuccess, onFailure func()) {
+// result, the corresponding `onSuccess` and `onFailure` functions are called.
+// The polling is traced within the span in the context, if any
+func (c Client) WaitForTransaction(ctx context.Context, txId string, onSuccess, onFailure func()) {
+	ctx, span := tracing.Continue(tracing.Detach(ctx), "wait for transaction",
+		trace.WithAttributes(attribute.String("hedera.transaction.id", txId)))
 	go func() {
+		defer span.End()
 		for {
-			response, err := c.GetTransaction(txId)
+			response, err := c.getTransaction(ctx, txId)
 			if response != nil && response.IsNotFound() {
 				continue
 			}
 			if err != nil {
 				c.logger.Errorf("[%s] Error while trying to get tx. Error: [%s].", txId, err.Error())
+				tracing.End(span, err)
 				return
 			}
 
@@ -519,6 +549,7 @@ func (c Client) WaitForTransaction(txId string, onSuccess, onFailure func()) {
 					onSuccess()
 				} else {
 					c.logger.Debugf("TX [%s] has failed", txId)
+					span.SetStatus(codes.Error, "transaction failed")
 					onFailure()
 				}
 				return
@@ -531,24 +562,32 @@ func (c Client) WaitForTransaction(txId string, onSuccess, onFailure func()) {
 }
 
 // WaitForScheduledTransaction Polls the transaction at intervals. Depending on the
-// result, the corresponding `onSuccess` and `onFailure` functions are called
-func (c Client) WaitForScheduledTransaction(txId string, onSuccess, onFailure func()) {
+// result, the corresponding `onSuccess` and `onFailure` functions are called.
+// `onFailure` is called with the result of the scheduled transaction.
+// The polling is traced within the span in the context, if any
+func (c Client) WaitForScheduledTransaction(ctx context.Context, txId string, onSuccess func(), onFailure func(result string)) {
 	c.logger.Debugf("Added new Scheduled TX [%s] for monitoring", txId)
+	ctx, span := tracing.Continue(tracing.Detach(ctx), "wait for scheduled transaction",
+		trace.WithAttributes(attribute.String("hedera.transaction.id", txId)))
+	defer span.End()
 	for {
-		response, err := c.GetTransaction(txId)
+		response, err := c.getTransaction(ctx, txId)
 		if response != nil && response.IsNotFound() {
 			continue
 		}
 		if err != nil {
 			c.logger.Errorf("[%s] Error while trying to get tx. Error: [%s].", txId, err)
+			tracing.End(span, err)
 			return
 		}
 
 		if len(response.Transactions) > 1 {
 			success := false
+			result := ""
 			for _, transaction := range response.Transactions {
-				if transaction.Scheduled && transaction.Result == hedera.StatusSuccess.String() {
-					success = true
+				if transaction.Scheduled {
+					result = transaction.Result
+					success = transaction.Result == hedera.StatusSuccess.String()
 					break
 				}
 			}
@@ -557,8 +596,9 @@ func (c Client) WaitForScheduledTransaction(txId string, onSuccess, onFailure fu
 				c.logger.Debugf("Scheduled TX [%s] was successfully mined", txId)
 				onSuccess()
 			} else {
-				c.logger.Debugf("Scheduled TX [%s] has failed", txId)
-				onFailure()
+				c.logger.Debugf("Scheduled TX [%s] has failed with [%s]", txId, result)
+				span.SetStatus(codes.Error, result)
+				onFailure(result)
 			}
 			return
 		}
@@ -577,12 +617,32 @@ func (c Client) getTransactionsByQuery(query string) (*transaction.Response, err
 	return c.getAndParse(transactionsQuery)
 }
 
+// getTransaction gets the transaction within the span in the context, if any
+func (c Client) getTransaction(ctx context.Context, transactionID string) (*transaction.Response, error) {
+	query := fmt.Sprintf("%s%s/%s", c.mirrorAPIAddress, "transactions", transactionID)
+	request, e := http.NewRequestWithContext(ctx, http.MethodGet, query, nil)
+	if e != nil {
+		return nil, e
+	}
+
+	httpResponse, e := c.httpClient.Do(request)
+	if e != nil {
+		return nil, e
+	}
+
+	return parse(query, httpResponse)
+}
+
 func (c Client) getAndParse(query string) (*transaction.Response, error) {
 	httpResponse, e := c.get(query)
 	if e != nil {
 		return nil, e
 	}
 
+	return parse(query, httpResponse)
+}
+
+func parse(query string, httpResponse *http.Response) (*transaction.Response, error) {
 	bodyBytes, e := readResponseBody(httpResponse)
 	if e != nil {
 		return nil, e
@@ -619,6 +679,11 @@ func (c Client) getTopicMessagesByQuery(query string) ([]message.Message, error)
 	return messages.Messages, nil
 }
 
+// pathAttributes returns the path of the request to the mirror node, which identifies the queried entity
+func pathAttributes(req *http.Request) []attribute.KeyValue {
+	return []attribute.KeyValue{attribute.String("url.path", req.URL.Path)}
+}
+
 func readResponseBody(response *http.Response) ([]byte, error) {
 	defer response.Body.Close()
 
@@ -31,6 +31,7 @@ import (
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/message"
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/token"
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/transaction"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
 	httpHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/http"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	testConstants "github.com/limechain/hedera-eth-bridge-validator/test/constants"
@@ -137,6 +138,17 @@ func Test_GetAccount_Status400(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func Test_GetAccount_NotFound(t *testing.T) {
+	setup()
+	response := &http.Response{
+		StatusCode: 404,
+	}
+	mocks.MHTTPClient.On("Get", mock.Anything).Return(response, nil)
+	result, err := c.GetAccount("0.0.2")
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, client.ErrNotFound)
+}
+
 func Test_GetAccount_Fails(t *testing.T) {
 	setup()
 	mocks.MHTTPClient.On("Get", mock.Anything).Return(nil, errors.New("some-error"))
@@ -278,6 +290,45 @@ func Test_GetAccountCreditTransactionsBetween(t *testing.T) {
 	assert.Equal(t, expected.Transactions[0].ConsensusTimestamp, response[0].ConsensusTimestamp)
 }
 
+func Test_GetLatestAccountCreditTransaction(t *testing.T) {
+	setup()
+
+	expected := transaction.Response{
+		Transactions: []transaction.Transaction{
+			{
+				ConsensusTimestamp: "1631092491.483966000",
+				Result:             hedera.StatusSuccess.String(),
+			},
+		},
+	}
+
+	encodedContent, err := httpHelper.EncodeBodyContent(expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mocks.MHTTPClient.On("Get", mock.Anything).Return(&http.Response{StatusCode: 200, Body: encodedContent}, nil)
+
+	response, err := c.GetLatestAccountCreditTransaction(accountId)
+	assert.Nil(t, err)
+	assert.Equal(t, expected.Transactions[0].ConsensusTimestamp, response.ConsensusTimestamp)
+}
+
+func Test_GetLatestAccountCreditTransaction_None(t *testing.T) {
+	setup()
+
+	encodedContent, err := httpHelper.EncodeBodyContent(transaction.Response{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mocks.MHTTPClient.On("Get", mock.Anything).Return(&http.Response{StatusCode: 200, Body: encodedContent}, nil)
+
+	response, err := c.GetLatestAccountCreditTransaction(accountId)
+	assert.Nil(t, err)
+	assert.Nil(t, response)
+}
+
 func Test_QueryDefaultLimit(t *testing.T) {
 	setup()
 
@@ -141,6 +141,18 @@ func (t Transaction) getIncomingNftTransferFor(account string) (serialNum int64,
 	return 0, "", errors.New("no incoming nft transfer found")
 }
 
+// GetMintedNftSerialNumber gets the serial number of the NFT of the given token, minted in the transaction.
+// Minted NFTs are transferred to the treasury account without a sender
+func (t Transaction) GetMintedNftSerialNumber(token string) (serialNum int64, isFound bool) {
+	for _, ntr := range t.NftTransfers {
+		if ntr.Token == token && ntr.SenderAccountID == "" {
+			return ntr.SerialNumber, true
+		}
+	}
+
+	return 0, false
+}
+
 // GetHBARTransfer gets the HBAR transfer for an Account
 func (t Transaction) GetHBARTransfer(account string) (amount int64, isFound bool) {
 	for _, tr := range t.Transfers {
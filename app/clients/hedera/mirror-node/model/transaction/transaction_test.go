@@ -210,3 +210,26 @@ func Test_GetTokenTransfer_NotFound(t *testing.T) {
 	assert.False(t, isFound)
 	assert.Equal(t, int64(0), actualAmount)
 }
+
+func Test_GetMintedNftSerialNumber(t *testing.T) {
+	setup()
+	transaction.NftTransfers = append(transaction.NftTransfers, NftTransfer{
+		ReceiverAccountID: nftReceiverAccountId,
+		SerialNumber:      serialNumber + 1,
+		Token:             token,
+	})
+
+	actualSerialNumber, isFound := transaction.GetMintedNftSerialNumber(token)
+
+	assert.True(t, isFound)
+	assert.Equal(t, serialNumber+1, actualSerialNumber)
+}
+
+func Test_GetMintedNftSerialNumber_NotFound(t *testing.T) {
+	setup()
+
+	actualSerialNumber, isFound := transaction.GetMintedNftSerialNumber(token)
+
+	assert.False(t, isFound)
+	assert.Equal(t, int64(0), actualSerialNumber)
+}
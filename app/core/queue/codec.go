@@ -0,0 +1,94 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package queue
+
+import (
+	"encoding/json"
+	"fmt"
+
+	big_numbers "github.com/limechain/hedera-eth-bridge-validator/app/helper/big-numbers"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/message"
+	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
+)
+
+// Payload kinds, persisted alongside the encoded payload
+const (
+	TransferKind     = "transfer"
+	TopicMessageKind = "topic_message"
+)
+
+// transfer is the encoded transfer payload. The amount is encoded as a decimal string, the way it was
+// encoded before becoming an arbitrary-precision integer, so that transfers queued before an upgrade are decoded
+type transfer struct {
+	*payload.Transfer
+	Amount string
+}
+
+type topicMessage struct {
+	Content              []byte
+	TransactionTimestamp int64
+}
+
+// Encode serialises a message payload, returning its kind and encoded bytes
+func Encode(p interface{}) (string, []byte, error) {
+	switch t := p.(type) {
+	case *payload.Transfer:
+		encoded := transfer{Transfer: t}
+		if t.Amount != nil {
+			encoded.Amount = t.Amount.String()
+		}
+		bytes, err := json.Marshal(encoded)
+		return TransferKind, bytes, err
+	case *message.Message:
+		content, err := t.ToBytes()
+		if err != nil {
+			return "", nil, err
+		}
+		bytes, err := json.Marshal(topicMessage{Content: content, TransactionTimestamp: t.TransactionTimestamp})
+		return TopicMessageKind, bytes, err
+	default:
+		return "", nil, fmt.Errorf("unsupported payload type [%T]", p)
+	}
+}
+
+// Decode deserialises a payload previously serialised by Encode
+func Decode(kind string, data []byte) (interface{}, error) {
+	switch kind {
+	case TransferKind:
+		decoded := transfer{Transfer: &payload.Transfer{}}
+		err := json.Unmarshal(data, &decoded)
+		if err != nil {
+			return nil, err
+		}
+		if decoded.Amount != "" {
+			decoded.Transfer.Amount, err = big_numbers.ToBigInt(decoded.Amount)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return decoded.Transfer, nil
+	case TopicMessageKind:
+		msg := &topicMessage{}
+		err := json.Unmarshal(data, msg)
+		if err != nil {
+			return nil, err
+		}
+		return message.FromBytesWithTS(msg.Content, msg.TransactionTimestamp)
+	default:
+		return nil, fmt.Errorf("unsupported payload kind [%s]", kind)
+	}
+}
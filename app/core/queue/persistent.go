@@ -0,0 +1,209 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package queue
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	log "github.com/sirupsen/logrus"
+)
+
+// ErrClosed is returned when pushing to a closed queue
+var ErrClosed = errors.New("queue is closed")
+
+// PersistentQueue is a database backed queue with at-least-once delivery.
+// Pushed messages are stored until they get acknowledged. Messages which are not acknowledged
+// within the visibility timeout are redelivered, and the ones exceeding the maximum attempts
+// are moved to the dead-letter table.
+type PersistentQueue struct {
+	repository        repository.Queue
+	channel           chan *Message
+	notify            chan struct{}
+	done              chan struct{}
+	once              sync.Once
+	closeOnce         sync.Once
+	pollingInterval   time.Duration
+	visibilityTimeout time.Duration
+	maxAttempts       int
+	batchSize         int
+	logger            *log.Entry
+}
+
+func NewPersistentQueue(repository repository.Queue, pollingInterval, visibilityTimeout time.Duration, maxAttempts, batchSize int, logger *log.Entry) *PersistentQueue {
+	return &PersistentQueue{
+		repository:        repository,
+		channel:           make(chan *Message),
+		notify:            make(chan struct{}, 1),
+		done:              make(chan struct{}),
+		pollingInterval:   pollingInterval,
+		visibilityTimeout: visibilityTimeout,
+		maxAttempts:       maxAttempts,
+		batchSize:         batchSize,
+		logger:            logger,
+	}
+}
+
+// Push stores the message in the database. It blocks until the message is persisted or the queue is closed,
+// in which case ErrClosed is returned
+func (pq *PersistentQueue) Push(message *Message) error {
+	kind, payload, err := Encode(message.Payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode message of topic [%s]: %w", message.Topic, err)
+	}
+
+	record := &entity.QueueMessage{
+		Topic:        message.Topic,
+		Kind:         kind,
+		Payload:      payload,
+		TraceContext: message.TraceContext,
+		VisibleAt:    time.Now().UnixNano(),
+	}
+	for {
+		err = pq.repository.Create(record)
+		if err == nil {
+			break
+		}
+		pq.logger.Errorf("Failed to persist message of topic [%s]. Retrying in [%s]. Error: [%s]", message.Topic, pq.pollingInterval, err)
+		if !pq.wait(pq.pollingInterval) {
+			return ErrClosed
+		}
+	}
+
+	select {
+	case pq.notify <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// Close stops the delivery of messages and the retries of pushes in progress.
+// Undelivered messages are kept in the database
+func (pq *PersistentQueue) Close() error {
+	pq.closeOnce.Do(func() {
+		close(pq.done)
+	})
+	return nil
+}
+
+// wait waits for the given duration. Returns false if the queue got closed in the meantime
+func (pq *PersistentQueue) wait(d time.Duration) bool {
+	select {
+	case <-pq.done:
+		return false
+	case <-time.After(d):
+		return true
+	}
+}
+
+// Channel returns the channel on which persisted messages are delivered. Delivery starts on the first call
+func (pq *PersistentQueue) Channel() chan *Message {
+	pq.once.Do(func() {
+		go pq.dispatch()
+	})
+	return pq.channel
+}
+
+// Ack removes the handled message from the database
+func (pq *PersistentQueue) Ack(message *Message) error {
+	return pq.repository.Acknowledge(message.Topic, message.ID)
+}
+
+// Nack schedules the message for redelivery with a linear backoff, or moves it to the dead-letter table
+// once it has reached the maximum delivery attempts
+func (pq *PersistentQueue) Nack(message *Message, reason error) error {
+	if message.Attempts >= pq.maxAttempts {
+		return pq.repository.MoveToDeadLetter(message.ID, reason.Error())
+	}
+
+	backoff := time.Duration(message.Attempts) * pq.pollingInterval
+	return pq.repository.Release(message.ID, time.Now().Add(backoff))
+}
+
+func (pq *PersistentQueue) dispatch() {
+	for {
+		messages, err := pq.repository.Claim(pq.batchSize, pq.visibilityTimeout)
+		if err != nil {
+			pq.logger.Errorf("Failed to claim queue messages. Error: [%s]", err)
+			if !pq.wait(pq.pollingInterval) {
+				return
+			}
+			continue
+		}
+
+		for _, m := range messages {
+			if !pq.deliver(m) {
+				return
+			}
+		}
+
+		if len(messages) == pq.batchSize {
+			continue
+		}
+
+		select {
+		case <-pq.notify:
+		case <-pq.done:
+			return
+		case <-time.After(pq.pollingInterval):
+		}
+	}
+}
+
+// deliver passes the message to the channel. Returns false if the queue got closed before the message was received.
+// Claimed, but undelivered messages are redelivered after the visibility timeout
+func (pq *PersistentQueue) deliver(record *entity.QueueMessage) bool {
+	if record.Attempts > pq.maxAttempts {
+		pq.deadLetter(record, fmt.Sprintf("exceeded maximum delivery attempts [%d]", pq.maxAttempts))
+		return true
+	}
+
+	payload, err := Decode(record.Kind, record.Payload)
+	if err != nil {
+		pq.deadLetter(record, fmt.Sprintf("failed to decode payload: %s", err))
+		return true
+	}
+
+	if record.Attempts > 1 {
+		pq.logger.Warnf("[%d] - Redelivering message of topic [%s]. Attempt [%d].", record.ID, record.Topic, record.Attempts)
+	}
+
+	message := &Message{
+		Payload:      payload,
+		Topic:        record.Topic,
+		TraceContext: record.TraceContext,
+		ID:           record.ID,
+		Attempts:     record.Attempts,
+	}
+	select {
+	case pq.channel <- message:
+		return true
+	case <-pq.done:
+		return false
+	}
+}
+
+func (pq *PersistentQueue) deadLetter(record *entity.QueueMessage, reason string) {
+	err := pq.repository.MoveToDeadLetter(record.ID, reason)
+	if err != nil {
+		pq.logger.Errorf("[%d] - Failed to move message to dead-letter. Error: [%s]", record.ID, err)
+	}
+}
@@ -0,0 +1,195 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package queue
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	persistentQueue    *PersistentQueue
+	mockRepository     *repository.MockQueueRepository
+	transferPayload    = &payload.Transfer{TransactionId: "0.0.123-123-123", Amount: big.NewInt(100)}
+	pollingInterval    = time.Millisecond
+	visibilityTimeout  = time.Minute
+	maxAttempts        = 3
+	batchSize          = 10
+	persistentTopic    = "topic"
+	traceContext       = map[string]string{"traceparent": "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"}
+	persistentMessages []*entity.QueueMessage
+)
+
+func setupPersistent() {
+	mockRepository = &repository.MockQueueRepository{}
+	persistentQueue = NewPersistentQueue(mockRepository, pollingInterval, visibilityTimeout, maxAttempts, batchSize, config.GetLoggerFor("Persistent Queue"))
+
+	_, encoded, _ := Encode(transferPayload)
+	persistentMessages = []*entity.QueueMessage{
+		{
+			ID:           1,
+			Topic:        persistentTopic,
+			Kind:         TransferKind,
+			Payload:      encoded,
+			TraceContext: traceContext,
+			Attempts:     1,
+		},
+	}
+}
+
+func Test_PersistentPush(t *testing.T) {
+	setupPersistent()
+	mockRepository.On("Create", mock.Anything).Return(nil)
+
+	err := persistentQueue.Push(&Message{Payload: transferPayload, Topic: persistentTopic, TraceContext: traceContext})
+
+	assert.Nil(t, err)
+	mockRepository.AssertCalled(t, "Create", mock.MatchedBy(func(m *entity.QueueMessage) bool {
+		return m.Topic == persistentTopic && m.Kind == TransferKind && m.TraceContext["traceparent"] == traceContext["traceparent"]
+	}))
+}
+
+func Test_PersistentPush_RetriesOnError(t *testing.T) {
+	setupPersistent()
+	mockRepository.On("Create", mock.Anything).Return(errors.New("some-error")).Once()
+	mockRepository.On("Create", mock.Anything).Return(nil).Once()
+
+	err := persistentQueue.Push(&Message{Payload: transferPayload, Topic: persistentTopic})
+
+	assert.Nil(t, err)
+	mockRepository.AssertNumberOfCalls(t, "Create", 2)
+}
+
+func Test_PersistentPush_StopsRetryingOnClose(t *testing.T) {
+	setupPersistent()
+	mockRepository.On("Create", mock.Anything).Return(errors.New("some-error"))
+
+	pushed := make(chan error)
+	go func() {
+		pushed <- persistentQueue.Push(&Message{Payload: transferPayload, Topic: persistentTopic})
+	}()
+	persistentQueue.Close()
+
+	assert.Equal(t, ErrClosed, <-pushed)
+}
+
+func Test_PersistentPush_UnsupportedPayload(t *testing.T) {
+	setupPersistent()
+
+	err := persistentQueue.Push(&Message{Payload: "unsupported", Topic: persistentTopic})
+
+	assert.NotNil(t, err)
+	mockRepository.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func Test_PersistentChannel(t *testing.T) {
+	setupPersistent()
+	mockRepository.On("Claim", batchSize, visibilityTimeout).Return(persistentMessages, nil).Once()
+	mockRepository.On("Claim", batchSize, visibilityTimeout).Return([]*entity.QueueMessage{}, nil)
+
+	actual := <-persistentQueue.Channel()
+
+	assert.Equal(t, uint64(1), actual.ID)
+	assert.Equal(t, persistentTopic, actual.Topic)
+	assert.Equal(t, 1, actual.Attempts)
+	assert.Equal(t, transferPayload, actual.Payload)
+	assert.Equal(t, traceContext, actual.TraceContext)
+	persistentQueue.Close()
+}
+
+func Test_PersistentDeliver_Closed(t *testing.T) {
+	setupPersistent()
+	persistentQueue.Close()
+
+	assert.False(t, persistentQueue.deliver(persistentMessages[0]))
+}
+
+func Test_PersistentAck(t *testing.T) {
+	setupPersistent()
+	mockRepository.On("Acknowledge", persistentTopic, uint64(1)).Return(nil)
+
+	err := persistentQueue.Ack(&Message{Payload: transferPayload, Topic: persistentTopic, ID: 1, Attempts: 1})
+
+	assert.Nil(t, err)
+}
+
+func Test_PersistentNack_Releases(t *testing.T) {
+	setupPersistent()
+	mockRepository.On("Release", uint64(1), mock.Anything).Return(nil)
+
+	err := persistentQueue.Nack(&Message{Payload: transferPayload, Topic: persistentTopic, ID: 1, Attempts: 1}, errors.New("some-error"))
+
+	assert.Nil(t, err)
+	mockRepository.AssertNotCalled(t, "MoveToDeadLetter", mock.Anything, mock.Anything)
+}
+
+func Test_PersistentNack_DeadLetters(t *testing.T) {
+	setupPersistent()
+	mockRepository.On("MoveToDeadLetter", uint64(1), "some-error").Return(nil)
+
+	err := persistentQueue.Nack(&Message{Payload: transferPayload, Topic: persistentTopic, ID: 1, Attempts: maxAttempts}, errors.New("some-error"))
+
+	assert.Nil(t, err)
+	mockRepository.AssertNotCalled(t, "Release", mock.Anything, mock.Anything)
+}
+
+func Test_PersistentDeliver_ExceededAttempts(t *testing.T) {
+	setupPersistent()
+	persistentMessages[0].Attempts = maxAttempts + 1
+	mockRepository.On("MoveToDeadLetter", persistentMessages[0].ID, mock.Anything).Return(nil)
+
+	persistentQueue.deliver(persistentMessages[0])
+
+	mockRepository.AssertCalled(t, "MoveToDeadLetter", persistentMessages[0].ID, mock.Anything)
+}
+
+func Test_PersistentDeliver_InvalidPayload(t *testing.T) {
+	setupPersistent()
+	persistentMessages[0].Kind = "unknown"
+	mockRepository.On("MoveToDeadLetter", persistentMessages[0].ID, mock.Anything).Return(nil)
+
+	persistentQueue.deliver(persistentMessages[0])
+
+	mockRepository.AssertCalled(t, "MoveToDeadLetter", persistentMessages[0].ID, mock.Anything)
+}
+
+func Test_DecodeTransfer(t *testing.T) {
+	large, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	_, encoded, err := Encode(&payload.Transfer{TransactionId: "0.0.123-123-123", Amount: large})
+	assert.Nil(t, err)
+
+	decoded, err := Decode(TransferKind, encoded)
+
+	assert.Nil(t, err)
+	assert.Equal(t, large, decoded.(*payload.Transfer).Amount)
+}
+
+func Test_DecodeTransfer_StringAmount(t *testing.T) {
+	decoded, err := Decode(TransferKind, []byte(`{"TransactionId":"0.0.123-123-123","Amount":"100"}`))
+
+	assert.Nil(t, err)
+	assert.Equal(t, transferPayload, decoded)
+}
@@ -19,6 +19,11 @@ package queue
 type Message struct {
 	Payload interface{}
 	Topic   string
+	// TraceContext carries the trace, in which the payload was produced, to its handler
+	TraceContext map[string]string
+	// ID and Attempts are set only for messages delivered by a persistent queue
+	ID       uint64
+	Attempts int
 }
 
 // Queue is a wrapper of a go channel, particularly to restrict actions on the channel itself
@@ -27,8 +32,14 @@ type Queue struct {
 }
 
 // Push pushes a message to the channel
-func (q *Queue) Push(message *Message) {
+func (q *Queue) Push(message *Message) error {
 	q.channel <- message
+	return nil
+}
+
+// Close does nothing, as the messages of an in-memory queue are lost on shutdown anyway
+func (q *Queue) Close() error {
+	return nil
 }
 
 func (q *Queue) Channel() chan *Message {
@@ -0,0 +1,69 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"context"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	syncHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/sync"
+)
+
+// controlledWatcher passes its gate to the watcher through the context, so that the watcher
+// blocks at the start of its next iteration while paused
+type controlledWatcher struct {
+	Watcher
+	gate *syncHelper.Gate
+}
+
+func (cw *controlledWatcher) Watch(ctx context.Context, queue queue.Queue) {
+	cw.Watcher.Watch(syncHelper.WithGate(ctx, cw.gate), queue)
+}
+
+// Watchers returns whether each controlled watcher is paused
+func (s *Server) Watchers() map[string]bool {
+	watchers := make(map[string]bool, len(s.gates))
+	for id, gate := range s.gates {
+		watchers[id] = gate.Paused()
+	}
+	return watchers
+}
+
+// PauseWatcher pauses the controlled watcher with the given ID. Returns service.ErrNotFound for unknown watchers
+func (s *Server) PauseWatcher(id string) error {
+	gate, ok := s.gates[id]
+	if !ok {
+		return service.ErrNotFound
+	}
+
+	gate.Pause()
+	s.logger.Infof("Paused watcher [%s]", id)
+	return nil
+}
+
+// ResumeWatcher resumes the controlled watcher with the given ID. Returns service.ErrNotFound for unknown watchers
+func (s *Server) ResumeWatcher(id string) error {
+	gate, ok := s.gates[id]
+	if !ok {
+		return service.ErrNotFound
+	}
+
+	gate.Resume()
+	s.logger.Infof("Resumed watcher [%s]", id)
+	return nil
+}
@@ -0,0 +1,82 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	syncHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/sync"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var watcherId = "0.0.1"
+
+func Test_AddControlledWatcher(t *testing.T) {
+	setup()
+
+	server.AddControlledWatcher(watcherId, mocks.MWatcher)
+
+	assert.Len(t, server.watchers, 1)
+	assert.Equal(t, map[string]bool{watcherId: false}, server.Watchers())
+}
+
+func Test_ControlledWatcher_PassesGate(t *testing.T) {
+	setup()
+	server.AddControlledWatcher(watcherId, mocks.MWatcher)
+	mocks.MWatcher.On("Watch", mock.Anything, queueInstance).Return()
+	assert.Nil(t, server.PauseWatcher(watcherId))
+
+	server.watchers[0].Watch(context.Background(), queueInstance)
+
+	ctx := mocks.MWatcher.Calls[0].Arguments.Get(0).(context.Context)
+	released := make(chan bool)
+	go func() {
+		released <- syncHelper.WaitIfPaused(ctx)
+	}()
+
+	select {
+	case <-released:
+		t.Fatal("Watcher was not paused")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	assert.Nil(t, server.ResumeWatcher(watcherId))
+	assert.True(t, <-released)
+}
+
+func Test_PauseAndResumeWatcher(t *testing.T) {
+	setup()
+	server.AddControlledWatcher(watcherId, mocks.MWatcher)
+
+	assert.Nil(t, server.PauseWatcher(watcherId))
+	assert.True(t, server.Watchers()[watcherId])
+
+	assert.Nil(t, server.ResumeWatcher(watcherId))
+	assert.False(t, server.Watchers()[watcherId])
+}
+
+func Test_PauseWatcher_Unknown(t *testing.T) {
+	setup()
+
+	assert.Equal(t, service.ErrNotFound, server.PauseWatcher(watcherId))
+	assert.Equal(t, service.ErrNotFound, server.ResumeWatcher(watcherId))
+}
@@ -0,0 +1,94 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"sync"
+
+	q "github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// pool is a bounded set of workers handling the messages of a single topic
+type pool struct {
+	topic       string
+	concurrency int
+	messages    chan *q.Message
+	depth       prometheus.Gauge
+	inFlight    prometheus.Gauge
+	workers     sync.WaitGroup
+}
+
+// newPool creates a pool with the given number of workers and a buffer of `size` pending messages.
+// The gauge vectors are nil if monitoring is disabled
+func newPool(topic string, concurrency, size int, depth, inFlight *prometheus.GaugeVec) *pool {
+	p := &pool{
+		topic:       topic,
+		concurrency: concurrency,
+		messages:    make(chan *q.Message, size),
+	}
+
+	if depth != nil {
+		p.depth = depth.WithLabelValues(topic)
+	}
+	if inFlight != nil {
+		p.inFlight = inFlight.WithLabelValues(topic)
+	}
+
+	return p
+}
+
+// push adds the message to the pool, blocking while the pool is full
+func (p *pool) push(message *q.Message) {
+	if p.depth != nil {
+		p.depth.Inc()
+	}
+	p.messages <- message
+}
+
+func (p *pool) start(handle func(message *q.Message)) {
+	p.workers.Add(p.concurrency)
+	for i := 0; i < p.concurrency; i++ {
+		go p.work(handle)
+	}
+}
+
+// stop lets the workers handle the pending messages and waits for them to exit.
+// No messages can be pushed once the pool is stopped
+func (p *pool) stop() {
+	close(p.messages)
+	p.workers.Wait()
+}
+
+func (p *pool) work(handle func(message *q.Message)) {
+	defer p.workers.Done()
+
+	for message := range p.messages {
+		if p.depth != nil {
+			p.depth.Dec()
+		}
+		if p.inFlight != nil {
+			p.inFlight.Inc()
+		}
+
+		handle(message)
+
+		if p.inFlight != nil {
+			p.inFlight.Dec()
+		}
+	}
+}
@@ -0,0 +1,76 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package server
+
+import (
+	"testing"
+
+	q "github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewPool(t *testing.T) {
+	p := newPool("topic", 3, 5, nil, nil)
+
+	assert.Equal(t, "topic", p.topic)
+	assert.Equal(t, 3, p.concurrency)
+	assert.Equal(t, 5, cap(p.messages))
+	assert.Nil(t, p.depth)
+	assert.Nil(t, p.inFlight)
+}
+
+func Test_Pool_BoundsConcurrency(t *testing.T) {
+	depth := prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "depth"}, []string{"topic"})
+	inFlight := prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "in_flight"}, []string{"topic"})
+	p := newPool("topic", 2, 2, depth, inFlight)
+
+	release := make(chan struct{})
+	started := make(chan struct{}, 4)
+	p.start(func(message *q.Message) {
+		started <- struct{}{}
+		<-release
+	})
+
+	for i := 0; i < 4; i++ {
+		p.push(&q.Message{Topic: "topic"})
+	}
+	<-started
+	<-started
+
+	assert.Equal(t, float64(2), testutil.ToFloat64(inFlight.WithLabelValues("topic")))
+	assert.Equal(t, float64(2), testutil.ToFloat64(depth.WithLabelValues("topic")))
+
+	close(release)
+}
+
+func Test_Pool_StopHandlesPendingMessages(t *testing.T) {
+	p := newPool("topic", 1, 3, nil, nil)
+
+	handled := 0
+	p.start(func(message *q.Message) {
+		handled++
+	})
+	for i := 0; i < 3; i++ {
+		p.push(&q.Message{Topic: "topic"})
+	}
+
+	p.stop()
+
+	assert.Equal(t, 3, handled)
+}
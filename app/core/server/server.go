@@ -17,34 +17,67 @@
 package server
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
 	"github.com/go-chi/chi"
 	q "github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	syncHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/sync"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/tracing"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/prometheus/client_golang/prometheus"
 	log "github.com/sirupsen/logrus"
-	"net/http"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
+// The time given to the HTTP server to finish serving the active requests on shutdown
+const httpShutdownTimeout = 5 * time.Second
+
+// Watcher produces messages until the context is cancelled
 type Watcher interface {
-	Watch(queue queue.Queue)
+	Watch(ctx context.Context, queue queue.Queue)
 }
 
+// Handler handles messages of a given topic. The context is cancelled if the handler
+// does not finish within the shutdown timeout. Messages, whose handling returns an error, are redelivered
+// by queues requiring acknowledgement
 type Handler interface {
-	Handle(interface{})
+	Handle(ctx context.Context, payload interface{}) error
 }
 
 type Server struct {
-	logger   *log.Entry
-	watchers []Watcher
-	handlers map[string]Handler
-	queue    queue.Queue
+	logger            *log.Entry
+	watchers          []Watcher
+	gates             map[string]*syncHelper.Gate
+	handlers          map[string]Handler
+	pools             map[string]*pool
+	shutdownHooks     []func() error
+	stopDispatch      chan struct{}
+	dispatchStopped   chan struct{}
+	queue             queue.Queue
+	workers           config.Workers
+	prometheusService service.Prometheus
 }
 
-func NewServer() *Server {
+func NewServer(queue queue.Queue, workers config.Workers, prometheusService service.Prometheus) *Server {
 	return &Server{
-		logger:   config.GetLoggerFor("Server"),
-		handlers: make(map[string]Handler),
-		queue:    q.NewQueue(),
+		logger:            config.GetLoggerFor("Server"),
+		handlers:          make(map[string]Handler),
+		gates:             make(map[string]*syncHelper.Gate),
+		pools:             make(map[string]*pool),
+		stopDispatch:      make(chan struct{}),
+		dispatchStopped:   make(chan struct{}),
+		queue:             queue,
+		workers:           workers,
+		prometheusService: prometheusService,
 	}
 }
 
@@ -52,21 +85,216 @@ func (s *Server) AddWatcher(watcher Watcher) {
 	s.watchers = append(s.watchers, watcher)
 }
 
+// AddControlledWatcher adds a watcher, which can be paused and resumed by the given ID
+func (s *Server) AddControlledWatcher(id string, watcher Watcher) {
+	gate := syncHelper.NewGate()
+	s.gates[id] = gate
+	s.watchers = append(s.watchers, &controlledWatcher{Watcher: watcher, gate: gate})
+}
+
 func (s *Server) AddHandler(topic string, handler Handler) {
 	s.handlers[topic] = handler
 }
 
-// Run starts every handler and watcher, serving the chi.Mux on a given port
-func (s *Server) Run(chi *chi.Mux, port string) {
+// AddShutdownHook registers a function executed on shutdown, once the handlers have finished
+func (s *Server) AddShutdownHook(hook func() error) {
+	s.shutdownHooks = append(s.shutdownHooks, hook)
+}
+
+// Run starts every handler and watcher, serving the chi.Mux on a given port until the context is cancelled.
+// Afterwards, it shuts down the server gracefully
+func (s *Server) Run(ctx context.Context, chi *chi.Mux, port string) {
+	handlersCtx, cancelHandlers := context.WithCancel(context.Background())
+	defer cancelHandlers()
+
+	s.startPools(handlersCtx)
+	go s.dispatch()
+
+	for _, watcher := range s.watchers {
+		go watcher.Watch(ctx, s.queue)
+	}
+
+	httpServer := &http.Server{Addr: port, Handler: chi}
 	go func() {
-		for message := range s.queue.Channel() {
-			go s.handlers[message.Topic].Handle(message.Payload)
+		s.logger.Infof("Listening on port [%s]", port)
+		err := httpServer.ListenAndServe()
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.logger.Fatal(err)
 		}
 	}()
 
-	for _, watcher := range s.watchers {
-		go watcher.Watch(s.queue)
+	<-ctx.Done()
+	s.shutdown(httpServer, cancelHandlers)
+}
+
+// shutdown stops the dispatching of messages and waits for the workers to handle the messages
+// already passed to them. Handlers which do not finish within the shutdown timeout get cancelled.
+// Afterwards, the shutdown hooks are executed and the HTTP server is stopped.
+// The watchers are expected to stop by themselves, as their context is already cancelled.
+func (s *Server) shutdown(httpServer *http.Server, cancelHandlers context.CancelFunc) {
+	timeout := s.workers.ShutdownTimeout * time.Second
+	s.logger.Infof("Shutting down. Waiting up to [%s] for in-flight handlers to finish ...", timeout)
+
+	drained := make(chan struct{})
+	go func() {
+		close(s.stopDispatch)
+		<-s.dispatchStopped
+		for _, p := range s.pools {
+			p.stop()
+		}
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+		s.logger.Infof("All handlers finished.")
+	case <-time.After(timeout):
+		s.logger.Warnf("Handlers did not finish within [%s]. Cancelling them.", timeout)
+		cancelHandlers()
+	}
+
+	for _, hook := range s.shutdownHooks {
+		err := hook()
+		if err != nil {
+			s.logger.Errorf("Failed to execute shutdown hook. Error: [%s]", err)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), httpShutdownTimeout)
+	defer cancel()
+	err := httpServer.Shutdown(ctx)
+	if err != nil {
+		s.logger.Errorf("Failed to shutdown HTTP server. Error: [%s]", err)
+	}
+	s.logger.Infof("Shutdown completed.")
+}
+
+// startPools starts a bounded pool of workers for every registered handler topic
+func (s *Server) startPools(ctx context.Context) {
+	depth := s.prometheusService.CreateGaugeVecIfNotExists(prometheus.GaugeOpts{
+		Name: constants.QueueDepthGaugeName,
+		Help: constants.QueueDepthGaugeHelp,
+	}, []string{constants.TopicMetricLabelKey})
+	inFlight := s.prometheusService.CreateGaugeVecIfNotExists(prometheus.GaugeOpts{
+		Name: constants.HandlersInFlightGaugeName,
+		Help: constants.HandlersInFlightGaugeHelp,
+	}, []string{constants.TopicMetricLabelKey})
+
+	for topic, handler := range s.handlers {
+		p := newPool(topic, s.workers.ConcurrencyFor(topic), s.workers.QueueSize, depth, inFlight)
+		handler := handler
+		p.start(func(message *q.Message) {
+			s.handle(ctx, handler, message)
+		})
+		s.pools[topic] = p
+		s.logger.Debugf("Started [%d] workers for topic [%s]", p.concurrency, topic)
+	}
+}
+
+// dispatch passes every queued message to the pool of its topic. Once a pool is full, dispatching blocks
+// until a worker frees up, which in turn blocks the watchers pushing to the queue.
+// On stop, the messages already waiting in the queue are dispatched as well.
+func (s *Server) dispatch() {
+	defer close(s.dispatchStopped)
+
+	channel := s.queue.Channel()
+	for {
+		select {
+		case message := <-channel:
+			s.route(message)
+		case <-s.stopDispatch:
+			s.drain(channel)
+			return
+		}
+	}
+}
+
+// drain dispatches the messages which are ready to be received from the queue without waiting for new ones.
+// Persistent queues are not drained, as their undelivered messages are kept in the database
+func (s *Server) drain(channel chan *q.Message) {
+	if _, ok := s.queue.(queue.Acknowledger); ok {
+		return
+	}
+
+	for {
+		select {
+		case message := <-channel:
+			s.route(message)
+		default:
+			return
+		}
+	}
+}
+
+func (s *Server) route(message *q.Message) {
+	p, exists := s.pools[message.Topic]
+	if !exists {
+		s.reject(message, fmt.Errorf("no handler registered for topic [%s]", message.Topic))
+		return
+	}
+	p.push(message)
+}
+
+// handle passes the message to the handler, within a span continuing the trace of the message. If the queue requires
+// acknowledgement, the message is acknowledged once handled and returned to the queue if the handler fails or panics,
+// so that it is redelivered with a backoff until it reaches the maximum delivery attempts.
+// Messages of cancelled handlers are not acknowledged, so that they get redelivered after the visibility timeout.
+func (s *Server) handle(ctx context.Context, handler Handler, message *q.Message) {
+	ctx, span := tracing.Tracer().Start(tracing.Extract(ctx, message.TraceContext), "handle "+message.Topic,
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(tracing.TopicKey.String(message.Topic)))
+	defer span.End()
+	if message.Attempts > 0 {
+		span.SetAttributes(attribute.Int("bridge.queue.attempts", message.Attempts))
+	}
+
+	acknowledger, ok := s.queue.(queue.Acknowledger)
+	if !ok {
+		err := handler.Handle(ctx, message.Payload)
+		if err != nil {
+			span.SetStatus(codes.Error, err.Error())
+			s.reject(message, err)
+		}
+		return
+	}
+
+	var err error
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("handler panicked: %v", r)
+		}
+
+		if ctx.Err() != nil {
+			// the handler got cancelled on shutdown, so the message is left to be redelivered
+			s.logger.Warnf("[%d] - Handling of message of topic [%s] got cancelled.", message.ID, message.Topic)
+			return
+		}
+
+		if err != nil {
+			span.SetStatus(codes.Error, err.Error())
+			s.reject(message, err)
+			return
+		}
+
+		err = acknowledger.Ack(message)
+		if err != nil {
+			s.logger.Errorf("[%d] - Failed to acknowledge message of topic [%s]. Error: [%s]", message.ID, message.Topic, err)
+		}
+	}()
+
+	err = handler.Handle(ctx, message.Payload)
+}
+
+func (s *Server) reject(message *q.Message, reason error) {
+	s.logger.Errorf("[%d] - Failed to handle message of topic [%s]. Error: [%s]", message.ID, message.Topic, reason)
+
+	acknowledger, ok := s.queue.(queue.Acknowledger)
+	if !ok {
+		return
+	}
+
+	err := acknowledger.Nack(message, reason)
+	if err != nil {
+		s.logger.Errorf("[%d] - Failed to return message of topic [%s] to the queue. Error: [%s]", message.ID, message.Topic, err)
 	}
-	s.logger.Infof("Listening on port [%s]", port)
-	s.logger.Fatal(http.ListenAndServe(port, chi))
 }
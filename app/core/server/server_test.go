@@ -17,13 +17,24 @@
 package server
 
 import (
+	"context"
+	"errors"
+	"net/http"
+
 	q "github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
+	syncHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/sync"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/tracing"
+	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/limechain/hedera-eth-bridge-validator/test/helper"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.opentelemetry.io/otel/trace"
 	"testing"
+	"time"
 )
 
 var (
@@ -31,16 +42,24 @@ var (
 	queueInstance queue.Queue
 	handlerTopic  = constants.TopicMessageSubmission
 	port          = ":8000"
+	workers       = config.Workers{
+		DefaultConcurrency: 2,
+		QueueSize:          1,
+		Concurrency:        map[string]int{constants.TopicMessageValidation: 4},
+	}
 )
 
 func Test_NewServer(t *testing.T) {
 	setup()
 
-	actualServer := NewServer()
+	actualServer := NewServer(queueInstance, workers, mocks.MPrometheusService)
 
 	assert.Equal(t, server.logger, actualServer.logger)
 	assert.Equal(t, server.handlers, actualServer.handlers)
 	assert.Equal(t, server.watchers, actualServer.watchers)
+	assert.Equal(t, server.gates, actualServer.gates)
+	assert.Equal(t, server.queue, actualServer.queue)
+	assert.Equal(t, server.workers, actualServer.workers)
 }
 
 func Test_AddWatcher(t *testing.T) {
@@ -61,13 +80,165 @@ func Test_AddHandler(t *testing.T) {
 	assert.Equal(t, server.handlers[handlerTopic], mocks.MHandler)
 }
 
+func Test_StartPools(t *testing.T) {
+	setup()
+	server.AddHandler(handlerTopic, mocks.MHandler)
+	server.AddHandler(constants.TopicMessageValidation, mocks.MHandler)
+
+	server.startPools(context.Background())
+
+	assert.Len(t, server.pools, 2)
+	assert.Equal(t, 2, server.pools[handlerTopic].concurrency)
+	assert.Equal(t, 4, server.pools[constants.TopicMessageValidation].concurrency)
+	assert.Equal(t, 1, cap(server.pools[handlerTopic].messages))
+}
+
+func Test_Dispatch(t *testing.T) {
+	setup()
+	handled := make(chan interface{}, 1)
+	mocks.MHandler.On("Handle", mock.Anything, "payload").Run(func(args mock.Arguments) {
+		handled <- args.Get(1)
+	}).Return(nil)
+	server.AddHandler(handlerTopic, mocks.MHandler)
+	server.startPools(context.Background())
+	go server.dispatch()
+
+	server.queue.Push(&q.Message{Payload: "payload", Topic: handlerTopic})
+
+	select {
+	case actual := <-handled:
+		assert.Equal(t, "payload", actual)
+	case <-time.After(time.Second):
+		t.Fatal("message was not handled")
+	}
+}
+
+func Test_Shutdown(t *testing.T) {
+	setup()
+	server.workers.ShutdownTimeout = 1
+	release := make(chan struct{})
+	handled := make(chan interface{}, 2)
+	mocks.MHandler.On("Handle", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		<-release
+		handled <- args.Get(1)
+	}).Return(nil)
+	hookExecuted := false
+	server.AddShutdownHook(func() error {
+		hookExecuted = true
+		return nil
+	})
+	server.AddHandler(handlerTopic, mocks.MHandler)
+	server.startPools(context.Background())
+	go server.dispatch()
+
+	server.queue.Push(&q.Message{Payload: "first", Topic: handlerTopic})
+	server.queue.Push(&q.Message{Payload: "second", Topic: handlerTopic})
+	close(release)
+
+	server.shutdown(&http.Server{}, func() {})
+
+	assert.Len(t, handled, 2)
+	assert.True(t, hookExecuted)
+}
+
+func Test_Shutdown_CancelsHandlersAfterTimeout(t *testing.T) {
+	setup()
+	server.workers.ShutdownTimeout = 0
+	started := make(chan struct{})
+	mocks.MHandler.On("Handle", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		close(started)
+		<-args.Get(0).(context.Context).Done()
+	}).Return(nil)
+	handlersCtx, cancelHandlers := context.WithCancel(context.Background())
+	server.AddHandler(handlerTopic, mocks.MHandler)
+	server.startPools(handlersCtx)
+	go server.dispatch()
+
+	server.queue.Push(&q.Message{Payload: "payload", Topic: handlerTopic})
+	<-started
+
+	server.shutdown(&http.Server{}, cancelHandlers)
+
+	assert.NotNil(t, handlersCtx.Err())
+}
+
+func Test_Handle_ContinuesTrace(t *testing.T) {
+	setup()
+	exporter := helper.SetupTracing(t)
+	var handlerCtx context.Context
+	mocks.MHandler.On("Handle", mock.Anything, "payload").Run(func(args mock.Arguments) {
+		handlerCtx = args.Get(0).(context.Context)
+	}).Return(nil)
+	watcherCtx, discovered := tracing.Start(context.Background(), "discovered")
+	discovered.End()
+
+	server.handle(context.Background(), mocks.MHandler, &q.Message{Payload: "payload", Topic: handlerTopic, TraceContext: tracing.Inject(watcherCtx)})
+
+	spans := exporter.GetSpans()
+	assert.Len(t, spans, 2)
+	assert.Equal(t, "handle "+handlerTopic, spans[1].Name)
+	assert.Equal(t, trace.SpanKindConsumer, spans[1].SpanKind)
+	assert.Equal(t, discovered.SpanContext().SpanID(), spans[1].Parent.SpanID())
+	assert.Equal(t, spans[1].SpanContext, trace.SpanContextFromContext(handlerCtx))
+}
+
+func Test_Handle_AcknowledgesHandled(t *testing.T) {
+	setup()
+	server.queue = persistentQueue()
+	mocks.MHandler.On("Handle", mock.Anything, "payload").Return(nil)
+	mocks.MQueueRepository.On("Acknowledge", handlerTopic, uint64(1)).Return(nil)
+
+	server.handle(context.Background(), mocks.MHandler, &q.Message{Payload: "payload", Topic: handlerTopic, ID: 1, Attempts: 1})
+
+	mocks.MQueueRepository.AssertCalled(t, "Acknowledge", handlerTopic, uint64(1))
+	mocks.MQueueRepository.AssertNotCalled(t, "Release", mock.Anything, mock.Anything)
+}
+
+func Test_Handle_ReleasesFailed(t *testing.T) {
+	setup()
+	server.queue = persistentQueue()
+	mocks.MHandler.On("Handle", mock.Anything, "payload").Return(errors.New("some-error"))
+	mocks.MQueueRepository.On("Release", uint64(1), mock.Anything).Return(nil)
+
+	server.handle(context.Background(), mocks.MHandler, &q.Message{Payload: "payload", Topic: handlerTopic, ID: 1, Attempts: 1})
+
+	mocks.MQueueRepository.AssertCalled(t, "Release", uint64(1), mock.Anything)
+	mocks.MQueueRepository.AssertNotCalled(t, "Acknowledge", mock.Anything, mock.Anything)
+}
+
+func Test_Handle_DeadLettersFailedAtMaxAttempts(t *testing.T) {
+	setup()
+	server.queue = persistentQueue()
+	transfer := &payload.Transfer{TransactionId: "0.0.1-1"}
+	mocks.MHandler.On("Handle", mock.Anything, transfer).Return(errors.New("some-error"))
+	mocks.MQueueRepository.On("MoveToDeadLetter", mock.Anything, "some-error").Return(nil)
+
+	server.handle(context.Background(), mocks.MHandler, &q.Message{Payload: transfer, Topic: handlerTopic, ID: 1, Attempts: 3})
+
+	mocks.MQueueRepository.AssertCalled(t, "MoveToDeadLetter", mock.Anything, "some-error")
+	mocks.MQueueRepository.AssertNotCalled(t, "Release", mock.Anything, mock.Anything)
+}
+
+// persistentQueue returns a queue, requiring acknowledgement, backed by the mocked repository
+func persistentQueue() queue.Queue {
+	return q.NewPersistentQueue(mocks.MQueueRepository, time.Millisecond, time.Second, 3, 10, config.GetLoggerFor("Persistent Queue"))
+}
+
 func setup() {
 	mocks.Setup()
 	queueInstance = q.NewQueue()
 
+	mocks.MPrometheusService.On("CreateGaugeVecIfNotExists", mock.Anything, mock.Anything).Return(nil)
+
 	server = &Server{
-		logger:   config.GetLoggerFor("Server"),
-		handlers: make(map[string]Handler),
-		queue:    queueInstance,
+		logger:            config.GetLoggerFor("Server"),
+		handlers:          make(map[string]Handler),
+		gates:             make(map[string]*syncHelper.Gate),
+		pools:             make(map[string]*pool),
+		stopDispatch:      make(chan struct{}),
+		dispatchStopped:   make(chan struct{}),
+		queue:             queueInstance,
+		workers:           workers,
+		prometheusService: mocks.MPrometheusService,
 	}
 }
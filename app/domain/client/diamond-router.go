@@ -34,6 +34,9 @@ type DiamondRouter interface {
 	ParseLock(log types.Log) (*router.RouterLock, error)
 	ParseUnlock(log types.Log) (*router.RouterUnlock, error)
 	ParseBurnERC721(log types.Log) (*router.RouterBurnERC721, error)
+	ParseLockERC721(log types.Log) (*router.RouterLockERC721, error)
+	ParseUnlockERC721(log types.Log) (*router.RouterUnlockERC721, error)
+	ParseMintERC721(log types.Log) (*router.RouterMintERC721, error)
 	WatchBurn(opts *bind.WatchOpts, sink chan<- *router.RouterBurn) (event.Subscription, error)
 	MembersCount(opts *bind.CallOpts) (*big.Int, error)
 	MemberAt(opts *bind.CallOpts, _index *big.Int) (common.Address, error)
@@ -45,14 +45,26 @@ type EVM interface {
 	// GetPrivateKey retrieves private key used for the specific EVM Client
 	GetPrivateKey() string
 	BlockConfirmations() uint64
+	// Nodes returns the clients of each of the configured node URLs, keyed by the host of the URL.
+	// The host leaves out any API key, sent in the path or the query of the URL
+	Nodes() map[string]EVM
 	// RetryBlockNumber returns the most recent block number
 	// Uses a retry mechanism in case the filter query is stuck
-	RetryBlockNumber() (uint64, error)
+	RetryBlockNumber(ctx context.Context) (uint64, error)
+	// RetryConfirmedBlockNumber returns the most recent block number considered final,
+	// either by the configured `safe`/`finalized` tag or by the block confirmations
+	// Uses a retry mechanism in case the query is stuck
+	RetryConfirmedBlockNumber(ctx context.Context) (uint64, error)
+	// RetryHeaderByNumber returns the header of the given block
+	// Uses a retry mechanism in case the query is stuck
+	RetryHeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
 	// RetryFilterLogs returns the logs from the input query
 	// Uses a retry mechanism in case the filter query is stuck
-	RetryFilterLogs(query ethereum.FilterQuery) ([]types.Log, error)
+	RetryFilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error)
+	// SubscribeNewHead subscribes to new chain heads over the configured WebSocket endpoint
+	SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error)
 	// WaitForTransactionReceipt Polls the provided hash every 5 seconds until the transaction mined (either successfully or reverted)
 	WaitForTransactionReceipt(hash common.Hash) (txReceipt *types.Receipt, err error)
 
-	RetryTransactionByHash(hash common.Hash) (*types.Transaction, error)
+	RetryTransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, error)
 }
@@ -17,6 +17,8 @@
 package client
 
 import (
+	"context"
+	"errors"
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/account"
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/message"
@@ -25,6 +27,9 @@ import (
 	"github.com/shopspring/decimal"
 )
 
+// ErrNotFound is returned by the mirror node, when the queried entity does not exist
+var ErrNotFound = errors.New("not found on the mirror node")
+
 type MirrorNode interface {
 	// GetAccountTokenMintTransactionsAfterTimestampString queries the hedera mirror node for transactions on a certain account with type TokenMint
 	GetAccountTokenMintTransactionsAfterTimestampString(accountId hedera.AccountID, from string) (*transaction.Response, error)
@@ -42,6 +47,8 @@ type MirrorNode interface {
 	GetAccountCreditTransactionsAfterTimestamp(accountId hedera.AccountID, from int64) (*transaction.Response, error)
 	// GetAccountCreditTransactionsBetween returns all incoming Transfers for the specified account between timestamp `from` and `to` excluded
 	GetAccountCreditTransactionsBetween(accountId hedera.AccountID, from, to int64) ([]transaction.Transaction, error)
+	// GetLatestAccountCreditTransaction returns the latest incoming Transfer for the specified account or nil if there is none
+	GetLatestAccountCreditTransaction(accountId hedera.AccountID) (*transaction.Transaction, error)
 	// GetTransactionsAfterTimestamp returns all transaction after a given timestamp for the specified account and transaction type
 	GetTransactionsAfterTimestamp(accountId hedera.AccountID, startTimestamp int64, transactionType string) ([]transaction.Transaction, error)
 	// GetMessagesAfterTimestamp returns all topic messages after the given timestamp
@@ -69,7 +76,7 @@ type MirrorNode interface {
 	GetNft(tokenID string, serialNum int64) (*transaction.Nft, error)
 	// AccountExists sends a query to check whether a specific account exists. If the query returns a status != 200, the function returns a false value
 	AccountExists(accountID hedera.AccountID) bool
-	// GetAccount gets the account data by ID.
+	// GetAccount gets the account data by ID, alias or EVM address. Returns ErrNotFound if there is no such account
 	GetAccount(accountID string) (*account.AccountsResponse, error)
 	// GetAccountByPublicKey gets the account data by public key
 	GetAccountByPublicKey(publicKey string) (*account.AccountsQueryResponse, error)
@@ -78,11 +85,14 @@ type MirrorNode interface {
 	// TopicExists sends a query to check whether a specific topic exists. If the query returns a status != 200, the function returns a false value
 	TopicExists(topicID hedera.TopicID) bool
 	// WaitForTransaction Polls the transaction at intervals. Depending on the
-	// result, the corresponding `onSuccess` and `onFailure` functions are called
-	WaitForTransaction(txId string, onSuccess, onFailure func())
+	// result, the corresponding `onSuccess` and `onFailure` functions are called.
+	// The polling is traced within the span in the context, if any
+	WaitForTransaction(ctx context.Context, txId string, onSuccess, onFailure func())
 	// WaitForScheduledTransaction Polls the transaction at intervals. Depending on the
-	// result, the corresponding `onSuccess` and `onFailure` functions are called
-	WaitForScheduledTransaction(txId string, onSuccess, onFailure func())
+	// result, the corresponding `onSuccess` and `onFailure` functions are called.
+	// `onFailure` is called with the result of the scheduled transaction.
+	// The polling is traced within the span in the context, if any
+	WaitForScheduledTransaction(ctx context.Context, txId string, onSuccess func(), onFailure func(result string))
 	// GetHBARUsdPrice Returns USD price for HBAR
 	GetHBARUsdPrice() (price decimal.Decimal, err error)
 	// QueryDefaultLimit returns the default records limit per query
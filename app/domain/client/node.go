@@ -17,6 +17,7 @@
 package client
 
 import (
+	"context"
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 )
@@ -26,21 +27,27 @@ type HederaNode interface {
 	GetClient() *hedera.Client
 	// SubmitTopicConsensusMessage submits the provided message bytes to the
 	// specified HCS `topicId`
-	SubmitTopicConsensusMessage(topicId hedera.TopicID, message []byte) (*hedera.TransactionID, error)
+	SubmitTopicConsensusMessage(ctx context.Context, topicId hedera.TopicID, message []byte) (*hedera.TransactionID, error)
 	// SubmitScheduledTokenTransferTransaction creates a token transfer transaction and submits it as a scheduled transaction
-	SubmitScheduledTokenTransferTransaction(tokenID hedera.TokenID, transfers []transfer.Hedera, payerAccountID hedera.AccountID, memo string) (*hedera.TransactionResponse, error)
+	SubmitScheduledTokenTransferTransaction(ctx context.Context, tokenID hedera.TokenID, transfers []transfer.Hedera, payerAccountID hedera.AccountID, memo string) (*hedera.TransactionResponse, error)
 	// SubmitScheduledHbarTransferTransaction creates an hbar transfer transaction and submits it as a scheduled transfer transaction
-	SubmitScheduledHbarTransferTransaction(transfers []transfer.Hedera, payerAccountID hedera.AccountID, memo string) (*hedera.TransactionResponse, error)
+	SubmitScheduledHbarTransferTransaction(ctx context.Context, transfers []transfer.Hedera, payerAccountID hedera.AccountID, memo string) (*hedera.TransactionResponse, error)
 	// SubmitScheduledNftTransferTransaction creates an Nft transfer transaction and submits it as a scheduled transfer transactions
-	SubmitScheduledNftTransferTransaction(nftID hedera.NftID, payerAccount hedera.AccountID, sender hedera.AccountID, receiving hedera.AccountID, memo string, approved bool) (*hedera.TransactionResponse, error)
+	SubmitScheduledNftTransferTransaction(ctx context.Context, nftID hedera.NftID, payerAccount hedera.AccountID, sender hedera.AccountID, receiving hedera.AccountID, memo string, approved bool) (*hedera.TransactionResponse, error)
 	// SubmitScheduledNftApproveTransaction creates an allowance of for the nft for the spender and submits it as a scheduled transaction
-	SubmitScheduledNftApproveTransaction(payer hedera.AccountID, memo string, nftId hedera.NftID, owner, spender hedera.AccountID) (*hedera.TransactionResponse, error)
+	SubmitScheduledNftApproveTransaction(ctx context.Context, payer hedera.AccountID, memo string, nftId hedera.NftID, owner, spender hedera.AccountID) (*hedera.TransactionResponse, error)
 	// SubmitScheduleSign submits a ScheduleSign transaction for a given ScheduleID
-	SubmitScheduleSign(scheduleID hedera.ScheduleID) (*hedera.TransactionResponse, error)
+	SubmitScheduleSign(ctx context.Context, scheduleID hedera.ScheduleID) (*hedera.TransactionResponse, error)
 	// SubmitScheduledTokenMintTransaction creates a token mint transaction and submits it as a scheduled mint transaction
-	SubmitScheduledTokenMintTransaction(tokenID hedera.TokenID, amount int64, payerAccountID hedera.AccountID, memo string) (*hedera.TransactionResponse, error)
+	SubmitScheduledTokenMintTransaction(ctx context.Context, tokenID hedera.TokenID, amount int64, payerAccountID hedera.AccountID, memo string) (*hedera.TransactionResponse, error)
 	// SubmitScheduledTokenBurnTransaction creates a token burn transaction and submits it as a scheduled burn transaction
-	SubmitScheduledTokenBurnTransaction(id hedera.TokenID, amount int64, account hedera.AccountID, memo string) (*hedera.TransactionResponse, error)
+	SubmitScheduledTokenBurnTransaction(ctx context.Context, id hedera.TokenID, amount int64, account hedera.AccountID, memo string) (*hedera.TransactionResponse, error)
+	// SubmitScheduledNftMintTransaction creates a token mint transaction of a single NFT with the given metadata and submits it as a scheduled mint transaction
+	SubmitScheduledNftMintTransaction(ctx context.Context, tokenID hedera.TokenID, metadata []byte, payerAccountID hedera.AccountID, memo string) (*hedera.TransactionResponse, error)
+	// SubmitScheduledNftBurnTransaction creates a token burn transaction of a single NFT and submits it as a scheduled burn transaction
+	SubmitScheduledNftBurnTransaction(ctx context.Context, nftID hedera.NftID, payerAccountID hedera.AccountID, memo string) (*hedera.TransactionResponse, error)
 	// TransactionReceiptQuery returns the receipt for a given transaction ID
 	TransactionReceiptQuery(transactionID hedera.TransactionID, nodeAccIds []hedera.AccountID) (hedera.TransactionReceipt, error)
+	// Ping queries the balance of the operator account, which is free of charge, to check whether the consensus nodes are reachable
+	Ping() error
 }
@@ -17,12 +17,18 @@
 package database
 
 import (
+	"context"
+
 	"gorm.io/gorm"
 )
 
 type Database interface {
 	Connection() *gorm.DB
 	Migrate()
+	// Ping verifies that the database is reachable
+	Ping(ctx context.Context) error
+	// Close closes the database connection
+	Close() error
 }
 
 type Connector interface {
@@ -21,6 +21,17 @@ import (
 )
 
 type Queue interface {
-	Push(message *queue.Message)
+	// Push queues the message. Returns an error if the message cannot be queued
+	Push(message *queue.Message) error
 	Channel() chan *queue.Message
+	// Close stops the queue on shutdown
+	Close() error
+}
+
+// Acknowledger is implemented by queues which require every delivered message to be acknowledged
+type Acknowledger interface {
+	// Ack marks the message as successfully handled
+	Ack(message *queue.Message) error
+	// Nack returns the message to the queue for redelivery or moves it to the dead-letter table
+	Nack(message *queue.Message, reason error) error
 }
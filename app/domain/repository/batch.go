@@ -0,0 +1,40 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repository
+
+import (
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+)
+
+type Batch interface {
+	// Save adds the item to its batch. Saving an item of an already executed transfer makes it pending again
+	Save(item *entity.BatchItem) error
+	// GetDue returns the pending batches, all of whose items can be executed at the given time.
+	// Each batch is returned as an item, carrying only its ID, its token and the time it can be executed at
+	GetDue(at time.Time) ([]*entity.BatchItem, error)
+	// CountUnbatched counts the INITIAL fungible transfers of the token to Hedera with events within [from, to),
+	// which are not added to a batch yet
+	CountUnbatched(token string, from, to time.Time) (int64, error)
+	// TakePending marks the pending items of the batch as executed and returns the ones, whose transfers are still in INITIAL
+	TakePending(batchID string) ([]*entity.BatchItem, error)
+	// Get returns the item of the given transfer. Returns nil if the transfer was not added to a batch
+	Get(transferID string) (*entity.BatchItem, error)
+	// Requeue makes the item of the given transfer pending again, keeping the batch and the execution time of the item
+	Requeue(transferID string) error
+}
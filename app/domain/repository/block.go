@@ -0,0 +1,30 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repository
+
+import "github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+
+type Block interface {
+	// Save creates or updates the processed block
+	Save(block *entity.Block) error
+	// GetLatest returns up to `limit` processed blocks of the given entity, ordered from the most recent one
+	GetLatest(entityID string, limit int) ([]*entity.Block, error)
+	// DeleteFrom deletes the processed blocks of the given entity with number greater than or equal to the given one
+	DeleteFrom(entityID string, number int64) error
+	// Prune deletes all processed blocks of the given entity, except for the latest `retain` ones
+	Prune(entityID string, retain int) error
+}
@@ -22,6 +22,9 @@ type Fee interface {
 	// Returns Fee. Returns nil if not found
 	Get(txId string) (*entity.Fee, error)
 	Create(entity *entity.Fee) error
+	// UpdateStatus moves the fee to the given status and records the change in the history of its transfer.
+	// Returns status.ErrInvalidTransition if the status is not reachable from the current one
+	UpdateStatus(txId, status, actor, reason string) error
 	UpdateStatusCompleted(txId string) error
 	UpdateStatusFailed(txId string) error
 	GetAllSubmittedIds() ([]*entity.Fee, error)
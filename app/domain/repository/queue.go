@@ -0,0 +1,35 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repository
+
+import (
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+)
+
+type Queue interface {
+	Create(message *entity.QueueMessage) error
+	// Claim returns up to `limit` visible messages and hides them for the given visibility timeout
+	Claim(limit int, visibilityTimeout time.Duration) ([]*entity.QueueMessage, error)
+	// Acknowledge removes a handled message of the given topic
+	Acknowledge(topic string, id uint64) error
+	// Release makes a message visible again at the given time
+	Release(id uint64, visibleAt time.Time) error
+	// MoveToDeadLetter removes the message with the given ID from the queue and stores all of it in the dead-letter table
+	MoveToDeadLetter(id uint64, reason string) error
+}
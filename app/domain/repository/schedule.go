@@ -16,14 +16,24 @@
 
 package repository
 
-import "github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+import (
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/failure"
+)
 
 type Schedule interface {
 	// Returns Schedule. Returns nil if not found
 	Get(txId string) (*entity.Schedule, error)
 	Create(entity *entity.Schedule) error
+	// UpdateStatus moves the scheduled transaction to the given status and records the change in the history of its transfer.
+	// Returns status.ErrInvalidTransition if the status is not reachable from the current one
+	UpdateStatus(txId, status, actor, reason string) error
 	UpdateStatusCompleted(txId string) error
 	UpdateStatusFailed(txId string) error
+	// Fail moves the scheduled transaction to FAILED, recording the code and the reason of the failure
+	Fail(txId string, code failure.Code, reason, actor string) error
+	// GetAllByTransactionID returns the records of the scheduled transaction. Batched scheduled transactions have a record per included transfer
+	GetAllByTransactionID(txId string) ([]*entity.Schedule, error)
 	GetReceiverTransferByTransactionID(id string) (*entity.Schedule, error)
 	GetAllSubmittedIds() ([]*entity.Schedule, error)
 }
@@ -17,8 +17,12 @@
 package repository
 
 import (
+	"context"
+	"time"
+
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/failure"
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
 )
 
@@ -29,9 +33,44 @@ type Transfer interface {
 	GetWithFee(txId string) (*entity.Transfer, error)
 	GetWithPreloads(txId string) (*entity.Transfer, error)
 	UpdateFee(txId string, fee string) error
+	// Returns the Transfer which minted the given serial number of a wrapped NFT. Returns nil if not found
+	GetByWrappedSerialNumber(wrappedAsset string, serialNumber int64) (*entity.Transfer, error)
+	UpdateWrappedSerialNumber(txId string, serialNumber int64) error
 
-	Create(ct *payload.Transfer) (*entity.Transfer, error)
+	// Create records the incoming transfer. The queries are traced within the span in the context, if any
+	Create(ctx context.Context, ct *payload.Transfer) (*entity.Transfer, error)
+	// Reject records the incoming transfer as failed with the given code, without it being processed.
+	// Returns nil if the transfer is already recorded
+	Reject(ct *payload.Transfer, code failure.Code, reason string) (*entity.Transfer, error)
+	// UpdateStatus moves the transfer to the given status and records the change in its history.
+	// Returns status.ErrInvalidTransition if the status is not reachable from the current one
+	UpdateStatus(txId, status, actor, reason string) error
+	// UpdateStatusCompleted completes the transfer on behalf of its handler
 	UpdateStatusCompleted(txId string) error
+	// UpdateStatusFailed fails the transfer on behalf of its handler
 	UpdateStatusFailed(txId string) error
+	// Fail moves the transfer to FAILED, recording the code and the reason of the failure
+	Fail(txId string, code failure.Code, reason, actor string) error
+	// UpdateStatusReorged marks the transfers from the given source chain with timestamp after the given one as reorged.
+	// Returns the IDs of the marked transfers
+	UpdateStatusReorged(sourceChainId uint64, after time.Time) ([]string, error)
+	// UpdateStatusClaimReorged returns the transfers, claimed on the given target chain at or after the given block, to the
+	// status they had before the claim. Returns the IDs of the returned transfers
+	UpdateStatusClaimReorged(targetChainId, fromBlock uint64) ([]string, error)
+	// UpdateStatusReincluded returns the reorged transfer to the status it had before the reorganisation, once its
+	// source transaction is included again, and updates its timestamp to the one of the new block
+	UpdateStatusReincluded(ct *payload.Transfer) (*entity.Transfer, error)
+	// UpdateStatusClaimed marks the transfer as claimed on the target EVM chain by the given transaction
+	UpdateStatusClaimed(txId, claimTxHash string, claimBlockNumber uint64, claimTimestamp int64, claimer string) error
 	Paged(req *transfer.PagedRequest) ([]*entity.Transfer, int64, error)
+	// GetStuck returns up to `limit` of the oldest transfers in INITIAL, whose source transaction and last re-drive
+	// happened before the given time and which were re-driven less than `maxRedrives` times. Transfers with pending
+	// or completed scheduled transactions or fees, or with a signature of one of the given signers are omitted.
+	// So are transfers, still pending in their batch. The completed mint of a batched transfer does not omit it
+	GetStuck(before time.Time, maxRedrives int, signers []string, limit int) ([]*entity.Transfer, error)
+	// MarkRedriven moves the transfer from the given status to INITIAL and records its re-drive, unless it was
+	// re-driven after the given time. Returns false if the transfer was not marked
+	MarkRedriven(txId, fromStatus string, notAfter time.Time, actor string) (bool, error)
+	// GetStatusHistory returns the status changes of the transfer, its schedules and fees in the order they happened
+	GetStatusHistory(txId string) ([]*entity.StatusHistory, error)
 }
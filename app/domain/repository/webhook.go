@@ -0,0 +1,34 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repository
+
+import (
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+)
+
+type WebhookDelivery interface {
+	Create(delivery *entity.WebhookDelivery) error
+	// Returns WebhookDelivery. Returns nil if not found
+	Get(id uint64) (*entity.WebhookDelivery, error)
+	// Due returns up to `limit` pending deliveries, whose next attempt is due, and postpones them by the given lease,
+	// so that they are not attempted concurrently
+	Due(limit int, lease time.Duration) ([]*entity.WebhookDelivery, error)
+	// Update stores the outcome of a delivery attempt
+	Update(delivery *entity.WebhookDelivery) error
+}
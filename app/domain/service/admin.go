@@ -0,0 +1,54 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import "github.com/limechain/hedera-eth-bridge-validator/app/model/admin"
+
+// Admin performs the operational actions, exposed through the authenticated admin API
+type Admin interface {
+	// UpdateTransferStatus marks the given transfer as COMPLETED or FAILED, recording the reason in its status history.
+	// Returns ErrNotFound if the transfer does not exist, ErrInvalidTransferStatus for any other status
+	// and ErrInvalidStatusTransition if the status is not reachable from the current one
+	UpdateTransferStatus(transferID, status, reason string) error
+	// RedriveTransfer pushes the given INITIAL or FAILED transfer back to its handler. See Redrive
+	RedriveTransfer(transferID string) error
+	// Watchers returns the controlled watchers together with the timestamp or block number they have processed
+	Watchers() ([]*admin.Watcher, error)
+	// PauseWatcher pauses the given watcher at the start of its next iteration
+	PauseWatcher(id string) error
+	// ResumeWatcher resumes the given watcher
+	ResumeWatcher(id string) error
+	// RewindWatcher sets the timestamp or block number, from which the given watcher continues once resumed.
+	// Returns ErrWatcherNotPaused if the watcher is running
+	RewindWatcher(id string, to int64) error
+	// ReloadMembers fetches the members from the router contracts of all EVM networks
+	ReloadMembers()
+	// Audit logs the given call to the admin API
+	Audit(entry *admin.AuditEntry)
+	// AuditLog returns up to `limit` of the latest logged calls to the admin API
+	AuditLog(limit int) ([]*admin.AuditEntry, error)
+}
+
+// WatcherControl pauses and resumes the watchers of the node
+type WatcherControl interface {
+	// Watchers returns whether each of the controlled watchers is paused
+	Watchers() map[string]bool
+	// PauseWatcher returns ErrNotFound for unknown watchers
+	PauseWatcher(id string) error
+	// ResumeWatcher returns ErrNotFound for unknown watchers
+	ResumeWatcher(id string) error
+}
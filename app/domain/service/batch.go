@@ -0,0 +1,45 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"time"
+
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+)
+
+// Batch aggregates the scheduled transfers of the same token within a time window
+// into a single scheduled transaction, split by the maximum transfers per transaction
+type Batch interface {
+	// Enabled returns whether scheduled transfers are batched
+	Enabled() bool
+	// ID returns the ID of the batch, including transfers of the given token with events at the given timestamp.
+	// The ID is used as memo of the batch scheduled transactions and is the same across all validators
+	ID(token string, timestamp time.Time) string
+	// Add persists the positive transfers of the given transfer in its batch. The bridge account is debited with their total amount,
+	// once the batch is executed. onSuccess and onFail are called once the scheduled transaction, crediting the receiver, is mined
+	Add(transferID, token string, timestamp time.Time, receiver hedera.AccountID, transfers []transfer.Hedera, onSuccess, onFail func()) error
+	// ExecuteDue executes the pending batches, whose windows and delays have passed. A window has passed once
+	// all EVM watchers have processed its blocks and all of its transfers have been added to the batch.
+	// A batch includes only its transfers, which are still in INITIAL status
+	ExecuteDue()
+	// Batched returns whether the transfer was added to a batch
+	Batched(transferID string) (bool, error)
+	// Requeue makes the transfer pending in its batch again, so that it is executed once the batch is due
+	Requeue(transferID string) error
+}
@@ -17,14 +17,17 @@
 package service
 
 import (
+	"context"
+
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
 )
 
 // BurnEvent is the major service used for processing BurnEvent operations
 type BurnEvent interface {
 	// ProcessEvent processes the burn event by submitting the appropriate
-	// scheduled transaction, leaving the synchronization of the actual transfer on HCS
-	ProcessEvent(transfer payload.Transfer)
+	// scheduled transaction, leaving the synchronization of the actual transfer on HCS.
+	// Returns an error if the event should be processed again
+	ProcessEvent(ctx context.Context, transfer payload.Transfer) error
 	// TransactionID returns the corresponding Scheduled Transaction paying out the
 	// fees to validators and the amount being bridged to the receiver address
 	TransactionID(id string) (string, error)
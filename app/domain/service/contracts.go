@@ -50,6 +50,12 @@ type Contracts interface {
 	ParseUnlockLog(log types.Log) (*abi.RouterUnlock, error)
 	// ParseBurnERC721Log parses a general typed log to a BurnERC721event
 	ParseBurnERC721Log(log types.Log) (*abi.RouterBurnERC721, error)
+	// ParseLockERC721Log parses a general typed log to a RouterLockERC721 event
+	ParseLockERC721Log(log types.Log) (*abi.RouterLockERC721, error)
+	// ParseUnlockERC721Log parses a general typed log to a RouterUnlockERC721 event
+	ParseUnlockERC721Log(log types.Log) (*abi.RouterUnlockERC721, error)
+	// ParseMintERC721Log parses a general typed log to a RouterMintERC721 event
+	ParseMintERC721Log(log types.Log) (*abi.RouterMintERC721, error)
 	// WatchBurnEventLogs creates a subscription for Burn Events emitted in the Bridge contract
 	WatchBurnEventLogs(opts *bind.WatchOpts, sink chan<- *abi.RouterBurn) (event.Subscription, error)
 	// WatchLockEventLogs creates a subscription for Lock Events emitted in the Bridge contract
@@ -17,6 +17,8 @@
 package service
 
 import (
+	"math/big"
+
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/transaction"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 )
@@ -29,5 +31,5 @@ type Distributor interface {
 	// CalculateMemberDistribution Returns an equally divided to each member
 	CalculateMemberDistribution(validFee int64) ([]transfer.Hedera, error)
 	// ValidAmount Returns the closest amount, which can be equally divided to members
-	ValidAmount(amount int64) int64
+	ValidAmount(amount *big.Int) *big.Int
 }
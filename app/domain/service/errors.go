@@ -25,3 +25,10 @@ var ErrNotFound = errors.New("not found")
 var ErrBadRequestTransferTargetNetworkNoSignaturesRequired = errors.New("transfer target network does not require signatures")
 var ErrWrongQuery = errors.New("wrong query parameter")
 var ErrTooManyRetires = fmt.Errorf("too many retries")
+var ErrWebhookNotConfigured = errors.New("webhook endpoint is not configured")
+var ErrWatcherNotPaused = errors.New("watcher must be paused first")
+var ErrInvalidTransferStatus = errors.New("invalid transfer status")
+var ErrInvalidStatusTransition = errors.New("status is not reachable from the current status of the transfer")
+var ErrTransferNotRedrivable = errors.New("transfer cannot be re-driven")
+var ErrTransferRedrivenRecently = errors.New("transfer was re-driven recently")
+var ErrOriginatorWithoutHederaAccount = errors.New("originator has no Hedera account")
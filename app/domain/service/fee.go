@@ -16,8 +16,10 @@
 
 package service
 
+import "math/big"
+
 // Fee interface is implemented by the Calculator Service
 type Fee interface {
 	// CalculateFee calculates the fee and remainder of a given amount, based on a specified token fee percentage
-	CalculateFee(token string, amount int64) (fee, remainder int64)
+	CalculateFee(token string, amount *big.Int) (fee, remainder *big.Int)
 }
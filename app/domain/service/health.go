@@ -0,0 +1,30 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package service
+
+import "github.com/limechain/hedera-eth-bridge-validator/app/model/health"
+
+// Health checks the dependencies and the watchers of the node
+type Health interface {
+	// Live reports whether the process of the node is running. None of the dependencies is checked, since a restart
+	// of the node does not recover them
+	Live() *health.Report
+	// Ready checks the database, the mirror node, the Hedera and the EVM nodes and how far the watchers lag behind
+	// the latest events on their chains
+	Ready() *health.Report
+}
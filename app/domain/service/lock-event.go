@@ -17,12 +17,14 @@
 package service
 
 import (
+	"context"
+
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
 )
 
 // LockEvent is the major service used for processing BurnEvent operations
 type LockEvent interface {
 	// ProcessEvent processes the lock event by submitting the appropriate
-	// Scheduled Token Mint and Transfer transactions
-	ProcessEvent(event payload.Transfer)
+	// Scheduled Token Mint and Transfer transactions. Returns an error if the event should be processed again
+	ProcessEvent(ctx context.Context, event payload.Transfer) error
 }
@@ -23,20 +23,22 @@ import (
 type Prometheus interface {
 	// CreateGaugeIfNotExists creates new Gauge Metric and registers it in Prometheus if not exists
 	CreateGaugeIfNotExists(opts prometheus.GaugeOpts) prometheus.Gauge
-	// CreateSuccessRateGaugeIfNotExists creates new Gauge Metric for Success Rate and registers it in Prometheus if not exists
-	CreateSuccessRateGaugeIfNotExists(transactionId string, sourceChainId, targetChainId uint64, asset, metricNameSuffix, metricHelp string) (prometheus.Gauge, error)
+	// CreateGaugeVecIfNotExists creates new Gauge Vector Metric partitioned by the passed labels and registers it in Prometheus if not exists
+	CreateGaugeVecIfNotExists(opts prometheus.GaugeOpts, labelNames []string) *prometheus.GaugeVec
 	// GetGauge retrieves Gauge by name with flag for existence
 	GetGauge(name string) prometheus.Gauge
 	// DeleteGauge unregisters and deletes Gauge with the passed name
 	DeleteGauge(name string)
 	// CreateCounterIfNotExists creates new Counter Metric and registers it in Prometheus
 	CreateCounterIfNotExists(opts prometheus.CounterOpts) prometheus.Counter
+	// CreateCounterVecIfNotExists creates new Counter Vector Metric partitioned by the passed labels and registers it in Prometheus if not exists
+	CreateCounterVecIfNotExists(opts prometheus.CounterOpts, labelNames []string) *prometheus.CounterVec
 	// GetCounter retrieves Counter by name with flag for existence
 	GetCounter(name string) prometheus.Counter
 	// DeleteCounter unregisters and deletes Counter with the passed name
 	DeleteCounter(name string)
-	// ConstructMetricName constructing name for metric
-	ConstructMetricName(sourceNetworkId, targetNetworkId uint64, asset, transactionId, metricTarget string) (string, error)
+	// CreateHistogramVecIfNotExists creates new Histogram Vector Metric partitioned by the passed labels and registers it in Prometheus if not exists
+	CreateHistogramVecIfNotExists(opts prometheus.HistogramOpts, labelNames []string) *prometheus.HistogramVec
 	// GetIsMonitoringEnabled returns if the monitoring is enabled
 	GetIsMonitoringEnabled() bool
 }
@@ -17,6 +17,8 @@
 package service
 
 import (
+	"context"
+
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	mirror_node "github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/transaction"
 	model "github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
@@ -24,11 +26,12 @@ import (
 )
 
 type ReadOnly interface {
-	FindTransfer(transferID string, fetch func() (*mirror_node.Response, error), save func(transactionID, scheduleID, status string) error)
-	FindAssetTransfer(transferID string, asset string, transfers []model.Hedera, fetch func() (*mirror_node.Response, error), save func(transactionID, scheduleID, status string) error)
-	FindNftTransfer(transferID string, tokenID string, serialNum int64, sender string, receiver string,
+	FindTransfer(ctx context.Context, transferID string, fetch func() (*mirror_node.Response, error), save func(transactionID, scheduleID, status string) error)
+	FindAssetTransfer(ctx context.Context, transferID string, asset string, transfers []model.Hedera, fetch func() (*mirror_node.Response, error), save func(transactionID, scheduleID, status string) error)
+	FindNftTransfer(ctx context.Context, transferID string, tokenID string, serialNum int64, sender string, receiver string,
 		save func(transactionID, scheduleID, status string) error)
 	FindScheduledNftAllowanceApprove(
+		ctx context.Context,
 		t *payload.Transfer,
 		sender hedera.AccountID,
 		save func(transactionID, scheduleID, status string) error)
@@ -0,0 +1,27 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+// Redrive pushes transfers, which got stuck, back onto the queue topic of their handler
+type Redrive interface {
+	// RedriveStuck re-drives the transfers, stuck in INITIAL for longer than the configured threshold
+	RedriveStuck()
+	// Redrive re-drives the given INITIAL or FAILED transfer. Returns ErrNotFound if the transfer does not exist,
+	// ErrTransferNotRedrivable if it is in any other status, was rejected or is still being processed and
+	// ErrTransferRedrivenRecently if it was re-driven within the configured threshold
+	Redrive(transferID string) error
+}
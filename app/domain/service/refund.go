@@ -0,0 +1,35 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"math/big"
+)
+
+// Refund returns the funds of transfers, which cannot be delivered, to their originator on Hedera
+type Refund interface {
+	// OnDeliveryFailed fails the transfer with the reason, for which the scheduled transaction crediting its receiver
+	// has failed. If the receiver cannot accept the asset, the refund of the given amount of it, left in the bridge account,
+	// is pushed to the queue
+	OnDeliveryFailed(transferID, transactionID, asset string, amount *big.Int)
+	// Refund submits a scheduled transfer of the given amount of the asset from the bridge account back to the
+	// originator of the transfer, minus the refund fee. Validators reach consensus by submitting identical transfers.
+	// Originators on EVM chains are refunded only to their existing Hedera account, otherwise the refund is rejected
+	// and the transfer stays FAILED. Returns an error if the refund should be attempted again
+	Refund(ctx context.Context, transferID, asset string, amount *big.Int) error
+}
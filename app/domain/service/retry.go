@@ -36,15 +36,20 @@ const (
 // the query is stuck forever and breaks the business logic. This way, if the query takes more than sleepPeriod, it will
 // retry the query {@param retries} times.
 // If {@param retries} is reached, it will return an error.
-func Retry(executionFunction func(context.Context) retry.Result, retries int) (interface{}, error) {
+// Every execution is bound to the given {@param ctx}. Once it is cancelled, no further retries are made.
+func Retry(ctx context.Context, executionFunction func(context.Context) retry.Result, retries int) (interface{}, error) {
 	times := 0
 
 	for {
-		ctx, cancel := context.WithTimeout(context.Background(), sleepPeriod)
-		executionResult := executionFunction(ctx)
+		executionCtx, cancel := context.WithTimeout(ctx, sleepPeriod)
+		executionResult := executionFunction(executionCtx)
 		cancel()
 
 		if executionResult.Error != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+
 			if errors.Is(executionResult.Error, context.DeadlineExceeded) {
 				times++
 				if times >= retries {
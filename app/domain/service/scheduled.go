@@ -17,23 +17,32 @@
 package service
 
 import (
+	"context"
+	"math/big"
+
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 )
 
 // Scheduled interface is implemented by the Scheduled Service
 // Provides business logic for execution of Scheduled Transactions
+// Transactions are submitted within the span in the given context, if any
 type Scheduled interface {
 	// ExecuteScheduledTransferTransaction submits a scheduled transfer transaction and executes provided functions when necessary
-	ExecuteScheduledTransferTransaction(id, asset string, transfers []transfer.Hedera, onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string))
+	ExecuteScheduledTransferTransaction(ctx context.Context, id, asset string, transfers []transfer.Hedera, onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string))
 	// ExecuteScheduledMintTransaction submits a scheduled mint transaction and executes provided functions when necessary
-	ExecuteScheduledMintTransaction(id, asset string, amount int64, status *chan string, onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string))
+	ExecuteScheduledMintTransaction(ctx context.Context, id, asset string, amount *big.Int, status *chan string, onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string))
 	// ExecuteScheduledBurnTransaction submits a scheduled burn transaction and executes provided functions when necessary
-	ExecuteScheduledBurnTransaction(id, asset string, amount int64, status *chan string, onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string))
+	ExecuteScheduledBurnTransaction(ctx context.Context, id, asset string, amount *big.Int, status *chan string, onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string))
 	// ExecuteScheduledNftTransferTransaction submits a scheduled nft transfer transaction and executes provided functions when necessary
-	ExecuteScheduledNftTransferTransaction(id string, nftID hedera.NftID, sender hedera.AccountID, receiving hedera.AccountID, approved bool, onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string))
+	ExecuteScheduledNftTransferTransaction(ctx context.Context, id string, nftID hedera.NftID, sender hedera.AccountID, receiving hedera.AccountID, approved bool, onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string))
 	// ExecuteScheduledNftAllowTransaction submits a scheduled NFT allow transaction and executes provided functions when necessary
 	ExecuteScheduledNftAllowTransaction(
+		ctx context.Context,
 		id string, nftID hedera.NftID, owner hedera.AccountID, spender hedera.AccountID,
 		onExecutionSuccess func(txId, scheduleId string), onExecutionFail, onSuccess, onFail func(txId string))
+	// ExecuteScheduledNftMintTransaction submits a scheduled mint transaction of a single NFT and executes provided functions when necessary
+	ExecuteScheduledNftMintTransaction(ctx context.Context, id string, tokenID hedera.TokenID, metadata []byte, onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string))
+	// ExecuteScheduledNftBurnTransaction submits a scheduled burn transaction of a single NFT and executes provided functions when necessary
+	ExecuteScheduledNftBurnTransaction(ctx context.Context, id string, nftID hedera.NftID, onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string))
 }
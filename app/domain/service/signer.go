@@ -17,12 +17,14 @@
 package service
 
 import (
+	"context"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"math/big"
 )
 
 type Signer interface {
-	Sign(msg []byte) ([]byte, error)
+	// Sign signs the Keccak-256 hash of the message, returning a signature with a recovery id of 27 or 28
+	Sign(ctx context.Context, msg []byte) ([]byte, error)
 	NewKeyTransactor(chainId *big.Int) (*bind.TransactOpts, error)
 	Address() string
 }
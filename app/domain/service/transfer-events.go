@@ -0,0 +1,32 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import model "github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+
+// TransferEvents streams the changes in the lifecycle of transfers to their subscribers
+type TransferEvents interface {
+	// Publish sends the event to all matching subscribers without blocking
+	Publish(event *model.Event)
+	// Subscribe returns a channel of the events, matching the subscription, and a function cancelling the subscription
+	Subscribe(subscription model.Subscription) (<-chan *model.Event, func())
+	// SubscribeLossless is like Subscribe, but queues the events of a slow subscriber instead of dropping them.
+	// Used by subscribers, which must observe every event
+	SubscribeLossless(subscription model.Subscription) (<-chan *model.Event, func())
+	// HasSubscribers returns whether there is at least one subscriber, so that publishers can skip building events
+	HasSubscribers() bool
+}
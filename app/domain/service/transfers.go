@@ -17,9 +17,11 @@
 package service
 
 import (
+	"context"
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/transaction"
 	model "github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/failure"
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
 )
 
@@ -30,36 +32,49 @@ type Transfers interface {
 	SanityCheckTransfer(tx transaction.Transaction) model.SanityCheckResult
 	// InitiateNewTransfer Stores the incoming transfer message into the Database
 	// aware of already processed transfers
-	InitiateNewTransfer(tm payload.Transfer) (*entity.Transfer, error)
+	InitiateNewTransfer(ctx context.Context, tm payload.Transfer) (*entity.Transfer, error)
+	// RejectTransfer Stores the incoming transfer message into the Database as failed with the given code,
+	// aware of already processed transfers. Rejected transfers are not processed
+	RejectTransfer(tm payload.Transfer, code failure.Code, reason string) error
 	// ProcessNativeTransfer processes the native fungible transfer message by signing the required
 	// authorisation signature submitting it into the required HCS Topic
-	ProcessNativeTransfer(tm payload.Transfer) error
+	ProcessNativeTransfer(ctx context.Context, tm payload.Transfer) error
 	// ProcessNativeNftTransfer processes the native nft transfer message by signing the required
 	// authorisation signature submitting it into the required HCS Topic
-	ProcessNativeNftTransfer(tm payload.Transfer) error
+	ProcessNativeNftTransfer(ctx context.Context, tm payload.Transfer) error
 	// ProcessWrappedTransfer processes the wrapped transfer message by signing the required
 	// authorisation signature submitting it into the required HCS Topic
-	ProcessWrappedTransfer(tm payload.Transfer) error
+	ProcessWrappedTransfer(ctx context.Context, tm payload.Transfer) error
+	// ProcessWrappedNftTransfer burns the wrapped NFT with the given serial number and processes the transfer message
+	// by signing the required authorisation signature submitting it into the required HCS Topic
+	ProcessWrappedNftTransfer(ctx context.Context, tm payload.Transfer, wrappedSerialNum int64) error
 	// TransferData returns from the database the given transfer, its signatures and
 	// calculates if its messages have reached super majority
 	TransferData(txId string) (interface{}, error)
+	// Timeline returns from the database the given transfer along with its source transaction, signatures,
+	// scheduled transactions, fees and claim, ordered by the time they occurred
+	Timeline(txId string) (*model.Timeline, error)
+	// StatusHistory returns the status changes of the given transfer and its scheduled transactions and fees,
+	// in the order they were made
+	StatusHistory(txId string) ([]*model.StatusChange, error)
 	// Paged returns a paginated list of all transfers
 	Paged(filter *model.PagedRequest) (*model.Paged, error)
-	// UpdateTransferStatusCompleted updates the transfer status to completed
-	UpdateTransferStatusCompleted(txId string) error
 }
 
 type TransferData struct {
-	IsNft         bool     `json:"isNft"`
-	Recipient     string   `json:"recipient"`
-	RouterAddress string   `json:"routerAddress"`
-	SourceChainId uint64   `json:"sourceChainId"`
-	TargetChainId uint64   `json:"targetChainId"`
-	SourceAsset   string   `json:"sourceAsset"`
-	NativeAsset   string   `json:"nativeAsset"`
-	TargetAsset   string   `json:"wrappedAsset"`
-	Signatures    []string `json:"signatures"`
-	Majority      bool     `json:"majority"`
+	IsNft         bool           `json:"isNft"`
+	Recipient     string         `json:"recipient"`
+	RouterAddress string         `json:"routerAddress"`
+	SourceChainId uint64         `json:"sourceChainId"`
+	TargetChainId uint64         `json:"targetChainId"`
+	SourceAsset   string         `json:"sourceAsset"`
+	NativeAsset   string         `json:"nativeAsset"`
+	TargetAsset   string         `json:"wrappedAsset"`
+	Signatures    []string       `json:"signatures"`
+	Majority      bool           `json:"majority"`
+	Status        string         `json:"status"`
+	Claim         *model.Claim   `json:"claim,omitempty"`
+	Failure       *model.Failure `json:"failure,omitempty"`
 }
 
 type NonFungibleTransferData struct {
@@ -0,0 +1,37 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/webhook"
+)
+
+// Webhooks notifies the configured endpoints of integrators about the lifecycle events of transfers
+type Webhooks interface {
+	// Start subscribes for transfer events and starts delivering them to the endpoints until the context is cancelled.
+	// Does nothing if there are no configured endpoints
+	Start(ctx context.Context)
+	// Drain waits for the deliveries in flight to finish, once the context passed to Start is cancelled
+	Drain() error
+	// Delivery returns the logged delivery with the given ID. Returns ErrNotFound if the delivery does not exist
+	Delivery(id uint64) (*webhook.Delivery, error)
+	// Replay schedules a new delivery of the payload of the given delivery. Returns ErrNotFound if the delivery
+	// does not exist and ErrWebhookNotConfigured if its endpoint is no longer configured
+	Replay(id uint64) (*webhook.Delivery, error)
+}
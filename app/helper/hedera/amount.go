@@ -0,0 +1,36 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hedera
+
+import (
+	"math"
+	"math/big"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/failure"
+)
+
+// MaxAmount is the max amount of HBAR or of a token, which can be transferred, minted or burned in a single Hedera transaction
+var MaxAmount = big.NewInt(math.MaxInt64)
+
+// Amount returns the amount as the 64-bit integer, used by Hedera transactions.
+// Returns an AmountExceedsLimit error for negative amounts and for amounts over MaxAmount
+func Amount(amount *big.Int) (int64, error) {
+	if amount.Sign() < 0 || amount.Cmp(MaxAmount) > 0 {
+		return 0, failure.Errorf(failure.AmountExceedsLimit, "amount [%s] is out of the range of Hedera amounts [0, %s]", amount, MaxAmount)
+	}
+	return amount.Int64(), nil
+}
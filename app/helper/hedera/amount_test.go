@@ -0,0 +1,46 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hedera
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/failure"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Amount(t *testing.T) {
+	amount, err := Amount(big.NewInt(100))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(100), amount)
+
+	amount, err = Amount(MaxAmount)
+	assert.Nil(t, err)
+	assert.Equal(t, MaxAmount.Int64(), amount)
+}
+
+func Test_AmountOutOfRange(t *testing.T) {
+	for _, amount := range []*big.Int{big.NewInt(-1), new(big.Int).Add(MaxAmount, big.NewInt(1))} {
+		_, err := Amount(amount)
+
+		var rejection *failure.Error
+		assert.True(t, errors.As(err, &rejection))
+		assert.Equal(t, failure.AmountExceedsLimit, rejection.Code)
+	}
+}
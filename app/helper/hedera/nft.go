@@ -18,10 +18,12 @@ package hedera
 
 import (
 	"database/sql"
+	"fmt"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
 	syncHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/sync"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
+	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
 	log "github.com/sirupsen/logrus"
 	"sync"
 )
@@ -134,3 +136,21 @@ func ScheduledNftTxMinedCallbacks(
 
 	return onSuccess, onFail
 }
+
+// ResolveEvmNativeNft replaces the serial number and metadata of the wrapped NFT in the transfer with the token ID and
+// metadata of the EVM native NFT, for which it was minted. Returns the serial number of the wrapped NFT
+func ResolveEvmNativeNft(transferRepository repository.Transfer, tm *payload.Transfer) (wrappedSerialNum int64, err error) {
+	mintTransfer, err := transferRepository.GetByWrappedSerialNumber(tm.SourceAsset, tm.SerialNum)
+	if err != nil {
+		return 0, err
+	}
+	if mintTransfer == nil {
+		return 0, fmt.Errorf("no transfer minted serial number [%d] of [%s]", tm.SerialNum, tm.SourceAsset)
+	}
+
+	wrappedSerialNum = tm.SerialNum
+	tm.SerialNum = mintTransfer.SerialNumber
+	tm.Metadata = mintTransfer.Metadata
+
+	return wrappedSerialNum, nil
+}
@@ -46,7 +46,7 @@ var (
 		},
 	}
 	createdScheduleOnError = *createdScheduleOnSuccess
-	error                  = errors.New("some-error")
+	someErr                = errors.New("some-error")
 )
 
 func Test_ScheduledNftTxExecutionCallbacks(t *testing.T) {
@@ -61,7 +61,7 @@ func Test_ScheduledNftTxExecutionCallbacks(t *testing.T) {
 func Test_ScheduledNftTxExecutionCallbacks_ErrScheduleCreateOnSuccess(t *testing.T) {
 	setupNftTest(false)
 
-	mocks.MScheduleRepository.On("Create", createdScheduleOnSuccess).Return(error)
+	mocks.MScheduleRepository.On("Create", createdScheduleOnSuccess).Return(someErr)
 
 	onSuccess, _ := ScheduledNftTxExecutionCallbacks(mocks.MTransferRepository, mocks.MScheduleRepository, logger, transactionId, true, statusResult, schedule.TRANSFER, wg)
 
@@ -71,7 +71,7 @@ func Test_ScheduledNftTxExecutionCallbacks_ErrScheduleCreateOnSuccess(t *testing
 func Test_ScheduledNftTxExecutionCallbacks_ErrScheduleCreateOnFail(t *testing.T) {
 	setupNftTest(false)
 	updateFieldsForCreatedScheduleOnError()
-	mocks.MScheduleRepository.On("Create", &createdScheduleOnError).Return(error)
+	mocks.MScheduleRepository.On("Create", &createdScheduleOnError).Return(someErr)
 
 	_, onFail := ScheduledNftTxExecutionCallbacks(mocks.MTransferRepository, mocks.MScheduleRepository, logger, transactionId, true, statusResult, schedule.TRANSFER, wg)
 
@@ -82,7 +82,7 @@ func Test_ScheduledNftTxExecutionCallbacks_ErrUpdateStatusFailedOnFail(t *testin
 	setupNftTest(false)
 	updateFieldsForCreatedScheduleOnError()
 	mocks.MScheduleRepository.On("Create", &createdScheduleOnError).Return(nil)
-	mocks.MTransferRepository.On("UpdateStatusFailed", transactionId).Return(error)
+	mocks.MTransferRepository.On("UpdateStatusFailed", transactionId).Return(someErr)
 
 	_, onFail := ScheduledNftTxExecutionCallbacks(mocks.MTransferRepository, mocks.MScheduleRepository, logger, transactionId, true, statusResult, schedule.TRANSFER, wg)
 
@@ -104,7 +104,7 @@ func Test_ScheduledNftTxMinedCallbacks(t *testing.T) {
 
 func Test_ScheduledNftTxMinedCallbacks_ErrTransferUpdateStatusCompletedOnSuccess(t *testing.T) {
 	setupNftTest(true)
-	mocks.MTransferRepository.On("UpdateStatusCompleted", transactionId).Return(error)
+	mocks.MTransferRepository.On("UpdateStatusCompleted", transactionId).Return(someErr)
 	wg.Add(1)
 
 	onSuccess, _ := ScheduledNftTxMinedCallbacks(mocks.MTransferRepository, mocks.MScheduleRepository, logger, transactionId, statusResult, wg)
@@ -115,7 +115,7 @@ func Test_ScheduledNftTxMinedCallbacks_ErrTransferUpdateStatusCompletedOnSuccess
 func Test_ScheduledNftTxMinedCallbacks_ErrScheduleUpdateStatusCompletedOnSuccess(t *testing.T) {
 	setupNftTest(true)
 	mocks.MTransferRepository.On("UpdateStatusCompleted", transactionId).Return(nil)
-	mocks.MScheduleRepository.On("UpdateStatusCompleted", transactionId).Return(error)
+	mocks.MScheduleRepository.On("UpdateStatusCompleted", transactionId).Return(someErr)
 	wg.Add(1)
 
 	onSuccess, _ := ScheduledNftTxMinedCallbacks(mocks.MTransferRepository, mocks.MScheduleRepository, logger, transactionId, statusResult, wg)
@@ -125,7 +125,7 @@ func Test_ScheduledNftTxMinedCallbacks_ErrScheduleUpdateStatusCompletedOnSuccess
 
 func Test_ScheduledNftTxMinedCallbacks_ErrScheduleUpdateStatusCompletedOnFail(t *testing.T) {
 	setupNftTest(true)
-	mocks.MScheduleRepository.On("UpdateStatusFailed", transactionId).Return(error)
+	mocks.MScheduleRepository.On("UpdateStatusFailed", transactionId).Return(someErr)
 	wg.Add(1)
 
 	_, onFail := ScheduledNftTxMinedCallbacks(mocks.MTransferRepository, mocks.MScheduleRepository, logger, transactionId, statusResult, wg)
@@ -136,7 +136,7 @@ func Test_ScheduledNftTxMinedCallbacks_ErrScheduleUpdateStatusCompletedOnFail(t
 func Test_ScheduledNftTxMinedCallbacks_ErrTransferUpdateStatusCompletedOnFail(t *testing.T) {
 	setupNftTest(false)
 	mocks.MScheduleRepository.On("UpdateStatusFailed", transactionId).Return(nil)
-	mocks.MTransferRepository.On("UpdateStatusFailed", transactionId).Return(error)
+	mocks.MTransferRepository.On("UpdateStatusFailed", transactionId).Return(someErr)
 	wg.Add(1)
 
 	_, onFail := ScheduledNftTxMinedCallbacks(mocks.MTransferRepository, mocks.MScheduleRepository, logger, transactionId, statusResult, wg)
@@ -21,6 +21,7 @@ import (
 	"strings"
 
 	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/timestamp"
 )
 
 // ToMirrorNodeTransactionID parses TX with format `0.0.X@{seconds}.{nanos}?scheduled` to format `0.0.X-{seconds}-{nanos}`
@@ -70,3 +71,13 @@ func OriginatorFromTxId(txId string) string {
 	parts := strings.Split(txId, "-")
 	return parts[0]
 }
+
+// TimestampFromTxId parses the valid start of TX with format `0.0.X-{seconds}-{nanos}` into int64 timestamp in nanos
+func TimestampFromTxId(txId string) (int64, error) {
+	parts := strings.Split(txId, "-")
+	if len(parts) != 3 {
+		return 0, fmt.Errorf("invalid transaction id [%s]", txId)
+	}
+
+	return timestamp.FromString(fmt.Sprintf("%s.%s", parts[1], parts[2]))
+}
@@ -47,3 +47,15 @@ func Test_FromHederaTransactionID(t *testing.T) {
 	assert.Equal(t, expectedTimestamp, res.Timestamp())
 	assert.Nil(t, err)
 }
+
+func Test_TimestampFromTxId(t *testing.T) {
+	res, err := TimestampFromTxId(expectedTransactionID)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1598924675082525000), res)
+}
+
+func Test_TimestampFromTxId_Invalid(t *testing.T) {
+	res, err := TimestampFromTxId(expectedAccountID)
+	assert.NotNil(t, err)
+	assert.Zero(t, res)
+}
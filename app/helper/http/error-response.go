@@ -35,6 +35,18 @@ func WriteErrorResponse(w http.ResponseWriter, r *http.Request, err error) {
 	case service.ErrWrongQuery:
 		render.Status(r, http.StatusBadRequest)
 		render.JSON(w, r, response.ErrorResponse(err))
+	case service.ErrWebhookNotConfigured:
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, response.ErrorResponse(err))
+	case service.ErrInvalidTransferStatus:
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, response.ErrorResponse(err))
+	case service.ErrWatcherNotPaused:
+		render.Status(r, http.StatusConflict)
+		render.JSON(w, r, response.ErrorResponse(err))
+	case service.ErrTransferNotRedrivable, service.ErrTransferRedrivenRecently, service.ErrInvalidStatusTransition:
+		render.Status(r, http.StatusConflict)
+		render.JSON(w, r, response.ErrorResponse(err))
 	default:
 		render.Status(r, http.StatusInternalServerError)
 		render.JSON(w, r, response.ErrorResponse(response.ErrorInternalServerError))
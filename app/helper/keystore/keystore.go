@@ -0,0 +1,149 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package keystore
+
+import (
+	"crypto/ecdsa"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+	"github.com/hashgraph/hedera-sdk-go/v2"
+)
+
+const (
+	encryptedPrivateKeyPemType = "ENCRYPTED PRIVATE KEY"
+	keyFilePermissions         = 0600
+)
+
+var (
+	// scrypt cost parameters used when storing EVM keys
+	scryptN = keystore.StandardScryptN
+	scryptP = keystore.StandardScryptP
+)
+
+// Password reads the keystore password from the given environment variable or file.
+// Trailing new lines of password files are ignored
+func Password(passwordEnv, passwordFile string) (string, error) {
+	if passwordEnv != "" {
+		password, ok := os.LookupEnv(passwordEnv)
+		if !ok {
+			return "", fmt.Errorf("environment variable [%s] is not set", passwordEnv)
+		}
+		return password, nil
+	}
+
+	if passwordFile != "" {
+		content, err := os.ReadFile(passwordFile)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimRight(string(content), "\r\n"), nil
+	}
+
+	return "", errors.New("no password env or password file provided")
+}
+
+// LoadEVMKey decrypts the go-ethereum V3 keystore JSON at the given path
+func LoadEVMKey(path, password string) (*ecdsa.PrivateKey, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := keystore.DecryptKey(content, password)
+	if err != nil {
+		return nil, err
+	}
+
+	return key.PrivateKey, nil
+}
+
+// StoreEVMKey encrypts the key into a go-ethereum V3 keystore JSON at the given path
+func StoreEVMKey(path, password string, privateKey *ecdsa.PrivateKey) error {
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return err
+	}
+
+	content, err := keystore.EncryptKey(&keystore.Key{
+		Id:         id,
+		Address:    crypto.PubkeyToAddress(privateKey.PublicKey),
+		PrivateKey: privateKey,
+	}, password, scryptN, scryptP)
+	if err != nil {
+		return err
+	}
+
+	return writeKeyFile(path, content)
+}
+
+// LoadHederaKey decrypts the password-protected PKCS#8 PEM file at the given path. Both ED25519 and ECDSA keys are supported
+func LoadHederaKey(path, password string) (hedera.PrivateKey, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return hedera.PrivateKey{}, err
+	}
+
+	block, _ := pem.Decode(content)
+	if block == nil || block.Type != encryptedPrivateKeyPemType {
+		return hedera.PrivateKey{}, fmt.Errorf("no [%s] PEM block found in [%s]", encryptedPrivateKeyPemType, path)
+	}
+
+	der, err := decryptPKCS8(block.Bytes, []byte(password))
+	if err != nil {
+		return hedera.PrivateKey{}, err
+	}
+
+	privateKey, err := hedera.PrivateKeyFromBytesDer(der)
+	if err != nil {
+		return hedera.PrivateKey{}, ErrIncorrectPassword
+	}
+
+	return privateKey, nil
+}
+
+// StoreHederaKey encrypts the key into a password-protected PKCS#8 PEM file at the given path
+func StoreHederaKey(path, password string, privateKey hedera.PrivateKey) error {
+	der, err := encryptPKCS8(privateKey.BytesDer(), []byte(password))
+	if err != nil {
+		return err
+	}
+
+	return writeKeyFile(path, pem.EncodeToMemory(&pem.Block{Type: encryptedPrivateKeyPemType, Bytes: der}))
+}
+
+// writeKeyFile writes the content to a new file, readable only by the current user
+func writeKeyFile(path string, content []byte) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, keyFilePermissions)
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(content)
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	return file.Close()
+}
@@ -19,10 +19,7 @@ package metrics
 import (
 	"fmt"
 	"github.com/hashgraph/hedera-sdk-go/v2"
-	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
 	"github.com/limechain/hedera-eth-bridge-validator/constants"
-	"github.com/prometheus/client_golang/prometheus"
-	log "github.com/sirupsen/logrus"
 	"math/big"
 	"strconv"
 	"strings"
@@ -36,126 +33,6 @@ func PrepareValueForPrometheusMetricName(value string) string {
 	return value
 }
 
-func ConstructNameForMetric(sourceNetworkId, targetNetworkId uint64, tokenType, transactionId, metricTarget string) (string, error) {
-	errMsg := "Network id %v is missing in id to name mapping."
-	sourceNetworkName, exist := constants.NetworksById[sourceNetworkId]
-	if !exist {
-		return "", fmt.Errorf(errMsg, sourceNetworkId)
-	}
-	targetNetworkName, exist := constants.NetworksById[targetNetworkId]
-	if !exist {
-		return "", fmt.Errorf(errMsg, targetNetworkId)
-	}
-
-	transactionId = PrepareValueForPrometheusMetricName(transactionId)
-
-	return fmt.Sprintf("%s_%s_to_%s_%s_%s", tokenType, sourceNetworkName, targetNetworkName, transactionId, metricTarget), nil
-}
-
-// Success Rate Metrics //
-
-func CreateUserGetHisTokensIfNotExists(sourceChainId, targetChainId uint64, asset string, transferID string, prometheusService service.Prometheus, logger *log.Entry) prometheus.Gauge {
-	if !prometheusService.GetIsMonitoringEnabled() {
-		return nil
-	}
-
-	gauge, err := prometheusService.CreateSuccessRateGaugeIfNotExists(
-		transferID,
-		sourceChainId,
-		targetChainId,
-		asset,
-		constants.UserGetHisTokensNameSuffix,
-		constants.UserGetHisTokensHelp)
-
-	if err != nil {
-		logger.Errorf("[%s] - Failed to create gauge metric for [%s]. Error: [%s]", transferID, constants.UserGetHisTokensNameSuffix, err)
-		return nil
-	}
-	return gauge
-}
-
-func SetUserGetHisTokens(sourceChainId, targetChainId uint64, asset string, transferID string, prometheusService service.Prometheus, logger *log.Entry) {
-	if !prometheusService.GetIsMonitoringEnabled() {
-		return
-	}
-	gauge := CreateUserGetHisTokensIfNotExists(sourceChainId, targetChainId, asset, transferID, prometheusService, logger)
-
-	if gauge == nil {
-		return
-	}
-	logger.Debugf("[%s] - Setting value to 1.0 for metric [%v]", transferID, constants.UserGetHisTokensNameSuffix)
-	gauge.Set(1.0)
-}
-
-func CreateFeeTransferredIfNotExists(sourceChainId, targetChainId uint64, asset string, transferID string, prometheusService service.Prometheus, logger *log.Entry) prometheus.Gauge {
-	if !prometheusService.GetIsMonitoringEnabled() {
-		return nil
-	}
-
-	gauge, err := prometheusService.CreateSuccessRateGaugeIfNotExists(
-		transferID,
-		sourceChainId,
-		targetChainId,
-		asset,
-		constants.FeeTransferredNameSuffix,
-		constants.FeeTransferredHelp)
-
-	if err != nil {
-		logger.Errorf("[%s] - Failed to create gauge metric for [%s]. Error: [%s]", transferID, constants.FeeTransferredNameSuffix, err)
-		return nil
-	}
-	return gauge
-}
-
-func SetFeeTransferred(sourceChainId, targetChainId uint64, asset string, transferID string, prometheusService service.Prometheus, logger *log.Entry) {
-	if !prometheusService.GetIsMonitoringEnabled() {
-		return
-	}
-	gauge := CreateFeeTransferredIfNotExists(sourceChainId, targetChainId, asset, transferID, prometheusService, logger)
-
-	if gauge == nil {
-		return
-	}
-
-	logger.Debugf("[%s] - Setting value to 1.0 for metric [%v]", transferID, constants.FeeTransferredNameSuffix)
-	gauge.Set(1.0)
-}
-
-func CreateMajorityReachedIfNotExists(sourceChainId uint64, targetChainId uint64, asset string, transferID string, prometheusService service.Prometheus, logger *log.Entry) prometheus.Gauge {
-	if !prometheusService.GetIsMonitoringEnabled() {
-		return nil
-	}
-
-	gauge, err := prometheusService.CreateSuccessRateGaugeIfNotExists(
-		transferID,
-		sourceChainId,
-		targetChainId,
-		asset,
-		constants.MajorityReachedNameSuffix,
-		constants.MajorityReachedHelp)
-
-	if err != nil {
-		logger.Errorf("[%s] - Failed to create gauge metric for [%s]. Error: [%s]", transferID, constants.MajorityReachedNameSuffix, err)
-		return nil
-	}
-
-	return gauge
-}
-
-func SetMajorityReached(sourceChainId, targetChainId uint64, asset string, transferID string, prometheusService service.Prometheus, logger *log.Entry) {
-	if !prometheusService.GetIsMonitoringEnabled() {
-		return
-	}
-	gauge := CreateMajorityReachedIfNotExists(sourceChainId, targetChainId, asset, transferID, prometheusService, logger)
-
-	if gauge == nil {
-		return
-	}
-
-	logger.Debugf("[%s] - Setting value to 1.0 for metric [%v]", transferID, constants.MajorityReachedNameSuffix)
-	gauge.Set(1.0)
-}
-
 func AssetAddressToMetricName(assetAddress string) string {
 	replace := PrepareValueForPrometheusMetricName(assetAddress)
 	result := fmt.Sprintf("%s%s", constants.AssetMetricsNamePrefix, replace)
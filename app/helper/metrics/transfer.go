@@ -0,0 +1,96 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package metrics
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+)
+
+var (
+	transferStageLabels         = []string{constants.SourceChainIdMetricLabelKey, constants.TargetChainIdMetricLabelKey, constants.TransferAssetMetricLabelKey, constants.StageMetricLabelKey}
+	transferStageLatencyBuckets = []float64{5, 15, 30, 60, 120, 300, 600, 1800, 3600, 7200}
+)
+
+// ExpectTransferStages counts a transfer, picked up by a watcher, towards the transfers of its route, which are expected
+// to reach each of the given stages. The asset is the asset of the transfer on its source chain
+func ExpectTransferStages(sourceChainId, targetChainId uint64, asset string, prometheusService service.Prometheus, stages ...string) {
+	if !prometheusService.GetIsMonitoringEnabled() {
+		return
+	}
+
+	expected := prometheusService.CreateCounterVecIfNotExists(prometheus.CounterOpts{
+		Name: constants.TransferStagesExpectedCounterName,
+		Help: constants.TransferStagesExpectedCounterHelp,
+	}, transferStageLabels)
+	for _, stage := range stages {
+		expected.WithLabelValues(transferStageLabelValues(sourceChainId, targetChainId, asset, stage)...).Inc()
+	}
+}
+
+// ReachTransferStage counts a transfer towards the transfers of its route, which reached the stage, and observes the
+// time since its source transaction. The latency is not observed for a zero sourceTime
+func ReachTransferStage(stage string, sourceChainId, targetChainId uint64, asset string, sourceTime time.Time, prometheusService service.Prometheus) {
+	if !prometheusService.GetIsMonitoringEnabled() {
+		return
+	}
+
+	values := transferStageLabelValues(sourceChainId, targetChainId, asset, stage)
+	prometheusService.CreateCounterVecIfNotExists(prometheus.CounterOpts{
+		Name: constants.TransferStagesReachedCounterName,
+		Help: constants.TransferStagesReachedCounterHelp,
+	}, transferStageLabels).WithLabelValues(values...).Inc()
+
+	if sourceTime.IsZero() {
+		return
+	}
+	prometheusService.CreateHistogramVecIfNotExists(prometheus.HistogramOpts{
+		Name:    constants.TransferStageLatencyHistogramName,
+		Help:    constants.TransferStageLatencyHistogramHelp,
+		Buckets: transferStageLatencyBuckets,
+	}, transferStageLabels).WithLabelValues(values...).Observe(time.Since(sourceTime).Seconds())
+}
+
+// ReachStoredTransferStage is ReachTransferStage for a transfer, known only by its ID. Its route and the time of its
+// source transaction are read from the persisted transfer
+func ReachStoredTransferStage(stage, transferID string, transferRepository repository.Transfer, prometheusService service.Prometheus, logger *log.Entry) {
+	if !prometheusService.GetIsMonitoringEnabled() {
+		return
+	}
+
+	t, err := transferRepository.GetByTransactionId(transferID)
+	if err != nil {
+		logger.Errorf("[%s] - Failed to get transfer for [%s] metrics. Error: [%s]", transferID, stage, err)
+		return
+	}
+	if t == nil {
+		logger.Warnf("[%s] - Transfer not found. Skipping [%s] metrics.", transferID, stage)
+		return
+	}
+
+	ReachTransferStage(stage, t.SourceChainID, t.TargetChainID, t.SourceAsset, t.Timestamp.Time, prometheusService)
+}
+
+func transferStageLabelValues(sourceChainId, targetChainId uint64, asset, stage string) []string {
+	return []string{strconv.FormatUint(sourceChainId, 10), strconv.FormatUint(targetChainId, 10), asset, stage}
+}
@@ -0,0 +1,120 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package metrics
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	stagesExpected *prometheus.CounterVec
+	stagesReached  *prometheus.CounterVec
+	stageLatency   *prometheus.HistogramVec
+	transferId     = "0.0.1337-1650000000-000000001"
+	hederaChainId  = strconv.FormatUint(constants.HederaNetworkId, 10)
+)
+
+func Test_ExpectTransferStages(t *testing.T) {
+	setupTransferStages(true)
+
+	ExpectTransferStages(constants.HederaNetworkId, 80001, constants.Hbar, mocks.MPrometheusService, constants.MajorityReachedStage, constants.FeeTransferredStage)
+	ExpectTransferStages(constants.HederaNetworkId, 80001, constants.Hbar, mocks.MPrometheusService, constants.MajorityReachedStage)
+
+	assert.Equal(t, float64(2), testutil.ToFloat64(stagesExpected.WithLabelValues(hederaChainId, "80001", constants.Hbar, constants.MajorityReachedStage)))
+	assert.Equal(t, float64(1), testutil.ToFloat64(stagesExpected.WithLabelValues(hederaChainId, "80001", constants.Hbar, constants.FeeTransferredStage)))
+	assert.Equal(t, 2, testutil.CollectAndCount(stagesExpected))
+}
+
+func Test_ExpectTransferStages_MonitoringDisabled(t *testing.T) {
+	setupTransferStages(false)
+
+	ExpectTransferStages(constants.HederaNetworkId, 80001, constants.Hbar, mocks.MPrometheusService, constants.MajorityReachedStage)
+
+	mocks.MPrometheusService.AssertNotCalled(t, "CreateCounterVecIfNotExists", mock.Anything, mock.Anything)
+}
+
+func Test_ReachTransferStage(t *testing.T) {
+	setupTransferStages(true)
+
+	ReachTransferStage(constants.UserGetHisTokensStage, 80001, constants.HederaNetworkId, "0xtoken", time.Now().Add(-time.Minute), mocks.MPrometheusService)
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(stagesReached.WithLabelValues("80001", hederaChainId, "0xtoken", constants.UserGetHisTokensStage)))
+	assert.Equal(t, 1, testutil.CollectAndCount(stageLatency))
+}
+
+func Test_ReachTransferStage_WithoutSourceTime(t *testing.T) {
+	setupTransferStages(true)
+
+	ReachTransferStage(constants.UserGetHisTokensStage, 80001, constants.HederaNetworkId, "0xtoken", time.Time{}, mocks.MPrometheusService)
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(stagesReached.WithLabelValues("80001", hederaChainId, "0xtoken", constants.UserGetHisTokensStage)))
+	assert.Equal(t, 0, testutil.CollectAndCount(stageLatency))
+}
+
+func Test_ReachStoredTransferStage(t *testing.T) {
+	setupTransferStages(true)
+	mocks.MTransferRepository.On("GetByTransactionId", transferId).Return(&entity.Transfer{
+		TransactionID: transferId,
+		SourceChainID: constants.HederaNetworkId,
+		TargetChainID: 80001,
+		SourceAsset:   constants.Hbar,
+		Timestamp:     entity.NanoTime{Time: time.Now().Add(-time.Minute)},
+	}, nil)
+
+	ReachStoredTransferStage(constants.MajorityReachedStage, transferId, mocks.MTransferRepository, mocks.MPrometheusService, config.GetLoggerFor("Metrics"))
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(stagesReached.WithLabelValues(hederaChainId, "80001", constants.Hbar, constants.MajorityReachedStage)))
+	assert.Equal(t, 1, testutil.CollectAndCount(stageLatency))
+}
+
+func Test_ReachStoredTransferStage_GetFails(t *testing.T) {
+	setupTransferStages(true)
+	mocks.MTransferRepository.On("GetByTransactionId", transferId).Return(nil, errors.New("some-error"))
+
+	ReachStoredTransferStage(constants.MajorityReachedStage, transferId, mocks.MTransferRepository, mocks.MPrometheusService, config.GetLoggerFor("Metrics"))
+
+	assert.Equal(t, 0, testutil.CollectAndCount(stagesReached))
+}
+
+// setupTransferStages mocks the transfer stage metrics with unregistered vectors, so that each test starts from zero
+func setupTransferStages(isMonitoringEnabled bool) {
+	mocks.Setup()
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(isMonitoringEnabled)
+
+	stagesExpected = prometheus.NewCounterVec(prometheus.CounterOpts{Name: constants.TransferStagesExpectedCounterName}, transferStageLabels)
+	stagesReached = prometheus.NewCounterVec(prometheus.CounterOpts{Name: constants.TransferStagesReachedCounterName}, transferStageLabels)
+	stageLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{Name: constants.TransferStageLatencyHistogramName}, transferStageLabels)
+	mocks.MPrometheusService.On("CreateCounterVecIfNotExists", mock.MatchedBy(func(opts prometheus.CounterOpts) bool {
+		return opts.Name == constants.TransferStagesExpectedCounterName
+	}), transferStageLabels).
+		Return(stagesExpected)
+	mocks.MPrometheusService.On("CreateCounterVecIfNotExists", mock.MatchedBy(func(opts prometheus.CounterOpts) bool { return opts.Name == constants.TransferStagesReachedCounterName }), transferStageLabels).
+		Return(stagesReached)
+	mocks.MPrometheusService.On("CreateHistogramVecIfNotExists", mock.Anything, transferStageLabels).Return(stageLatency)
+}
@@ -0,0 +1,167 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package metrics
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	pollItemsBuckets    = []float64{0, 1, 5, 10, 25, 50, 100, 250, 500}
+	pollDurationBuckets = prometheus.ExponentialBuckets(0.05, 2, 10)
+)
+
+// Watcher records the progress of a single watcher. The chain time, up to which the watcher has processed
+// all events, is the start of its last poll without new events or the time of the last event it processed.
+// A nil Watcher, returned while monitoring is disabled, records nothing
+type Watcher struct {
+	mu                     sync.Mutex
+	lastProcessedBlock     prometheus.Gauge
+	chainHeadBlock         prometheus.Gauge
+	lagBlocks              prometheus.Gauge
+	lastProcessedTimestamp prometheus.Gauge
+	chainHeadTimestamp     prometheus.Gauge
+	lagSeconds             prometheus.Gauge
+	pollItems              prometheus.Observer
+	pollErrors             prometheus.Counter
+	pollDuration           prometheus.Observer
+	processedUpTo          time.Time
+}
+
+// NewWatcher creates the metrics of the watcher with the given ID, labelled with the ID of the chain it watches
+func NewWatcher(prometheusService service.Prometheus, chainId uint64, id string) *Watcher {
+	if !prometheusService.GetIsMonitoringEnabled() {
+		return nil
+	}
+
+	labels := []string{constants.ChainIdMetricLabelKey, constants.WatcherMetricLabelKey}
+	values := []string{strconv.FormatUint(chainId, 10), id}
+	gauge := func(name, help string) prometheus.Gauge {
+		return prometheusService.CreateGaugeVecIfNotExists(prometheus.GaugeOpts{Name: name, Help: help}, labels).WithLabelValues(values...)
+	}
+	histogram := func(name, help string, buckets []float64) prometheus.Observer {
+		return prometheusService.CreateHistogramVecIfNotExists(prometheus.HistogramOpts{Name: name, Help: help, Buckets: buckets}, labels).WithLabelValues(values...)
+	}
+
+	return &Watcher{
+		lastProcessedBlock:     gauge(constants.WatcherLastProcessedBlockGaugeName, constants.WatcherLastProcessedBlockGaugeHelp),
+		chainHeadBlock:         gauge(constants.WatcherChainHeadBlockGaugeName, constants.WatcherChainHeadBlockGaugeHelp),
+		lagBlocks:              gauge(constants.WatcherLagBlocksGaugeName, constants.WatcherLagBlocksGaugeHelp),
+		lastProcessedTimestamp: gauge(constants.WatcherLastProcessedTimestampGaugeName, constants.WatcherLastProcessedTimestampGaugeHelp),
+		chainHeadTimestamp:     gauge(constants.WatcherChainHeadTimestampGaugeName, constants.WatcherChainHeadTimestampGaugeHelp),
+		lagSeconds:             gauge(constants.WatcherLagSecondsGaugeName, constants.WatcherLagSecondsGaugeHelp),
+		pollItems:              histogram(constants.WatcherPollItemsHistogramName, constants.WatcherPollItemsHistogramHelp, pollItemsBuckets),
+		pollErrors: prometheusService.CreateCounterVecIfNotExists(prometheus.CounterOpts{
+			Name: constants.WatcherPollErrorsCounterName,
+			Help: constants.WatcherPollErrorsCounterHelp,
+		}, labels).WithLabelValues(values...),
+		pollDuration: histogram(constants.WatcherPollDurationHistogramName, constants.WatcherPollDurationHistogramHelp, pollDurationBuckets),
+	}
+}
+
+// PolledHedera records a successful poll of the mirror node, started at `start`, which found the given number of
+// new events. lastProcessed is the consensus timestamp (in nanoseconds) of the last event, processed by the watcher
+func (w *Watcher) PolledHedera(start time.Time, items int, lastProcessed int64) {
+	if w == nil {
+		return
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.lastProcessedTimestamp.Set(float64(lastProcessed) / float64(time.Second))
+	w.chainHeadTimestamp.Set(float64(start.UnixNano()) / float64(time.Second))
+	if items == 0 {
+		w.processedUpTo = start
+	} else {
+		w.processedUpTo = time.Unix(0, lastProcessed)
+	}
+	w.observe(start, items)
+}
+
+// PolledEvm records a successful poll of the EVM chain, started at `start`, which found the given number of new logs.
+// lastProcessed is the last block, processed by the watcher, and head is the latest confirmed block of the chain
+func (w *Watcher) PolledEvm(start time.Time, items int, lastProcessed, head int64) {
+	if w == nil {
+		return
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	lag := head - lastProcessed
+	if lag < 0 {
+		lag = 0
+	}
+	w.lastProcessedBlock.Set(float64(lastProcessed))
+	w.chainHeadBlock.Set(float64(head))
+	w.lagBlocks.Set(float64(lag))
+	w.observe(start, items)
+}
+
+// ProcessedBlock records the time (in seconds), at which the last block processed by an EVM watcher was produced
+func (w *Watcher) ProcessedBlock(timestamp uint64) {
+	if w == nil {
+		return
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.lastProcessedTimestamp.Set(float64(timestamp))
+	w.processedUpTo = time.Unix(int64(timestamp), 0)
+}
+
+// PollFailed records a failed poll, started at `start`
+func (w *Watcher) PollFailed(start time.Time) {
+	if w == nil {
+		return
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.pollErrors.Inc()
+	w.pollDuration.Observe(time.Since(start).Seconds())
+	w.updateLag()
+}
+
+func (w *Watcher) observe(start time.Time, items int) {
+	w.pollItems.Observe(float64(items))
+	w.pollDuration.Observe(time.Since(start).Seconds())
+	w.updateLag()
+}
+
+// updateLag sets the time since the chain time, up to which the watcher has processed all events.
+// Unknown until the watcher processes its first block or poll
+func (w *Watcher) updateLag() {
+	if w.processedUpTo.IsZero() {
+		return
+	}
+
+	lag := time.Since(w.processedUpTo).Seconds()
+	if lag < 0 {
+		lag = 0
+	}
+	w.lagSeconds.Set(lag)
+}
@@ -0,0 +1,132 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	gaugeVecs     map[string]*prometheus.GaugeVec
+	histogramVecs map[string]*prometheus.HistogramVec
+	pollErrors    *prometheus.CounterVec
+)
+
+func Test_NewWatcher_MonitoringDisabled(t *testing.T) {
+	mocks.Setup()
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+
+	w := NewWatcher(mocks.MPrometheusService, 80001, "80001-0xrouter")
+
+	assert.Nil(t, w)
+	w.PolledEvm(time.Now(), 1, 10, 20)
+	w.ProcessedBlock(1650000000)
+	w.PolledHedera(time.Now(), 1, 1650000000000000000)
+	w.PollFailed(time.Now())
+}
+
+func Test_PolledHedera(t *testing.T) {
+	w := setupWatcher(constants.HederaNetworkId, "0.0.1234")
+	start := time.Now()
+	lastProcessed := start.Add(-time.Minute).UnixNano()
+
+	w.PolledHedera(start, 2, lastProcessed)
+
+	assert.Equal(t, float64(lastProcessed)/float64(time.Second), gauge(constants.WatcherLastProcessedTimestampGaugeName))
+	assert.Equal(t, float64(start.UnixNano())/float64(time.Second), gauge(constants.WatcherChainHeadTimestampGaugeName))
+	assert.InDelta(t, 60, gauge(constants.WatcherLagSecondsGaugeName), 1)
+	assert.Equal(t, 1, testutil.CollectAndCount(histogramVecs[constants.WatcherPollItemsHistogramName]))
+
+	w.PolledHedera(time.Now(), 0, lastProcessed)
+
+	assert.InDelta(t, 0, gauge(constants.WatcherLagSecondsGaugeName), 1)
+}
+
+func Test_PolledEvm(t *testing.T) {
+	w := setupWatcher(80001, "80001-0xrouter")
+	processedAt := time.Now().Add(-2 * time.Minute)
+
+	w.ProcessedBlock(uint64(processedAt.Unix()))
+	w.PolledEvm(time.Now(), 3, 100, 130)
+
+	assert.Equal(t, float64(100), gauge(constants.WatcherLastProcessedBlockGaugeName))
+	assert.Equal(t, float64(130), gauge(constants.WatcherChainHeadBlockGaugeName))
+	assert.Equal(t, float64(30), gauge(constants.WatcherLagBlocksGaugeName))
+	assert.Equal(t, float64(processedAt.Unix()), gauge(constants.WatcherLastProcessedTimestampGaugeName))
+	assert.InDelta(t, 120, gauge(constants.WatcherLagSecondsGaugeName), 1)
+
+	w.PolledEvm(time.Now(), 0, 131, 130)
+
+	assert.Equal(t, float64(0), gauge(constants.WatcherLagBlocksGaugeName))
+}
+
+func Test_PollFailed(t *testing.T) {
+	w := setupWatcher(80001, "80001-0xrouter")
+
+	w.PollFailed(time.Now())
+	w.PollFailed(time.Now())
+
+	assert.Equal(t, float64(2), testutil.ToFloat64(pollErrors))
+	assert.Equal(t, float64(0), gauge(constants.WatcherLagSecondsGaugeName))
+}
+
+func gauge(name string) float64 {
+	return testutil.ToFloat64(gaugeVecs[name])
+}
+
+// setupWatcher creates the metrics of the watcher with unregistered vectors, so that each test starts from zero
+func setupWatcher(chainId uint64, id string) *Watcher {
+	mocks.Setup()
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(true)
+	labels := []string{constants.ChainIdMetricLabelKey, constants.WatcherMetricLabelKey}
+
+	gaugeVecs = make(map[string]*prometheus.GaugeVec)
+	for _, name := range []string{
+		constants.WatcherLastProcessedBlockGaugeName,
+		constants.WatcherChainHeadBlockGaugeName,
+		constants.WatcherLagBlocksGaugeName,
+		constants.WatcherLastProcessedTimestampGaugeName,
+		constants.WatcherChainHeadTimestampGaugeName,
+		constants.WatcherLagSecondsGaugeName,
+	} {
+		name := name
+		gaugeVecs[name] = prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: name}, labels)
+		mocks.MPrometheusService.On("CreateGaugeVecIfNotExists", mock.MatchedBy(func(opts prometheus.GaugeOpts) bool { return opts.Name == name }), labels).
+			Return(gaugeVecs[name])
+	}
+
+	histogramVecs = make(map[string]*prometheus.HistogramVec)
+	for _, name := range []string{constants.WatcherPollItemsHistogramName, constants.WatcherPollDurationHistogramName} {
+		name := name
+		histogramVecs[name] = prometheus.NewHistogramVec(prometheus.HistogramOpts{Name: name}, labels)
+		mocks.MPrometheusService.On("CreateHistogramVecIfNotExists", mock.MatchedBy(func(opts prometheus.HistogramOpts) bool { return opts.Name == name }), labels).
+			Return(histogramVecs[name])
+	}
+
+	pollErrors = prometheus.NewCounterVec(prometheus.CounterOpts{Name: constants.WatcherPollErrorsCounterName}, labels)
+	mocks.MPrometheusService.On("CreateCounterVecIfNotExists", mock.Anything, labels).Return(pollErrors)
+
+	return NewWatcher(mocks.MPrometheusService, chainId, id)
+}
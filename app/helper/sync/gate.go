@@ -0,0 +1,97 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sync
+
+import (
+	"context"
+	"sync"
+)
+
+// Gate pauses the loops of a watcher. Watchers call WaitIfPaused at the start of every iteration,
+// so that a paused watcher finishes its current iteration and then blocks until it gets resumed
+type Gate struct {
+	mutex   sync.Mutex
+	resumed chan struct{} // nil while the gate is open, closed on resume
+}
+
+func NewGate() *Gate {
+	return &Gate{}
+}
+
+// Pause blocks the waiters of the gate until Resume is called
+func (g *Gate) Pause() {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	if g.resumed == nil {
+		g.resumed = make(chan struct{})
+	}
+}
+
+// Resume releases the waiters of the gate
+func (g *Gate) Resume() {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	if g.resumed != nil {
+		close(g.resumed)
+		g.resumed = nil
+	}
+}
+
+// Paused returns whether the gate is paused
+func (g *Gate) Paused() bool {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	return g.resumed != nil
+}
+
+// Wait blocks while the gate is paused. Returns false if the context got cancelled, so that loops can stop.
+func (g *Gate) Wait(ctx context.Context) bool {
+	g.mutex.Lock()
+	resumed := g.resumed
+	g.mutex.Unlock()
+
+	if resumed == nil {
+		return ctx.Err() == nil
+	}
+
+	select {
+	case <-resumed:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+type gateKey struct{}
+
+// WithGate returns a copy of the context, carrying the gate of a watcher
+func WithGate(ctx context.Context, gate *Gate) context.Context {
+	return context.WithValue(ctx, gateKey{}, gate)
+}
+
+// WaitIfPaused blocks while the gate, carried by the context, is paused. Contexts without a gate never block.
+// Returns false if the context got cancelled, so that loops can stop.
+func WaitIfPaused(ctx context.Context) bool {
+	gate, ok := ctx.Value(gateKey{}).(*Gate)
+	if !ok {
+		return ctx.Err() == nil
+	}
+	return gate.Wait(ctx)
+}
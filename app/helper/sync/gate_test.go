@@ -0,0 +1,72 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Gate_Open(t *testing.T) {
+	gate := NewGate()
+
+	assert.False(t, gate.Paused())
+	assert.True(t, gate.Wait(context.Background()))
+}
+
+func Test_Gate_PauseAndResume(t *testing.T) {
+	gate := NewGate()
+	gate.Pause()
+	assert.True(t, gate.Paused())
+
+	released := make(chan bool)
+	go func() {
+		released <- gate.Wait(context.Background())
+	}()
+
+	select {
+	case <-released:
+		t.Fatal("Wait returned while the gate is paused")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	gate.Resume()
+	assert.True(t, <-released)
+	assert.False(t, gate.Paused())
+}
+
+func Test_Gate_Cancelled(t *testing.T) {
+	gate := NewGate()
+	gate.Pause()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	assert.False(t, gate.Wait(ctx))
+}
+
+func Test_WaitIfPaused(t *testing.T) {
+	gate := NewGate()
+	ctx, cancel := context.WithCancel(WithGate(context.Background(), gate))
+	gate.Pause()
+	cancel()
+
+	assert.True(t, WaitIfPaused(context.Background()))
+	assert.False(t, WaitIfPaused(ctx))
+}
@@ -0,0 +1,54 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Sleep(t *testing.T) {
+	assert.True(t, Sleep(context.Background(), time.Millisecond))
+}
+
+func Test_Sleep_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	assert.False(t, Sleep(ctx, time.Hour))
+}
+
+func Test_SleepOrWake_Woken(t *testing.T) {
+	wake := make(chan struct{}, 1)
+	wake <- struct{}{}
+
+	assert.True(t, SleepOrWake(context.Background(), time.Hour, wake))
+}
+
+func Test_SleepOrWake_NilWake(t *testing.T) {
+	assert.True(t, SleepOrWake(context.Background(), time.Millisecond, nil))
+}
+
+func Test_SleepOrWake_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	assert.False(t, SleepOrWake(ctx, time.Hour, make(chan struct{})))
+}
@@ -0,0 +1,96 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/limechain/hedera-eth-bridge-validator/test/helper"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func Test_Transport(t *testing.T) {
+	exporter := helper.SetupTracing(t)
+	var traceparent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("traceparent")
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: Transport("mirror-node", nil, func(req *http.Request) []attribute.KeyValue {
+		return []attribute.KeyValue{attribute.String("some-key", "some-value")}
+	})}
+	ctx, parent := Start(context.Background(), "handled")
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/api/v1/transactions", nil)
+
+	response, err := client.Do(req)
+	parent.End()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	spans := exporter.GetSpans()
+	assert.Len(t, spans, 2)
+	assert.Equal(t, "mirror-node GET", spans[0].Name)
+	assert.Equal(t, trace.SpanKindClient, spans[0].SpanKind)
+	assert.Equal(t, parent.SpanContext().SpanID(), spans[0].Parent.SpanID())
+	assert.Contains(t, spans[0].Attributes, attribute.Int("http.response.status_code", http.StatusOK))
+	assert.Contains(t, spans[0].Attributes, attribute.String("some-key", "some-value"))
+	assert.Contains(t, traceparent, spans[0].SpanContext.SpanID().String())
+}
+
+func Test_Transport_ServerError(t *testing.T) {
+	exporter := helper.SetupTracing(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: Transport("mirror-node", nil, nil)}
+	ctx, parent := Start(context.Background(), "handled")
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+
+	_, err := client.Do(req)
+	parent.End()
+
+	assert.Nil(t, err)
+	spans := exporter.GetSpans()
+	assert.Len(t, spans, 2)
+	assert.Equal(t, codes.Error, spans[0].Status.Code)
+}
+
+func Test_Transport_WithoutSpan(t *testing.T) {
+	exporter := helper.SetupTracing(t)
+	var traceparent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("traceparent")
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: Transport("mirror-node", nil, nil)}
+
+	_, err := client.Get(server.URL)
+
+	assert.Nil(t, err)
+	assert.Empty(t, traceparent)
+	assert.Empty(t, exporter.GetSpans())
+}
@@ -0,0 +1,73 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tracing
+
+import (
+	"context"
+
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// Setup sets the global tracer provider, exporting the spans over OTLP/gRPC to the configured endpoint.
+// If tracing is disabled, the spans of the node are not recorded.
+// Returns a function, which exports the remaining spans and stops the export
+func Setup(cfg config.Tracing) (func(ctx context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	if !cfg.Enabled {
+		return func(ctx context.Context) error { return nil }, nil
+	}
+
+	options := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.Endpoint)}
+	if cfg.Insecure {
+		options = append(options, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(context.Background(), options...)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := NewProvider(cfg, sdktrace.NewBatchSpanProcessor(exporter))
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// NewProvider creates a tracer provider, passing the spans, sampled by the configured sampler, to the given processor
+func NewProvider(cfg config.Tracing, processor sdktrace.SpanProcessor) *sdktrace.TracerProvider {
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithSpanProcessor(processor),
+		sdktrace.WithSampler(Sampler(cfg)),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", cfg.ServiceName))))
+}
+
+// Sampler returns the configured sampler. The sampler decides only on the traces, started by the node,
+// so that the spans of a sampled trace are always recorded
+func Sampler(cfg config.Tracing) sdktrace.Sampler {
+	switch cfg.Sampler {
+	case config.AlwaysOffSampler:
+		return sdktrace.ParentBased(sdktrace.NeverSample())
+	case config.RatioSampler:
+		return sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SamplerRatio))
+	default:
+		return sdktrace.ParentBased(sdktrace.AlwaysSample())
+	}
+}
@@ -0,0 +1,83 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func Test_NewProvider(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := NewProvider(config.Tracing{ServiceName: "some-validator", Sampler: config.AlwaysOnSampler}, sdktrace.NewSimpleSpanProcessor(exporter))
+
+	_, span := provider.Tracer(TracerName).Start(context.Background(), "span")
+	span.End()
+
+	spans := exporter.GetSpans()
+	assert.Len(t, spans, 1)
+	assert.Contains(t, spans[0].Resource.Attributes(), attribute.String("service.name", "some-validator"))
+}
+
+func Test_Sampler(t *testing.T) {
+	cases := []struct {
+		cfg     config.Tracing
+		sampled bool
+	}{
+		{config.Tracing{Sampler: config.AlwaysOnSampler}, true},
+		{config.Tracing{Sampler: config.AlwaysOffSampler}, false},
+		{config.Tracing{Sampler: config.RatioSampler, SamplerRatio: 1}, true},
+		{config.Tracing{Sampler: config.RatioSampler, SamplerRatio: 0}, false},
+	}
+
+	for _, c := range cases {
+		provider := NewProvider(c.cfg, sdktrace.NewSimpleSpanProcessor(tracetest.NewInMemoryExporter()))
+
+		_, span := provider.Tracer(TracerName).Start(context.Background(), "root")
+		assert.Equal(t, c.sampled, span.SpanContext().IsSampled(), c.cfg.Sampler)
+		span.End()
+	}
+}
+
+func Test_Sampler_FollowsParent(t *testing.T) {
+	provider := NewProvider(config.Tracing{Sampler: config.AlwaysOffSampler}, sdktrace.NewSimpleSpanProcessor(tracetest.NewInMemoryExporter()))
+	parent := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{1},
+		TraceFlags: trace.FlagsSampled,
+		Remote:     true,
+	})
+
+	_, span := provider.Tracer(TracerName).Start(trace.ContextWithRemoteSpanContext(context.Background(), parent), "child")
+	defer span.End()
+
+	assert.True(t, span.SpanContext().IsSampled())
+}
+
+func Test_Setup_Disabled(t *testing.T) {
+	shutdown, err := Setup(config.Tracing{Enabled: false})
+
+	assert.Nil(t, err)
+	assert.Nil(t, shutdown(context.Background()))
+}
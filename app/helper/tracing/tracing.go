@@ -0,0 +1,104 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tracing
+
+import (
+	"context"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracerName is the name of the tracer of the node, under which every span is recorded
+const TracerName = "github.com/limechain/hedera-eth-bridge-validator"
+
+// Attributes of the spans
+const (
+	TransferIdKey    = attribute.Key("bridge.transfer.id")
+	SourceChainIdKey = attribute.Key("bridge.transfer.source_chain_id")
+	TargetChainIdKey = attribute.Key("bridge.transfer.target_chain_id")
+	SourceAssetKey   = attribute.Key("bridge.transfer.source_asset")
+	TopicKey         = attribute.Key("bridge.queue.topic")
+)
+
+// Tracer returns the tracer of the node from the global tracer provider
+func Tracer() trace.Tracer {
+	return otel.Tracer(TracerName)
+}
+
+// Start starts a span with the given attributes, as a child of the span in the context, if any
+func Start(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, name, trace.WithAttributes(attributes...))
+}
+
+// Continue starts a span as a child of the span in the context. Work outside of a trace is not recorded,
+// hence the context is returned along with its non-recording span, if it carries no span
+func Continue(ctx context.Context, name string, options ...trace.SpanStartOption) (context.Context, trace.Span) {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return ctx, trace.SpanFromContext(ctx)
+	}
+	return Tracer().Start(ctx, name, options...)
+}
+
+// End ends the span, recording the error, if any
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// TransferAttributes returns the attributes, identifying the transfer in a span
+func TransferAttributes(transfer *payload.Transfer) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		TransferIdKey.String(transfer.TransactionId),
+		SourceChainIdKey.Int64(int64(transfer.SourceChainId)),
+		TargetChainIdKey.Int64(int64(transfer.TargetChainId)),
+		SourceAssetKey.String(transfer.SourceAsset),
+	}
+}
+
+// Inject returns the trace context of the span in the context, so that it can travel along with a message.
+// Returns nil if the context has no span
+func Inject(ctx context.Context) map[string]string {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return nil
+	}
+
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	return carrier
+}
+
+// Extract returns a copy of the context, carrying the span of the given trace context
+func Extract(ctx context.Context, traceContext map[string]string) context.Context {
+	if len(traceContext) == 0 {
+		return ctx
+	}
+	return otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier(traceContext))
+}
+
+// Detach returns a context, carrying the span of the given one, which is never cancelled.
+// It is used by the work, which outlives the handling of a message, such as waiting for a transaction to be mined
+func Detach(ctx context.Context) context.Context {
+	return trace.ContextWithSpan(context.Background(), trace.SpanFromContext(ctx))
+}
@@ -0,0 +1,140 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
+	"github.com/limechain/hedera-eth-bridge-validator/test/helper"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func Test_Start(t *testing.T) {
+	exporter := helper.SetupTracing(t)
+
+	ctx, parent := Start(context.Background(), "parent")
+	_, child := Start(ctx, "child", TopicKey.String("some-topic"))
+	child.End()
+	parent.End()
+
+	spans := exporter.GetSpans()
+	assert.Len(t, spans, 2)
+	assert.Equal(t, "child", spans[0].Name)
+	assert.Equal(t, spans[1].SpanContext.SpanID(), spans[0].Parent.SpanID())
+	assert.Contains(t, spans[0].Attributes, TopicKey.String("some-topic"))
+}
+
+func Test_End_WithError(t *testing.T) {
+	exporter := helper.SetupTracing(t)
+
+	_, span := Start(context.Background(), "failing")
+	End(span, errors.New("some-error"))
+
+	spans := exporter.GetSpans()
+	assert.Len(t, spans, 1)
+	assert.Equal(t, codes.Error, spans[0].Status.Code)
+	assert.Equal(t, "some-error", spans[0].Status.Description)
+	assert.Len(t, spans[0].Events, 1)
+}
+
+func Test_TransferAttributes(t *testing.T) {
+	transfer := payload.New("0.0.1-1-1", 296, 80001, 296, "0xreceiver", "HBAR", "0xwrapped", "HBAR", big.NewInt(100))
+
+	expected := []attribute.KeyValue{
+		TransferIdKey.String("0.0.1-1-1"),
+		SourceChainIdKey.Int64(296),
+		TargetChainIdKey.Int64(80001),
+		SourceAssetKey.String("HBAR"),
+	}
+	assert.Equal(t, expected, TransferAttributes(transfer))
+}
+
+func Test_InjectExtract(t *testing.T) {
+	exporter := helper.SetupTracing(t)
+
+	ctx, discovered := Start(context.Background(), "discovered")
+	traceContext := Inject(ctx)
+	discovered.End()
+
+	_, handled := Start(Extract(context.Background(), traceContext), "handled")
+	handled.End()
+
+	spans := exporter.GetSpans()
+	assert.Len(t, spans, 2)
+	assert.Equal(t, spans[0].SpanContext.TraceID(), spans[1].SpanContext.TraceID())
+	assert.Equal(t, spans[0].SpanContext.SpanID(), spans[1].Parent.SpanID())
+	assert.True(t, spans[1].Parent.IsRemote())
+}
+
+func Test_Inject_WithoutSpan(t *testing.T) {
+	helper.SetupTracing(t)
+
+	assert.Nil(t, Inject(context.Background()))
+}
+
+func Test_Extract_WithoutTraceContext(t *testing.T) {
+	helper.SetupTracing(t)
+
+	ctx := Extract(context.Background(), nil)
+
+	assert.False(t, trace.SpanContextFromContext(ctx).IsValid())
+}
+
+func Test_Continue(t *testing.T) {
+	exporter := helper.SetupTracing(t)
+
+	ctx, parent := Start(context.Background(), "parent")
+	_, child := Continue(ctx, "child")
+	child.End()
+	parent.End()
+
+	spans := exporter.GetSpans()
+	assert.Len(t, spans, 2)
+	assert.Equal(t, parent.SpanContext().SpanID(), spans[0].Parent.SpanID())
+}
+
+func Test_Continue_WithoutSpan(t *testing.T) {
+	exporter := helper.SetupTracing(t)
+
+	ctx, span := Continue(context.Background(), "orphan")
+	span.End()
+
+	assert.Equal(t, context.Background(), ctx)
+	assert.False(t, span.IsRecording())
+	assert.Empty(t, exporter.GetSpans())
+}
+
+func Test_Detach(t *testing.T) {
+	helper.SetupTracing(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ctx, span := Start(ctx, "handled")
+	defer span.End()
+	cancel()
+
+	detached := Detach(ctx)
+
+	assert.Nil(t, detached.Err())
+	assert.Equal(t, span.SpanContext(), trace.SpanContextFromContext(detached))
+}
@@ -0,0 +1,84 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+// Scopes of the admin API credentials
+const (
+	ScopeAll       = "*"
+	ScopeTransfers = "transfers:write"
+	ScopeWatchers  = "watchers:write"
+	ScopeMembers   = "members:write"
+	ScopeWebhooks  = "webhooks:write"
+	ScopeAudit     = "audit:read"
+)
+
+// Headers of HMAC signed admin requests
+const (
+	HeaderKey       = "X-Admin-Key"
+	HeaderTimestamp = "X-Admin-Timestamp"
+	// HeaderNonce is a unique value per request, rejected if already seen within the clock skew window
+	HeaderNonce = "X-Admin-Nonce"
+	// HeaderSignature is `sha256=<hex encoded HMAC-SHA256 of "<timestamp>\n<nonce>\n<method>\n<request URI>\n<body>">`
+	HeaderSignature = "X-Admin-Signature"
+)
+
+// Principal is the authenticated caller of the admin API
+type Principal struct {
+	Name   string
+	Scopes []string
+}
+
+// HasScope returns whether the principal is granted the given scope
+func (p Principal) HasScope(scope string) bool {
+	for _, s := range p.Scopes {
+		if s == scope || s == ScopeAll {
+			return true
+		}
+	}
+	return false
+}
+
+// TransferStatusRequest is the body of the request, marking a transfer as COMPLETED or FAILED
+type TransferStatusRequest struct {
+	Status string `json:"status"`
+	Reason string `json:"reason"` // recorded in the status history of the transfer
+}
+
+// RewindRequest is the body of the request, rewinding the Status of a watcher to the given
+// consensus timestamp (Hedera watchers) or block number (EVM watchers)
+type RewindRequest struct {
+	To int64 `json:"to"`
+}
+
+// Watcher is a watcher, which can be paused, resumed and rewound through the admin API
+type Watcher struct {
+	Id     string `json:"id"`
+	Paused bool   `json:"paused"`
+	Last   int64  `json:"last"` // timestamp or block number, up to which the watcher has processed events
+}
+
+// AuditEntry is a logged call to the admin API
+type AuditEntry struct {
+	Id         uint64 `json:"id"`
+	Actor      string `json:"actor"`
+	Method     string `json:"method"`
+	Path       string `json:"path"`
+	Body       string `json:"body,omitempty"`
+	StatusCode int    `json:"statusCode"`
+	RemoteAddr string `json:"remoteAddr"`
+	CreatedAt  int64  `json:"createdAt"`
+}
@@ -27,6 +27,16 @@ import (
 // EncodeFungibleBytesFrom returns the array of bytes representing an
 // authorisation ERC-20 Mint signature ready to be signed by EVM Private Key
 func EncodeFungibleBytesFrom(sourceChainId, targetChainId uint64, txId, asset, receiverEthAddress, amount string) ([]byte, error) {
+	message, err := EncodeFungibleMessageFrom(sourceChainId, targetChainId, txId, asset, receiverEthAddress, amount)
+	if err != nil {
+		return nil, err
+	}
+	return crypto.Keccak256(message), nil
+}
+
+// EncodeFungibleMessageFrom returns the EIP-191 message of an authorisation ERC-20 Mint signature,
+// whose Keccak-256 hash is returned by EncodeFungibleBytesFrom
+func EncodeFungibleMessageFrom(sourceChainId, targetChainId uint64, txId, asset, receiverEthAddress, amount string) ([]byte, error) {
 	args, err := generateFungibleArguments()
 	if err != nil {
 		return nil, err
@@ -46,12 +56,22 @@ func EncodeFungibleBytesFrom(sourceChainId, targetChainId uint64, txId, asset, r
 	if err != nil {
 		return nil, err
 	}
-	return keccak(bytesToHash), nil
+	return ethSignedMessage(bytesToHash), nil
 }
 
 // EncodeNftBytesFrom returns the array of bytes representing an
 // authorisation ERC-721 NFT signature for Mint ready to be signed by EVM Private Key
 func EncodeNftBytesFrom(sourceChainId, targetChainId uint64, txId, asset string, serialNum int64, metadata, receiverEthAddress string) ([]byte, error) {
+	message, err := EncodeNftMessageFrom(sourceChainId, targetChainId, txId, asset, serialNum, metadata, receiverEthAddress)
+	if err != nil {
+		return nil, err
+	}
+	return crypto.Keccak256(message), nil
+}
+
+// EncodeNftMessageFrom returns the EIP-191 message of an authorisation ERC-721 NFT signature for Mint,
+// whose Keccak-256 hash is returned by EncodeNftBytesFrom
+func EncodeNftMessageFrom(sourceChainId, targetChainId uint64, txId, asset string, serialNum int64, metadata, receiverEthAddress string) ([]byte, error) {
 	args, err := generateNftArguments()
 	if err != nil {
 		return nil, err
@@ -68,7 +88,7 @@ func EncodeNftBytesFrom(sourceChainId, targetChainId uint64, txId, asset string,
 	if err != nil {
 		return nil, err
 	}
-	return keccak(bytesToHash), nil
+	return ethSignedMessage(bytesToHash), nil
 }
 
 func generateNftArguments() (abi.Arguments, error) {
@@ -154,8 +174,9 @@ func generateFungibleArguments() (abi.Arguments, error) {
 		}}, nil
 }
 
-func keccak(encodedData []byte) []byte {
+// ethSignedMessage returns the hash of the encoded data, prefixed as defined by EIP-191
+func ethSignedMessage(encodedData []byte) []byte {
 	toEthSignedMsg := []byte("\x19Ethereum Signed Message:\n32")
 	hash := crypto.Keccak256(encodedData)
-	return crypto.Keccak256(toEthSignedMsg, hash)
+	return append(toEthSignedMsg, hash...)
 }
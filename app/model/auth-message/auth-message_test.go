@@ -1,6 +1,7 @@
 package auth_message
 
 import (
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
@@ -40,3 +41,20 @@ func Test_EncodeFungibleBytesFromWorks(t *testing.T) {
 	assert.Nil(t, err)
 	assert.NotNil(t, actualResult)
 }
+
+func Test_EncodeFungibleMessageFrom(t *testing.T) {
+	message, err := EncodeFungibleMessageFrom(sourceChainId, targetChainId, txId, asset, receiverAddress, amount)
+	assert.Nil(t, err)
+
+	digest, _ := EncodeFungibleBytesFrom(sourceChainId, targetChainId, txId, asset, receiverAddress, amount)
+	assert.Equal(t, digest, crypto.Keccak256(message))
+	assert.Equal(t, []byte("\x19Ethereum Signed Message:\n32"), message[:28])
+}
+
+func Test_EncodeNftMessageFrom(t *testing.T) {
+	message, err := EncodeNftMessageFrom(sourceChainId, targetChainId, txId, asset, 1, "metadata", receiverAddress)
+	assert.Nil(t, err)
+
+	digest, _ := EncodeNftBytesFrom(sourceChainId, targetChainId, txId, asset, 1, "metadata", receiverAddress)
+	assert.Equal(t, digest, crypto.Keccak256(message))
+}
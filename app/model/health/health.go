@@ -0,0 +1,75 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package health
+
+// Statuses of the node and its components
+const (
+	StatusUp       = "UP"
+	StatusDegraded = "DEGRADED"
+	StatusDown     = "DOWN"
+)
+
+// Report is the health of the node, whose status is the worst status of its components
+type Report struct {
+	Status     string                `json:"status"`
+	Components map[string]*Component `json:"components"`
+}
+
+// Component is the result of the check of a single dependency or watcher of the node.
+// Only the fields relevant to the type of the component are populated
+type Component struct {
+	Status      string `json:"status"`
+	LatencyMs   int64  `json:"latencyMs,omitempty"`
+	BlockNumber uint64 `json:"blockNumber,omitempty"` // head of an EVM node
+	Last        int64  `json:"last,omitempty"`        // timestamp or block number, up to which a watcher has processed events
+	LagSeconds  *int64 `json:"lagSeconds,omitempty"`  // between the last processed and the latest event of a watcher
+	LagBlocks   *int64 `json:"lagBlocks,omitempty"`   // of an EVM node or watcher, behind the highest head of the EVM network
+	Error       string `json:"error,omitempty"`
+}
+
+// NewReport creates a report, which is UP until a component with a worse status is added
+func NewReport() *Report {
+	return &Report{
+		Status:     StatusUp,
+		Components: make(map[string]*Component),
+	}
+}
+
+// Add adds the component under the given name, degrading the status of the report to the status of the component
+func (r *Report) Add(name string, component *Component) {
+	r.Components[name] = component
+	r.Status = Worst(r.Status, component.Status)
+}
+
+// Worst returns the worse of the two statuses
+func Worst(a, b string) string {
+	if severity(b) > severity(a) {
+		return b
+	}
+	return a
+}
+
+func severity(status string) int {
+	switch status {
+	case StatusUp:
+		return 0
+	case StatusDegraded:
+		return 1
+	default:
+		return 2
+	}
+}
@@ -0,0 +1,47 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package health
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Add(t *testing.T) {
+	report := NewReport()
+	assert.Equal(t, StatusUp, report.Status)
+
+	report.Add("database", &Component{Status: StatusUp})
+	assert.Equal(t, StatusUp, report.Status)
+
+	report.Add("mirror-node", &Component{Status: StatusDegraded})
+	assert.Equal(t, StatusDegraded, report.Status)
+
+	report.Add("hedera-node", &Component{Status: StatusDown})
+	report.Add("watcher-0.0.1", &Component{Status: StatusDegraded})
+	assert.Equal(t, StatusDown, report.Status)
+	assert.Len(t, report.Components, 4)
+}
+
+func Test_Worst(t *testing.T) {
+	assert.Equal(t, StatusUp, Worst(StatusUp, StatusUp))
+	assert.Equal(t, StatusDegraded, Worst(StatusUp, StatusDegraded))
+	assert.Equal(t, StatusDegraded, Worst(StatusDegraded, StatusUp))
+	assert.Equal(t, StatusDown, Worst(StatusDegraded, StatusDown))
+	assert.Equal(t, StatusDown, Worst(StatusDown, StatusUp))
+}
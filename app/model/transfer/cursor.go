@@ -0,0 +1,52 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transfer
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// EncodeCursor returns an opaque cursor, pointing to the transfer with the given timestamp (in nanos) and transaction ID
+func EncodeCursor(timestamp int64, transactionId string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d,%s", timestamp, transactionId)))
+}
+
+// DecodeCursor returns the timestamp (in nanos) and transaction ID of the transfer, the given cursor points to
+func DecodeCursor(cursor string) (int64, string, error) {
+	bytes, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, "", ErrInvalidCursor
+	}
+
+	parts := strings.SplitN(string(bytes), ",", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return 0, "", ErrInvalidCursor
+	}
+
+	timestamp, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return 0, "", ErrInvalidCursor
+	}
+
+	return timestamp, parts[1], nil
+}
@@ -0,0 +1,46 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transfer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Cursor(t *testing.T) {
+	cursor := EncodeCursor(1650000000123456789, "0x1234-5")
+
+	timestamp, transactionId, err := DecodeCursor(cursor)
+
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1650000000123456789), timestamp)
+	assert.Equal(t, "0x1234-5", transactionId)
+}
+
+func Test_DecodeCursor_Invalid(t *testing.T) {
+	for _, cursor := range []string{"", "%%%", EncodeCursor(1, "")[:2], "bm90LWEtbnVtYmVyLDB4MQ"} {
+		_, _, err := DecodeCursor(cursor)
+		assert.Equal(t, ErrInvalidCursor, err, cursor)
+	}
+}
+
+func Test_CountsTotal(t *testing.T) {
+	assert.True(t, (&PagedRequest{Page: 2}).CountsTotal())
+	assert.True(t, (&PagedRequest{}).CountsTotal())
+	assert.False(t, (&PagedRequest{Cursor: EncodeCursor(1, "0x1234-5")}).CountsTotal())
+}
@@ -0,0 +1,80 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transfer
+
+import (
+	"strings"
+	"time"
+)
+
+// Types of the events, streamed to the subscribers of transfers
+const (
+	EventTransferCreated               = "TRANSFER_CREATED"
+	EventSignatureAdded                = "SIGNATURE_ADDED"
+	EventMajorityReached               = "MAJORITY_REACHED"
+	EventScheduledTransactionCompleted = "SCHEDULED_TRANSACTION_COMPLETED"
+	EventScheduledTransactionFailed    = "SCHEDULED_TRANSACTION_FAILED"
+	EventStatusChanged                 = "STATUS_CHANGED"
+)
+
+// Event is a change in the lifecycle of a transfer.
+// Only the fields relevant to the type of the event are populated
+type Event struct {
+	Type                   string    `json:"type"`
+	TransferId             string    `json:"transferId"`
+	Receiver               string    `json:"receiver,omitempty"`
+	Originator             string    `json:"originator,omitempty"`
+	Status                 string    `json:"status,omitempty"`
+	Signer                 string    `json:"signer,omitempty"`
+	Signatures             int       `json:"signatures,omitempty"`
+	ScheduledTransactionId string    `json:"scheduledTransactionId,omitempty"`
+	Timestamp              time.Time `json:"timestamp"`
+}
+
+// Subscription selects the events of the given transfers and the transfers of the given receiver or originator.
+// All selects the events of all transfers and is used by internal subscribers only
+type Subscription struct {
+	TransferIds []string
+	Receiver    string
+	Originator  string
+	All         bool
+}
+
+// IsEmpty returns whether the subscription does not select any events
+func (s Subscription) IsEmpty() bool {
+	return !s.All && len(s.TransferIds) == 0 && s.Receiver == "" && s.Originator == ""
+}
+
+// Matches returns whether the given event is selected by the subscription
+func (s Subscription) Matches(event *Event) bool {
+	if s.All {
+		return true
+	}
+
+	for _, id := range s.TransferIds {
+		if id == event.TransferId {
+			return true
+		}
+	}
+
+	// EVM addresses are case-insensitive
+	if s.Receiver != "" && strings.EqualFold(s.Receiver, event.Receiver) {
+		return true
+	}
+
+	return s.Originator != "" && strings.EqualFold(s.Originator, event.Originator)
+}
@@ -0,0 +1,30 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transfer
+
+import "time"
+
+// StatusChange is a single change of status of a transfer, or of one of its scheduled transactions and fees
+type StatusChange struct {
+	Kind      string    `json:"kind"` // TRANSFER, SCHEDULE or FEE
+	Id        string    `json:"id"`   // transaction ID of the transfer, schedule or fee
+	From      string    `json:"from,omitempty"`
+	To        string    `json:"to"`
+	Reason    string    `json:"reason"`
+	Actor     string    `json:"actor"`
+	Timestamp time.Time `json:"timestamp"`
+}
@@ -0,0 +1,54 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transfer
+
+import "time"
+
+// Types of the steps in the lifecycle of a transfer
+const (
+	StepSourceTransaction    = "SOURCE_TRANSACTION"
+	StepSignature            = "SIGNATURE"
+	StepScheduledTransaction = "SCHEDULED_TRANSACTION"
+	StepFee                  = "FEE"
+	StepClaim                = "CLAIM"
+)
+
+// Timeline is the ordered lifecycle of a transfer, starting from its source transaction
+type Timeline struct {
+	TransactionId string          `json:"transactionId"`
+	Status        string          `json:"status"`
+	Steps         []*TimelineStep `json:"steps"`
+	ElapsedMs     int64           `json:"elapsedMs"` // time between the source transaction and the last step
+}
+
+// TimelineStep is a single step in the lifecycle of a transfer.
+// Only the fields relevant to the type of the step are populated
+type TimelineStep struct {
+	Type          string    `json:"type"`
+	Timestamp     time.Time `json:"timestamp"`
+	ElapsedMs     int64     `json:"elapsedMs"` // time since the previous step
+	TransactionId string    `json:"transactionId,omitempty"`
+	Signer        string    `json:"signer,omitempty"`
+	Signature     string    `json:"signature,omitempty"`
+	Operation     string    `json:"operation,omitempty"`
+	ScheduleId    string    `json:"scheduleId,omitempty"`
+	Status        string    `json:"status,omitempty"`
+	Amount        string    `json:"amount,omitempty"`
+	BlockNumber   uint64    `json:"blockNumber,omitempty"`
+	Claimer       string    `json:"claimer,omitempty"`
+	Failure       *Failure  `json:"failure,omitempty"`
+}
@@ -40,24 +40,69 @@ type Transfer struct {
 	Timestamp     time.Time `json:"timestamp"`
 	Fee           string    `json:"fee,omitempty"`
 	Status        string    `json:"status"`
+	Claim         *Claim    `json:"claim,omitempty"`
+	Failure       *Failure  `json:"failure,omitempty"`
+}
+
+// Claim is the EVM transaction, in which the receiver claimed a transfer
+type Claim struct {
+	TxHash      string    `json:"txHash"`
+	BlockNumber uint64    `json:"blockNumber"`
+	Timestamp   time.Time `json:"timestamp"`
+	Claimer     string    `json:"claimer"`
+}
+
+// Failure is the reason, for which a transfer or a scheduled transaction has failed
+type Failure struct {
+	Code        string `json:"code"`
+	Reason      string `json:"reason"`
+	Description string `json:"description"` // generic description of the code
 }
 
 type Paged struct {
 	Items      []*Transfer `json:"items"`
-	TotalCount int64       `json:"totalCount"`
+	TotalCount *int64      `json:"totalCount,omitempty"` // omitted for the pages after the first one of cursor pagination
+	NextCursor string      `json:"nextCursor,omitempty"` // set only for cursor pagination, when there may be more items
 }
 
+// PagedRequest is either offset based (Page > 0) or cursor based (Page is omitted).
+// Cursor based requests start without a Cursor and continue with the NextCursor of the previous response
 type PagedRequest struct {
 	Page     uint64 `json:"page"`
 	PageSize uint64 `json:"pageSize"`
+	Cursor   string `json:"cursor"`
 	Filter   Filter `json:"filter"`
 }
 
+// IsCursorBased returns whether the request pages by cursor instead of by offset
+func (r *PagedRequest) IsCursorBased() bool {
+	return r.Page == 0
+}
+
+// CountsTotal returns whether the total count of the matching transfers is returned with the page. Cursor based
+// requests count it only on their first page, so that paging through all of them does not count them each time
+func (r *PagedRequest) CountsTotal() bool {
+	return !r.IsCursorBased() || r.Cursor == ""
+}
+
 type Filter struct {
-	Originator     string `json:"originator"`
-	TimestampQuery string `json:"timestamp"`
-	TokenId        string `json:"tokenId"`
-	TransactionId  string `json:"transactionId"`
+	Originator     string     `json:"originator"`
+	TimestampQuery string     `json:"timestamp"`
+	TokenId        string     `json:"tokenId"`
+	TransactionId  string     `json:"transactionId"`
+	Claimed        *bool      `json:"claimed"`
+	Claimer        string     `json:"claimer"`
+	Status         string     `json:"status"`
+	FailureCode    string     `json:"failureCode"`
+	SourceChainId  uint64     `json:"sourceChainId"`
+	TargetChainId  uint64     `json:"targetChainId"`
+	NativeChainId  uint64     `json:"nativeChainId"`
+	Receiver       string     `json:"receiver"`
+	IsNft          *bool      `json:"isNft"`
+	MinAmount      string     `json:"minAmount"` // inclusive, in the smallest denomination of the source asset
+	MaxAmount      string     `json:"maxAmount"` // inclusive, in the smallest denomination of the source asset
+	From           *time.Time `json:"from"`      // inclusive
+	To             *time.Time `json:"to"`        // exclusive
 }
 
 type SanityCheckResult struct {
@@ -66,11 +111,3 @@ type SanityCheckResult struct {
 	NftId      *hedera.NftID
 	Err        error
 }
-
-type TransferReset struct {
-	TransactionId string `json:"transactionId"`
-	SourceChainId uint64 `json:"sourceChainId"`
-	TargetChainId uint64 `json:"targetChainId"`
-	SourceToken   string `json:"sourceToken"`
-	Password      string `json:"password"`
-}
@@ -0,0 +1,73 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package webhook
+
+import (
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+)
+
+// Events, for which webhooks are fired
+const (
+	EventTransferCreated               = transfer.EventTransferCreated
+	EventMajorityReached               = transfer.EventMajorityReached
+	EventScheduledTransactionCompleted = transfer.EventScheduledTransactionCompleted
+	EventScheduledTransactionFailed    = transfer.EventScheduledTransactionFailed
+	EventTransferCompleted             = "TRANSFER_COMPLETED"
+	EventTransferFailed                = "TRANSFER_FAILED"
+	EventTransferClaimed               = "TRANSFER_CLAIMED"
+	EventTransferRefunded              = "TRANSFER_REFUNDED"
+)
+
+// Statuses of a webhook delivery
+const (
+	StatusPending   = "PENDING"
+	StatusDelivered = "DELIVERED"
+	StatusFailed    = "FAILED"
+)
+
+// Headers of the webhook requests
+const (
+	HeaderEvent     = "X-Bridge-Event"
+	HeaderDelivery  = "X-Bridge-Delivery"
+	HeaderTimestamp = "X-Bridge-Timestamp"
+	// HeaderSignature is `sha256=<hex encoded HMAC-SHA256 of "<timestamp>.<body>">`
+	HeaderSignature = "X-Bridge-Signature"
+)
+
+// Payload is the JSON body, POSTed to the webhook endpoints
+type Payload struct {
+	Event     string          `json:"event"`
+	Timestamp time.Time       `json:"timestamp"`
+	Data      *transfer.Event `json:"data"`
+}
+
+// Delivery is a logged delivery of a webhook
+type Delivery struct {
+	ID           uint64 `json:"id"`
+	Url          string `json:"url"`
+	Event        string `json:"event"`
+	TransferId   string `json:"transferId"`
+	Status       string `json:"status"`
+	Attempts     int    `json:"attempts"`
+	ResponseCode int    `json:"responseCode,omitempty"`
+	LastError    string `json:"lastError,omitempty"`
+	ReplayOf     uint64 `json:"replayOf,omitempty"`
+	CreatedAt    int64  `json:"createdAt"`
+	DeliveredAt  int64  `json:"deliveredAt,omitempty"`
+}
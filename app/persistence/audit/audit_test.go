@@ -0,0 +1,91 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package audit
+
+import (
+	"database/sql/driver"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/test/helper"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+var (
+	repository   *Repository
+	dbConnection *gorm.DB
+	sqlMock      sqlmock.Sqlmock
+
+	getLatestQuery = regexp.QuoteMeta(`SELECT * FROM "audit_logs" ORDER BY id desc LIMIT 10`)
+
+	columns = []string{"id", "actor", "method", "path", "body", "status_code", "remote_addr", "created_at"}
+	rowArgs = []driver.Value{uint64(1), "ops", "POST", "/api/v1/admin/watchers/0.0.1234/pause", "", 204, "127.0.0.1:1234", int64(1650000000000000000)}
+	entry   = &entity.AuditLog{
+		ID:         1,
+		Actor:      "ops",
+		Method:     "POST",
+		Path:       "/api/v1/admin/watchers/0.0.1234/pause",
+		StatusCode: 204,
+		RemoteAddr: "127.0.0.1:1234",
+		CreatedAt:  1650000000000000000,
+	}
+)
+
+func setup() {
+	mocks.Setup()
+	dbConnection, sqlMock, _ = helper.SetupSqlMock()
+
+	repository = &Repository{
+		db:     dbConnection,
+		logger: config.GetLoggerFor("Audit Log Repository"),
+	}
+}
+
+func Test_NewRepository(t *testing.T) {
+	setup()
+
+	actualRepository := NewRepository(dbConnection)
+
+	assert.Equal(t, repository, actualRepository)
+}
+
+func Test_GetLatest(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	helper.SqlMockPrepareQuery(sqlMock, columns, rowArgs, getLatestQuery)
+
+	actual, err := repository.GetLatest(10)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []*entity.AuditLog{entry}, actual)
+}
+
+func Test_GetLatest_Error(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	expectedErr := helper.SqlMockPrepareQueryWithErrInvalidData(sqlMock, getLatestQuery)
+
+	actual, err := repository.GetLatest(10)
+
+	assert.Equal(t, expectedErr, err)
+	assert.Nil(t, actual)
+}
@@ -0,0 +1,133 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package batch
+
+import (
+	"errors"
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Repository struct {
+	db     *gorm.DB
+	logger *log.Entry
+}
+
+func NewRepository(dbClient *gorm.DB) *Repository {
+	return &Repository{
+		db:     dbClient,
+		logger: config.GetLoggerFor("Batch Repository"),
+	}
+}
+
+func (r *Repository) Save(item *entity.BatchItem) error {
+	return r.db.
+		Clauses(clause.OnConflict{UpdateAll: true}).
+		Create(item).
+		Error
+}
+
+func (r *Repository) GetDue(at time.Time) ([]*entity.BatchItem, error) {
+	var batches []*entity.BatchItem
+	err := r.db.
+		Model(&entity.BatchItem{}).
+		Select("batch_id, token, MAX(execute_at) AS execute_at").
+		Where("executed_at = ?", 0).
+		Group("batch_id, token").
+		Having("MAX(execute_at) <= ?", at.UnixNano()).
+		Find(&batches).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return batches, nil
+}
+
+func (r *Repository) CountUnbatched(token string, from, to time.Time) (int64, error) {
+	var count int64
+	err := r.db.
+		Model(&entity.Transfer{}).
+		Where("status = ? AND target_asset = ? AND target_chain_id IN ? AND is_nft = ? AND timestamp >= ? AND timestamp < ?",
+			status.Initial, token, []uint64{constants.HederaNetworkId, constants.OldHederaNetworkId}, false, from.UnixNano(), to.UnixNano()).
+		Where("NOT EXISTS (SELECT 1 FROM batch_items WHERE batch_items.transfer_id = transfers.transaction_id)").
+		Count(&count).
+		Error
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (r *Repository) TakePending(batchID string) ([]*entity.BatchItem, error) {
+	var items []*entity.BatchItem
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.
+			Select("batch_items.*").
+			Joins("JOIN transfers ON transfers.transaction_id = batch_items.transfer_id").
+			Where("batch_items.batch_id = ? AND batch_items.executed_at = ? AND transfers.status = ?", batchID, 0, status.Initial).
+			Find(&items).
+			Error
+		if err != nil {
+			return err
+		}
+
+		// Items of transfers, which are no longer in INITIAL, are dropped along with the executed ones
+		return tx.
+			Model(&entity.BatchItem{}).
+			Where("batch_id = ? AND executed_at = ?", batchID, 0).
+			UpdateColumn("executed_at", time.Now().UnixNano()).
+			Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+func (r *Repository) Get(transferID string) (*entity.BatchItem, error) {
+	item := &entity.BatchItem{}
+	err := r.db.
+		Where("transfer_id = ?", transferID).
+		First(item).
+		Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return item, nil
+}
+
+func (r *Repository) Requeue(transferID string) error {
+	return r.db.
+		Model(&entity.BatchItem{}).
+		Where("transfer_id = ?", transferID).
+		UpdateColumn("executed_at", 0).
+		Error
+}
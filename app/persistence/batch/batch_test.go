@@ -0,0 +1,230 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package batch
+
+import (
+	"database/sql/driver"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/limechain/hedera-eth-bridge-validator/test/helper"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+var (
+	repository   *Repository
+	dbConnection *gorm.DB
+	sqlMock      sqlmock.Sqlmock
+
+	saveQuery           = regexp.QuoteMeta(`INSERT INTO "batch_items" ("transfer_id","batch_id","token","receiver","transfers","execute_at","executed_at") VALUES ($1,$2,$3,$4,$5,$6,$7) ON CONFLICT ("transfer_id") DO UPDATE SET "batch_id"="excluded"."batch_id","token"="excluded"."token","receiver"="excluded"."receiver","transfers"="excluded"."transfers","execute_at"="excluded"."execute_at","executed_at"="excluded"."executed_at"`)
+	getDueQuery         = regexp.QuoteMeta(`SELECT batch_id, token, MAX(execute_at) AS execute_at FROM "batch_items" WHERE executed_at = $1 GROUP BY batch_id, token HAVING MAX(execute_at) <= $2`)
+	countUnbatchedQuery = regexp.QuoteMeta(`SELECT count(*) FROM "transfers" WHERE (status = $1 AND target_asset = $2 AND target_chain_id IN ($3,$4) AND is_nft = $5 AND timestamp >= $6 AND timestamp < $7) AND NOT EXISTS (SELECT 1 FROM batch_items WHERE batch_items.transfer_id = transfers.transaction_id)`)
+	getQuery            = regexp.QuoteMeta(`SELECT * FROM "batch_items" WHERE transfer_id = $1 ORDER BY "batch_items"."transfer_id" LIMIT 1`)
+	requeueQuery        = regexp.QuoteMeta(`UPDATE "batch_items" SET "executed_at"=$1 WHERE transfer_id = $2`)
+	selectPendingQuery  = regexp.QuoteMeta(`SELECT batch_items.* FROM "batch_items" JOIN transfers ON transfers.transaction_id = batch_items.transfer_id WHERE batch_items.batch_id = $1 AND batch_items.executed_at = $2 AND transfers.status = $3`)
+	markExecutedQuery   = regexp.QuoteMeta(`UPDATE "batch_items" SET "executed_at"=$1 WHERE batch_id = $2 AND executed_at = $3`)
+
+	batchId = "batch-0.0.111111-1700000000"
+	item    = &entity.BatchItem{
+		TransferID: "0xb24e4c40ab2ea16d3c8a1ad8ed25e1db8c0e6a1a2fbd5a2f0d1a6a5f8e4e1f2a-1",
+		BatchID:    batchId,
+		Token:      "0.0.111111",
+		Receiver:   "0.0.222222",
+		Transfers: []entity.BatchTransfer{
+			{AccountID: "0.0.222222", Amount: 90},
+			{AccountID: "0.0.333333", Amount: 10},
+		},
+		ExecuteAt: 1700000090000000000,
+	}
+	serializedTransfers = `[{"AccountID":"0.0.222222","Amount":90},{"AccountID":"0.0.333333","Amount":10}]`
+	columns             = []string{"transfer_id", "batch_id", "token", "receiver", "transfers", "execute_at", "executed_at"}
+)
+
+func setup() {
+	mocks.Setup()
+	dbConnection, sqlMock, _ = helper.SetupSqlMock()
+
+	repository = &Repository{
+		db:     dbConnection,
+		logger: config.GetLoggerFor("Batch Repository"),
+	}
+}
+
+func Test_NewRepository(t *testing.T) {
+	setup()
+
+	actualRepository := NewRepository(dbConnection)
+
+	assert.Equal(t, repository, actualRepository)
+}
+
+func Test_Save(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	helper.SqlMockPrepareExec(sqlMock, saveQuery, item.TransferID, item.BatchID, item.Token, item.Receiver, serializedTransfers, item.ExecuteAt, item.ExecutedAt)
+
+	err := repository.Save(item)
+
+	assert.Nil(t, err)
+}
+
+func Test_Save_Error(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	expectedErr := helper.SqlMockPrepareExecWithErr(sqlMock, saveQuery, item.TransferID, item.BatchID, item.Token, item.Receiver, serializedTransfers, item.ExecuteAt, item.ExecutedAt)
+
+	err := repository.Save(item)
+
+	assert.Error(t, err, expectedErr)
+}
+
+func Test_GetDue(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	at := time.Unix(0, item.ExecuteAt)
+	helper.SqlMockPrepareQuery(sqlMock, []string{"batch_id", "token", "execute_at"}, []driver.Value{batchId, item.Token, item.ExecuteAt}, getDueQuery, 0, item.ExecuteAt)
+
+	actual, err := repository.GetDue(at)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []*entity.BatchItem{{BatchID: batchId, Token: item.Token, ExecuteAt: item.ExecuteAt}}, actual)
+}
+
+func Test_GetDue_Error(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	at := time.Unix(0, item.ExecuteAt)
+	expectedErr := helper.SqlMockPrepareQueryWithErrInvalidData(sqlMock, getDueQuery, 0, item.ExecuteAt)
+
+	actual, err := repository.GetDue(at)
+
+	assert.Nil(t, actual)
+	assert.Error(t, err, expectedErr)
+}
+
+func Test_CountUnbatched(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	from, to := time.Unix(1700000000, 0), time.Unix(1700000030, 0)
+	helper.SqlMockPrepareQuery(sqlMock, []string{"count"}, []driver.Value{2}, countUnbatchedQuery,
+		status.Initial, item.Token, constants.HederaNetworkId, constants.OldHederaNetworkId, false, from.UnixNano(), to.UnixNano())
+
+	actual, err := repository.CountUnbatched(item.Token, from, to)
+
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), actual)
+}
+
+func Test_CountUnbatched_Error(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	from, to := time.Unix(1700000000, 0), time.Unix(1700000030, 0)
+	expectedErr := helper.SqlMockPrepareQueryWithErrInvalidData(sqlMock, countUnbatchedQuery,
+		status.Initial, item.Token, constants.HederaNetworkId, constants.OldHederaNetworkId, false, from.UnixNano(), to.UnixNano())
+
+	actual, err := repository.CountUnbatched(item.Token, from, to)
+
+	assert.Equal(t, int64(0), actual)
+	assert.Error(t, err, expectedErr)
+}
+
+func Test_TakePending(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	sqlMock.ExpectBegin()
+	helper.SqlMockPrepareQuery(sqlMock, columns,
+		[]driver.Value{item.TransferID, item.BatchID, item.Token, item.Receiver, serializedTransfers, item.ExecuteAt, item.ExecutedAt},
+		selectPendingQuery, batchId, 0, status.Initial)
+	helper.SqlMockPrepareExec(sqlMock, markExecutedQuery, sqlmock.AnyArg(), batchId, 0)
+	sqlMock.ExpectCommit()
+
+	actual, err := repository.TakePending(batchId)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []*entity.BatchItem{item}, actual)
+}
+
+func Test_TakePending_SelectError(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	sqlMock.ExpectBegin()
+	expectedErr := helper.SqlMockPrepareQueryWithErrInvalidData(sqlMock, selectPendingQuery, batchId, 0, status.Initial)
+	sqlMock.ExpectRollback()
+
+	actual, err := repository.TakePending(batchId)
+
+	assert.Nil(t, actual)
+	assert.Error(t, err, expectedErr)
+}
+
+func Test_TakePending_UpdateError(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	sqlMock.ExpectBegin()
+	helper.SqlMockPrepareQuery(sqlMock, columns,
+		[]driver.Value{item.TransferID, item.BatchID, item.Token, item.Receiver, serializedTransfers, item.ExecuteAt, item.ExecutedAt},
+		selectPendingQuery, batchId, 0, status.Initial)
+	expectedErr := helper.SqlMockPrepareExecWithErr(sqlMock, markExecutedQuery, sqlmock.AnyArg(), batchId, 0)
+	sqlMock.ExpectRollback()
+
+	actual, err := repository.TakePending(batchId)
+
+	assert.Nil(t, actual)
+	assert.Error(t, err, expectedErr)
+}
+
+func Test_Get(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	helper.SqlMockPrepareQuery(sqlMock, columns,
+		[]driver.Value{item.TransferID, item.BatchID, item.Token, item.Receiver, serializedTransfers, item.ExecuteAt, item.ExecutedAt},
+		getQuery, item.TransferID)
+
+	actual, err := repository.Get(item.TransferID)
+
+	assert.Nil(t, err)
+	assert.Equal(t, item, actual)
+}
+
+func Test_Get_NotFound(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	_ = helper.SqlMockPrepareQueryWithErrNotFound(sqlMock, getQuery, item.TransferID)
+
+	actual, err := repository.Get(item.TransferID)
+
+	assert.Nil(t, err)
+	assert.Nil(t, actual)
+}
+
+func Test_Requeue(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	helper.SqlMockPrepareExec(sqlMock, requeueQuery, 0, item.TransferID)
+
+	err := repository.Requeue(item.TransferID)
+
+	assert.Nil(t, err)
+}
@@ -0,0 +1,80 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package block
+
+import (
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Repository struct {
+	db     *gorm.DB
+	logger *log.Entry
+}
+
+func NewRepository(dbClient *gorm.DB) *Repository {
+	return &Repository{
+		db:     dbClient,
+		logger: config.GetLoggerFor("Block Repository"),
+	}
+}
+
+func (r *Repository) Save(block *entity.Block) error {
+	return r.db.
+		Clauses(clause.OnConflict{UpdateAll: true}).
+		Create(block).
+		Error
+}
+
+func (r *Repository) GetLatest(entityID string, limit int) ([]*entity.Block, error) {
+	var blocks []*entity.Block
+	err := r.db.
+		Where("entity_id = ?", entityID).
+		Order("number desc").
+		Limit(limit).
+		Find(&blocks).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return blocks, nil
+}
+
+func (r *Repository) DeleteFrom(entityID string, number int64) error {
+	return r.db.
+		Where("entity_id = ? AND number >= ?", entityID, number).
+		Delete(&entity.Block{}).
+		Error
+}
+
+func (r *Repository) Prune(entityID string, retain int) error {
+	latest := r.db.
+		Model(&entity.Block{}).
+		Select("number").
+		Where("entity_id = ?", entityID).
+		Order("number desc").
+		Limit(retain)
+
+	return r.db.
+		Where("entity_id = ? AND number NOT IN (?)", entityID, latest).
+		Delete(&entity.Block{}).
+		Error
+}
@@ -0,0 +1,131 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package block
+
+import (
+	"database/sql/driver"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/test/helper"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+var (
+	repository   *Repository
+	dbConnection *gorm.DB
+	sqlMock      sqlmock.Sqlmock
+
+	saveQuery      = regexp.QuoteMeta(`INSERT INTO "blocks" ("entity_id","number","hash","timestamp") VALUES ($1,$2,$3,$4) ON CONFLICT ("entity_id","number") DO UPDATE SET "hash"="excluded"."hash","timestamp"="excluded"."timestamp"`)
+	getLatestQuery = regexp.QuoteMeta(`SELECT * FROM "blocks" WHERE entity_id = $1 ORDER BY number desc LIMIT 2`)
+	deleteQuery    = regexp.QuoteMeta(`DELETE FROM "blocks" WHERE entity_id = $1 AND number >= $2`)
+	pruneQuery     = regexp.QuoteMeta(`DELETE FROM "blocks" WHERE entity_id = $1 AND number NOT IN (SELECT "number" FROM "blocks" WHERE entity_id = $2 ORDER BY number desc LIMIT 2)`)
+
+	entityId = "80001-0x0000000000000000000000000000000000000001"
+	block    = &entity.Block{
+		EntityID:  entityId,
+		Number:    100,
+		Hash:      "0xb24e4c40ab2ea16d3c8a1ad8ed25e1db8c0e6a1a2fbd5a2f0d1a6a5f8e4e1f2a",
+		Timestamp: 1649256000,
+	}
+	columns = []string{"entity_id", "number", "hash", "timestamp"}
+)
+
+func setup() {
+	mocks.Setup()
+	dbConnection, sqlMock, _ = helper.SetupSqlMock()
+
+	repository = &Repository{
+		db:     dbConnection,
+		logger: config.GetLoggerFor("Block Repository"),
+	}
+}
+
+func Test_NewRepository(t *testing.T) {
+	setup()
+
+	actualRepository := NewRepository(dbConnection)
+
+	assert.Equal(t, repository, actualRepository)
+}
+
+func Test_Save(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	helper.SqlMockPrepareExec(sqlMock, saveQuery, block.EntityID, block.Number, block.Hash, block.Timestamp)
+
+	err := repository.Save(block)
+
+	assert.Nil(t, err)
+}
+
+func Test_Save_Error(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	expectedErr := helper.SqlMockPrepareExecWithErr(sqlMock, saveQuery, block.EntityID, block.Number, block.Hash, block.Timestamp)
+
+	err := repository.Save(block)
+
+	assert.Error(t, err, expectedErr)
+}
+
+func Test_GetLatest(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	helper.SqlMockPrepareQuery(sqlMock, columns, []driver.Value{block.EntityID, block.Number, block.Hash, block.Timestamp}, getLatestQuery, entityId)
+
+	actual, err := repository.GetLatest(entityId, 2)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []*entity.Block{block}, actual)
+}
+
+func Test_GetLatest_Error(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	expectedErr := helper.SqlMockPrepareQueryWithErrInvalidData(sqlMock, getLatestQuery, entityId)
+
+	actual, err := repository.GetLatest(entityId, 2)
+
+	assert.Nil(t, actual)
+	assert.Error(t, err, expectedErr)
+}
+
+func Test_DeleteFrom(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	helper.SqlMockPrepareExec(sqlMock, deleteQuery, entityId, block.Number)
+
+	err := repository.DeleteFrom(entityId, block.Number)
+
+	assert.Nil(t, err)
+}
+
+func Test_Prune(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	helper.SqlMockPrepareExec(sqlMock, pruneQuery, entityId, entityId)
+
+	err := repository.Prune(entityId, 2)
+
+	assert.Nil(t, err)
+}
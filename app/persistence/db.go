@@ -17,6 +17,8 @@
 package persistence
 
 import (
+	"context"
+
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/database"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
 	log "github.com/sirupsen/logrus"
@@ -53,9 +55,65 @@ func (db *Database) Migrate() {
 			entity.Fee{},
 			entity.Message{},
 			entity.Schedule{},
-			entity.Status{})
+			entity.Status{},
+			entity.QueueMessage{},
+			entity.DeadLetter{},
+			entity.Block{},
+			entity.BatchItem{},
+			entity.WebhookDelivery{},
+			entity.AuditLog{},
+			entity.StatusHistory{})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	err = db.migrateSchedulesPrimaryKey()
 	if err != nil {
 		log.Fatal(err)
 	}
 	log.Println("Migrations passed successfully")
 }
+
+// migrateSchedulesPrimaryKey extends the primary key of schedules with the transfer ID, since
+// batched scheduled transactions are recorded once for every transfer they include
+func (db *Database) migrateSchedulesPrimaryKey() error {
+	var columns int64
+	err := db.Connection().
+		Table("information_schema.key_column_usage").
+		Where("table_name = ? AND constraint_name = ?", "schedules", "schedules_pkey").
+		Count(&columns).
+		Error
+	if err != nil || columns != 1 {
+		return err
+	}
+
+	return db.Connection().Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec(`UPDATE schedules SET transfer_id = '' WHERE transfer_id IS NULL`).Error
+		if err != nil {
+			return err
+		}
+		return tx.Exec(`ALTER TABLE schedules DROP CONSTRAINT schedules_pkey, ADD PRIMARY KEY (transaction_id, transfer_id)`).Error
+	})
+}
+
+// Ping verifies that the database is reachable
+func (db *Database) Ping(ctx context.Context) error {
+	sqlDB, err := db.Connection().DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.PingContext(ctx)
+}
+
+// Close waits for the running queries to finish and closes the connection, if one is established
+func (db *Database) Close() error {
+	if db.connection == nil {
+		return nil
+	}
+
+	sqlDB, err := db.connection.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.Close()
+}
@@ -64,5 +64,10 @@ func (c *pgConnector) tryConnection() *gorm.DB {
 		log.Infof("Retrying to connect to DB with connection string [%s]", c.connString)
 		return c.tryConnection()
 	}
+
+	err = db.Use(TracingPlugin{})
+	if err != nil {
+		log.Fatalf("Failed to register the tracing plugin. Error: [%s]", err)
+	}
 	return db
 }
@@ -66,3 +66,24 @@ func Test_GetConnectionAfterInit(t *testing.T) {
 	assert.Equal(t, dbConn, actual)
 	mocks.MConnector.AssertNotCalled(t, "Connect")
 }
+
+func Test_Close(t *testing.T) {
+	setupDatabase()
+
+	db.connection = dbConn
+	sqlMock.ExpectClose()
+
+	err := db.Close()
+
+	assert.Nil(t, err)
+	assert.Nil(t, sqlMock.ExpectationsWereMet())
+}
+
+func Test_CloseWithoutConnection(t *testing.T) {
+	setupDatabase()
+
+	err := db.Close()
+
+	assert.Nil(t, err)
+	mocks.MConnector.AssertNotCalled(t, "Connect")
+}
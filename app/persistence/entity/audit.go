@@ -0,0 +1,44 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package entity
+
+import "github.com/limechain/hedera-eth-bridge-validator/app/model/admin"
+
+// AuditLog is an append-only db model used to log the calls to the admin API
+type AuditLog struct {
+	ID         uint64 `gorm:"primaryKey;autoIncrement"`
+	Actor      string `gorm:"index"` // name of the token or ID of the key, empty for unauthenticated calls
+	Method     string
+	Path       string
+	Body       string
+	StatusCode int
+	RemoteAddr string
+	CreatedAt  int64 `gorm:"autoCreateTime:nano;index"`
+}
+
+func (a *AuditLog) ToDto() *admin.AuditEntry {
+	return &admin.AuditEntry{
+		Id:         a.ID,
+		Actor:      a.Actor,
+		Method:     a.Method,
+		Path:       a.Path,
+		Body:       a.Body,
+		StatusCode: a.StatusCode,
+		RemoteAddr: a.RemoteAddr,
+		CreatedAt:  a.CreatedAt,
+	}
+}
@@ -0,0 +1,35 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package entity
+
+// BatchItem is a db model used to persist the transfers added to a batch until the batch is executed,
+// so that batches are built from the same transfers by all validators and survive restarts
+type BatchItem struct {
+	TransferID string          `gorm:"primaryKey"` // the included transfer
+	BatchID    string          `gorm:"index"`
+	Token      string          // the token, transferred by the batch
+	Receiver   string          // the Hedera account receiving the amount of the transfer
+	Transfers  []BatchTransfer `gorm:"type:text;serializer:json"` // the positive transfers of the amount and the fee
+	ExecuteAt  int64           // unix nano timestamp after which the batch of the item can be executed
+	ExecutedAt int64           `gorm:"index"` // unix nano timestamp of the execution of the batch. Zero while pending
+}
+
+// BatchTransfer is an amount, credited to a Hedera account by a batch item
+type BatchTransfer struct {
+	AccountID string
+	Amount    int64
+}
@@ -0,0 +1,25 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package entity
+
+// Block is a db model used to track the hashes of the blocks processed by the EVM watchers, so that chain reorganisations can be detected
+type Block struct {
+	EntityID  string `gorm:"primaryKey"`                     // identifier of the EVM watcher
+	Number    int64  `gorm:"primaryKey;autoIncrement:false"` // the last block of a processed range
+	Hash      string
+	Timestamp int64 // block timestamp in seconds
+}
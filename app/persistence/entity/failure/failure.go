@@ -0,0 +1,144 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package failure
+
+import "fmt"
+
+// Code identifies the reason, for which a transfer or a scheduled transaction has failed
+type Code string
+
+// Rejections of incoming transfers by the watchers. Rejected transfers are recorded as failed and are never processed
+const (
+	// Blacklisted is set when the sender or any other party of the source transaction is blacklisted
+	Blacklisted Code = "BLACKLISTED"
+	// InvalidMemo is set when the memo of a Hedera transfer is not a valid `<chain-id>-<receiver>[-<nft-id>]` memo
+	InvalidMemo Code = "INVALID_MEMO"
+	// InvalidReceiver is set when the receiver of an EVM transfer is empty or is not a valid Hedera account
+	InvalidReceiver Code = "INVALID_RECEIVER"
+	// UnsupportedAsset is set when the transferred asset is not configured for the target chain
+	UnsupportedAsset Code = "UNSUPPORTED_ASSET"
+	// UnsupportedRoute is set when the asset cannot be bridged to the target chain, e.g. a wrapped NFT to a chain other than its native one
+	UnsupportedRoute Code = "UNSUPPORTED_ROUTE"
+	// PriceUnavailable is set when there is no USD price of the asset, needed to validate the amount
+	PriceUnavailable Code = "PRICE_UNAVAILABLE"
+	// AmountBelowMinimum is set when the amount is less than the minimum amount of the asset, including the fee
+	AmountBelowMinimum Code = "AMOUNT_BELOW_MINIMUM"
+	// InvalidNftFee is set when the HBAR or custom fee, sent along with a Hedera native NFT, is missing or insufficient
+	InvalidNftFee Code = "INVALID_NFT_FEE"
+	// AmountExceedsLimit is set when the amount, in the decimals of the Hedera asset, does not fit the 64-bit amounts of Hedera transactions
+	AmountExceedsLimit Code = "AMOUNT_EXCEEDS_LIMIT"
+	// InvalidTokenId is set when the id of an ERC-721 token does not fit the 64-bit serial numbers of HTS NFTs
+	InvalidTokenId Code = "INVALID_TOKEN_ID"
+)
+
+// Failures of accepted transfers and their scheduled transactions
+const (
+	// ScheduleSubmissionFailed is set when the scheduled transaction could not be submitted to Hedera
+	ScheduleSubmissionFailed Code = "SCHEDULE_SUBMISSION_FAILED"
+	// ScheduledTransactionFailed is set when the scheduled transaction was executed unsuccessfully for a reason without a dedicated code
+	ScheduledTransactionFailed Code = "SCHEDULED_TRANSACTION_FAILED"
+	// ReceiverNotAssociated is set when the Hedera receiver is not associated with the token
+	ReceiverNotAssociated Code = "RECEIVER_NOT_ASSOCIATED"
+	// ReceiverFrozen is set when the Hedera receiver is frozen for the token
+	ReceiverFrozen Code = "RECEIVER_FROZEN"
+	// ReceiverKycNotGranted is set when the Hedera receiver is not granted KYC for the token
+	ReceiverKycNotGranted Code = "RECEIVER_KYC_NOT_GRANTED"
+	// InsufficientBridgeBalance is set when the bridge or the payer account lacks the balance to execute the transfer
+	InsufficientBridgeBalance Code = "INSUFFICIENT_BRIDGE_BALANCE"
+	// ManuallyFailed is set when the transfer was failed through the admin API
+	ManuallyFailed Code = "MANUALLY_FAILED"
+)
+
+var descriptions = map[Code]string{
+	Blacklisted:                "The transfer involves a blacklisted account.",
+	InvalidMemo:                "The memo of the transfer is not a valid bridge memo.",
+	InvalidReceiver:            "The receiver of the transfer is not a valid account on the target chain.",
+	UnsupportedAsset:           "The asset is not supported for the target chain.",
+	UnsupportedRoute:           "The asset cannot be bridged to the target chain.",
+	PriceUnavailable:           "There is no USD price of the asset to validate the amount against.",
+	AmountBelowMinimum:         "The amount is less than the minimum amount of the asset.",
+	InvalidNftFee:              "The fee, sent along with the NFT, is missing or insufficient.",
+	AmountExceedsLimit:         "The amount exceeds the maximum amount of a Hedera transaction.",
+	InvalidTokenId:             "The id of the token does not fit a Hedera NFT serial number.",
+	ScheduleSubmissionFailed:   "The scheduled transaction could not be submitted to Hedera.",
+	ScheduledTransactionFailed: "The scheduled transaction failed on Hedera.",
+	ReceiverNotAssociated:      "The receiver is not associated with the token.",
+	ReceiverFrozen:             "The receiver is frozen for the token.",
+	ReceiverKycNotGranted:      "The receiver is not granted KYC for the token.",
+	InsufficientBridgeBalance:  "The bridge has insufficient balance to execute the transfer.",
+	ManuallyFailed:             "The transfer was failed by an operator.",
+}
+
+// hederaResults maps the results of failed Hedera transactions to their codes
+var hederaResults = map[string]Code{
+	"TOKEN_NOT_ASSOCIATED_TO_ACCOUNT":   ReceiverNotAssociated,
+	"ACCOUNT_FROZEN_FOR_TOKEN":          ReceiverFrozen,
+	"ACCOUNT_KYC_NOT_GRANTED_FOR_TOKEN": ReceiverKycNotGranted,
+	"INSUFFICIENT_ACCOUNT_BALANCE":      InsufficientBridgeBalance,
+	"INSUFFICIENT_TOKEN_BALANCE":        InsufficientBridgeBalance,
+	"INSUFFICIENT_PAYER_BALANCE":        InsufficientBridgeBalance,
+}
+
+// Description returns a human-readable description of the code. Returns an empty string for unknown codes
+func (c Code) Description() string {
+	return descriptions[c]
+}
+
+// IsRejection returns whether the code is set by a watcher, rejecting an incoming transfer
+func (c Code) IsRejection() bool {
+	switch c {
+	case Blacklisted, InvalidMemo, InvalidReceiver, UnsupportedAsset, UnsupportedRoute, PriceUnavailable, AmountBelowMinimum, InvalidNftFee, AmountExceedsLimit, InvalidTokenId:
+		return true
+	}
+	return false
+}
+
+// IsRefundable returns whether the funds of a transfer, failed with the code, are refunded to its originator.
+// These are the failures, for which the transfer cannot be delivered, regardless of how many times it is processed
+func (c Code) IsRefundable() bool {
+	switch c {
+	case InvalidMemo, ReceiverNotAssociated, ReceiverFrozen, ReceiverKycNotGranted:
+		return true
+	}
+	return false
+}
+
+// FromHederaResult returns the code for the result of a failed Hedera transaction
+func FromHederaResult(result string) Code {
+	if code, ok := hederaResults[result]; ok {
+		return code
+	}
+	return ScheduledTransactionFailed
+}
+
+// Error is returned when a transfer is rejected, carrying the code of the rejection
+type Error struct {
+	Code   Code
+	Reason string
+}
+
+// Errorf returns an error with the given code and a reason, formatted according to the format specifier
+func Errorf(code Code, format string, a ...interface{}) *Error {
+	return &Error{
+		Code:   code,
+		Reason: fmt.Sprintf(format, a...),
+	}
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Reason)
+}
@@ -0,0 +1,64 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package failure
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Description(t *testing.T) {
+	for code := range descriptions {
+		assert.NotEmpty(t, code.Description())
+	}
+	assert.Empty(t, Code("UNKNOWN").Description())
+}
+
+func Test_IsRejection(t *testing.T) {
+	assert.True(t, Blacklisted.IsRejection())
+	assert.True(t, AmountBelowMinimum.IsRejection())
+	assert.True(t, AmountExceedsLimit.IsRejection())
+	assert.True(t, InvalidTokenId.IsRejection())
+	assert.False(t, ReceiverNotAssociated.IsRejection())
+	assert.False(t, ManuallyFailed.IsRejection())
+}
+
+func Test_IsRefundable(t *testing.T) {
+	assert.True(t, InvalidMemo.IsRefundable())
+	assert.True(t, ReceiverFrozen.IsRefundable())
+	assert.False(t, Blacklisted.IsRefundable())
+	assert.False(t, InsufficientBridgeBalance.IsRefundable())
+}
+
+func Test_FromHederaResult(t *testing.T) {
+	assert.Equal(t, ReceiverNotAssociated, FromHederaResult("TOKEN_NOT_ASSOCIATED_TO_ACCOUNT"))
+	assert.Equal(t, InsufficientBridgeBalance, FromHederaResult("INSUFFICIENT_TOKEN_BALANCE"))
+	assert.Equal(t, ScheduledTransactionFailed, FromHederaResult("INVALID_SIGNATURE"))
+}
+
+func Test_Error(t *testing.T) {
+	err := fmt.Errorf("wrapped: %w", Errorf(Blacklisted, "sender [%s] is blacklisted", "0.0.1"))
+
+	var rejection *Error
+	assert.True(t, errors.As(err, &rejection))
+	assert.Equal(t, Blacklisted, rejection.Code)
+	assert.Equal(t, "sender [0.0.1] is blacklisted", rejection.Reason)
+	assert.Equal(t, "wrapped: BLACKLISTED: sender [0.0.1] is blacklisted", err.Error())
+}
@@ -0,0 +1,43 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package entity
+
+// QueueMessage is a db model used to persist messages pushed to the queue until they get acknowledged
+type QueueMessage struct {
+	ID           uint64 `gorm:"primaryKey;autoIncrement"`
+	Topic        string `gorm:"index"`
+	Kind         string // type of the encoded payload
+	Payload      []byte
+	TraceContext map[string]string `gorm:"type:text;serializer:json"` // trace, in which the message was pushed
+	Attempts     int               // number of times the message has been delivered to a handler
+	VisibleAt    int64             `gorm:"index"` // unix nano timestamp after which the message can be (re)delivered
+	CreatedAt    int64             `gorm:"autoCreateTime:nano"`
+}
+
+// DeadLetter is a db model used to store queue messages which could not be handled, along with every column of them
+type DeadLetter struct {
+	ID           uint64 `gorm:"primaryKey"` // ID of the original queue message
+	Topic        string `gorm:"index"`
+	Kind         string
+	Payload      []byte
+	TraceContext map[string]string `gorm:"type:text;serializer:json"`
+	Attempts     int
+	VisibleAt    int64
+	Reason       string
+	CreatedAt    int64
+	FailedAt     int64 `gorm:"autoCreateTime:nano"`
+}
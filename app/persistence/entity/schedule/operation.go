@@ -21,4 +21,5 @@ const (
 	MINT     = "mint"
 	TRANSFER = "transfer"
 	APPROVE  = "approve"
+	REFUND   = "refund"
 )
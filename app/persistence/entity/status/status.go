@@ -16,16 +16,26 @@
 
 package status
 
-// Entity Statuses
+// Entity Statuses. The statuses, to which a transfer, a schedule or a fee can move from each of them, are listed
+// in transitions.go
 const (
 	// Initial is the first status upon Transfer Record creation
 	Initial = "INITIAL"
 	// Completed is a status set once an operation is successfully finished.
-	// This is a terminal status
+	// This is a terminal status of schedules and fees. A completed Transfer can still be claimed or reorged
 	Completed = "COMPLETED"
 	// Failed is a status set once an operation has failed.
-	// This is a terminal status
+	// This is a terminal status of schedules and fees. A failed Transfer can still be re-driven, refunded or reorged
 	Failed = "FAILED"
 	// Submitted is set when a pending Fee/Schedule operation is created.
 	Submitted = "SUBMITTED"
+	// Reorged is set once the source transaction of a Transfer has been removed by a chain reorganisation.
+	// It is left only when the source transaction is included again
+	Reorged = "REORGED"
+	// Claimed is set once the receiver has claimed the Transfer on the target EVM chain.
+	// A claimed Transfer can still be reorged
+	Claimed = "CLAIMED"
+	// Refunded is set once the funds of a failed Transfer have been returned to its originator.
+	// A refunded Transfer can still be reorged
+	Refunded = "REFUNDED"
 )
@@ -0,0 +1,86 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package status
+
+import "errors"
+
+// ErrInvalidTransition is returned when moving a record to a status, which is not reachable from its current one
+var ErrInvalidTransition = errors.New("invalid status transition")
+
+// Records, whose status changes are kept in the history of a transfer
+const (
+	KindTransfer = "TRANSFER"
+	KindSchedule = "SCHEDULE"
+	KindFee      = "FEE"
+)
+
+// Actors, changing the status of a record
+const (
+	ActorWatcher  = "WATCHER"
+	ActorHandler  = "HANDLER"
+	ActorRecovery = "RECOVERY"
+	ActorAdmin    = "ADMIN"
+)
+
+// transferTransitions lists the statuses, to which a transfer can move from each status.
+// Moving to Initial re-drives the transfer. A reorganisation of the source chain overrides any other status.
+// Reorged is left only when the source transaction is included again, which returns the transfer to the status
+// it had before the reorganisation and is not a generic transition. Likewise, a reorganisation of the target chain
+// returns a claimed transfer to the status it had before the claim
+var transferTransitions = map[string][]string{
+	Initial:   {Initial, Completed, Failed, Reorged, Claimed},
+	Completed: {Claimed, Reorged},
+	Failed:    {Initial, Reorged, Refunded},
+	Claimed:   {Reorged},
+	Refunded:  {Reorged},
+	Reorged:   {},
+}
+
+// operationTransitions lists the statuses, to which a schedule or a fee can move from each status
+var operationTransitions = map[string][]string{
+	Submitted: {Completed, Failed},
+	Completed: {},
+	Failed:    {},
+}
+
+// CanTransition returns whether a record of the given kind can move from one status to another
+func CanTransition(kind, from, to string) bool {
+	for _, s := range transitions(kind)[from] {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
+
+// Sources returns the statuses, from which a record of the given kind can move to the given status
+func Sources(kind, to string) []string {
+	var sources []string
+	for _, from := range []string{Initial, Submitted, Completed, Failed, Claimed, Refunded, Reorged} {
+		if CanTransition(kind, from, to) {
+			sources = append(sources, from)
+		}
+	}
+	return sources
+}
+
+func transitions(kind string) map[string][]string {
+	if kind == KindTransfer {
+		return transferTransitions
+	}
+	return operationTransitions
+}
@@ -0,0 +1,62 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package status
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_CanTransition_Transfer(t *testing.T) {
+	assert.True(t, CanTransition(KindTransfer, Initial, Completed))
+	assert.True(t, CanTransition(KindTransfer, Initial, Initial))
+	assert.True(t, CanTransition(KindTransfer, Completed, Claimed))
+	assert.True(t, CanTransition(KindTransfer, Failed, Initial))
+	assert.True(t, CanTransition(KindTransfer, Claimed, Reorged))
+	assert.True(t, CanTransition(KindTransfer, Failed, Refunded))
+	assert.True(t, CanTransition(KindTransfer, Refunded, Reorged))
+	assert.False(t, CanTransition(KindTransfer, Failed, Completed))
+	assert.False(t, CanTransition(KindTransfer, Completed, Failed))
+	assert.False(t, CanTransition(KindTransfer, Claimed, Completed))
+	assert.False(t, CanTransition(KindTransfer, Reorged, Initial))
+	assert.False(t, CanTransition(KindTransfer, Reorged, Completed))
+	assert.False(t, CanTransition(KindTransfer, Reorged, Claimed))
+	assert.False(t, CanTransition(KindTransfer, Reorged, Refunded))
+	assert.False(t, CanTransition(KindTransfer, Reorged, Reorged))
+	assert.False(t, CanTransition(KindTransfer, Completed, Refunded))
+	assert.False(t, CanTransition(KindTransfer, Refunded, Initial))
+	assert.False(t, CanTransition(KindTransfer, Submitted, Completed))
+}
+
+func Test_CanTransition_Operation(t *testing.T) {
+	for _, kind := range []string{KindSchedule, KindFee} {
+		assert.True(t, CanTransition(kind, Submitted, Completed))
+		assert.True(t, CanTransition(kind, Submitted, Failed))
+		assert.False(t, CanTransition(kind, Failed, Completed))
+		assert.False(t, CanTransition(kind, Completed, Submitted))
+		assert.False(t, CanTransition(kind, Initial, Completed))
+	}
+}
+
+func Test_Sources(t *testing.T) {
+	assert.Equal(t, []string{Initial, Completed, Failed, Claimed, Refunded}, Sources(KindTransfer, Reorged))
+	assert.Equal(t, []string{Initial, Completed}, Sources(KindTransfer, Claimed))
+	assert.Equal(t, []string{Failed}, Sources(KindTransfer, Refunded))
+	assert.Equal(t, []string{Submitted}, Sources(KindSchedule, Failed))
+	assert.Nil(t, Sources(KindFee, Submitted))
+}
@@ -0,0 +1,51 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package entity
+
+import (
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/timestamp"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+)
+
+// StatusHistory is an append-only db model, recording each status change of a transfer, its schedules and fees
+type StatusHistory struct {
+	ID         uint64 `gorm:"primaryKey;autoIncrement"`
+	TransferID string `gorm:"index"` // empty for schedules and fees, which are not part of a transfer
+	Kind       string // one of status.KindTransfer, status.KindSchedule or status.KindFee
+	RecordID   string // transaction ID of the transfer, schedule or fee
+	FromStatus string // empty once the record is created
+	ToStatus   string
+	Reason     string
+	Actor      string
+	CreatedAt  int64 `gorm:"autoCreateTime:nano"`
+}
+
+func (StatusHistory) TableName() string {
+	return "transfer_status_history"
+}
+
+func (h *StatusHistory) ToDto() *transfer.StatusChange {
+	return &transfer.StatusChange{
+		Kind:      h.Kind,
+		Id:        h.RecordID,
+		From:      h.FromStatus,
+		To:        h.ToStatus,
+		Reason:    h.Reason,
+		Actor:     h.Actor,
+		Timestamp: timestamp.FromNanos(h.CreatedAt),
+	}
+}
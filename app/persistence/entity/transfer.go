@@ -23,28 +23,38 @@ import (
 	"time"
 
 	transferModel "github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/failure"
 )
 
 type Transfer struct {
-	TransactionID string `gorm:"primaryKey"`
-	SourceChainID uint64
-	TargetChainID uint64
-	NativeChainID uint64
-	SourceAsset   string
-	TargetAsset   string
-	NativeAsset   string
-	Receiver      string
-	Amount        string
-	Fee           string
-	Status        string
-	SerialNumber  int64
-	Metadata      string
-	IsNft         bool     `gorm:"default:false"`
-	Timestamp     NanoTime `sql:"type:bigint" gorm:"index:,sort:desc"`
-	Originator    string
-	Messages      []Message  `gorm:"foreignKey:TransferID"`
-	Fees          []Fee      `gorm:"foreignKey:TransferID"`
-	Schedules     []Schedule `gorm:"foreignKey:TransferID"`
+	TransactionID       string `gorm:"primaryKey"`
+	SourceChainID       uint64
+	TargetChainID       uint64
+	NativeChainID       uint64
+	SourceAsset         string
+	TargetAsset         string
+	NativeAsset         string
+	Receiver            string
+	Amount              string
+	Fee                 string
+	Status              string
+	SerialNumber        int64
+	Metadata            string
+	IsNft               bool     `gorm:"default:false"`
+	Timestamp           NanoTime `sql:"type:bigint" gorm:"index:,sort:desc"`
+	Originator          string
+	WrappedSerialNumber int64        // Serial number of the wrapped Hedera NFT, minted for EVM native NFTs
+	ClaimTxHash         string       `gorm:"default:''"` // Hash of the EVM transaction, in which the receiver claimed the transfer
+	ClaimBlockNumber    uint64       // Number of the block, including the claim transaction
+	ClaimTimestamp      int64        // Unix timestamp (in seconds) of the block, including the claim transaction
+	Claimer             string       // Sender of the claim transaction
+	Redrives            int          // Number of times the transfer has been pushed back to its handler
+	RedrivenAt          int64        // Unix timestamp (in nanoseconds) of the last re-drive
+	FailureCode         failure.Code `gorm:"default:''"` // Set once the transfer has failed or has been rejected by a watcher
+	FailureReason       string       `gorm:"default:''"` // Details of the failure, complementing its code
+	Messages            []Message    `gorm:"foreignKey:TransferID"`
+	Fees                []Fee        `gorm:"foreignKey:TransferID"`
+	Schedules           []Schedule   `gorm:"foreignKey:TransferID"`
 }
 
 func (t *Transfer) ToDto() *transferModel.Transfer {
@@ -65,9 +75,42 @@ func (t *Transfer) ToDto() *transferModel.Transfer {
 		Timestamp:     t.Timestamp.Time,
 		Fee:           t.Fee,
 		Status:        t.Status,
+		Claim:         t.Claim(),
+		Failure:       t.Failure(),
+	}
+}
+
+// ToEvent returns an event of the given type for the current state of the transfer
+func (t *Transfer) ToEvent(eventType string) *transferModel.Event {
+	return &transferModel.Event{
+		Type:       eventType,
+		TransferId: t.TransactionID,
+		Receiver:   t.Receiver,
+		Originator: t.Originator,
+		Status:     t.Status,
+		Timestamp:  time.Now().UTC(),
+	}
+}
+
+// Claim returns the EVM transaction, in which the receiver claimed the transfer. Returns nil if not claimed
+func (t *Transfer) Claim() *transferModel.Claim {
+	if t.ClaimTxHash == "" {
+		return nil
+	}
+
+	return &transferModel.Claim{
+		TxHash:      t.ClaimTxHash,
+		BlockNumber: t.ClaimBlockNumber,
+		Timestamp:   time.Unix(t.ClaimTimestamp, 0).UTC(),
+		Claimer:     t.Claimer,
 	}
 }
 
+// Failure returns the reason, for which the transfer has failed. Returns nil if the transfer has not failed
+func (t *Transfer) Failure() *transferModel.Failure {
+	return newFailure(t.FailureCode, t.FailureReason)
+}
+
 // Message is a db model used to track the messages signed by validators for a given transfer
 type Message struct {
 	TransferID           string
@@ -94,7 +137,26 @@ type Schedule struct {
 	HasReceiver   bool   // True if the scheduled transaction includes the receiver of the TransferID in itself
 	Operation     string // type of scheduled transaction (TokenMint, TokenBurn, CryptoTransfer)
 	Status        string
-	TransferID    sql.NullString // foreign key to the transfer ID
+	TransferID    sql.NullString `gorm:"primaryKey"` // foreign key to the transfer ID. Batched scheduled transactions have a record per included transfer
+	FailureCode   failure.Code   `gorm:"default:''"` // Set once the scheduled transaction has failed
+	FailureReason string         `gorm:"default:''"` // Details of the failure, complementing its code
+}
+
+// Failure returns the reason, for which the scheduled transaction has failed. Returns nil if it has not failed
+func (s *Schedule) Failure() *transferModel.Failure {
+	return newFailure(s.FailureCode, s.FailureReason)
+}
+
+func newFailure(code failure.Code, reason string) *transferModel.Failure {
+	if code == "" {
+		return nil
+	}
+
+	return &transferModel.Failure{
+		Code:        string(code),
+		Reason:      reason,
+		Description: code.Description(),
+	}
 }
 
 type NanoTime struct {
@@ -0,0 +1,52 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package entity
+
+import webhookModel "github.com/limechain/hedera-eth-bridge-validator/app/model/webhook"
+
+// WebhookDelivery is a db model used to log the deliveries of transfer events to webhook endpoints
+type WebhookDelivery struct {
+	ID            uint64 `gorm:"primaryKey;autoIncrement"`
+	Url           string
+	Event         string `gorm:"index"`
+	TransferID    string `gorm:"index"`
+	Payload       []byte
+	Status        string `gorm:"index"` // PENDING, DELIVERED or FAILED
+	Attempts      int
+	ResponseCode  int    // HTTP status code of the last attempt
+	LastError     string // error of the last failed attempt
+	NextAttemptAt int64  `gorm:"index"` // unix nano timestamp after which the delivery can be (re)attempted
+	ReplayOf      uint64 // ID of the replayed delivery
+	CreatedAt     int64  `gorm:"autoCreateTime:nano"`
+	DeliveredAt   int64
+}
+
+func (d *WebhookDelivery) ToDto() *webhookModel.Delivery {
+	return &webhookModel.Delivery{
+		ID:           d.ID,
+		Url:          d.Url,
+		Event:        d.Event,
+		TransferId:   d.TransferID,
+		Status:       d.Status,
+		Attempts:     d.Attempts,
+		ResponseCode: d.ResponseCode,
+		LastError:    d.LastError,
+		ReplayOf:     d.ReplayOf,
+		CreatedAt:    d.CreatedAt,
+		DeliveredAt:  d.DeliveredAt,
+	}
+}
@@ -17,7 +17,9 @@
 package fee
 
 import (
+	"database/sql"
 	"errors"
+	"fmt"
 
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
@@ -57,32 +59,87 @@ func (r *Repository) Get(id string) (*entity.Fee, error) {
 }
 
 func (r *Repository) Create(entity *entity.Fee) error {
-	return r.db.Create(entity).Error
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Create(entity).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Create(r.history(entity.TransactionID, entity.TransferID, "", entity.Status, status.ActorHandler, "fee transfer created")).Error
+	})
+}
+
+// UpdateStatus moves the fee transfer to the given status and records the change in the history of its transfer
+func (r *Repository) UpdateStatus(txId, s, actor, reason string) error {
+	return r.updateStatus(txId, s, actor, reason)
 }
 
 func (r *Repository) UpdateStatusCompleted(txId string) error {
-	return r.updateStatus(txId, status.Completed)
+	return r.updateStatus(txId, status.Completed, status.ActorHandler, "fee transfer executed")
 }
 
 func (r *Repository) UpdateStatusFailed(txId string) error {
-	return r.updateStatus(txId, status.Failed)
+	return r.updateStatus(txId, status.Failed, status.ActorHandler, "fee transfer failed")
 }
 
-func (r *Repository) updateStatus(txId string, s string) error {
-	err := r.db.
-		Model(entity.Fee{}).
-		Where("transaction_id = ?", txId).
-		UpdateColumn("status", s).
-		Error
-
-	if err == nil {
-		if s == status.Failed {
-			r.logger.Errorf("[%s] - Updated Status to [%s]", txId, s)
+// updateStatus moves the fee transfer to the given status, if it is reachable from the current one, and appends the change
+// to the history of its transfer. Moving to the current status is a no-op
+func (r *Repository) updateStatus(txId, to, actor, reason string) error {
+	var from string
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		record := &entity.Fee{}
+		err := tx.
+			Select("status", "transfer_id").
+			Where("transaction_id = ?", txId).
+			First(record).
+			Error
+		if err != nil {
 			return err
 		}
-		r.logger.Infof("[%s] - Updated Status to [%s]", txId, s)
+
+		from = record.Status
+		if from == to {
+			return nil
+		}
+		if !status.CanTransition(status.KindFee, from, to) {
+			return fmt.Errorf("%w from [%s] to [%s]", status.ErrInvalidTransition, from, to)
+		}
+
+		result := tx.
+			Model(entity.Fee{}).
+			Where("transaction_id = ? AND status = ?", txId, from).
+			UpdateColumn("status", to)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected != 1 {
+			return fmt.Errorf("updated %d rows, expected 1", result.RowsAffected)
+		}
+
+		return tx.Create(r.history(txId, record.TransferID, from, to, actor, reason)).Error
+	})
+	if err != nil || from == to {
+		return err
+	}
+
+	if to == status.Failed {
+		r.logger.Errorf("[%s] - Updated Status from [%s] to [%s] by [%s]: %s", txId, from, to, actor, reason)
+	} else {
+		r.logger.Infof("[%s] - Updated Status from [%s] to [%s] by [%s]: %s", txId, from, to, actor, reason)
+	}
+	return nil
+}
+
+func (r *Repository) history(txId string, transferID sql.NullString, from, to, actor, reason string) *entity.StatusHistory {
+	return &entity.StatusHistory{
+		TransferID: transferID.String,
+		Kind:       status.KindFee,
+		RecordID:   txId,
+		FromStatus: from,
+		ToStatus:   to,
+		Reason:     reason,
+		Actor:      actor,
 	}
-	return err
 }
 
 func (r *Repository) GetAllSubmittedIds() ([]*entity.Fee, error) {
@@ -53,7 +53,9 @@ var (
 
 	feeQuery                = regexp.QuoteMeta(`SELECT * FROM "fees" WHERE transaction_id = $1 ORDER BY "fees"."transaction_id" LIMIT 1`)
 	createQuery             = regexp.QuoteMeta(`INSERT INTO "fees" ("transaction_id","schedule_id","amount","status","transfer_id") VALUES ($1,$2,$3,$4,$5)`)
-	updateStatusQuery       = regexp.QuoteMeta(`UPDATE "fees" SET "status"=$1 WHERE transaction_id = $2`)
+	selectStatusQuery       = regexp.QuoteMeta(`SELECT "status","transfer_id" FROM "fees" WHERE transaction_id = $1 ORDER BY "fees"."transaction_id" LIMIT 1`)
+	updateStatusQuery       = regexp.QuoteMeta(`UPDATE "fees" SET "status"=$1 WHERE transaction_id = $2 AND status = $3`)
+	createHistoryQuery      = regexp.QuoteMeta(`INSERT INTO "transfer_status_history" ("transfer_id","kind","record_id","from_status","to_status","reason","actor","created_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`)
 	getAllSubmittedIdsQuery = regexp.QuoteMeta(`SELECT "transaction_id" FROM "fees" WHERE status = $1`)
 )
 
@@ -93,68 +95,110 @@ func Test_Get_NotFound(t *testing.T) {
 
 func Test_Create(t *testing.T) {
 	setup()
+	sqlMock.ExpectBegin()
 	helper.SqlMockPrepareExec(sqlMock, createQuery,
 		expectedFee.TransactionID,
 		expectedFee.ScheduleID,
 		expectedFee.Amount,
 		expectedFee.Status,
 		expectedFee.TransferID)
+	prepareCreateHistory("", someStatus, entityStatus.ActorHandler, "fee transfer created")
+	sqlMock.ExpectCommit()
 
 	err := repository.Create(expectedFee)
 	assert.Nil(t, err)
+	helper.CheckSqlMockExpectationsMet(sqlMock, t)
 }
 
 func Test_Create_Err(t *testing.T) {
 	setup()
+	sqlMock.ExpectBegin()
 	_ = helper.SqlMockPrepareExecWithErr(sqlMock, createQuery,
 		expectedFee.TransactionID,
 		expectedFee.ScheduleID,
 		expectedFee.Amount,
 		expectedFee.Status,
 		expectedFee.TransferID)
+	sqlMock.ExpectRollback()
 
 	err := repository.Create(expectedFee)
 	assert.NotNil(t, err)
+	helper.CheckSqlMockExpectationsMet(sqlMock, t)
 }
 
 func Test_UpdateStatusCompleted(t *testing.T) {
 	setup()
-	helper.SqlMockPrepareExec(sqlMock, updateStatusQuery,
-		entityStatus.Completed,
-		expectedFee.TransactionID)
+	prepareUpdateStatus(entityStatus.Completed, entityStatus.ActorHandler, "fee transfer executed")
 
 	err := repository.UpdateStatusCompleted(transactionId)
 	assert.Nil(t, err)
+	helper.CheckSqlMockExpectationsMet(sqlMock, t)
 }
 
 func Test_UpdateStatusCompleted_Err(t *testing.T) {
 	setup()
+	sqlMock.ExpectBegin()
+	helper.SqlMockPrepareQuery(sqlMock, []string{"status", "transfer_id"}, []driver.Value{entityStatus.Submitted, transferId}, selectStatusQuery, transactionId)
 	_ = helper.SqlMockPrepareExecWithErr(sqlMock, updateStatusQuery,
 		entityStatus.Completed,
-		expectedFee.TransactionID)
+		expectedFee.TransactionID,
+		entityStatus.Submitted)
+	sqlMock.ExpectRollback()
 
 	err := repository.UpdateStatusCompleted(transactionId)
 	assert.NotNil(t, err)
+	helper.CheckSqlMockExpectationsMet(sqlMock, t)
 }
 
 func Test_UpdateStatusFailed(t *testing.T) {
 	setup()
-	helper.SqlMockPrepareExec(sqlMock, updateStatusQuery,
-		entityStatus.Failed,
-		expectedFee.TransactionID)
+	prepareUpdateStatus(entityStatus.Failed, entityStatus.ActorHandler, "fee transfer failed")
 
 	err := repository.UpdateStatusFailed(transactionId)
 	assert.Nil(t, err)
+	helper.CheckSqlMockExpectationsMet(sqlMock, t)
 }
 
-func Test_UpdateStatusFailed_Err(t *testing.T) {
+func Test_UpdateStatusFailed_NotFound(t *testing.T) {
 	setup()
-	_ = helper.SqlMockPrepareExecWithErr(sqlMock, updateStatusQuery,
-		entityStatus.Failed,
-		expectedFee.TransactionID)
+	sqlMock.ExpectBegin()
+	_ = helper.SqlMockPrepareQueryWithErrNotFound(sqlMock, selectStatusQuery, transactionId)
+	sqlMock.ExpectRollback()
 
-	err := repository.UpdateStatusCompleted(transactionId)
+	err := repository.UpdateStatusFailed(transactionId)
 	assert.NotNil(t, err)
+	helper.CheckSqlMockExpectationsMet(sqlMock, t)
+}
+
+func Test_UpdateStatus(t *testing.T) {
+	setup()
+	prepareUpdateStatus(entityStatus.Completed, entityStatus.ActorRecovery, "found on the mirror node")
+
+	err := repository.UpdateStatus(transactionId, entityStatus.Completed, entityStatus.ActorRecovery, "found on the mirror node")
+	assert.Nil(t, err)
+	helper.CheckSqlMockExpectationsMet(sqlMock, t)
+}
+
+func Test_UpdateStatus_SameStatus(t *testing.T) {
+	setup()
+	sqlMock.ExpectBegin()
+	helper.SqlMockPrepareQuery(sqlMock, []string{"status", "transfer_id"}, []driver.Value{entityStatus.Completed, transferId}, selectStatusQuery, transactionId)
+	sqlMock.ExpectCommit()
+
+	err := repository.UpdateStatus(transactionId, entityStatus.Completed, entityStatus.ActorRecovery, "found on the mirror node")
+	assert.Nil(t, err)
+	helper.CheckSqlMockExpectationsMet(sqlMock, t)
+}
+
+func Test_UpdateStatus_InvalidTransition(t *testing.T) {
+	setup()
+	sqlMock.ExpectBegin()
+	helper.SqlMockPrepareQuery(sqlMock, []string{"status", "transfer_id"}, []driver.Value{entityStatus.Failed, transferId}, selectStatusQuery, transactionId)
+	sqlMock.ExpectRollback()
+
+	err := repository.UpdateStatus(transactionId, entityStatus.Completed, entityStatus.ActorRecovery, "found on the mirror node")
+	assert.ErrorIs(t, err, entityStatus.ErrInvalidTransition)
+	helper.CheckSqlMockExpectationsMet(sqlMock, t)
 }
 
 func Test_GetAllSubmittedIds(t *testing.T) {
@@ -177,22 +221,15 @@ func Test_GetAllSubmittedIds_Err(t *testing.T) {
 	assert.Nil(t, actual)
 }
 
-func Test_UpdateStatus(t *testing.T) {
-	setup()
-	helper.SqlMockPrepareExec(sqlMock, updateStatusQuery,
-		entityStatus.Completed,
-		expectedFee.TransactionID)
-
-	err := repository.updateStatus(transactionId, entityStatus.Completed)
-	assert.Nil(t, err)
+func prepareUpdateStatus(to, actor, reason string) {
+	sqlMock.ExpectBegin()
+	helper.SqlMockPrepareQuery(sqlMock, []string{"status", "transfer_id"}, []driver.Value{entityStatus.Submitted, transferId}, selectStatusQuery, transactionId)
+	helper.SqlMockPrepareExec(sqlMock, updateStatusQuery, to, transactionId, entityStatus.Submitted)
+	prepareCreateHistory(entityStatus.Submitted, to, actor, reason)
+	sqlMock.ExpectCommit()
 }
 
-func Test_UpdateStatus_Err(t *testing.T) {
-	setup()
-	_ = helper.SqlMockPrepareExecWithErr(sqlMock, updateStatusQuery,
-		entityStatus.Completed,
-		expectedFee.TransactionID)
-
-	err := repository.updateStatus(transactionId, entityStatus.Completed)
-	assert.NotNil(t, err)
+func prepareCreateHistory(from, to, actor, reason string) {
+	helper.SqlMockPrepareQuery(sqlMock, []string{"id"}, []driver.Value{1}, createHistoryQuery,
+		transferId, entityStatus.KindFee, transactionId, from, to, reason, actor, sqlmock.AnyArg())
 }
@@ -0,0 +1,156 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package queue
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Repository struct {
+	db     *gorm.DB
+	logger *log.Entry
+}
+
+func NewRepository(dbClient *gorm.DB) *Repository {
+	return &Repository{
+		db:     dbClient,
+		logger: config.GetLoggerFor("Queue Repository"),
+	}
+}
+
+func (r *Repository) Create(message *entity.QueueMessage) error {
+	return r.db.Create(message).Error
+}
+
+// Claim returns up to `limit` visible messages and hides them for the given visibility timeout.
+// Rows locked by another claim are skipped, so concurrent consumers never receive the same message.
+func (r *Repository) Claim(limit int, visibilityTimeout time.Duration) ([]*entity.QueueMessage, error) {
+	var messages []*entity.QueueMessage
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		err := tx.
+			Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("visible_at <= ?", now.UnixNano()).
+			Order("id asc").
+			Limit(limit).
+			Find(&messages).
+			Error
+		if err != nil || len(messages) == 0 {
+			return err
+		}
+
+		ids := make([]uint64, len(messages))
+		for i, m := range messages {
+			ids[i] = m.ID
+		}
+
+		visibleAt := now.Add(visibilityTimeout).UnixNano()
+		err = tx.
+			Model(entity.QueueMessage{}).
+			Where("id IN ?", ids).
+			Updates(map[string]interface{}{
+				"attempts":   gorm.Expr("attempts + 1"),
+				"visible_at": visibleAt,
+			}).
+			Error
+		if err != nil {
+			return err
+		}
+
+		for _, m := range messages {
+			m.Attempts++
+			m.VisibleAt = visibleAt
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return messages, nil
+}
+
+func (r *Repository) Acknowledge(topic string, id uint64) error {
+	result := r.db.
+		Where("id = ? AND topic = ?", id, topic).
+		Delete(&entity.QueueMessage{})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected != 1 {
+		return fmt.Errorf("acknowledged %d messages, expected 1", result.RowsAffected)
+	}
+	return nil
+}
+
+func (r *Repository) Release(id uint64, visibleAt time.Time) error {
+	return r.db.
+		Model(entity.QueueMessage{}).
+		Where("id = ?", id).
+		UpdateColumn("visible_at", visibleAt.UnixNano()).
+		Error
+}
+
+// MoveToDeadLetter removes the message with the given ID from the queue and stores it in the dead-letter table.
+// The stored row is copied, so that every column of it is kept
+func (r *Repository) MoveToDeadLetter(id uint64, reason string) error {
+	message := &entity.QueueMessage{}
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", id).
+			First(message).
+			Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Create(&entity.DeadLetter{
+			ID:           message.ID,
+			Topic:        message.Topic,
+			Kind:         message.Kind,
+			Payload:      message.Payload,
+			TraceContext: message.TraceContext,
+			Attempts:     message.Attempts,
+			VisibleAt:    message.VisibleAt,
+			Reason:       reason,
+			CreatedAt:    message.CreatedAt,
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.
+			Where("id = ?", id).
+			Delete(&entity.QueueMessage{}).
+			Error
+	})
+
+	if err == nil {
+		r.logger.Errorf("[%d] - Moved message of topic [%s] to dead-letter. Reason: [%s]", id, message.Topic, reason)
+	}
+	return err
+}
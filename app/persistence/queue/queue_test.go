@@ -0,0 +1,227 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package queue
+
+import (
+	"database/sql/driver"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/test/helper"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+var (
+	repository   *Repository
+	dbConnection *gorm.DB
+	sqlMock      sqlmock.Sqlmock
+
+	claimQuery       = regexp.QuoteMeta(`SELECT * FROM "queue_messages" WHERE visible_at <= $1 ORDER BY id asc LIMIT 10 FOR UPDATE SKIP LOCKED`)
+	leaseQuery       = regexp.QuoteMeta(`UPDATE "queue_messages" SET "attempts"=attempts + 1,"visible_at"=$1 WHERE id IN ($2,$3)`)
+	acknowledgeQuery = regexp.QuoteMeta(`DELETE FROM "queue_messages" WHERE id = $1 AND topic = $2`)
+	releaseQuery     = regexp.QuoteMeta(`UPDATE "queue_messages" SET "visible_at"=$1 WHERE id = $2`)
+	deleteQuery      = regexp.QuoteMeta(`DELETE FROM "queue_messages" WHERE id = $1`)
+
+	selectForDeadLetterQuery = regexp.QuoteMeta(`SELECT * FROM "queue_messages" WHERE id = $1 ORDER BY "queue_messages"."id" LIMIT 1 FOR UPDATE`)
+	createDeadLetterQuery    = regexp.QuoteMeta(`INSERT INTO "dead_letters" ("topic","kind","payload","trace_context","attempts","visible_at","reason","created_at","failed_at","id") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10) RETURNING "id"`)
+
+	id           = uint64(1)
+	topic        = "someTopic"
+	visibleAt    = time.Unix(0, 1649256000000000000)
+	kind         = "transfer"
+	payload      = []byte(`{"TransactionId":"0.0.1-1"}`)
+	traceContext = `{"traceparent":"00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"}`
+	attempts     = 5
+	createdAt    = int64(1649255000000000000)
+	reason       = "some-error"
+)
+
+func setup() {
+	mocks.Setup()
+	dbConnection, sqlMock, _ = helper.SetupSqlMock()
+
+	repository = &Repository{
+		db:     dbConnection,
+		logger: config.GetLoggerFor("Queue Repository"),
+	}
+}
+
+func Test_NewRepository(t *testing.T) {
+	setup()
+
+	actualRepository := NewRepository(dbConnection)
+
+	assert.Equal(t, repository, actualRepository)
+}
+
+func Test_Claim(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	visibilityTimeout := time.Minute
+	before := time.Now()
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(claimQuery).
+		WithArgs(notBefore(before)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "topic", "kind", "payload", "attempts", "visible_at", "created_at"}).
+			AddRow(id, topic, kind, payload, 0, visibleAt.UnixNano(), createdAt).
+			AddRow(id+1, topic, kind, payload, attempts, visibleAt.UnixNano(), createdAt))
+	sqlMock.ExpectExec(leaseQuery).
+		WithArgs(notBefore(before.Add(visibilityTimeout)), id, id+1).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	sqlMock.ExpectCommit()
+
+	messages, err := repository.Claim(10, visibilityTimeout)
+
+	assert.Nil(t, err)
+	assert.Len(t, messages, 2)
+	assert.Equal(t, id, messages[0].ID)
+	assert.Equal(t, 1, messages[0].Attempts)
+	assert.Equal(t, id+1, messages[1].ID)
+	assert.Equal(t, attempts+1, messages[1].Attempts)
+	for _, m := range messages {
+		assert.GreaterOrEqual(t, m.VisibleAt, before.Add(visibilityTimeout).UnixNano())
+		assert.LessOrEqual(t, m.VisibleAt, time.Now().Add(visibilityTimeout).UnixNano())
+	}
+}
+
+func Test_Claim_NoVisibleMessages(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(claimQuery).
+		WithArgs(sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	sqlMock.ExpectCommit()
+
+	messages, err := repository.Claim(10, time.Minute)
+
+	assert.Nil(t, err)
+	assert.Empty(t, messages)
+}
+
+func Test_Claim_LeaseError(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(claimQuery).
+		WithArgs(sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "topic"}).AddRow(id, topic).AddRow(id+1, topic))
+	sqlMock.ExpectExec(leaseQuery).
+		WithArgs(sqlmock.AnyArg(), id, id+1).
+		WillReturnError(errors.New("some-error"))
+	sqlMock.ExpectRollback()
+
+	messages, err := repository.Claim(10, time.Minute)
+
+	assert.NotNil(t, err)
+	assert.Nil(t, messages)
+}
+
+func Test_Acknowledge(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	helper.SqlMockPrepareExec(sqlMock, acknowledgeQuery, id, topic)
+
+	err := repository.Acknowledge(topic, id)
+
+	assert.Nil(t, err)
+}
+
+func Test_Acknowledge_Error(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	expectedErr := helper.SqlMockPrepareExecWithErr(sqlMock, acknowledgeQuery, id, topic)
+
+	err := repository.Acknowledge(topic, id)
+
+	assert.Error(t, err, expectedErr)
+}
+
+func Test_Acknowledge_NotFound(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	sqlMock.ExpectExec(acknowledgeQuery).WithArgs(id, topic).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err := repository.Acknowledge(topic, id)
+
+	assert.Error(t, err)
+}
+
+func Test_Release(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	helper.SqlMockPrepareExec(sqlMock, releaseQuery, visibleAt.UnixNano(), id)
+
+	err := repository.Release(id, visibleAt)
+
+	assert.Nil(t, err)
+}
+
+func Test_Release_Error(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	expectedErr := helper.SqlMockPrepareExecWithErr(sqlMock, releaseQuery, visibleAt.UnixNano(), id)
+
+	err := repository.Release(id, visibleAt)
+
+	assert.Error(t, err, expectedErr)
+}
+
+func Test_MoveToDeadLetter(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	sqlMock.ExpectBegin()
+	helper.SqlMockPrepareQuery(sqlMock,
+		[]string{"id", "topic", "kind", "payload", "trace_context", "attempts", "visible_at", "created_at"},
+		[]driver.Value{id, topic, kind, payload, traceContext, attempts, visibleAt.UnixNano(), createdAt},
+		selectForDeadLetterQuery, id)
+	sqlMock.ExpectQuery(createDeadLetterQuery).
+		WithArgs(topic, kind, payload, traceContext, attempts, visibleAt.UnixNano(), reason, createdAt, sqlmock.AnyArg(), id).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(id))
+	helper.SqlMockPrepareExec(sqlMock, deleteQuery, id)
+	sqlMock.ExpectCommit()
+
+	err := repository.MoveToDeadLetter(id, reason)
+
+	assert.Nil(t, err)
+}
+
+func Test_MoveToDeadLetter_NotFound(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(selectForDeadLetterQuery).WithArgs(id).WillReturnError(gorm.ErrRecordNotFound)
+	sqlMock.ExpectRollback()
+
+	err := repository.MoveToDeadLetter(id, reason)
+
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+}
+
+// notBefore matches unix nano timestamps, taken at or after the given time
+type notBefore time.Time
+
+func (n notBefore) Match(v driver.Value) bool {
+	nanos, ok := v.(int64)
+	return ok && nanos >= time.Time(n).UnixNano()
+}
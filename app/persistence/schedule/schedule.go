@@ -17,9 +17,12 @@
 package schedule
 
 import (
+	"database/sql"
 	"errors"
+	"fmt"
 
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/failure"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/schedule"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
@@ -39,33 +42,116 @@ func NewRepository(dbClient *gorm.DB) *Repository {
 	}
 }
 
+// Create creates the scheduled transaction. Failed scheduled transactions without a schedule ID could not be submitted
 func (r *Repository) Create(entity *entity.Schedule) error {
-	return r.db.Create(entity).Error
+	if entity.Status == status.Failed && entity.FailureCode == "" {
+		entity.FailureCode, entity.FailureReason = failure.ScheduledTransactionFailed, "scheduled transaction failed"
+		if entity.ScheduleID == "" {
+			entity.FailureCode, entity.FailureReason = failure.ScheduleSubmissionFailed, "scheduled transaction could not be submitted"
+		}
+	}
+
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Create(entity).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Create(r.history(entity.TransactionID, entity.TransferID, "", entity.Status, status.ActorHandler, "scheduled transaction created")).Error
+	})
+}
+
+// UpdateStatus moves the scheduled transaction to the given status and records the change in the history of its transfer
+func (r *Repository) UpdateStatus(txId, s, actor, reason string) error {
+	return r.updateStatus(txId, s, actor, reason, nil)
 }
 
 func (r *Repository) UpdateStatusCompleted(txId string) error {
-	return r.updateStatus(txId, status.Completed)
+	return r.updateStatus(txId, status.Completed, status.ActorHandler, "scheduled transaction executed", nil)
 }
 
 func (r *Repository) UpdateStatusFailed(txId string) error {
-	return r.updateStatus(txId, status.Failed)
+	return r.Fail(txId, failure.ScheduledTransactionFailed, "scheduled transaction failed", status.ActorHandler)
 }
 
-func (r *Repository) updateStatus(txId string, s string) error {
-	err := r.db.
-		Model(entity.Schedule{}).
-		Where("transaction_id = ?", txId).
-		UpdateColumn("status", s).
-		Error
+// Fail moves the scheduled transaction to FAILED, recording the code and the reason of the failure
+func (r *Repository) Fail(txId string, code failure.Code, reason, actor string) error {
+	return r.updateStatus(txId, status.Failed, actor, reason, map[string]interface{}{
+		"failure_code":   code,
+		"failure_reason": reason,
+	})
+}
 
-	if err == nil {
-		if s == status.Failed {
-			r.logger.Errorf("[%s] - Updated Status to [%s]", txId, s)
+// updateStatus moves the scheduled transaction to the given status, updating the given columns along with it, if it is reachable
+// from the current one, and appends the change to the history of its transfers. Batched scheduled transactions have a record per
+// included transfer, so all of them are moved. Moving to the current status is a no-op
+func (r *Repository) updateStatus(txId, to, actor, reason string, columns map[string]interface{}) error {
+	var from string
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var records []*entity.Schedule
+		err := tx.
+			Select("status", "transfer_id").
+			Where("transaction_id = ?", txId).
+			Find(&records).
+			Error
+		if err != nil {
 			return err
 		}
-		r.logger.Infof("[%s] - Updated Status to [%s]", txId, s)
+		if len(records) == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		from = records[0].Status
+		if from == to {
+			return nil
+		}
+		if !status.CanTransition(status.KindSchedule, from, to) {
+			return fmt.Errorf("%w from [%s] to [%s]", status.ErrInvalidTransition, from, to)
+		}
+
+		if columns == nil {
+			columns = make(map[string]interface{})
+		}
+		columns["status"] = to
+		result := tx.
+			Model(entity.Schedule{}).
+			Where("transaction_id = ? AND status = ?", txId, from).
+			UpdateColumns(columns)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected != int64(len(records)) {
+			return fmt.Errorf("updated %d rows, expected %d", result.RowsAffected, len(records))
+		}
+
+		history := make([]*entity.StatusHistory, len(records))
+		for i, record := range records {
+			history[i] = r.history(txId, record.TransferID, from, to, actor, reason)
+		}
+		return tx.Create(&history).Error
+	})
+	if err != nil || from == to {
+		return err
+	}
+
+	if to == status.Failed {
+		r.logger.Errorf("[%s] - Updated Status from [%s] to [%s] by [%s]: %s", txId, from, to, actor, reason)
+	} else {
+		r.logger.Infof("[%s] - Updated Status from [%s] to [%s] by [%s]: %s", txId, from, to, actor, reason)
+	}
+	return nil
+}
+
+func (r *Repository) history(txId string, transferID sql.NullString, from, to, actor, reason string) *entity.StatusHistory {
+	return &entity.StatusHistory{
+		TransferID: transferID.String,
+		Kind:       status.KindSchedule,
+		RecordID:   txId,
+		FromStatus: from,
+		ToStatus:   to,
+		Reason:     reason,
+		Actor:      actor,
 	}
-	return err
 }
 
 // Get Returns Schedule. Returns nil if not found
@@ -86,6 +172,16 @@ func (r *Repository) Get(id string) (*entity.Schedule, error) {
 	return record, nil
 }
 
+// GetAllByTransactionID returns the records of the scheduled transaction. Batched scheduled transactions have a record per included transfer
+func (r *Repository) GetAllByTransactionID(txId string) ([]*entity.Schedule, error) {
+	var schedules []*entity.Schedule
+
+	err := r.db.
+		Where("transaction_id = ?", txId).
+		Find(&schedules).Error
+	return schedules, err
+}
+
 func (r *Repository) GetReceiverTransferByTransactionID(id string) (*entity.Schedule, error) {
 	record := &entity.Schedule{}
 	result := r.db.
@@ -24,6 +24,7 @@ import (
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/failure"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/schedule"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
@@ -39,9 +40,14 @@ var (
 	sqlMock      sqlmock.Sqlmock
 	db           *sql.DB
 
-	insertQuery                 = regexp.QuoteMeta(`INSERT INTO "schedules" ("transaction_id","schedule_id","has_receiver","operation","status","transfer_id") VALUES ($1,$2,$3,$4,$5,$6)`)
-	updateStatusQuery           = regexp.QuoteMeta(`UPDATE "schedules" SET "status"=$1 WHERE transaction_id = $2`)
+	insertQuery                 = regexp.QuoteMeta(`INSERT INTO "schedules" ("transaction_id","schedule_id","has_receiver","operation","status","transfer_id","failure_code","failure_reason") VALUES ($1,$2,$3,$4,$5,$6,$7,$8)`)
+	updateStatusQuery           = regexp.QuoteMeta(`UPDATE "schedules" SET "status"=$1 WHERE transaction_id = $2 AND status = $3`)
+	updateStatusFailureQuery    = regexp.QuoteMeta(`UPDATE "schedules" SET "failure_code"=$1,"failure_reason"=$2,"status"=$3 WHERE transaction_id = $4 AND status = $5`)
+	selectStatusQuery           = regexp.QuoteMeta(`SELECT "status","transfer_id" FROM "schedules" WHERE transaction_id = $1`)
+	createHistoryQuery          = regexp.QuoteMeta(`INSERT INTO "transfer_status_history" ("transfer_id","kind","record_id","from_status","to_status","reason","actor","created_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`)
+	createHistoriesQuery        = regexp.QuoteMeta(`INSERT INTO "transfer_status_history" ("transfer_id","kind","record_id","from_status","to_status","reason","actor","created_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8),($9,$10,$11,$12,$13,$14,$15,$16) RETURNING "id"`)
 	selectQuery                 = regexp.QuoteMeta(`SELECT * FROM "schedules" WHERE transaction_id = $1 ORDER BY "schedules"."transaction_id" LIMIT 1`)
+	selectByTransactionIdQuery  = regexp.QuoteMeta(`SELECT * FROM "schedules" WHERE transaction_id = $1`)
 	selectIdsByStatusQuery      = regexp.QuoteMeta(`SELECT "transaction_id" FROM "schedules" WHERE status = $1`)
 	selectReceiverTransferQuery = regexp.QuoteMeta(`SELECT * FROM "schedules" WHERE transfer_id = $1 AND operation IN ($2, $3) AND has_receiver = true ORDER BY "schedules"."transaction_id" LIMIT 1`)
 
@@ -52,8 +58,8 @@ var (
 	expectedStatus = status.Submitted
 	transferId     = sql.NullString{String: "someTransferId", Valid: true}
 
-	entityColumns = []string{"transaction_id", "schedule_id", "has_receiver", "operation", "status", "transfer_id"}
-	entityArgs    = []driver.Value{transactionId, scheduleId, hasReceiver, operation, expectedStatus, transferId}
+	entityColumns = []string{"transaction_id", "schedule_id", "has_receiver", "operation", "status", "transfer_id", "failure_code", "failure_reason"}
+	entityArgs    = []driver.Value{transactionId, scheduleId, hasReceiver, operation, expectedStatus, transferId, "", ""}
 
 	expectedSchedule = &entity.Schedule{
 		TransactionID: transactionId,
@@ -76,7 +82,10 @@ func Test_NewRepository(t *testing.T) {
 func Test_Create(t *testing.T) {
 	setup()
 	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
-	helper.SqlMockPrepareExec(sqlMock, insertQuery, transactionId, scheduleId, hasReceiver, operation, expectedStatus, transferId)
+	sqlMock.ExpectBegin()
+	helper.SqlMockPrepareExec(sqlMock, insertQuery, transactionId, scheduleId, hasReceiver, operation, expectedStatus, transferId, "", "")
+	prepareCreateHistory("", expectedStatus, status.ActorHandler, "scheduled transaction created")
+	sqlMock.ExpectCommit()
 
 	err := repository.Create(expectedSchedule)
 
@@ -86,17 +95,42 @@ func Test_Create(t *testing.T) {
 func Test_Create_Error(t *testing.T) {
 	setup()
 	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
-	expectedErr := helper.SqlMockPrepareExecWithErr(sqlMock, insertQuery, transactionId, scheduleId, hasReceiver, operation, expectedStatus, transferId)
+	sqlMock.ExpectBegin()
+	expectedErr := helper.SqlMockPrepareExecWithErr(sqlMock, insertQuery, transactionId, scheduleId, hasReceiver, operation, expectedStatus, transferId, "", "")
+	sqlMock.ExpectRollback()
 
 	err := repository.Create(expectedSchedule)
 
 	assert.Error(t, err, expectedErr)
 }
 
+func Test_Create_Failed(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	reason := "scheduled transaction could not be submitted"
+	sqlMock.ExpectBegin()
+	helper.SqlMockPrepareExec(sqlMock, insertQuery, transactionId, "", false, operation, status.Failed, transferId, failure.ScheduleSubmissionFailed, reason)
+	prepareCreateHistory("", status.Failed, status.ActorHandler, "scheduled transaction created")
+	sqlMock.ExpectCommit()
+
+	failed := &entity.Schedule{
+		TransactionID: transactionId,
+		Operation:     operation,
+		Status:        status.Failed,
+		TransferID:    transferId,
+	}
+	err := repository.Create(failed)
+
+	assert.Nil(t, err)
+	assert.Equal(t, failure.ScheduleSubmissionFailed, failed.FailureCode)
+}
+
 func Test_UpdateStatusCompleted(t *testing.T) {
 	setup()
 	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
-	helper.SqlMockPrepareExec(sqlMock, updateStatusQuery, status.Completed, transactionId)
+	prepareUpdateStatus(status.Completed)
+	prepareCreateHistory(status.Submitted, status.Completed, status.ActorHandler, "scheduled transaction executed")
+	sqlMock.ExpectCommit()
 
 	err := repository.UpdateStatusCompleted(transactionId)
 
@@ -106,7 +140,10 @@ func Test_UpdateStatusCompleted(t *testing.T) {
 func Test_UpdateStatusCompleted_Error(t *testing.T) {
 	setup()
 	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
-	expectedErr := helper.SqlMockPrepareExecWithErr(sqlMock, updateStatusQuery, status.Completed, transactionId)
+	sqlMock.ExpectBegin()
+	helper.SqlMockPrepareQuery(sqlMock, []string{"status", "transfer_id"}, []driver.Value{status.Submitted, transferId.String}, selectStatusQuery, transactionId)
+	expectedErr := helper.SqlMockPrepareExecWithErr(sqlMock, updateStatusQuery, status.Completed, transactionId, status.Submitted)
+	sqlMock.ExpectRollback()
 
 	err := repository.UpdateStatusCompleted(transactionId)
 
@@ -116,21 +153,71 @@ func Test_UpdateStatusCompleted_Error(t *testing.T) {
 func Test_UpdateStatusFailed(t *testing.T) {
 	setup()
 	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
-	helper.SqlMockPrepareExec(sqlMock, updateStatusQuery, status.Failed, transactionId)
+	prepareFail(failure.ScheduledTransactionFailed, "scheduled transaction failed")
+	prepareCreateHistory(status.Submitted, status.Failed, status.ActorHandler, "scheduled transaction failed")
+	sqlMock.ExpectCommit()
 
 	err := repository.UpdateStatusFailed(transactionId)
 
 	assert.Nil(t, err)
 }
 
-func Test_UpdateStatusFailed_Error(t *testing.T) {
+func Test_Fail(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	reason := "resolved with [TOKEN_NOT_ASSOCIATED_TO_ACCOUNT], as found on the mirror node"
+	prepareFail(failure.ReceiverNotAssociated, reason)
+	prepareCreateHistory(status.Submitted, status.Failed, status.ActorRecovery, reason)
+	sqlMock.ExpectCommit()
+
+	err := repository.Fail(transactionId, failure.ReceiverNotAssociated, reason, status.ActorRecovery)
+
+	assert.Nil(t, err)
+}
+
+func Test_UpdateStatusFailed_NotFound(t *testing.T) {
 	setup()
 	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
-	expectedErr := helper.SqlMockPrepareExecWithErr(sqlMock, updateStatusQuery, status.Failed, transactionId)
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(selectStatusQuery).WithArgs(transactionId).WillReturnRows(sqlmock.NewRows([]string{"status", "transfer_id"}))
+	sqlMock.ExpectRollback()
 
 	err := repository.UpdateStatusFailed(transactionId)
 
-	assert.Error(t, err, expectedErr)
+	assert.Equal(t, gorm.ErrRecordNotFound, err)
+}
+
+func Test_UpdateStatus_Batched(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	otherTransferId := "someOtherTransferId"
+	reason := "found on the mirror node"
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(selectStatusQuery).WithArgs(transactionId).WillReturnRows(
+		sqlmock.NewRows([]string{"status", "transfer_id"}).
+			AddRow(status.Submitted, transferId.String).
+			AddRow(status.Submitted, otherTransferId))
+	sqlMock.ExpectExec(updateStatusQuery).WithArgs(status.Completed, transactionId, status.Submitted).WillReturnResult(sqlmock.NewResult(2, 2))
+	helper.SqlMockPrepareQuery(sqlMock, []string{"id"}, []driver.Value{1}, createHistoriesQuery,
+		transferId.String, status.KindSchedule, transactionId, status.Submitted, status.Completed, reason, status.ActorRecovery, sqlmock.AnyArg(),
+		otherTransferId, status.KindSchedule, transactionId, status.Submitted, status.Completed, reason, status.ActorRecovery, sqlmock.AnyArg())
+	sqlMock.ExpectCommit()
+
+	err := repository.UpdateStatus(transactionId, status.Completed, status.ActorRecovery, reason)
+
+	assert.Nil(t, err)
+}
+
+func Test_UpdateStatus_InvalidTransition(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	sqlMock.ExpectBegin()
+	helper.SqlMockPrepareQuery(sqlMock, []string{"status", "transfer_id"}, []driver.Value{status.Completed, transferId.String}, selectStatusQuery, transactionId)
+	sqlMock.ExpectRollback()
+
+	err := repository.UpdateStatus(transactionId, status.Failed, status.ActorRecovery, "found on the mirror node")
+
+	assert.ErrorIs(t, err, status.ErrInvalidTransition)
 }
 
 func Test_Get(t *testing.T) {
@@ -160,6 +247,28 @@ func Test_Get_Error(t *testing.T) {
 	assert.Nil(t, err2)
 }
 
+func Test_GetAllByTransactionID(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	helper.SqlMockPrepareQuery(sqlMock, entityColumns, entityArgs, selectByTransactionIdQuery, transactionId)
+
+	fetchedSchedules, err := repository.GetAllByTransactionID(transactionId)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []*entity.Schedule{expectedSchedule}, fetchedSchedules)
+}
+
+func Test_GetAllByTransactionID_Error(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	expectedErr := helper.SqlMockPrepareQueryWithErrInvalidData(sqlMock, selectByTransactionIdQuery, transactionId)
+
+	fetchedSchedules, err := repository.GetAllByTransactionID(transactionId)
+
+	assert.Error(t, err, expectedErr)
+	assert.Empty(t, fetchedSchedules)
+}
+
 func Test_GetReceiverTransferByTransactionID(t *testing.T) {
 	setup()
 	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
@@ -210,6 +319,23 @@ func Test_GetAllSubmittedIds_Error(t *testing.T) {
 	assert.Nil(t, fetchedSchedule)
 }
 
+func prepareUpdateStatus(to string) {
+	sqlMock.ExpectBegin()
+	helper.SqlMockPrepareQuery(sqlMock, []string{"status", "transfer_id"}, []driver.Value{status.Submitted, transferId.String}, selectStatusQuery, transactionId)
+	helper.SqlMockPrepareExec(sqlMock, updateStatusQuery, to, transactionId, status.Submitted)
+}
+
+func prepareFail(code failure.Code, reason string) {
+	sqlMock.ExpectBegin()
+	helper.SqlMockPrepareQuery(sqlMock, []string{"status", "transfer_id"}, []driver.Value{status.Submitted, transferId.String}, selectStatusQuery, transactionId)
+	helper.SqlMockPrepareExec(sqlMock, updateStatusFailureQuery, code, reason, status.Failed, transactionId, status.Submitted)
+}
+
+func prepareCreateHistory(from, to, actor, reason string) {
+	helper.SqlMockPrepareQuery(sqlMock, []string{"id"}, []driver.Value{1}, createHistoryQuery,
+		transferId.String, status.KindSchedule, transactionId, from, to, reason, actor, sqlmock.AnyArg())
+}
+
 func setup() {
 	mocks.Setup()
 	dbConnection, sqlMock, db = helper.SetupSqlMock()
@@ -0,0 +1,64 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package persistence
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/tracing"
+	"github.com/limechain/hedera-eth-bridge-validator/test/helper"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/codes"
+)
+
+type traced struct {
+	ID string
+}
+
+func Test_TracingPlugin(t *testing.T) {
+	mocks.Setup()
+	dbConn, sqlMock, _ := helper.SetupSqlMock()
+	exporter := helper.SetupTracing(t)
+	err := dbConn.Use(TracingPlugin{})
+	assert.Nil(t, err)
+
+	sqlMock.ExpectQuery(`SELECT \* FROM "traceds"`).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("some-id"))
+	sqlMock.ExpectQuery(`SELECT \* FROM "traceds"`).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("some-id"))
+	sqlMock.ExpectQuery(`SELECT \* FROM "traceds"`).WillReturnError(sqlmock.ErrCancelled)
+
+	// Queries without a span in their context are not recorded
+	var records []traced
+	dbConn.Find(&records)
+	assert.Empty(t, exporter.GetSpans())
+
+	ctx, span := tracing.Start(context.Background(), "parent")
+	dbConn.WithContext(ctx).Find(&records)
+	result := dbConn.WithContext(ctx).Find(&records)
+	span.End()
+
+	spans := exporter.GetSpans()
+	assert.NotNil(t, result.Error)
+	assert.Len(t, spans, 3)
+	assert.Equal(t, "db query traceds", spans[0].Name)
+	assert.Equal(t, span.SpanContext().SpanID(), spans[0].Parent.SpanID())
+	assert.Equal(t, codes.Unset, spans[0].Status.Code)
+	assert.Equal(t, codes.Error, spans[1].Status.Code)
+	helper.CheckSqlMockExpectationsMet(sqlMock, t)
+}
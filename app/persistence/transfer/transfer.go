@@ -17,6 +17,7 @@
 package transfer
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
@@ -31,10 +32,13 @@ import (
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
 
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/failure"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/schedule"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	log "github.com/sirupsen/logrus"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type Repository struct {
@@ -73,6 +77,7 @@ func (r *Repository) GetWithPreloads(txId string) (*entity.Transfer, error) {
 	result := r.db.
 		Preload("Fees").
 		Preload("Messages").
+		Preload("Schedules").
 		Model(entity.Transfer{}).
 		Where("transaction_id = ?", txId).
 		First(tx)
@@ -108,9 +113,61 @@ func (r *Repository) GetWithFee(txId string) (*entity.Transfer, error) {
 	return tx, nil
 }
 
+// Returns the Transfer which minted the given serial number of a wrapped NFT. Returns nil if not found
+func (r *Repository) GetByWrappedSerialNumber(wrappedAsset string, serialNumber int64) (*entity.Transfer, error) {
+	tx := &entity.Transfer{}
+	result := r.db.
+		Model(entity.Transfer{}).
+		Where("target_asset = ? AND wrapped_serial_number = ?", wrappedAsset, serialNumber).
+		First(tx)
+
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	r.updateHederaChainId(tx)
+
+	return tx, nil
+}
+
 // Create creates new record of Transfer
-func (r *Repository) Create(ct *payload.Transfer) (*entity.Transfer, error) {
-	return r.create(ct, status.Initial)
+func (r *Repository) Create(ctx context.Context, ct *payload.Transfer) (*entity.Transfer, error) {
+	return r.create(ctx, ct, status.Initial)
+}
+
+// Reject records the incoming transfer as failed with the given code, without it being processed.
+// Returns nil if the transfer is already recorded
+func (r *Repository) Reject(ct *payload.Transfer, code failure.Code, reason string) (*entity.Transfer, error) {
+	tx := newTransfer(ct, status.Failed)
+	tx.FailureCode = code
+	tx.FailureReason = reason
+
+	created := false
+	err := r.db.Transaction(func(db *gorm.DB) error {
+		// Watchers process the same source transaction again after a restart
+		result := db.Clauses(clause.OnConflict{DoNothing: true}).Create(tx)
+		if result.Error != nil || result.RowsAffected != 1 {
+			return result.Error
+		}
+
+		created = true
+		return db.Create(&entity.StatusHistory{
+			TransferID: tx.TransactionID,
+			Kind:       status.KindTransfer,
+			RecordID:   tx.TransactionID,
+			ToStatus:   status.Failed,
+			Reason:     reason,
+			Actor:      status.ActorWatcher,
+		}).Error
+	})
+	if err != nil || !created {
+		return nil, err
+	}
+
+	r.logger.Errorf("[%s] - Rejected with [%s]: %s", tx.TransactionID, code, reason)
+	return tx, nil
 }
 
 // Save updates the provided Transfer instance
@@ -130,12 +187,249 @@ func (r *Repository) UpdateFee(txId string, fee string) error {
 	return err
 }
 
+func (r *Repository) UpdateWrappedSerialNumber(txId string, serialNumber int64) error {
+	err := r.db.
+		Model(entity.Transfer{}).
+		Where("transaction_id = ?", txId).
+		UpdateColumn("wrapped_serial_number", serialNumber).
+		Error
+	if err == nil {
+		r.logger.Debugf("Updated Wrapped Serial Number of TX [%s] to [%d]", txId, serialNumber)
+	}
+	return err
+}
+
+// UpdateStatus moves the transfer to the given status and records the change in its history
+func (r *Repository) UpdateStatus(txId, s, actor, reason string) error {
+	return r.transition(txId, s, actor, reason, nil)
+}
+
 func (r *Repository) UpdateStatusCompleted(txId string) error {
-	return r.updateStatus(txId, status.Completed)
+	return r.transition(txId, status.Completed, status.ActorHandler, "transfer processed", nil)
 }
 
 func (r *Repository) UpdateStatusFailed(txId string) error {
-	return r.updateStatus(txId, status.Failed)
+	return r.Fail(txId, failure.ScheduledTransactionFailed, "transfer processing failed", status.ActorHandler)
+}
+
+// Fail moves the transfer to FAILED, recording the code and the reason of the failure
+func (r *Repository) Fail(txId string, code failure.Code, reason, actor string) error {
+	return r.transition(txId, status.Failed, actor, reason, map[string]interface{}{
+		"failure_code":   code,
+		"failure_reason": reason,
+	})
+}
+
+// UpdateStatusClaimed marks the transfer as claimed on the target EVM chain by the given transaction
+func (r *Repository) UpdateStatusClaimed(txId, claimTxHash string, claimBlockNumber uint64, claimTimestamp int64, claimer string) error {
+	return r.transition(txId, status.Claimed, status.ActorWatcher, fmt.Sprintf("claimed in [%s]", claimTxHash), map[string]interface{}{
+		"claim_tx_hash":      claimTxHash,
+		"claim_block_number": claimBlockNumber,
+		"claim_timestamp":    claimTimestamp,
+		"claimer":            claimer,
+	})
+}
+
+// UpdateStatusReorged marks the transfers from the given source chain with timestamp after the given one as reorged.
+// Returns the IDs of the marked transfers
+func (r *Repository) UpdateStatusReorged(sourceChainId uint64, after time.Time) ([]string, error) {
+	var transfers []*entity.Transfer
+	var txIds []string
+	sources := status.Sources(status.KindTransfer, status.Reorged)
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.
+			Select("transaction_id", "status").
+			Where("source_chain_id = ? AND timestamp > ? AND status IN ?", sourceChainId, after.UnixNano(), sources).
+			Find(&transfers).
+			Error
+		if err != nil || len(transfers) == 0 {
+			return err
+		}
+
+		history := make([]*entity.StatusHistory, len(transfers))
+		for i, t := range transfers {
+			txIds = append(txIds, t.TransactionID)
+			history[i] = &entity.StatusHistory{
+				TransferID: t.TransactionID,
+				Kind:       status.KindTransfer,
+				RecordID:   t.TransactionID,
+				FromStatus: t.Status,
+				ToStatus:   status.Reorged,
+				Reason:     "source transaction removed by a chain reorganisation",
+				Actor:      status.ActorWatcher,
+			}
+		}
+
+		result := tx.
+			Model(entity.Transfer{}).
+			Where("transaction_id IN ? AND status IN ?", txIds, sources).
+			UpdateColumn("status", status.Reorged)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected != int64(len(txIds)) {
+			return fmt.Errorf("updated %d rows, expected %d", result.RowsAffected, len(txIds))
+		}
+
+		return tx.Create(&history).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, txId := range txIds {
+		r.logger.Errorf("Updated Status of TX [%s] to [%s]", txId, status.Reorged)
+	}
+	return txIds, nil
+}
+
+// UpdateStatusClaimReorged returns the transfers, claimed on the given target chain at or after the given block, to the
+// status they had before the claim, as the claim transaction got removed by a chain reorganisation.
+// Returns the IDs of the returned transfers
+func (r *Repository) UpdateStatusClaimReorged(targetChainId, fromBlock uint64) ([]string, error) {
+	var transfers []*entity.Transfer
+	err := r.db.
+		Select("transaction_id").
+		Where("target_chain_id = ? AND status = ? AND claim_block_number >= ?", targetChainId, status.Claimed, fromBlock).
+		Find(&transfers).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	var txIds []string
+	for _, t := range transfers {
+		previous, err := r.statusBefore(t.TransactionID, status.Claimed)
+		if err != nil {
+			return txIds, err
+		}
+		if previous == "" {
+			previous = status.Completed
+		}
+
+		// Claimed is left only here, as the claim is recorded again once the claim transaction is included again
+		err = r.move(t.TransactionID, previous, status.ActorWatcher, "claim transaction removed by a chain reorganisation",
+			map[string]interface{}{
+				"claim_tx_hash":      "",
+				"claim_block_number": 0,
+				"claim_timestamp":    0,
+				"claimer":            "",
+			},
+			func(from string) bool {
+				return from == status.Claimed
+			})
+		if err != nil {
+			return txIds, err
+		}
+		txIds = append(txIds, t.TransactionID)
+	}
+
+	return txIds, nil
+}
+
+func (r *Repository) UpdateStatusReincluded(ct *payload.Transfer) (*entity.Transfer, error) {
+	previous, err := r.statusBefore(ct.TransactionId, status.Reorged)
+	if err != nil {
+		return nil, err
+	}
+	if previous == "" {
+		previous = status.Initial
+	}
+
+	// Reorged is left only here, as the generic transitions must not overwrite the mark of a reorganisation
+	err = r.move(ct.TransactionId, previous, status.ActorWatcher, "source transaction included again after a chain reorganisation",
+		map[string]interface{}{"timestamp": entity.NanoTime{Time: ct.Timestamp}},
+		func(from string) bool {
+			return from == status.Reorged
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetByTransactionId(ct.TransactionId)
+}
+
+func (r *Repository) GetStuck(before time.Time, maxRedrives int, signers []string, limit int) ([]*entity.Transfer, error) {
+	var transfers []*entity.Transfer
+	q := r.db.
+		Model(entity.Transfer{}).
+		Where("status = ? AND timestamp < ? AND redriven_at < ? AND redrives < ?", status.Initial, before.UnixNano(), before.UnixNano(), maxRedrives).
+		// The mint of a batched transfer is executed before the transfer is added to its batch
+		Where("NOT EXISTS (SELECT 1 FROM schedules WHERE schedules.transfer_id = transfers.transaction_id AND schedules.status IN ? "+
+			"AND (schedules.operation <> ? OR NOT EXISTS (SELECT 1 FROM batch_items WHERE batch_items.transfer_id = transfers.transaction_id)))",
+			[]string{status.Submitted, status.Completed}, schedule.MINT).
+		Where("NOT EXISTS (SELECT 1 FROM fees WHERE fees.transfer_id = transfers.transaction_id AND fees.status IN ?)", []string{status.Submitted, status.Completed}).
+		// Transfers, which are still pending in their batch, are executed along with it
+		Where("NOT EXISTS (SELECT 1 FROM batch_items WHERE batch_items.transfer_id = transfers.transaction_id AND batch_items.executed_at = ?)", 0)
+	if len(signers) > 0 {
+		q = q.Where("NOT EXISTS (SELECT 1 FROM messages WHERE messages.transfer_id = transfers.transaction_id AND messages.signer IN ?)", signers)
+	}
+
+	err := q.
+		Order("timestamp asc").
+		Limit(limit).
+		Find(&transfers).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range transfers {
+		r.updateHederaChainId(t)
+	}
+	return transfers, nil
+}
+
+func (r *Repository) MarkRedriven(txId, fromStatus string, notAfter time.Time, actor string) (bool, error) {
+	if !status.CanTransition(status.KindTransfer, fromStatus, status.Initial) {
+		return false, fmt.Errorf("%w from [%s] to [%s]", status.ErrInvalidTransition, fromStatus, status.Initial)
+	}
+
+	marked := false
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.
+			Model(entity.Transfer{}).
+			Where("transaction_id = ? AND status = ? AND redriven_at < ?", txId, fromStatus, notAfter.UnixNano()).
+			UpdateColumns(map[string]interface{}{
+				"status":         status.Initial,
+				"redrives":       gorm.Expr("redrives + ?", 1),
+				"redriven_at":    time.Now().UnixNano(),
+				"failure_code":   "",
+				"failure_reason": "",
+			})
+		if result.Error != nil || result.RowsAffected != 1 {
+			return result.Error
+		}
+
+		marked = true
+		return tx.Create(&entity.StatusHistory{
+			TransferID: txId,
+			Kind:       status.KindTransfer,
+			RecordID:   txId,
+			FromStatus: fromStatus,
+			ToStatus:   status.Initial,
+			Reason:     "re-driven",
+			Actor:      actor,
+		}).Error
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if marked {
+		r.logger.Infof("[%s] - Re-driven from status [%s]", txId, fromStatus)
+	}
+	return marked, nil
+}
+
+func (r *Repository) GetStatusHistory(txId string) ([]*entity.StatusHistory, error) {
+	var history []*entity.StatusHistory
+	err := r.db.
+		Where("transfer_id = ?", txId).
+		Order("id asc").
+		Find(&history).
+		Error
+	return history, err
 }
 
 func formatTimestampFilter(q *gorm.DB, ts_query string) (*gorm.DB, error) {
@@ -192,12 +486,15 @@ func (r *Repository) Paged(req *transfer.PagedRequest) ([]*entity.Transfer, int6
 		count int64
 	)
 
-	offset := (req.Page - 1) * req.PageSize
 	res := make([]*entity.Transfer, 0, req.PageSize)
 	f := req.Filter
-	q := r.db.
-		Model(entity.Transfer{}).
-		Order("timestamp desc, status asc")
+	q := r.db.Model(entity.Transfer{})
+	if req.IsCursorBased() {
+		// Transaction ID is a tie-breaker for transfers with equal timestamps, making the order stable
+		q = q.Order("timestamp desc, transaction_id desc")
+	} else {
+		q = q.Order("timestamp desc, status asc")
+	}
 
 	if f.Originator != "" {
 		if strings.Contains(f.Originator, "0x") {
@@ -227,10 +524,72 @@ func (r *Repository) Paged(req *transfer.PagedRequest) ([]*entity.Transfer, int6
 	if f.TransactionId != "" {
 		q = q.Where("transaction_id LIKE ?", fmt.Sprintf(`%s%%`, f.TransactionId))
 	}
+	if f.Claimed != nil {
+		if *f.Claimed {
+			q = q.Where("claim_tx_hash <> ''")
+		} else {
+			q = q.Where("target_chain_id <> ? AND claim_tx_hash = ''", constants.HederaNetworkId)
+		}
+	}
+	if f.Claimer != "" {
+		q = q.Where("claimer = ?", common.HexToAddress(f.Claimer).String())
+	}
+	if f.Status != "" {
+		q = q.Where("status = ?", f.Status)
+	}
+	if f.FailureCode != "" {
+		q = q.Where("failure_code = ?", f.FailureCode)
+	}
+	if f.SourceChainId != 0 {
+		q = chainIdFilter(q, "source_chain_id", f.SourceChainId)
+	}
+	if f.TargetChainId != 0 {
+		q = chainIdFilter(q, "target_chain_id", f.TargetChainId)
+	}
+	if f.NativeChainId != 0 {
+		q = chainIdFilter(q, "native_chain_id", f.NativeChainId)
+	}
+	if f.Receiver != "" {
+		if strings.Contains(f.Receiver, "0x") {
+			q = q.Where("receiver = ?", common.HexToAddress(f.Receiver).String())
+		} else {
+			q = q.Where("receiver = ?", f.Receiver)
+		}
+	}
+	if f.IsNft != nil {
+		q = q.Where("is_nft = ?", *f.IsNft)
+	}
+	// Amounts are stored as strings and are empty for NFTs
+	if f.MinAmount != "" {
+		q = q.Where("CAST(NULLIF(amount, '') AS NUMERIC) >= ?", f.MinAmount)
+	}
+	if f.MaxAmount != "" {
+		q = q.Where("CAST(NULLIF(amount, '') AS NUMERIC) <= ?", f.MaxAmount)
+	}
+	if f.From != nil {
+		q = q.Where("timestamp >= ?", f.From.UnixNano())
+	}
+	if f.To != nil {
+		q = q.Where("timestamp < ?", f.To.UnixNano())
+	}
 
-	q = q.Count(&count).
-		Offset(int(offset)).
-		Limit(int(req.PageSize))
+	if req.CountsTotal() {
+		q = q.Count(&count)
+	}
+	q = q.Limit(int(req.PageSize))
+
+	if req.IsCursorBased() {
+		if req.Cursor != "" {
+			timestamp, transactionId, err := transfer.DecodeCursor(req.Cursor)
+			if err != nil {
+				r.logger.Errorf("Failed to get paged transfers: [%s]", err)
+				return nil, 0, err
+			}
+			q = q.Where("(timestamp, transaction_id) < (?, ?)", timestamp, transactionId)
+		}
+	} else {
+		q = q.Offset(int((req.Page - 1) * req.PageSize))
+	}
 
 	err = q.Find(&res).Error
 	if err != nil {
@@ -241,8 +600,44 @@ func (r *Repository) Paged(req *transfer.PagedRequest) ([]*entity.Transfer, int6
 	return res, count, nil
 }
 
-func (r *Repository) create(ct *payload.Transfer, status string) (*entity.Transfer, error) {
-	tx := &entity.Transfer{
+// chainIdFilter filters the given chain ID column, matching the legacy Hedera network ID as well
+func chainIdFilter(q *gorm.DB, column string, chainId uint64) *gorm.DB {
+	if chainId == constants.HederaNetworkId {
+		return q.Where(column+" IN (?, ?)", constants.HederaNetworkId, constants.OldHederaNetworkId)
+	}
+
+	return q.Where(column+" = ?", chainId)
+}
+
+func (r *Repository) create(ctx context.Context, ct *payload.Transfer, s string) (*entity.Transfer, error) {
+	tx := newTransfer(ct, s)
+	err := r.db.WithContext(ctx).Transaction(func(db *gorm.DB) error {
+		err := db.Create(tx).Error
+		if err != nil {
+			return err
+		}
+
+		return db.Create(&entity.StatusHistory{
+			TransferID: tx.TransactionID,
+			Kind:       status.KindTransfer,
+			RecordID:   tx.TransactionID,
+			ToStatus:   s,
+			Reason:     "transfer created",
+			Actor:      status.ActorHandler,
+		}).Error
+	})
+
+	return tx, err
+}
+
+func newTransfer(ct *payload.Transfer, s string) *entity.Transfer {
+	// The amounts are persisted as decimal strings, so that they are not limited in size. NFTs have no amount
+	amount := ""
+	if ct.Amount != nil {
+		amount = ct.Amount.String()
+	}
+
+	return &entity.Transfer{
 		TransactionID: ct.TransactionId,
 		SourceChainID: ct.SourceChainId,
 		TargetChainID: ct.TargetChainId,
@@ -251,44 +646,101 @@ func (r *Repository) create(ct *payload.Transfer, status string) (*entity.Transf
 		TargetAsset:   ct.TargetAsset,
 		NativeAsset:   ct.NativeAsset,
 		Receiver:      ct.Receiver,
-		Amount:        ct.Amount,
-		Status:        status,
+		Amount:        amount,
+		Status:        s,
 		SerialNumber:  ct.SerialNum,
 		Metadata:      ct.Metadata,
 		IsNft:         ct.IsNft,
 		Timestamp:     entity.NanoTime{Time: ct.Timestamp},
 		Originator:    ct.Originator,
 	}
-	err := r.db.Create(tx).Error
+}
 
-	return tx, err
+// transition moves the transfer to the given status, updating the given columns along with it, and appends the change
+// to its history. Moving to the current status is a no-op, moving to a status not reachable from it is an error
+func (r *Repository) transition(txId, to, actor, reason string, columns map[string]interface{}) error {
+	return r.move(txId, to, actor, reason, columns, func(from string) bool {
+		return status.CanTransition(status.KindTransfer, from, to)
+	})
 }
 
-func (r *Repository) updateStatus(txId string, s string) error {
-	// Sanity check
-	if s != status.Initial &&
-		s != status.Completed &&
-		s != status.Failed {
-		return errors.New("invalid status")
+// statusBefore returns the status, from which the transfer last moved to the given one. Returns an empty string if it never did
+func (r *Repository) statusBefore(txId, to string) (string, error) {
+	history := &entity.StatusHistory{}
+	err := r.db.
+		Where("transfer_id = ? AND kind = ? AND to_status = ?", txId, status.KindTransfer, to).
+		Order("id desc").
+		First(history).
+		Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return "", err
 	}
 
-	result := r.db.
-		Model(entity.Transfer{}).
-		Where("transaction_id = ?", txId).
-		UpdateColumn("status", s)
-	if result.Error == nil {
-		if s == status.Failed {
-			r.logger.Errorf("Updated Status of TX [%s] to [%s]", txId, s)
+	return history.FromStatus, nil
+}
+
+// move moves the transfer to the given status, if allowed from its current one, as described in transition
+func (r *Repository) move(txId, to, actor, reason string, columns map[string]interface{}, allowed func(from string) bool) error {
+	var from string
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		t := &entity.Transfer{}
+		err := tx.
+			Select("status").
+			Where("transaction_id = ?", txId).
+			First(t).
+			Error
+		if err != nil {
+			return err
+		}
+
+		from = t.Status
+		if from == to {
 			return nil
 		}
-		r.logger.Infof("Updated Status of TX [%s] to [%s]", txId, s)
-	}
+		if !allowed(from) {
+			return fmt.Errorf("%w from [%s] to [%s]", status.ErrInvalidTransition, from, to)
+		}
 
-	if result.RowsAffected != 1 {
-		return fmt.Errorf("updated %d rows, expected 1", result.RowsAffected)
+		if columns == nil {
+			columns = make(map[string]interface{})
+		}
+		if to == status.Initial {
+			// Re-driven transfers are no longer failed
+			columns["failure_code"] = ""
+			columns["failure_reason"] = ""
+		}
+		columns["status"] = to
+		result := tx.
+			Model(entity.Transfer{}).
+			Where("transaction_id = ? AND status = ?", txId, from).
+			UpdateColumns(columns)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected != 1 {
+			return fmt.Errorf("updated %d rows, expected 1", result.RowsAffected)
+		}
+
+		return tx.Create(&entity.StatusHistory{
+			TransferID: txId,
+			Kind:       status.KindTransfer,
+			RecordID:   txId,
+			FromStatus: from,
+			ToStatus:   to,
+			Reason:     reason,
+			Actor:      actor,
+		}).Error
+	})
+	if err != nil || from == to {
+		return err
 	}
 
-	return result.Error
+	if to == status.Failed || to == status.Reorged {
+		r.logger.Errorf("Updated Status of TX [%s] from [%s] to [%s] by [%s]: %s", txId, from, to, actor, reason)
+	} else {
+		r.logger.Infof("Updated Status of TX [%s] from [%s] to [%s] by [%s]: %s", txId, from, to, actor, reason)
+	}
+	return nil
 }
 
 func (r *Repository) updateHederaChainId(tx *entity.Transfer) {
@@ -17,9 +17,11 @@
 package transfer
 
 import (
+	"context"
 	"database/sql"
 	"database/sql/driver"
 	"fmt"
+	"math/big"
 	"regexp"
 	"testing"
 	"time"
@@ -34,6 +36,8 @@ import (
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/failure"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/schedule"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/test/helper"
@@ -55,7 +59,7 @@ var (
 	targetAsset         = "targetAsset"
 	nativeAsset         = "nativeAsset"
 	receiver            = "receiver"
-	amount              = "amount"
+	amount              = "100"
 	fee                 = ""
 	someStatus          = status.Initial
 	serialNumber        = int64(0)
@@ -65,12 +69,18 @@ var (
 	nanoTime            = entity.NanoTime{Time: now}
 	originator          = "originator"
 	originatorEVM       = "0x1235"
+	wrappedSerialNumber = int64(0)
+	claimTxHash         = "0xclaimTxHash"
+	claimBlockNumber    = uint64(10)
+	claimTimestamp      = int64(1649256000)
+	claimer             = "0x0000000000000000000000000000000000001235"
 
-	transferColumns = []string{"transaction_id", "source_chain_id", "target_chain_id", "native_chain_id", "source_asset", "target_asset", "native_asset", "receiver", "amount", "fee", "status", "serial_number", "metadata", "is_nft", "timestamp", "originator"}
+	transferColumns = []string{"transaction_id", "source_chain_id", "target_chain_id", "native_chain_id", "source_asset", "target_asset", "native_asset", "receiver", "amount", "fee", "status", "serial_number", "metadata", "is_nft", "timestamp", "originator", "wrapped_serial_number", "claim_tx_hash", "claim_block_number", "claim_timestamp", "claimer", "redrives", "redriven_at", "failure_code", "failure_reason"}
 	feeColumns      = []string{"transaction_id", "schedule_id", "amount", "status", "transfer_id"}
 	messageColumns  = []string{"transfer_id", "hash", "signature", "signer", "transaction_timestamp"}
+	scheduleColumns = []string{"transaction_id", "schedule_id", "has_receiver", "operation", "status", "transfer_id", "failure_code", "failure_reason"}
 
-	transferRowArgs = []driver.Value{transactionId, sourceChainId, targetChainId, nativeChainId, sourceAsset, targetAsset, nativeAsset, receiver, amount, fee, someStatus, serialNumber, metadata, isNft, nanoTime, originator}
+	transferRowArgs = []driver.Value{transactionId, sourceChainId, targetChainId, nativeChainId, sourceAsset, targetAsset, nativeAsset, receiver, amount, fee, someStatus, serialNumber, metadata, isNft, nanoTime, originator, wrappedSerialNumber, "", uint64(0), int64(0), "", 0, int64(0), "", ""}
 	feesRowArgs     = []driver.Value{
 		transactionId,
 		expectedEntityFee.ScheduleID,
@@ -78,7 +88,17 @@ var (
 		expectedEntityFee.Status,
 		expectedEntityFee.TransferID,
 	}
-	messageRowArgs = []driver.Value{transactionId, "hash", "signature", "signer", uint8(1)}
+	messageRowArgs  = []driver.Value{transactionId, "hash", "signature", "signer", uint8(1)}
+	scheduleRowArgs = []driver.Value{
+		expectedEntitySchedule.TransactionID,
+		expectedEntitySchedule.ScheduleID,
+		expectedEntitySchedule.HasReceiver,
+		expectedEntitySchedule.Operation,
+		expectedEntitySchedule.Status,
+		transactionId,
+		"",
+		"",
+	}
 
 	expectedEntityTransfer = &entity.Transfer{
 		TransactionID: transactionId,
@@ -107,7 +127,7 @@ var (
 		TargetAsset:      targetAsset,
 		NativeAsset:      nativeAsset,
 		Receiver:         receiver,
-		Amount:           amount,
+		Amount:           big.NewInt(100),
 		SerialNum:        serialNumber,
 		Metadata:         metadata,
 		IsNft:            isNft,
@@ -133,6 +153,17 @@ var (
 		Signer:               "signer",
 		TransactionTimestamp: 1,
 	}
+	expectedEntitySchedule = entity.Schedule{
+		TransactionID: "0.0.1-1650000000-000000001",
+		ScheduleID:    "scheduleId",
+		HasReceiver:   true,
+		Operation:     "operation",
+		Status:        "status",
+		TransferID: sql.NullString{
+			String: transactionId,
+			Valid:  true,
+		},
+	}
 
 	expectedEntityTransferWithFee = &entity.Transfer{
 		TransactionID: transactionId,
@@ -178,17 +209,39 @@ var (
 		Messages: []entity.Message{
 			expectedEntityMessage,
 		},
+		Schedules: []entity.Schedule{
+			expectedEntitySchedule,
+		},
 	}
 
 	getByTransactionIdQuery       = regexp.QuoteMeta(`SELECT * FROM "transfers" WHERE transaction_id = $1`)
+	getByWrappedSerialNumberQuery = regexp.QuoteMeta(`SELECT * FROM "transfers" WHERE target_asset = $1 AND wrapped_serial_number = $2`)
 	getWithPreloadsTransfersQuery = regexp.QuoteMeta(`SELECT * FROM "transfers" WHERE transaction_id = $1`)
 	getWithPreloadsFeesQuery      = regexp.QuoteMeta(`SELECT * FROM "fees" WHERE "fees"."transfer_id" = $1`)
 	getWithPreloadsMessagesQuery  = regexp.QuoteMeta(`SELECT * FROM "messages" WHERE "messages"."transfer_id" = $1`)
-
-	createQuery       = regexp.QuoteMeta(`INSERT INTO "transfers" ("transaction_id","source_chain_id","target_chain_id","native_chain_id","source_asset","target_asset","native_asset","receiver","amount","fee","status","serial_number","metadata","is_nft","timestamp","originator") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16)`)
-	saveQuery         = regexp.QuoteMeta(`UPDATE "transfers" SET "source_chain_id"=$1,"target_chain_id"=$2,"native_chain_id"=$3,"source_asset"=$4,"target_asset"=$5,"native_asset"=$6,"receiver"=$7,"amount"=$8,"fee"=$9,"status"=$10,"serial_number"=$11,"metadata"=$12,"is_nft"=$13,"timestamp"=$14,"originator"=$15 WHERE "transaction_id" = $16`)
-	updateFeeQuery    = regexp.QuoteMeta(`UPDATE "transfers" SET "fee"=$1 WHERE transaction_id = $2`)
-	updateStatusQuery = regexp.QuoteMeta(`UPDATE "transfers" SET "status"=$1 WHERE transaction_id = $2`)
+	getWithPreloadsSchedulesQuery = regexp.QuoteMeta(`SELECT * FROM "schedules" WHERE "schedules"."transfer_id" = $1`)
+
+	createQuery                    = regexp.QuoteMeta(`INSERT INTO "transfers" ("transaction_id","source_chain_id","target_chain_id","native_chain_id","source_asset","target_asset","native_asset","receiver","amount","fee","status","serial_number","metadata","is_nft","timestamp","originator","wrapped_serial_number","claim_tx_hash","claim_block_number","claim_timestamp","claimer","redrives","redriven_at","failure_code","failure_reason") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17,$18,$19,$20,$21,$22,$23,$24,$25)`)
+	saveQuery                      = regexp.QuoteMeta(`UPDATE "transfers" SET "source_chain_id"=$1,"target_chain_id"=$2,"native_chain_id"=$3,"source_asset"=$4,"target_asset"=$5,"native_asset"=$6,"receiver"=$7,"amount"=$8,"fee"=$9,"status"=$10,"serial_number"=$11,"metadata"=$12,"is_nft"=$13,"timestamp"=$14,"originator"=$15,"wrapped_serial_number"=$16,"claim_tx_hash"=$17,"claim_block_number"=$18,"claim_timestamp"=$19,"claimer"=$20,"redrives"=$21,"redriven_at"=$22,"failure_code"=$23,"failure_reason"=$24 WHERE "transaction_id" = $25`)
+	updateFeeQuery                 = regexp.QuoteMeta(`UPDATE "transfers" SET "fee"=$1 WHERE transaction_id = $2`)
+	updateWrappedSerialNumberQuery = regexp.QuoteMeta(`UPDATE "transfers" SET "wrapped_serial_number"=$1 WHERE transaction_id = $2`)
+	selectStatusQuery              = regexp.QuoteMeta(`SELECT "status" FROM "transfers" WHERE transaction_id = $1 ORDER BY "transfers"."transaction_id" LIMIT 1`)
+	updateStatusQuery              = regexp.QuoteMeta(`UPDATE "transfers" SET "status"=$1 WHERE transaction_id = $2 AND status = $3`)
+	updateStatusFailureQuery       = regexp.QuoteMeta(`UPDATE "transfers" SET "failure_code"=$1,"failure_reason"=$2,"status"=$3 WHERE transaction_id = $4 AND status = $5`)
+	updateStatusClaimedQuery       = regexp.QuoteMeta(`UPDATE "transfers" SET "claim_block_number"=$1,"claim_timestamp"=$2,"claim_tx_hash"=$3,"claimer"=$4,"status"=$5 WHERE transaction_id = $6 AND status = $7`)
+	createHistoryQuery             = regexp.QuoteMeta(`INSERT INTO "transfer_status_history" ("transfer_id","kind","record_id","from_status","to_status","reason","actor","created_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`)
+	getStatusHistoryQuery          = regexp.QuoteMeta(`SELECT * FROM "transfer_status_history" WHERE transfer_id = $1 ORDER BY id asc`)
+
+	selectReorgedQuery          = regexp.QuoteMeta(`SELECT "transaction_id","status" FROM "transfers" WHERE source_chain_id = $1 AND timestamp > $2 AND status IN ($3,$4,$5,$6,$7)`)
+	updateClaimReorgedQuery     = regexp.QuoteMeta(`UPDATE "transfers" SET "claim_block_number"=$1,"claim_timestamp"=$2,"claim_tx_hash"=$3,"claimer"=$4,"failure_code"=$5,"failure_reason"=$6,"status"=$7 WHERE transaction_id = $8 AND status = $9`)
+	selectClaimReorgedQuery     = regexp.QuoteMeta(`SELECT "transaction_id" FROM "transfers" WHERE target_chain_id = $1 AND status = $2 AND claim_block_number >= $3`)
+	selectLastReorgedQuery      = regexp.QuoteMeta(`SELECT * FROM "transfer_status_history" WHERE transfer_id = $1 AND kind = $2 AND to_status = $3 ORDER BY id desc,"transfer_status_history"."id" LIMIT 1`)
+	updateStatusReincludedQuery = regexp.QuoteMeta(`UPDATE "transfers" SET "failure_code"=$1,"failure_reason"=$2,"status"=$3,"timestamp"=$4 WHERE transaction_id = $5 AND status = $6`)
+	updateStatusTimestampQuery  = regexp.QuoteMeta(`UPDATE "transfers" SET "status"=$1,"timestamp"=$2 WHERE transaction_id = $3 AND status = $4`)
+	updateReorgedQuery          = regexp.QuoteMeta(`UPDATE "transfers" SET "status"=$1 WHERE transaction_id IN ($2) AND status IN ($3,$4,$5,$6,$7)`)
+	getStuckQuery               = regexp.QuoteMeta(`SELECT * FROM "transfers" WHERE (status = $1 AND timestamp < $2 AND redriven_at < $3 AND redrives < $4) AND (NOT EXISTS (SELECT 1 FROM schedules WHERE schedules.transfer_id = transfers.transaction_id AND schedules.status IN ($5,$6) AND (schedules.operation <> $7 OR NOT EXISTS (SELECT 1 FROM batch_items WHERE batch_items.transfer_id = transfers.transaction_id)))) AND (NOT EXISTS (SELECT 1 FROM fees WHERE fees.transfer_id = transfers.transaction_id AND fees.status IN ($8,$9))) AND (NOT EXISTS (SELECT 1 FROM batch_items WHERE batch_items.transfer_id = transfers.transaction_id AND batch_items.executed_at = $10)) AND (NOT EXISTS (SELECT 1 FROM messages WHERE messages.transfer_id = transfers.transaction_id AND messages.signer IN ($11))) ORDER BY timestamp asc LIMIT 10`)
+	markRedrivenQuery           = regexp.QuoteMeta(`UPDATE "transfers" SET "failure_code"=$1,"failure_reason"=$2,"redriven_at"=$3,"redrives"=redrives + $4,"status"=$5 WHERE transaction_id = $6 AND status = $7 AND redriven_at < $8`)
+	rejectQuery                 = regexp.QuoteMeta(`INSERT INTO "transfers" ("transaction_id","source_chain_id","target_chain_id","native_chain_id","source_asset","target_asset","native_asset","receiver","amount","fee","status","serial_number","metadata","is_nft","timestamp","originator","wrapped_serial_number","claim_tx_hash","claim_block_number","claim_timestamp","claimer","redrives","redriven_at","failure_code","failure_reason") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17,$18,$19,$20,$21,$22,$23,$24,$25) ON CONFLICT DO NOTHING`)
 
 	// "SELECT count(*) FROM \"transfers\"\"
 	countQuery                      = regexp.QuoteMeta(`SELECT count(*) FROM "transfers"`)
@@ -198,6 +251,13 @@ var (
 	pagedFilterFromToTimestampQuery = regexp.QuoteMeta(`SELECT * FROM "transfers" WHERE timestamp <= $1 AND timestamp >= $2 ORDER BY timestamp desc, status asc LIMIT 10`)
 	pagedFilterTransactionIdQuery   = regexp.QuoteMeta(`SELECT * FROM "transfers" WHERE transaction_id LIKE $1 ORDER BY timestamp desc, status asc LIMIT 10`)
 	pagedFilterTokenIdQuery         = regexp.QuoteMeta(`SELECT * FROM "transfers" WHERE (source_asset = $1 OR target_asset = $2) ORDER BY timestamp desc, status asc LIMIT 10`)
+	pagedFilterClaimedQuery         = regexp.QuoteMeta(`SELECT * FROM "transfers" WHERE claim_tx_hash <> '' ORDER BY timestamp desc, status asc LIMIT 10`)
+	pagedFilterNotClaimedQuery      = regexp.QuoteMeta(`SELECT * FROM "transfers" WHERE target_chain_id <> $1 AND claim_tx_hash = '' ORDER BY timestamp desc, status asc LIMIT 10`)
+	pagedFilterClaimerQuery         = regexp.QuoteMeta(`SELECT * FROM "transfers" WHERE claimer = $1 ORDER BY timestamp desc, status asc LIMIT 10`)
+	pagedFilterFailureCodeQuery     = regexp.QuoteMeta(`SELECT * FROM "transfers" WHERE status = $1 AND failure_code = $2 ORDER BY timestamp desc, status asc LIMIT 10`)
+	pagedFilterQuery                = regexp.QuoteMeta(`SELECT * FROM "transfers" WHERE status = $1 AND source_chain_id IN ($2, $3) AND target_chain_id = $4 AND receiver = $5 AND is_nft = $6 AND CAST(NULLIF(amount, '') AS NUMERIC) >= $7 AND CAST(NULLIF(amount, '') AS NUMERIC) <= $8 AND timestamp >= $9 AND timestamp < $10 ORDER BY timestamp desc, status asc LIMIT 10`)
+	pagedCursorFirstQuery           = regexp.QuoteMeta(`SELECT * FROM "transfers" ORDER BY timestamp desc, transaction_id desc LIMIT 10`)
+	pagedCursorQuery                = regexp.QuoteMeta(`SELECT * FROM "transfers" WHERE (timestamp, transaction_id) < ($1, $2) ORDER BY timestamp desc, transaction_id desc LIMIT 10`)
 )
 
 func setup() {
@@ -246,12 +306,33 @@ func Test_GetByTransactionId_InvalidData(t *testing.T) {
 	assert.Nil(t, actual)
 }
 
+func Test_GetByWrappedSerialNumber(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	helper.SqlMockPrepareQuery(sqlMock, transferColumns, transferRowArgs, getByWrappedSerialNumberQuery, targetAsset, wrappedSerialNumber)
+
+	actual, err := repository.GetByWrappedSerialNumber(targetAsset, wrappedSerialNumber)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedEntityTransfer, actual)
+}
+
+func Test_GetByWrappedSerialNumber_NotFound(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	_ = helper.SqlMockPrepareQueryWithErrNotFound(sqlMock, getByWrappedSerialNumberQuery, targetAsset, wrappedSerialNumber)
+
+	actual, err := repository.GetByWrappedSerialNumber(targetAsset, wrappedSerialNumber)
+	assert.Nil(t, err)
+	assert.Nil(t, actual)
+}
+
 func Test_GetWithPreloads(t *testing.T) {
 	setup()
 	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
 	helper.SqlMockPrepareQuery(sqlMock, transferColumns, transferRowArgs, getWithPreloadsTransfersQuery, transactionId)
 	helper.SqlMockPrepareQuery(sqlMock, feeColumns, feesRowArgs, getWithPreloadsFeesQuery, transactionId)
 	helper.SqlMockPrepareQuery(sqlMock, messageColumns, messageRowArgs, getWithPreloadsMessagesQuery, transactionId)
+	helper.SqlMockPrepareQuery(sqlMock, scheduleColumns, scheduleRowArgs, getWithPreloadsSchedulesQuery, transactionId)
 
 	actual, err := repository.GetWithPreloads(transactionId)
 	assert.Nil(t, err)
@@ -314,6 +395,7 @@ func Test_GetWithFee_InvalidData(t *testing.T) {
 func Test_Create(t *testing.T) {
 	setup()
 	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	sqlMock.ExpectBegin()
 	helper.SqlMockPrepareExec(sqlMock, createQuery,
 		transactionId,
 		sourceChainId,
@@ -330,9 +412,20 @@ func Test_Create(t *testing.T) {
 		metadata,
 		isNft,
 		nanoTime,
-		originator)
-
-	actual, err := repository.Create(expectedModelTransfer)
+		originator,
+		wrappedSerialNumber,
+		"",
+		uint64(0),
+		int64(0),
+		"",
+		0,
+		int64(0),
+		"",
+		"")
+	prepareCreateHistory("", someStatus, status.ActorHandler, "transfer created")
+	sqlMock.ExpectCommit()
+
+	actual, err := repository.Create(context.Background(), expectedModelTransfer)
 	assert.Nil(t, err)
 	assert.Equal(t, expectedEntityTransfer, actual)
 }
@@ -340,6 +433,7 @@ func Test_Create(t *testing.T) {
 func Test_Create_Err(t *testing.T) {
 	setup()
 	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	sqlMock.ExpectBegin()
 	_ = helper.SqlMockPrepareExecWithErr(sqlMock, createQuery,
 		transactionId,
 		sourceChainId,
@@ -356,9 +450,19 @@ func Test_Create_Err(t *testing.T) {
 		metadata,
 		isNft,
 		nanoTime,
-		originator)
-
-	actual, err := repository.Create(expectedModelTransfer)
+		originator,
+		wrappedSerialNumber,
+		"",
+		uint64(0),
+		int64(0),
+		"",
+		0,
+		int64(0),
+		"",
+		"")
+	sqlMock.ExpectRollback()
+
+	actual, err := repository.Create(context.Background(), expectedModelTransfer)
 	assert.NotNil(t, err)
 	assert.NotNil(t, actual)
 }
@@ -382,6 +486,15 @@ func Test_Save(t *testing.T) {
 		isNft,
 		nanoTime,
 		originator,
+		wrappedSerialNumber,
+		"",
+		uint64(0),
+		int64(0),
+		"",
+		0,
+		int64(0),
+		"",
+		"",
 		transactionId)
 
 	err := repository.Save(expectedEntityTransfer)
@@ -407,6 +520,15 @@ func Test_Save_Err(t *testing.T) {
 		isNft,
 		nanoTime,
 		originator,
+		wrappedSerialNumber,
+		"",
+		uint64(0),
+		int64(0),
+		"",
+		0,
+		int64(0),
+		"",
+		"",
 		transactionId)
 
 	err := repository.Save(expectedEntityTransfer)
@@ -433,12 +555,36 @@ func Test_UpdateFee_Err(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func Test_UpdateWrappedSerialNumber(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	helper.SqlMockPrepareExec(sqlMock, updateWrappedSerialNumberQuery,
+		wrappedSerialNumber, transactionId)
+
+	err := repository.UpdateWrappedSerialNumber(transactionId, wrappedSerialNumber)
+	assert.Nil(t, err)
+}
+
+func Test_UpdateWrappedSerialNumber_Err(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	_ = helper.SqlMockPrepareExecWithErr(sqlMock, updateWrappedSerialNumberQuery,
+		wrappedSerialNumber, transactionId)
+
+	err := repository.UpdateWrappedSerialNumber(transactionId, wrappedSerialNumber)
+	assert.NotNil(t, err)
+}
+
 func Test_UpdateStatusCompleted(t *testing.T) {
 	setup()
 	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	prepareSelectStatus(status.Initial)
 	helper.SqlMockPrepareExec(sqlMock, updateStatusQuery,
 		status.Completed,
-		transactionId)
+		transactionId,
+		status.Initial)
+	prepareCreateHistory(status.Initial, status.Completed, status.ActorHandler, "transfer processed")
+	sqlMock.ExpectCommit()
 
 	err := repository.UpdateStatusCompleted(transactionId)
 	assert.Nil(t, err)
@@ -447,39 +593,343 @@ func Test_UpdateStatusCompleted(t *testing.T) {
 func Test_UpdateStatusCompleted_Err(t *testing.T) {
 	setup()
 	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	prepareSelectStatus(status.Initial)
 	_ = helper.SqlMockPrepareExecWithErr(sqlMock, updateStatusQuery,
 		status.Completed,
-		transactionId)
+		transactionId,
+		status.Initial)
+	sqlMock.ExpectRollback()
 
 	err := repository.UpdateStatusCompleted(transactionId)
 	assert.NotNil(t, err)
 }
 
+func Test_UpdateStatusCompleted_AlreadyCompleted(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	prepareSelectStatus(status.Completed)
+	sqlMock.ExpectCommit()
+
+	err := repository.UpdateStatusCompleted(transactionId)
+	assert.Nil(t, err)
+}
+
+func Test_UpdateStatusCompleted_InvalidTransition(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	prepareSelectStatus(status.Claimed)
+	sqlMock.ExpectRollback()
+
+	err := repository.UpdateStatusCompleted(transactionId)
+	assert.ErrorIs(t, err, status.ErrInvalidTransition)
+}
+
+func Test_UpdateStatusCompleted_Reorged(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	prepareSelectStatus(status.Reorged)
+	sqlMock.ExpectRollback()
+
+	err := repository.UpdateStatusCompleted(transactionId)
+	assert.ErrorIs(t, err, status.ErrInvalidTransition)
+}
+
 func Test_UpdateStatusFailed(t *testing.T) {
 	setup()
 	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
-	helper.SqlMockPrepareExec(sqlMock, updateStatusQuery,
+	prepareSelectStatus(status.Initial)
+	helper.SqlMockPrepareExec(sqlMock, updateStatusFailureQuery,
+		failure.ScheduledTransactionFailed,
+		"transfer processing failed",
 		status.Failed,
-		transactionId)
+		transactionId,
+		status.Initial)
+	prepareCreateHistory(status.Initial, status.Failed, status.ActorHandler, "transfer processing failed")
+	sqlMock.ExpectCommit()
 
 	err := repository.UpdateStatusFailed(transactionId)
 	assert.Nil(t, err)
 }
 
-func Test_UpdateStatusFailed_Err(t *testing.T) {
+func Test_UpdateStatusFailed_NotFound(t *testing.T) {
 	setup()
 	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
-	_ = helper.SqlMockPrepareExecWithErr(sqlMock, updateStatusQuery,
-		status.Failed,
-		transactionId)
+	sqlMock.ExpectBegin()
+	_ = helper.SqlMockPrepareQueryWithErrNotFound(sqlMock, selectStatusQuery, transactionId)
+	sqlMock.ExpectRollback()
 
 	err := repository.UpdateStatusFailed(transactionId)
 	assert.NotNil(t, err)
 }
 
+func Test_Fail(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	prepareSelectStatus(status.Initial)
+	helper.SqlMockPrepareExec(sqlMock, updateStatusFailureQuery,
+		failure.ReceiverNotAssociated,
+		"resolved with [TOKEN_NOT_ASSOCIATED_TO_ACCOUNT]",
+		status.Failed,
+		transactionId,
+		status.Initial)
+	prepareCreateHistory(status.Initial, status.Failed, status.ActorHandler, "resolved with [TOKEN_NOT_ASSOCIATED_TO_ACCOUNT]")
+	sqlMock.ExpectCommit()
+
+	err := repository.Fail(transactionId, failure.ReceiverNotAssociated, "resolved with [TOKEN_NOT_ASSOCIATED_TO_ACCOUNT]", status.ActorHandler)
+	assert.Nil(t, err)
+}
+
+func Test_Fail_InvalidTransition(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	prepareSelectStatus(status.Completed)
+	sqlMock.ExpectRollback()
+
+	err := repository.Fail(transactionId, failure.ManuallyFailed, "manually failed", status.ActorAdmin)
+	assert.ErrorIs(t, err, status.ErrInvalidTransition)
+}
+
+func Test_Reject(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	sqlMock.ExpectBegin()
+	prepareReject(sqlmock.NewResult(1, 1))
+	prepareCreateHistory("", status.Failed, status.ActorWatcher, "amount below minimum")
+	sqlMock.ExpectCommit()
+
+	actual, err := repository.Reject(expectedModelTransfer, failure.AmountBelowMinimum, "amount below minimum")
+	assert.Nil(t, err)
+	assert.Equal(t, status.Failed, actual.Status)
+	assert.Equal(t, failure.AmountBelowMinimum, actual.FailureCode)
+	assert.Equal(t, "amount below minimum", actual.FailureReason)
+}
+
+func Test_Reject_AlreadyRecorded(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	sqlMock.ExpectBegin()
+	prepareReject(sqlmock.NewResult(0, 0))
+	sqlMock.ExpectCommit()
+
+	actual, err := repository.Reject(expectedModelTransfer, failure.AmountBelowMinimum, "amount below minimum")
+	assert.Nil(t, err)
+	assert.Nil(t, actual)
+}
+
+func Test_UpdateStatus(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	prepareSelectStatus(status.Failed)
+	helper.SqlMockPrepareExec(sqlMock, updateStatusFailureQuery,
+		"",
+		"",
+		status.Initial,
+		transactionId,
+		status.Failed)
+	prepareCreateHistory(status.Failed, status.Initial, status.ActorAdmin, "manually updated")
+	sqlMock.ExpectCommit()
+
+	err := repository.UpdateStatus(transactionId, status.Initial, status.ActorAdmin, "manually updated")
+	assert.Nil(t, err)
+}
+
+func Test_UpdateStatus_ConcurrentlyUpdated(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	prepareSelectStatus(status.Initial)
+	sqlMock.ExpectExec(updateStatusQuery).
+		WithArgs(status.Completed, transactionId, status.Initial).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	sqlMock.ExpectRollback()
+
+	err := repository.UpdateStatus(transactionId, status.Completed, status.ActorAdmin, "manually updated")
+	assert.NotNil(t, err)
+}
+
+func Test_UpdateStatusClaimed(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	prepareSelectStatus(status.Completed)
+	helper.SqlMockPrepareExec(sqlMock, updateStatusClaimedQuery,
+		claimBlockNumber, claimTimestamp, claimTxHash, claimer, status.Claimed, transactionId, status.Completed)
+	prepareCreateHistory(status.Completed, status.Claimed, status.ActorWatcher, fmt.Sprintf("claimed in [%s]", claimTxHash))
+	sqlMock.ExpectCommit()
+
+	err := repository.UpdateStatusClaimed(transactionId, claimTxHash, claimBlockNumber, claimTimestamp, claimer)
+	assert.Nil(t, err)
+}
+
+func Test_UpdateStatusClaimed_Err(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	prepareSelectStatus(status.Completed)
+	_ = helper.SqlMockPrepareExecWithErr(sqlMock, updateStatusClaimedQuery,
+		claimBlockNumber, claimTimestamp, claimTxHash, claimer, status.Claimed, transactionId, status.Completed)
+	sqlMock.ExpectRollback()
+
+	err := repository.UpdateStatusClaimed(transactionId, claimTxHash, claimBlockNumber, claimTimestamp, claimer)
+	assert.NotNil(t, err)
+}
+
+func Test_UpdateStatusReorged(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	after := time.Unix(1649256000, 0)
+	sqlMock.ExpectBegin()
+	helper.SqlMockPrepareQuery(sqlMock, []string{"transaction_id", "status"}, []driver.Value{transactionId, status.Claimed}, selectReorgedQuery,
+		uint64(80001), after.UnixNano(), status.Initial, status.Completed, status.Failed, status.Claimed, status.Refunded)
+	helper.SqlMockPrepareExec(sqlMock, updateReorgedQuery, status.Reorged, transactionId, status.Initial, status.Completed, status.Failed, status.Claimed, status.Refunded)
+	prepareCreateHistory(status.Claimed, status.Reorged, status.ActorWatcher, "source transaction removed by a chain reorganisation")
+	sqlMock.ExpectCommit()
+
+	actual, err := repository.UpdateStatusReorged(80001, after)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{transactionId}, actual)
+}
+
+func Test_UpdateStatusReorged_NoTransfers(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	after := time.Unix(1649256000, 0)
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(selectReorgedQuery).
+		WithArgs(uint64(80001), after.UnixNano(), status.Initial, status.Completed, status.Failed, status.Claimed, status.Refunded).
+		WillReturnRows(sqlmock.NewRows([]string{"transaction_id", "status"}))
+	sqlMock.ExpectCommit()
+
+	actual, err := repository.UpdateStatusReorged(80001, after)
+	assert.Nil(t, err)
+	assert.Empty(t, actual)
+}
+
+func Test_UpdateStatusReorged_Err(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	after := time.Unix(1649256000, 0)
+	sqlMock.ExpectBegin()
+	_ = helper.SqlMockPrepareQueryWithErrInvalidData(sqlMock, selectReorgedQuery,
+		uint64(80001), after.UnixNano(), status.Initial, status.Completed, status.Failed, status.Claimed, status.Refunded)
+	sqlMock.ExpectRollback()
+
+	actual, err := repository.UpdateStatusReorged(80001, after)
+	assert.Nil(t, actual)
+	assert.NotNil(t, err)
+}
+
+func Test_UpdateStatusClaimReorged(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	helper.SqlMockPrepareQuery(sqlMock, []string{"transaction_id"}, []driver.Value{transactionId}, selectClaimReorgedQuery,
+		uint64(80001), status.Claimed, claimBlockNumber)
+	helper.SqlMockPrepareQuery(sqlMock,
+		[]string{"id", "transfer_id", "kind", "record_id", "from_status", "to_status", "reason", "actor", "created_at"},
+		[]driver.Value{3, transactionId, status.KindTransfer, transactionId, status.Initial, status.Claimed, "claimed in [" + claimTxHash + "]", status.ActorWatcher, int64(1649256000000000000)},
+		selectLastReorgedQuery, transactionId, status.KindTransfer, status.Claimed)
+	prepareSelectStatus(status.Claimed)
+	helper.SqlMockPrepareExec(sqlMock, updateClaimReorgedQuery, 0, 0, "", "", "", "", status.Initial, transactionId, status.Claimed)
+	prepareCreateHistory(status.Claimed, status.Initial, status.ActorWatcher, "claim transaction removed by a chain reorganisation")
+	sqlMock.ExpectCommit()
+
+	actual, err := repository.UpdateStatusClaimReorged(80001, claimBlockNumber)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{transactionId}, actual)
+}
+
+func Test_UpdateStatusClaimReorged_DefaultsToCompleted(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	helper.SqlMockPrepareQuery(sqlMock, []string{"transaction_id"}, []driver.Value{transactionId}, selectClaimReorgedQuery,
+		uint64(80001), status.Claimed, claimBlockNumber)
+	sqlMock.ExpectQuery(selectLastReorgedQuery).
+		WithArgs(transactionId, status.KindTransfer, status.Claimed).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	prepareSelectStatus(status.Claimed)
+	helper.SqlMockPrepareExec(sqlMock, updateStatusClaimedQuery, 0, 0, "", "", status.Completed, transactionId, status.Claimed)
+	prepareCreateHistory(status.Claimed, status.Completed, status.ActorWatcher, "claim transaction removed by a chain reorganisation")
+	sqlMock.ExpectCommit()
+
+	actual, err := repository.UpdateStatusClaimReorged(80001, claimBlockNumber)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{transactionId}, actual)
+}
+
+func Test_UpdateStatusClaimReorged_Err(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	_ = helper.SqlMockPrepareQueryWithErrInvalidData(sqlMock, selectClaimReorgedQuery, uint64(80001), status.Claimed, claimBlockNumber)
+
+	actual, err := repository.UpdateStatusClaimReorged(80001, claimBlockNumber)
+	assert.Nil(t, actual)
+	assert.NotNil(t, err)
+}
+
+func Test_UpdateStatusReincluded(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	reincluded := *expectedModelTransfer
+	reincluded.Timestamp = now.Add(time.Minute)
+	prepareSelectLastReorged(status.Initial)
+	prepareSelectStatus(status.Reorged)
+	helper.SqlMockPrepareExec(sqlMock, updateStatusReincludedQuery,
+		"",
+		"",
+		status.Initial,
+		entity.NanoTime{Time: reincluded.Timestamp},
+		transactionId,
+		status.Reorged)
+	prepareCreateHistory(status.Reorged, status.Initial, status.ActorWatcher, "source transaction included again after a chain reorganisation")
+	sqlMock.ExpectCommit()
+	helper.SqlMockPrepareQuery(sqlMock, transferColumns, transferRowArgs, getByTransactionIdQuery, transactionId)
+
+	actual, err := repository.UpdateStatusReincluded(&reincluded)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedEntityTransfer, actual)
+}
+
+func Test_UpdateStatusReincluded_RestoresCompleted(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	prepareSelectLastReorged(status.Completed)
+	prepareSelectStatus(status.Reorged)
+	helper.SqlMockPrepareExec(sqlMock, updateStatusTimestampQuery,
+		status.Completed,
+		nanoTime,
+		transactionId,
+		status.Reorged)
+	prepareCreateHistory(status.Reorged, status.Completed, status.ActorWatcher, "source transaction included again after a chain reorganisation")
+	sqlMock.ExpectCommit()
+	helper.SqlMockPrepareQuery(sqlMock, transferColumns, transferRowArgs, getByTransactionIdQuery, transactionId)
+
+	_, err := repository.UpdateStatusReincluded(expectedModelTransfer)
+	assert.Nil(t, err)
+}
+
+func Test_UpdateStatusReincluded_NotReorged(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	prepareSelectLastReorged(status.Initial)
+	prepareSelectStatus(status.Completed)
+	sqlMock.ExpectRollback()
+
+	actual, err := repository.UpdateStatusReincluded(expectedModelTransfer)
+	assert.Nil(t, actual)
+	assert.ErrorIs(t, err, status.ErrInvalidTransition)
+}
+
+func Test_UpdateStatusReincluded_Err(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	_ = helper.SqlMockPrepareQueryWithErrInvalidData(sqlMock, selectLastReorgedQuery, transactionId, status.KindTransfer, status.Reorged)
+
+	actual, err := repository.UpdateStatusReincluded(expectedModelTransfer)
+	assert.Nil(t, actual)
+	assert.NotNil(t, err)
+}
+
 func Test_create(t *testing.T) {
 	setup()
 	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	sqlMock.ExpectBegin()
 	helper.SqlMockPrepareExec(sqlMock, createQuery,
 		transactionId,
 		sourceChainId,
@@ -496,9 +946,20 @@ func Test_create(t *testing.T) {
 		metadata,
 		isNft,
 		nanoTime,
-		originator)
-
-	actual, err := repository.create(expectedModelTransfer, someStatus)
+		originator,
+		wrappedSerialNumber,
+		"",
+		uint64(0),
+		int64(0),
+		"",
+		0,
+		int64(0),
+		"",
+		"")
+	prepareCreateHistory("", someStatus, status.ActorHandler, "transfer created")
+	sqlMock.ExpectCommit()
+
+	actual, err := repository.create(context.Background(), expectedModelTransfer, someStatus)
 	assert.Nil(t, err)
 	assert.Equal(t, expectedEntityTransfer, actual)
 }
@@ -506,6 +967,7 @@ func Test_create(t *testing.T) {
 func Test_create_Err(t *testing.T) {
 	setup()
 	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	sqlMock.ExpectBegin()
 	_ = helper.SqlMockPrepareExecWithErr(sqlMock, createQuery,
 		transactionId,
 		sourceChainId,
@@ -522,35 +984,23 @@ func Test_create_Err(t *testing.T) {
 		metadata,
 		isNft,
 		nanoTime,
-		originator)
-
-	actual, err := repository.create(expectedModelTransfer, someStatus)
+		originator,
+		wrappedSerialNumber,
+		"",
+		uint64(0),
+		int64(0),
+		"",
+		0,
+		int64(0),
+		"",
+		"")
+	sqlMock.ExpectRollback()
+
+	actual, err := repository.create(context.Background(), expectedModelTransfer, someStatus)
 	assert.NotNil(t, err)
 	assert.NotNil(t, actual)
 }
 
-func Test_updateStatus(t *testing.T) {
-	setup()
-	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
-	helper.SqlMockPrepareExec(sqlMock, updateStatusQuery,
-		status.Initial,
-		transactionId)
-
-	err := repository.updateStatus(transactionId, status.Initial)
-	assert.Nil(t, err)
-}
-
-func Test_updateStatus_Err(t *testing.T) {
-	setup()
-	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
-	_ = helper.SqlMockPrepareExecWithErr(sqlMock, updateStatusQuery,
-		status.Initial,
-		transactionId)
-
-	err := repository.updateStatus(transactionId, status.Initial)
-	assert.NotNil(t, err)
-}
-
 func Test_Paged(t *testing.T) {
 	setup()
 	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
@@ -735,3 +1185,301 @@ func Test_PagedWithFilterTokenId(t *testing.T) {
 	assert.Nil(t, err)
 	assert.NotEmpty(t, actual)
 }
+
+func Test_PagedWithFilterClaimed(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	claimed := true
+	req := &transfer.PagedRequest{
+		Page:     1,
+		PageSize: 10,
+		Filter: transfer.Filter{
+			Claimed: &claimed,
+		},
+	}
+
+	expected := int64(1)
+	helper.SqlMockPrepareQuery(sqlMock, []string{"count"}, []driver.Value{expected}, countQuery)
+	helper.SqlMockPrepareQuery(sqlMock, transferColumns, transferRowArgs, pagedFilterClaimedQuery)
+
+	actual, _, err := repository.Paged(req)
+
+	assert.Nil(t, err)
+	assert.NotEmpty(t, actual)
+}
+
+func Test_PagedWithFilterNotClaimed(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	claimed := false
+	req := &transfer.PagedRequest{
+		Page:     1,
+		PageSize: 10,
+		Filter: transfer.Filter{
+			Claimed: &claimed,
+		},
+	}
+
+	expected := int64(1)
+	helper.SqlMockPrepareQuery(sqlMock, []string{"count"}, []driver.Value{expected}, countQuery)
+	helper.SqlMockPrepareQuery(sqlMock, transferColumns, transferRowArgs, pagedFilterNotClaimedQuery, constants.HederaNetworkId)
+
+	actual, _, err := repository.Paged(req)
+
+	assert.Nil(t, err)
+	assert.NotEmpty(t, actual)
+}
+
+func Test_PagedWithFilterClaimer(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	req := &transfer.PagedRequest{
+		Page:     1,
+		PageSize: 10,
+		Filter: transfer.Filter{
+			Claimer: claimer,
+		},
+	}
+
+	expected := int64(1)
+	helper.SqlMockPrepareQuery(sqlMock, []string{"count"}, []driver.Value{expected}, countQuery)
+	helper.SqlMockPrepareQuery(sqlMock, transferColumns, transferRowArgs, pagedFilterClaimerQuery, claimer)
+
+	actual, _, err := repository.Paged(req)
+
+	assert.Nil(t, err)
+	assert.NotEmpty(t, actual)
+}
+
+func Test_PagedWithFilterFailureCode(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	req := &transfer.PagedRequest{
+		Page:     1,
+		PageSize: 10,
+		Filter: transfer.Filter{
+			Status:      status.Failed,
+			FailureCode: string(failure.Blacklisted),
+		},
+	}
+
+	expected := int64(1)
+	helper.SqlMockPrepareQuery(sqlMock, []string{"count"}, []driver.Value{expected}, countQuery)
+	helper.SqlMockPrepareQuery(sqlMock, transferColumns, transferRowArgs, pagedFilterFailureCodeQuery, status.Failed, failure.Blacklisted)
+
+	actual, _, err := repository.Paged(req)
+
+	assert.Nil(t, err)
+	assert.NotEmpty(t, actual)
+}
+
+func Test_PagedWithFilters(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	isNft := false
+	from := nanoTime.Time.Add(-time.Hour)
+	to := nanoTime.Time.Add(time.Hour)
+	req := &transfer.PagedRequest{
+		Page:     1,
+		PageSize: 10,
+		Filter: transfer.Filter{
+			Status:        someStatus,
+			SourceChainId: constants.HederaNetworkId,
+			TargetChainId: targetChainId,
+			Receiver:      receiver,
+			IsNft:         &isNft,
+			MinAmount:     "1",
+			MaxAmount:     "100",
+			From:          &from,
+			To:            &to,
+		},
+	}
+
+	expected := int64(1)
+	helper.SqlMockPrepareQuery(sqlMock, []string{"count"}, []driver.Value{expected}, countQuery)
+	helper.SqlMockPrepareQuery(sqlMock, transferColumns, transferRowArgs, pagedFilterQuery,
+		someStatus, constants.HederaNetworkId, constants.OldHederaNetworkId, targetChainId, receiver, isNft, "1", "100", from.UnixNano(), to.UnixNano())
+
+	actual, _, err := repository.Paged(req)
+
+	assert.Nil(t, err)
+	assert.NotEmpty(t, actual)
+}
+
+func Test_PagedCursorFirstPage(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	req := &transfer.PagedRequest{
+		PageSize: 10,
+	}
+
+	expected := int64(1)
+	helper.SqlMockPrepareQuery(sqlMock, []string{"count"}, []driver.Value{expected}, countQuery)
+	helper.SqlMockPrepareQuery(sqlMock, transferColumns, transferRowArgs, pagedCursorFirstQuery)
+
+	actual, _, err := repository.Paged(req)
+
+	assert.Nil(t, err)
+	assert.NotEmpty(t, actual)
+}
+
+func Test_PagedCursor(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	req := &transfer.PagedRequest{
+		PageSize: 10,
+		Cursor:   transfer.EncodeCursor(nanoTime.UnixNano(), transactionId),
+	}
+
+	helper.SqlMockPrepareQuery(sqlMock, transferColumns, transferRowArgs, pagedCursorQuery, nanoTime.UnixNano(), transactionId)
+
+	actual, _, err := repository.Paged(req)
+
+	assert.Nil(t, err)
+	assert.NotEmpty(t, actual)
+}
+
+func Test_PagedInvalidCursor(t *testing.T) {
+	setup()
+	req := &transfer.PagedRequest{
+		PageSize: 10,
+		Cursor:   "invalid",
+	}
+
+	actual, _, err := repository.Paged(req)
+
+	assert.Equal(t, transfer.ErrInvalidCursor, err)
+	assert.Nil(t, actual)
+}
+
+func Test_GetStuck(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	before := time.Unix(1649256000, 0)
+	helper.SqlMockPrepareQuery(sqlMock, transferColumns, transferRowArgs, getStuckQuery,
+		status.Initial, before.UnixNano(), before.UnixNano(), 3, status.Submitted, status.Completed, schedule.MINT, status.Submitted, status.Completed, 0, "0xsigner")
+
+	actual, err := repository.GetStuck(before, 3, []string{"0xsigner"}, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, []*entity.Transfer{expectedEntityTransfer}, actual)
+}
+
+func Test_GetStuck_Err(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	before := time.Unix(1649256000, 0)
+	_ = helper.SqlMockPrepareQueryWithErrInvalidData(sqlMock, getStuckQuery,
+		status.Initial, before.UnixNano(), before.UnixNano(), 3, status.Submitted, status.Completed, schedule.MINT, status.Submitted, status.Completed, 0, "0xsigner")
+
+	actual, err := repository.GetStuck(before, 3, []string{"0xsigner"}, 10)
+	assert.NotNil(t, err)
+	assert.Nil(t, actual)
+}
+
+func Test_MarkRedriven(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	notAfter := time.Unix(1649256000, 0)
+	sqlMock.ExpectBegin()
+	helper.SqlMockPrepareExec(sqlMock, markRedrivenQuery, "", "", sqlmock.AnyArg(), 1, status.Initial, transactionId, status.Failed, notAfter.UnixNano())
+	prepareCreateHistory(status.Failed, status.Initial, status.ActorAdmin, "re-driven")
+	sqlMock.ExpectCommit()
+
+	marked, err := repository.MarkRedriven(transactionId, status.Failed, notAfter, status.ActorAdmin)
+	assert.Nil(t, err)
+	assert.True(t, marked)
+}
+
+func Test_MarkRedriven_NotMarked(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	notAfter := time.Unix(1649256000, 0)
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectExec(markRedrivenQuery).
+		WithArgs("", "", sqlmock.AnyArg(), 1, status.Initial, transactionId, status.Initial, notAfter.UnixNano()).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	sqlMock.ExpectCommit()
+
+	marked, err := repository.MarkRedriven(transactionId, status.Initial, notAfter, status.ActorWatcher)
+	assert.Nil(t, err)
+	assert.False(t, marked)
+}
+
+func Test_MarkRedriven_Err(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	notAfter := time.Unix(1649256000, 0)
+	sqlMock.ExpectBegin()
+	_ = helper.SqlMockPrepareExecWithErr(sqlMock, markRedrivenQuery, "", "", sqlmock.AnyArg(), 1, status.Initial, transactionId, status.Initial, notAfter.UnixNano())
+	sqlMock.ExpectRollback()
+
+	marked, err := repository.MarkRedriven(transactionId, status.Initial, notAfter, status.ActorWatcher)
+	assert.NotNil(t, err)
+	assert.False(t, marked)
+}
+
+func Test_MarkRedriven_InvalidTransition(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+
+	marked, err := repository.MarkRedriven(transactionId, status.Completed, time.Now(), status.ActorAdmin)
+	assert.ErrorIs(t, err, status.ErrInvalidTransition)
+	assert.False(t, marked)
+}
+
+func Test_GetStatusHistory(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	helper.SqlMockPrepareQuery(sqlMock,
+		[]string{"id", "transfer_id", "kind", "record_id", "from_status", "to_status", "reason", "actor", "created_at"},
+		[]driver.Value{1, transactionId, status.KindTransfer, transactionId, "", status.Initial, "transfer created", status.ActorHandler, int64(1649256000000000000)},
+		getStatusHistoryQuery, transactionId)
+
+	actual, err := repository.GetStatusHistory(transactionId)
+	assert.Nil(t, err)
+	assert.Equal(t, []*entity.StatusHistory{{
+		ID:         1,
+		TransferID: transactionId,
+		Kind:       status.KindTransfer,
+		RecordID:   transactionId,
+		ToStatus:   status.Initial,
+		Reason:     "transfer created",
+		Actor:      status.ActorHandler,
+		CreatedAt:  1649256000000000000,
+	}}, actual)
+}
+
+func Test_GetStatusHistory_Err(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	_ = helper.SqlMockPrepareQueryWithErrInvalidData(sqlMock, getStatusHistoryQuery, transactionId)
+
+	actual, err := repository.GetStatusHistory(transactionId)
+	assert.NotNil(t, err)
+	assert.Empty(t, actual)
+}
+
+func prepareReject(result driver.Result) {
+	sqlMock.ExpectExec(rejectQuery).
+		WithArgs(transactionId, sourceChainId, targetChainId, nativeChainId, sourceAsset, targetAsset, nativeAsset, receiver, amount, "",
+			status.Failed, serialNumber, metadata, isNft, nanoTime, originator, wrappedSerialNumber, "", uint64(0), int64(0), "", 0, int64(0),
+			failure.AmountBelowMinimum, "amount below minimum").
+		WillReturnResult(result)
+}
+
+func prepareSelectStatus(s string) {
+	sqlMock.ExpectBegin()
+	helper.SqlMockPrepareQuery(sqlMock, []string{"status"}, []driver.Value{s}, selectStatusQuery, transactionId)
+}
+
+func prepareSelectLastReorged(from string) {
+	helper.SqlMockPrepareQuery(sqlMock,
+		[]string{"id", "transfer_id", "kind", "record_id", "from_status", "to_status", "reason", "actor", "created_at"},
+		[]driver.Value{2, transactionId, status.KindTransfer, transactionId, from, status.Reorged, "source transaction removed by a chain reorganisation", status.ActorWatcher, int64(1649256000000000000)},
+		selectLastReorgedQuery, transactionId, status.KindTransfer, status.Reorged)
+}
+
+func prepareCreateHistory(from, to, actor, reason string) {
+	helper.SqlMockPrepareQuery(sqlMock, []string{"id"}, []driver.Value{1}, createHistoryQuery,
+		transactionId, status.KindTransfer, transactionId, from, to, reason, actor, sqlmock.AnyArg())
+}
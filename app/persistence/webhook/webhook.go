@@ -0,0 +1,128 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package webhook
+
+import (
+	"errors"
+	"time"
+
+	webhookModel "github.com/limechain/hedera-eth-bridge-validator/app/model/webhook"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Repository struct {
+	db     *gorm.DB
+	logger *log.Entry
+}
+
+func NewRepository(dbClient *gorm.DB) *Repository {
+	return &Repository{
+		db:     dbClient,
+		logger: config.GetLoggerFor("Webhook Delivery Repository"),
+	}
+}
+
+func (r *Repository) Create(delivery *entity.WebhookDelivery) error {
+	return r.db.Create(delivery).Error
+}
+
+// Get Returns WebhookDelivery. Returns nil if not found
+func (r *Repository) Get(id uint64) (*entity.WebhookDelivery, error) {
+	record := &entity.WebhookDelivery{}
+
+	result := r.db.
+		Model(entity.WebhookDelivery{}).
+		Where("id = ?", id).
+		First(record)
+
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return record, nil
+}
+
+// Due returns up to `limit` pending deliveries, whose next attempt is due, and postpones them by the given lease.
+// Rows locked by another poll are skipped, so concurrent dispatchers never attempt the same delivery.
+func (r *Repository) Due(limit int, lease time.Duration) ([]*entity.WebhookDelivery, error) {
+	var deliveries []*entity.WebhookDelivery
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		err := tx.
+			Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? AND next_attempt_at <= ?", webhookModel.StatusPending, now.UnixNano()).
+			Order("next_attempt_at asc").
+			Limit(limit).
+			Find(&deliveries).
+			Error
+		if err != nil || len(deliveries) == 0 {
+			return err
+		}
+
+		ids := make([]uint64, len(deliveries))
+		for i, d := range deliveries {
+			ids[i] = d.ID
+		}
+
+		nextAttemptAt := now.Add(lease).UnixNano()
+		err = tx.
+			Model(entity.WebhookDelivery{}).
+			Where("id IN ?", ids).
+			UpdateColumn("next_attempt_at", nextAttemptAt).
+			Error
+		if err != nil {
+			return err
+		}
+
+		for _, d := range deliveries {
+			d.NextAttemptAt = nextAttemptAt
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+func (r *Repository) Update(delivery *entity.WebhookDelivery) error {
+	err := r.db.
+		Model(entity.WebhookDelivery{}).
+		Where("id = ?", delivery.ID).
+		UpdateColumns(map[string]interface{}{
+			"status":          delivery.Status,
+			"attempts":        delivery.Attempts,
+			"response_code":   delivery.ResponseCode,
+			"last_error":      delivery.LastError,
+			"next_attempt_at": delivery.NextAttemptAt,
+			"delivered_at":    delivery.DeliveredAt,
+		}).
+		Error
+
+	if err == nil && delivery.Status == webhookModel.StatusFailed {
+		r.logger.Errorf("[%d] - Webhook delivery of [%s] to [%s] failed after [%d] attempts. Error: [%s]", delivery.ID, delivery.Event, delivery.Url, delivery.Attempts, delivery.LastError)
+	}
+	return err
+}
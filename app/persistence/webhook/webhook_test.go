@@ -0,0 +1,142 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package webhook
+
+import (
+	"database/sql/driver"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	webhookModel "github.com/limechain/hedera-eth-bridge-validator/app/model/webhook"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/test/helper"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+var (
+	repository   *Repository
+	dbConnection *gorm.DB
+	sqlMock      sqlmock.Sqlmock
+
+	selectQuery = regexp.QuoteMeta(`SELECT * FROM "webhook_deliveries" WHERE id = $1 ORDER BY "webhook_deliveries"."id" LIMIT 1`)
+	updateQuery = regexp.QuoteMeta(`UPDATE "webhook_deliveries" SET "attempts"=$1,"delivered_at"=$2,"last_error"=$3,"next_attempt_at"=$4,"response_code"=$5,"status"=$6 WHERE id = $7`)
+
+	id         = uint64(1)
+	url        = "https://integrator/hooks"
+	event      = webhookModel.EventTransferClaimed
+	transferId = "0.0.123456-1650000000-000000001"
+	payload    = []byte(`{"event":"TRANSFER_CLAIMED"}`)
+
+	columns  = []string{"id", "url", "event", "transfer_id", "payload", "status", "attempts"}
+	rowArgs  = []driver.Value{id, url, event, transferId, payload, webhookModel.StatusPending, 0}
+	delivery = &entity.WebhookDelivery{
+		ID:         id,
+		Url:        url,
+		Event:      event,
+		TransferID: transferId,
+		Payload:    payload,
+		Status:     webhookModel.StatusPending,
+	}
+)
+
+func setup() {
+	mocks.Setup()
+	dbConnection, sqlMock, _ = helper.SetupSqlMock()
+
+	repository = &Repository{
+		db:     dbConnection,
+		logger: config.GetLoggerFor("Webhook Delivery Repository"),
+	}
+}
+
+func Test_NewRepository(t *testing.T) {
+	setup()
+
+	actualRepository := NewRepository(dbConnection)
+
+	assert.Equal(t, repository, actualRepository)
+}
+
+func Test_Get(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	helper.SqlMockPrepareQuery(sqlMock, columns, rowArgs, selectQuery, id)
+
+	actual, err := repository.Get(id)
+
+	assert.Nil(t, err)
+	assert.Equal(t, delivery, actual)
+}
+
+func Test_Get_NotFound(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	_ = helper.SqlMockPrepareQueryWithErrNotFound(sqlMock, selectQuery, id)
+
+	actual, err := repository.Get(id)
+
+	assert.Nil(t, err)
+	assert.Nil(t, actual)
+}
+
+func Test_Get_Error(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	expectedErr := helper.SqlMockPrepareQueryWithErrInvalidData(sqlMock, selectQuery, id)
+
+	actual, err := repository.Get(id)
+
+	assert.Equal(t, expectedErr, err)
+	assert.Nil(t, actual)
+}
+
+func Test_Update(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	updated := &entity.WebhookDelivery{
+		ID:           id,
+		Status:       webhookModel.StatusDelivered,
+		Attempts:     1,
+		ResponseCode: 200,
+		DeliveredAt:  1650000000000000000,
+	}
+	helper.SqlMockPrepareExec(sqlMock, updateQuery, 1, int64(1650000000000000000), "", int64(0), 200, webhookModel.StatusDelivered, id)
+
+	err := repository.Update(updated)
+
+	assert.Nil(t, err)
+}
+
+func Test_Update_Error(t *testing.T) {
+	setup()
+	defer helper.CheckSqlMockExpectationsMet(sqlMock, t)
+	updated := &entity.WebhookDelivery{
+		ID:        id,
+		Status:    webhookModel.StatusFailed,
+		Attempts:  5,
+		LastError: "timeout",
+	}
+	expectedErr := helper.SqlMockPrepareExecWithErr(sqlMock, updateQuery, 5, int64(0), "timeout", int64(0), 0, webhookModel.StatusFailed, id)
+
+	err := repository.Update(updated)
+
+	assert.Error(t, err, expectedErr)
+}
@@ -17,6 +17,8 @@
 package burn_message
 
 import (
+	"context"
+	"fmt"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
@@ -36,27 +38,27 @@ func NewHandler(transferService service.Transfers) *Handler {
 	}
 }
 
-func (mhh Handler) Handle(p interface{}) {
+func (mhh Handler) Handle(ctx context.Context, p interface{}) error {
 	transferMsg, ok := p.(*payload.Transfer)
 	if !ok {
-		mhh.logger.Errorf("Could not cast payload [%s]", p)
-		return
+		return fmt.Errorf("could not cast payload [%s]", p)
 	}
 
-	transactionRecord, err := mhh.transfersService.InitiateNewTransfer(*transferMsg)
+	transactionRecord, err := mhh.transfersService.InitiateNewTransfer(ctx, *transferMsg)
 	if err != nil {
 		mhh.logger.Errorf("[%s] - Error occurred while initiating processing. Error: [%s]", transferMsg.TransactionId, err)
-		return
+		return err
 	}
 
 	if transactionRecord.Status != status.Initial {
 		mhh.logger.Debugf("[%s] - Previously added with status [%s]. Skipping further execution.", transactionRecord.TransactionID, transactionRecord.Status)
-		return
+		return nil
 	}
 
-	err = mhh.transfersService.ProcessWrappedTransfer(*transferMsg)
+	err = mhh.transfersService.ProcessWrappedTransfer(ctx, *transferMsg)
 	if err != nil {
 		mhh.logger.Errorf("[%s] - Processing failed. Error: [%s]", transferMsg.TransactionId, err)
-		return
+		return err
 	}
+	return nil
 }
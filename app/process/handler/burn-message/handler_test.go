@@ -17,7 +17,9 @@
 package burn_message
 
 import (
+	"context"
 	"errors"
+	"math/big"
 	"testing"
 
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
@@ -26,6 +28,7 @@ import (
 	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks/service"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -33,7 +36,7 @@ var (
 	mt = payload.Transfer{
 		TransactionId: "0.0.0-0000000-1234",
 		Receiver:      "0x12345",
-		Amount:        "10000000000",
+		Amount:        big.NewInt(10000000000),
 		NativeAsset:   constants.Hbar,
 		TargetAsset:   "0x45678",
 	}
@@ -51,14 +54,15 @@ func Test_Handle_ProcessWrappedTransfer_Fails(t *testing.T) {
 	tx := &entity.Transfer{
 		TransactionID: mt.TransactionId,
 		Receiver:      mt.Receiver,
-		Amount:        mt.Amount,
+		Amount:        mt.Amount.String(),
 		Status:        status.Initial,
 	}
 
-	mockedService.On("InitiateNewTransfer", mt).Return(tx, nil)
-	mockedService.On("ProcessWrappedTransfer", mt).Return(errors.New("some-error"))
+	mockedService.On("InitiateNewTransfer", mock.Anything, mt).Return(tx, nil)
+	mockedService.On("ProcessWrappedTransfer", mock.Anything, mt).Return(errors.New("some-error"))
 
-	ctHandler.Handle(&mt)
+	err := ctHandler.Handle(context.Background(), &mt)
+	assert.NotNil(t, err)
 }
 
 func Test_Handle_NotInitial(t *testing.T) {
@@ -67,25 +71,28 @@ func Test_Handle_NotInitial(t *testing.T) {
 	tx := &entity.Transfer{
 		TransactionID: mt.TransactionId,
 		Receiver:      mt.Receiver,
-		Amount:        mt.Amount,
+		Amount:        mt.Amount.String(),
 		Status:        status.Completed,
 	}
 
-	mockedService.On("InitiateNewTransfer", mt).Return(tx, nil)
-	ctHandler.Handle(&mt)
-	mockedService.AssertNotCalled(t, "ProcessWrappedTransfer", mock.Anything)
+	mockedService.On("InitiateNewTransfer", mock.Anything, mt).Return(tx, nil)
+	err := ctHandler.Handle(context.Background(), &mt)
+	assert.Nil(t, err)
+	mockedService.AssertNotCalled(t, "ProcessWrappedTransfer", mock.Anything, mock.Anything)
 }
 
 func Test_Handle_InitiateNewTransfer_Fails(t *testing.T) {
 	ctHandler, mockedService := InitializeHandler()
-	mockedService.On("InitiateNewTransfer", mt).Return(nil, errors.New("some-error"))
-	ctHandler.Handle(&mt)
-	mockedService.AssertNotCalled(t, "ProcessWrappedTransfer", mock.Anything)
+	mockedService.On("InitiateNewTransfer", mock.Anything, mt).Return(nil, errors.New("some-error"))
+	err := ctHandler.Handle(context.Background(), &mt)
+	assert.NotNil(t, err)
+	mockedService.AssertNotCalled(t, "ProcessWrappedTransfer", mock.Anything, mock.Anything)
 }
 
 func Test_Handle_Payload_Fails(t *testing.T) {
 	ctHandler, mockedService := InitializeHandler()
-	ctHandler.Handle("string")
-	mockedService.AssertNotCalled(t, "InitiateNewTransfer", mock.Anything)
-	mockedService.AssertNotCalled(t, "ProcessWrappedTransfer", mock.Anything)
+	err := ctHandler.Handle(context.Background(), "string")
+	assert.NotNil(t, err)
+	mockedService.AssertNotCalled(t, "InitiateNewTransfer", mock.Anything, mock.Anything)
+	mockedService.AssertNotCalled(t, "ProcessWrappedTransfer", mock.Anything, mock.Anything)
 }
@@ -17,7 +17,9 @@
 package fee_message
 
 import (
+	"context"
 	"errors"
+	"math/big"
 	"testing"
 
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
@@ -26,13 +28,14 @@ import (
 	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks/service"
+	"github.com/stretchr/testify/mock"
 )
 
 var (
 	mt = payload.Transfer{
 		TransactionId: "0.0.0-0000000-1234",
 		Receiver:      "0x12345",
-		Amount:        "10000000000",
+		Amount:        big.NewInt(10000000000),
 		NativeAsset:   constants.Hbar,
 		TargetAsset:   "0x45678",
 	}
@@ -50,19 +53,19 @@ func Test_Handle(t *testing.T) {
 	tx := &entity.Transfer{
 		TransactionID: mt.TransactionId,
 		Receiver:      mt.Receiver,
-		Amount:        mt.Amount,
+		Amount:        mt.Amount.String(),
 		NativeAsset:   mt.NativeAsset,
 		TargetAsset:   mt.TargetAsset,
 		Status:        status.Initial,
 	}
 
-	mockedService.On("InitiateNewTransfer", mt).Return(tx, nil)
-	mockedService.On("ProcessNativeTransfer", mt).Return(nil)
+	mockedService.On("InitiateNewTransfer", mock.Anything, mt).Return(tx, nil)
+	mockedService.On("ProcessNativeTransfer", mock.Anything, mt).Return(nil)
 
-	ctHandler.Handle(&mt)
+	ctHandler.Handle(context.Background(), &mt)
 
-	mockedService.AssertCalled(t, "InitiateNewTransfer", mt)
-	mockedService.AssertCalled(t, "ProcessNativeTransfer", mt)
+	mockedService.AssertCalled(t, "InitiateNewTransfer", mock.Anything, mt)
+	mockedService.AssertCalled(t, "ProcessNativeTransfer", mock.Anything, mt)
 }
 
 func Test_Handle_Encoding_Fails(t *testing.T) {
@@ -70,7 +73,7 @@ func Test_Handle_Encoding_Fails(t *testing.T) {
 
 	invalidTransferPayload := []byte{1, 2, 1}
 
-	ctHandler.Handle(invalidTransferPayload)
+	ctHandler.Handle(context.Background(), invalidTransferPayload)
 
 	mockedService.AssertNotCalled(t, "InitiateNewTransfer")
 	mockedService.AssertNotCalled(t, "ProcessNativeTransfer")
@@ -79,9 +82,9 @@ func Test_Handle_Encoding_Fails(t *testing.T) {
 func Test_Handle_InitiateNewTransfer_Fails(t *testing.T) {
 	ctHandler, mockedService := InitializeHandler()
 
-	mockedService.On("InitiateNewTransfer", mt).Return(nil, errors.New("some-error"))
+	mockedService.On("InitiateNewTransfer", mock.Anything, mt).Return(nil, errors.New("some-error"))
 
-	ctHandler.Handle(&mt)
+	ctHandler.Handle(context.Background(), &mt)
 
 	mockedService.AssertNotCalled(t, "ProcessNativeTransfer")
 }
@@ -92,13 +95,13 @@ func Test_Handle_StatusNotInitial_Fails(t *testing.T) {
 	tx := &entity.Transfer{
 		TransactionID: mt.TransactionId,
 		Receiver:      mt.Receiver,
-		Amount:        mt.Amount,
+		Amount:        mt.Amount.String(),
 		Status:        status.Completed,
 	}
 
-	mockedService.On("InitiateNewTransfer", mt).Return(tx, nil)
+	mockedService.On("InitiateNewTransfer", mock.Anything, mt).Return(tx, nil)
 
-	ctHandler.Handle(&mt)
+	ctHandler.Handle(context.Background(), &mt)
 
 	mockedService.AssertNotCalled(t, "ProcessNativeTransfer")
 }
@@ -109,12 +112,12 @@ func Test_Handle_ProcessNativeTransfer_Fails(t *testing.T) {
 	tx := &entity.Transfer{
 		TransactionID: mt.TransactionId,
 		Receiver:      mt.Receiver,
-		Amount:        mt.Amount,
+		Amount:        mt.Amount.String(),
 		Status:        status.Completed,
 	}
 
-	mockedService.On("InitiateNewTransfer", mt).Return(tx, nil)
-	mockedService.On("ProcessNativeTransfer", mt).Return(errors.New("some-error"))
+	mockedService.On("InitiateNewTransfer", mock.Anything, mt).Return(tx, nil)
+	mockedService.On("ProcessNativeTransfer", mock.Anything, mt).Return(errors.New("some-error"))
 
-	ctHandler.Handle(&mt)
+	ctHandler.Handle(context.Background(), &mt)
 }
@@ -17,6 +17,8 @@
 package fee_transfer
 
 import (
+	"context"
+	"fmt"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
@@ -35,11 +37,10 @@ func NewHandler(burnService service.BurnEvent) *Handler {
 	}
 }
 
-func (fth Handler) Handle(p interface{}) {
+func (fth Handler) Handle(ctx context.Context, p interface{}) error {
 	event, ok := p.(*payload.Transfer)
 	if !ok {
-		fth.logger.Errorf("Could not cast payload [%s]", p)
-		return
+		return fmt.Errorf("could not cast payload [%s]", p)
 	}
-	fth.burnService.ProcessEvent(*event)
+	return fth.burnService.ProcessEvent(ctx, *event)
 }
@@ -17,12 +17,15 @@
 package fee_transfer
 
 import (
+	"context"
+	"math/big"
 	"testing"
 
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 var (
@@ -49,11 +52,12 @@ func Test_Handle_Burn(t *testing.T) {
 		TargetAsset:   "",
 		NativeAsset:   "",
 		Receiver:      "",
-		Amount:        "0",
+		Amount:        big.NewInt(0),
 	}
-	mocks.MBurnService.On("ProcessEvent", *someEvent).Return()
-	feeTransferHandler.Handle(someEvent)
-	mocks.MBurnService.AssertCalled(t, "ProcessEvent", *someEvent)
+	mocks.MBurnService.On("ProcessEvent", mock.Anything, *someEvent).Return(nil)
+	err := feeTransferHandler.Handle(context.Background(), someEvent)
+	assert.Nil(t, err)
+	mocks.MBurnService.AssertCalled(t, "ProcessEvent", mock.Anything, *someEvent)
 }
 
 func Test_Handle_Encoding_Fails(t *testing.T) {
@@ -61,7 +65,8 @@ func Test_Handle_Encoding_Fails(t *testing.T) {
 
 	invalidTransferPayload := []byte{1, 2, 1}
 
-	feeTransferHandler.Handle(invalidTransferPayload)
+	err := feeTransferHandler.Handle(context.Background(), invalidTransferPayload)
+	assert.NotNil(t, err)
 
 	mocks.MBurnService.AssertNotCalled(t, "ProcessEvent")
 }
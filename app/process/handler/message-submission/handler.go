@@ -17,6 +17,8 @@
 package message_submission
 
 import (
+	"context"
+	"fmt"
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
@@ -63,37 +65,38 @@ func NewHandler(
 	}
 }
 
-func (smh Handler) Handle(p interface{}) {
+func (smh Handler) Handle(ctx context.Context, p interface{}) error {
 	transferMsg, ok := p.(*payload.Transfer)
 	if !ok {
-		smh.logger.Errorf("Could not cast payload [%s]", p)
-		return
+		return fmt.Errorf("could not cast payload [%s]", p)
 	}
-	transactionRecord, err := smh.transfersService.InitiateNewTransfer(*transferMsg)
+	transactionRecord, err := smh.transfersService.InitiateNewTransfer(ctx, *transferMsg)
 	if err != nil {
 		smh.logger.Errorf("[%s] - Error occurred while initiating processing. Error: [%s]", transferMsg.TransactionId, err)
-		return
+		return err
 	}
 
 	if transactionRecord.Status != status.Initial {
 		smh.logger.Debugf("[%s] - Previously added with status [%s]. Skipping further execution.", transactionRecord.TransactionID, transactionRecord.Status)
-		return
+		return nil
 	}
 
-	err = smh.submitMessage(transferMsg)
+	err = smh.submitMessage(ctx, transferMsg)
 	if err != nil {
 		smh.logger.Errorf("[%s] - Processing failed. Error: [%s]", transferMsg.TransactionId, err)
-		return
+		return err
 	}
+	return nil
 }
 
-func (smh Handler) submitMessage(tm *payload.Transfer) error {
-	signatureMessageBytes, err := smh.messageService.SignFungibleMessage(*tm)
+func (smh Handler) submitMessage(ctx context.Context, tm *payload.Transfer) error {
+	signatureMessageBytes, err := smh.messageService.SignFungibleMessage(ctx, *tm)
 	if err != nil {
 		return err
 	}
 
 	messageTxId, err := smh.hederaNode.SubmitTopicConsensusMessage(
+		ctx,
 		smh.topicID,
 		signatureMessageBytes)
 	if err != nil {
@@ -104,7 +107,7 @@ func (smh Handler) submitMessage(tm *payload.Transfer) error {
 	// Attach update callbacks on Signature HCS Message
 	smh.logger.Infof("[%s] - Submitted signature on Topic [%s]", tm.TransactionId, smh.topicID)
 	onSuccessfulAuthMessage, onFailedAuthMessage := smh.authMessageSubmissionCallbacks(tm.TransactionId)
-	smh.mirrorNode.WaitForTransaction(hederahelper.ToMirrorNodeTransactionID(messageTxId.String()), onSuccessfulAuthMessage, onFailedAuthMessage)
+	smh.mirrorNode.WaitForTransaction(ctx, hederahelper.ToMirrorNodeTransactionID(messageTxId.String()), onSuccessfulAuthMessage, onFailedAuthMessage)
 	return nil
 }
 
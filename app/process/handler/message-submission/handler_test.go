@@ -17,7 +17,9 @@
 package message_submission
 
 import (
+	"context"
 	"errors"
+	"math/big"
 	"testing"
 	"time"
 
@@ -46,7 +48,7 @@ var (
 		TargetAsset:   "0xethaddress",
 		NativeAsset:   "0.0.1123",
 		Receiver:      "0xethaddress-2",
-		Amount:        "100",
+		Amount:        big.NewInt(100),
 	}
 
 	transferRecord = &entity.Transfer{
@@ -58,7 +60,7 @@ var (
 		TargetAsset:   tr.TargetAsset,
 		NativeAsset:   tr.NativeAsset,
 		Receiver:      tr.Receiver,
-		Amount:        tr.Amount,
+		Amount:        tr.Amount.String(),
 		Status:        status.Initial,
 		Messages:      nil,
 		Fees:          []entity.Fee{},
@@ -114,15 +116,15 @@ func Test_Handle_Encoding_Fails(t *testing.T) {
 
 	invalidTransferPayload := []byte{1, 2, 1}
 
-	msHandler.Handle(invalidTransferPayload)
+	msHandler.Handle(context.Background(), invalidTransferPayload)
 
 	mocks.MLockService.AssertNotCalled(t, "ProcessEvent")
 }
 
 func Test_Invalid_Payload(t *testing.T) {
 	setup()
-	msHandler.Handle(tr)
-	mocks.MTransferService.AssertNotCalled(t, "InitiateNewTransfer", mock.Anything)
+	msHandler.Handle(context.Background(), tr)
+	mocks.MTransferService.AssertNotCalled(t, "InitiateNewTransfer", mock.Anything, mock.Anything)
 }
 
 func Test_AuthMessageSubmissionCallbacks(t *testing.T) {
@@ -134,51 +136,51 @@ func Test_AuthMessageSubmissionCallbacks(t *testing.T) {
 
 func Test_Handle(t *testing.T) {
 	setup()
-	mocks.MTransferService.On("InitiateNewTransfer", tr).Return(transferRecord, nil)
-	mocks.MMessageService.On("SignFungibleMessage", mock.Anything).Return(authMsgBytes, nil)
-	mocks.MHederaNodeClient.On("SubmitTopicConsensusMessage", topicId, mock.Anything).Return(txId, nil)
-	mocks.MHederaMirrorClient.On("WaitForTransaction", hederahelper.ToMirrorNodeTransactionID(txId.String()), mock.Anything, mock.Anything)
-	msHandler.Handle(&tr)
+	mocks.MTransferService.On("InitiateNewTransfer", mock.Anything, tr).Return(transferRecord, nil)
+	mocks.MMessageService.On("SignFungibleMessage", mock.Anything, mock.Anything).Return(authMsgBytes, nil)
+	mocks.MHederaNodeClient.On("SubmitTopicConsensusMessage", mock.Anything, topicId, mock.Anything).Return(txId, nil)
+	mocks.MHederaMirrorClient.On("WaitForTransaction", mock.Anything, hederahelper.ToMirrorNodeTransactionID(txId.String()), mock.Anything, mock.Anything)
+	msHandler.Handle(context.Background(), &tr)
 }
 
 func Test_Handle_SubmitTopicConsensusMessageFails(t *testing.T) {
 	setup()
-	mocks.MTransferService.On("InitiateNewTransfer", tr).Return(transferRecord, nil)
-	mocks.MMessageService.On("SignFungibleMessage", mock.Anything).Return(authMsgBytes, nil)
-	mocks.MHederaNodeClient.On("SubmitTopicConsensusMessage", topicId, mock.Anything).Return(txId, errors.New("some-error"))
-	msHandler.Handle(&tr)
-	mocks.MHederaMirrorClient.AssertNotCalled(t, "WaitForTransaction", hederahelper.ToMirrorNodeTransactionID(txId.String()), mock.Anything, mock.Anything)
+	mocks.MTransferService.On("InitiateNewTransfer", mock.Anything, tr).Return(transferRecord, nil)
+	mocks.MMessageService.On("SignFungibleMessage", mock.Anything, mock.Anything).Return(authMsgBytes, nil)
+	mocks.MHederaNodeClient.On("SubmitTopicConsensusMessage", mock.Anything, topicId, mock.Anything).Return(txId, errors.New("some-error"))
+	msHandler.Handle(context.Background(), &tr)
+	mocks.MHederaMirrorClient.AssertNotCalled(t, "WaitForTransaction", mock.Anything, hederahelper.ToMirrorNodeTransactionID(txId.String()), mock.Anything, mock.Anything)
 }
 
 func Test_Handle_InitiateNewTransfer_Fails(t *testing.T) {
 	setup()
-	mocks.MTransferService.On("InitiateNewTransfer", tr).Return(transferRecord, errors.New("some-error"))
-	msHandler.Handle(&tr)
-	mocks.MSignerService.AssertNotCalled(t, "Sign", mock.Anything)
-	mocks.MHederaNodeClient.AssertNotCalled(t, "SubmitTopicConsensusMessage", topicId, mock.Anything)
-	mocks.MHederaMirrorClient.AssertNotCalled(t, "WaitForTransaction", hederahelper.ToMirrorNodeTransactionID(txId.String()), mock.Anything, mock.Anything)
+	mocks.MTransferService.On("InitiateNewTransfer", mock.Anything, tr).Return(transferRecord, errors.New("some-error"))
+	msHandler.Handle(context.Background(), &tr)
+	mocks.MSignerService.AssertNotCalled(t, "Sign", mock.Anything, mock.Anything)
+	mocks.MHederaNodeClient.AssertNotCalled(t, "SubmitTopicConsensusMessage", mock.Anything, topicId, mock.Anything)
+	mocks.MHederaMirrorClient.AssertNotCalled(t, "WaitForTransaction", mock.Anything, hederahelper.ToMirrorNodeTransactionID(txId.String()), mock.Anything, mock.Anything)
 }
 
 func Test_Handle_InitiateNewTransfer_NotInitial(t *testing.T) {
 	setup()
 	transferRecord.Status = "not-initial"
 
-	mocks.MTransferService.On("InitiateNewTransfer", tr).Return(transferRecord, nil)
-	msHandler.Handle(&tr)
-	mocks.MSignerService.AssertNotCalled(t, "Sign", mock.Anything)
-	mocks.MHederaNodeClient.AssertNotCalled(t, "SubmitTopicConsensusMessage", topicId, mock.Anything)
-	mocks.MHederaMirrorClient.AssertNotCalled(t, "WaitForTransaction", hederahelper.ToMirrorNodeTransactionID(txId.String()), mock.Anything, mock.Anything)
+	mocks.MTransferService.On("InitiateNewTransfer", mock.Anything, tr).Return(transferRecord, nil)
+	msHandler.Handle(context.Background(), &tr)
+	mocks.MSignerService.AssertNotCalled(t, "Sign", mock.Anything, mock.Anything)
+	mocks.MHederaNodeClient.AssertNotCalled(t, "SubmitTopicConsensusMessage", mock.Anything, topicId, mock.Anything)
+	mocks.MHederaMirrorClient.AssertNotCalled(t, "WaitForTransaction", mock.Anything, hederahelper.ToMirrorNodeTransactionID(txId.String()), mock.Anything, mock.Anything)
 
 	transferRecord.Status = status.Initial
 }
 
 func Test_Handle_SignFungibleMessage_Fails(t *testing.T) {
 	setup()
-	mocks.MTransferService.On("InitiateNewTransfer", tr).Return(transferRecord, nil)
-	mocks.MMessageService.On("SignFungibleMessage", mock.Anything).Return([]byte{}, errors.New("some-error"))
-	msHandler.Handle(&tr)
-	mocks.MHederaNodeClient.AssertNotCalled(t, "SubmitTopicConsensusMessage", topicId, mock.Anything)
-	mocks.MHederaMirrorClient.AssertNotCalled(t, "WaitForTransaction", hederahelper.ToMirrorNodeTransactionID(txId.String()), mock.Anything, mock.Anything)
+	mocks.MTransferService.On("InitiateNewTransfer", mock.Anything, tr).Return(transferRecord, nil)
+	mocks.MMessageService.On("SignFungibleMessage", mock.Anything, mock.Anything).Return([]byte{}, errors.New("some-error"))
+	msHandler.Handle(context.Background(), &tr)
+	mocks.MHederaNodeClient.AssertNotCalled(t, "SubmitTopicConsensusMessage", mock.Anything, topicId, mock.Anything)
+	mocks.MHederaMirrorClient.AssertNotCalled(t, "WaitForTransaction", mock.Anything, hederahelper.ToMirrorNodeTransactionID(txId.String()), mock.Anything, mock.Anything)
 }
 
 func setup() {
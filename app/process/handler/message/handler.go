@@ -17,6 +17,8 @@
 package message
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"github.com/dariubs/percent"
 	"github.com/hashgraph/hedera-sdk-go/v2"
@@ -27,6 +29,7 @@ import (
 	auth_message "github.com/limechain/hedera-eth-bridge-validator/app/model/auth-message"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/message"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	"github.com/limechain/hedera-eth-bridge-validator/proto"
@@ -44,7 +47,6 @@ type Handler struct {
 	logger                 *log.Entry
 	participationRateGauge prometheus.Gauge
 	prometheusService      service.Prometheus
-	assetsService          service.Assets
 }
 
 func NewHandler(
@@ -54,7 +56,6 @@ func NewHandler(
 	contractServices map[uint64]service.Contracts,
 	messages service.Messages,
 	prometheusService service.Prometheus,
-	assetsService service.Assets,
 ) *Handler {
 	topicID, err := hedera.TopicIDFromString(topicId)
 	if err != nil {
@@ -79,15 +80,13 @@ func NewHandler(
 		logger:                 config.GetLoggerFor(fmt.Sprintf("Topic [%s] Handler", topicID.String())),
 		prometheusService:      prometheusService,
 		participationRateGauge: participationRate,
-		assetsService:          assetsService,
 	}
 }
 
-func (cmh Handler) Handle(payload interface{}) {
+func (cmh Handler) Handle(ctx context.Context, payload interface{}) error {
 	m, ok := payload.(*message.Message)
 	if !ok {
-		cmh.logger.Errorf("Could not cast payload [%s]", payload)
-		return
+		return fmt.Errorf("could not cast payload [%s]", payload)
 	}
 
 	switch msg := m.Message.(type) {
@@ -100,9 +99,9 @@ func (cmh Handler) Handle(payload interface{}) {
 		cmh.handleNftSignatureMessage(msg.NftSignatureMessage, m.TransactionTimestamp)
 		break
 	default:
-		cmh.logger.Errorf("Invalid topic message provided: [%v]", msg)
-		break
+		return fmt.Errorf("invalid topic message provided: [%v]", msg)
 	}
+	return nil
 }
 
 // handleFungibleSignatureMessage is the main component responsible for the processing of new incoming Signature Messages
@@ -131,7 +130,7 @@ func (cmh Handler) handleFungibleSignatureMessage(tsm *proto.TopicEthSignatureMe
 		return
 	}
 
-	cmh.completeTransfer(tsm.TransferID, tsm.TargetChainId, tsm.SourceChainId, tsm.Asset, false)
+	cmh.completeTransfer(tsm.TransferID, tsm.TargetChainId, false)
 }
 
 // handleNftSignatureMessage is the main component responsible for the processing of new incoming Signature Messages
@@ -159,10 +158,10 @@ func (cmh Handler) handleNftSignatureMessage(tsm *proto.TopicEthNftSignatureMess
 		return
 	}
 
-	cmh.completeTransfer(tsm.TransferID, tsm.TargetChainId, tsm.SourceChainId, tsm.Asset, true)
+	cmh.completeTransfer(tsm.TransferID, tsm.TargetChainId, true)
 }
 
-func (cmh Handler) completeTransfer(transferID string, targetChainId, sourceChainId uint64, asset string, isNFT bool) {
+func (cmh Handler) completeTransfer(transferID string, targetChainId uint64, isNFT bool) {
 	majorityReached, err := cmh.checkMajority(transferID, targetChainId)
 	if err != nil {
 		cmh.logger.Errorf("[%s] - Could not determine whether majority was reached. Error: [%s]", transferID, err)
@@ -170,20 +169,14 @@ func (cmh Handler) completeTransfer(transferID string, targetChainId, sourceChai
 	}
 
 	if majorityReached {
-		if !isNFT { // metrics for fungible only
-			oppositeAsset := cmh.assetsService.OppositeAsset(sourceChainId, targetChainId, asset)
-			metrics.SetMajorityReached(
-				sourceChainId,
-				targetChainId,
-				oppositeAsset,
-				transferID,
-				cmh.prometheusService,
-				cmh.logger,
-			)
-		}
 		err = cmh.transferRepository.UpdateStatusCompleted(transferID)
-		if err != nil {
+		if errors.Is(err, status.ErrInvalidTransition) {
+			// Signatures arriving after the transfer is claimed or re-orged must not move it back
+			cmh.logger.Debugf("[%s] - Majority reached after the transfer was finalised. Skipping completion: [%s]", transferID, err)
+		} else if err != nil {
 			cmh.logger.Errorf("[%s] - Failed to complete. Error: [%s]", transferID, err)
+		} else if !isNFT { // metrics for fungible only, counted once, by the signature completing the transfer
+			metrics.ReachStoredTransferStage(constants.MajorityReachedStage, transferID, cmh.transferRepository, cmh.prometheusService, cmh.logger)
 		}
 	}
 }
@@ -17,12 +17,15 @@
 package mint_hts
 
 import (
+	"context"
+	"math/big"
 	"testing"
 
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 var (
@@ -49,11 +52,12 @@ func Test_Handle_Lock(t *testing.T) {
 		TargetAsset:   "",
 		NativeAsset:   "",
 		Receiver:      "",
-		Amount:        "0",
+		Amount:        big.NewInt(0),
 	}
-	mocks.MLockService.On("ProcessEvent", *tr).Return()
-	mintHtsHandler.Handle(tr)
-	mocks.MLockService.AssertCalled(t, "ProcessEvent", *tr)
+	mocks.MLockService.On("ProcessEvent", mock.Anything, *tr).Return(nil)
+	err := mintHtsHandler.Handle(context.Background(), tr)
+	assert.Nil(t, err)
+	mocks.MLockService.AssertCalled(t, "ProcessEvent", mock.Anything, *tr)
 }
 
 func Test_Handle_Encoding_Fails(t *testing.T) {
@@ -61,7 +65,8 @@ func Test_Handle_Encoding_Fails(t *testing.T) {
 
 	invalidTransferPayload := []byte{1, 2, 1}
 
-	mintHtsHandler.Handle(invalidTransferPayload)
+	err := mintHtsHandler.Handle(context.Background(), invalidTransferPayload)
+	assert.NotNil(t, err)
 
 	mocks.MLockService.AssertNotCalled(t, "ProcessEvent")
 }
@@ -0,0 +1,83 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package burn_message
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	hederaHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/hedera"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
+	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+)
+
+// Handler is the wrapped NFT transfer handler. It burns the wrapped NFT and
+// signs the unlock of the EVM native NFT
+type Handler struct {
+	repository       repository.Transfer
+	transfersService service.Transfers
+	logger           *log.Entry
+}
+
+func NewHandler(repository repository.Transfer, transferService service.Transfers) *Handler {
+	return &Handler{
+		repository:       repository,
+		transfersService: transferService,
+		logger:           config.GetLoggerFor("Hedera NFT Burn and Topic Message Handler"),
+	}
+}
+
+func (bmh Handler) Handle(ctx context.Context, p interface{}) error {
+	transferMsg, ok := p.(*payload.Transfer)
+	if !ok {
+		return fmt.Errorf("could not cast payload [%s]", p)
+	}
+
+	wrappedSerialNum, err := hederaHelper.ResolveEvmNativeNft(bmh.repository, transferMsg)
+	if err != nil {
+		bmh.logger.Errorf("[%s] - Failed to resolve native NFT of [%s] with serial number [%d]. Error: [%s]", transferMsg.TransactionId, transferMsg.SourceAsset, transferMsg.SerialNum, err)
+		return err
+	}
+
+	transactionRecord, err := bmh.transfersService.InitiateNewTransfer(ctx, *transferMsg)
+	if err != nil {
+		bmh.logger.Errorf("[%s] - Error occurred while initiating processing. Error: [%s]", transferMsg.TransactionId, err)
+		return err
+	}
+
+	if transactionRecord.Status != status.Initial {
+		bmh.logger.Debugf("[%s] - Previously added with status [%s]. Skipping further execution.", transactionRecord.TransactionID, transactionRecord.Status)
+		return nil
+	}
+
+	err = bmh.repository.UpdateWrappedSerialNumber(transferMsg.TransactionId, wrappedSerialNum)
+	if err != nil {
+		bmh.logger.Errorf("[%s] - Failed to update wrapped serial number [%d]. Error: [%s]", transferMsg.TransactionId, wrappedSerialNum, err)
+		return err
+	}
+
+	err = bmh.transfersService.ProcessWrappedNftTransfer(ctx, *transferMsg, wrappedSerialNum)
+	if err != nil {
+		bmh.logger.Errorf("[%s] - Processing failed. Error: [%s]", transferMsg.TransactionId, err)
+		return err
+	}
+	return nil
+}
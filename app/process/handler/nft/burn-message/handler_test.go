@@ -0,0 +1,126 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package burn_message
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
+	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	wrappedSerialNum = int64(3)
+	tokenId          = int64(7)
+	metadata         = "ipfs://metadata"
+
+	mt = payload.Transfer{
+		TransactionId: "0.0.0-0000000-1234",
+		Receiver:      "0x12345",
+		SourceAsset:   "0.0.222222",
+		TargetAsset:   "0x45678",
+		NativeAsset:   "0x45678",
+		SerialNum:     wrappedSerialNum,
+		IsNft:         true,
+	}
+
+	mintTransfer = &entity.Transfer{
+		TransactionID:       "0xabcdef-1",
+		TargetAsset:         mt.SourceAsset,
+		SerialNumber:        tokenId,
+		Metadata:            metadata,
+		WrappedSerialNumber: wrappedSerialNum,
+	}
+)
+
+func InitializeHandler() *Handler {
+	mocks.Setup()
+
+	return NewHandler(mocks.MTransferRepository, mocks.MTransferService)
+}
+
+func resolvedTransfer() payload.Transfer {
+	resolved := mt
+	resolved.SerialNum = tokenId
+	resolved.Metadata = metadata
+	return resolved
+}
+
+func Test_Handle(t *testing.T) {
+	handler := InitializeHandler()
+	resolved := resolvedTransfer()
+
+	mocks.MTransferRepository.On("GetByWrappedSerialNumber", mt.SourceAsset, wrappedSerialNum).Return(mintTransfer, nil)
+	mocks.MTransferService.On("InitiateNewTransfer", mock.Anything, resolved).Return(&entity.Transfer{TransactionID: mt.TransactionId, Status: status.Initial}, nil)
+	mocks.MTransferRepository.On("UpdateWrappedSerialNumber", mt.TransactionId, wrappedSerialNum).Return(nil)
+	mocks.MTransferService.On("ProcessWrappedNftTransfer", mock.Anything, resolved, wrappedSerialNum).Return(nil)
+
+	p := mt
+	handler.Handle(context.Background(), &p)
+
+	mocks.MTransferService.AssertCalled(t, "ProcessWrappedNftTransfer", mock.Anything, resolved, wrappedSerialNum)
+}
+
+func Test_Handle_MintTransferNotFound(t *testing.T) {
+	handler := InitializeHandler()
+
+	mocks.MTransferRepository.On("GetByWrappedSerialNumber", mt.SourceAsset, wrappedSerialNum).Return((*entity.Transfer)(nil), nil)
+
+	p := mt
+	handler.Handle(context.Background(), &p)
+
+	mocks.MTransferService.AssertNotCalled(t, "InitiateNewTransfer", mock.Anything, mock.Anything)
+	mocks.MTransferService.AssertNotCalled(t, "ProcessWrappedNftTransfer", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Handle_NotInitial(t *testing.T) {
+	handler := InitializeHandler()
+	resolved := resolvedTransfer()
+
+	mocks.MTransferRepository.On("GetByWrappedSerialNumber", mt.SourceAsset, wrappedSerialNum).Return(mintTransfer, nil)
+	mocks.MTransferService.On("InitiateNewTransfer", mock.Anything, resolved).Return(&entity.Transfer{TransactionID: mt.TransactionId, Status: status.Completed}, nil)
+
+	p := mt
+	handler.Handle(context.Background(), &p)
+
+	mocks.MTransferRepository.AssertNotCalled(t, "UpdateWrappedSerialNumber", mock.Anything, mock.Anything)
+	mocks.MTransferService.AssertNotCalled(t, "ProcessWrappedNftTransfer", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Handle_ProcessWrappedNftTransfer_Fails(t *testing.T) {
+	handler := InitializeHandler()
+	resolved := resolvedTransfer()
+
+	mocks.MTransferRepository.On("GetByWrappedSerialNumber", mt.SourceAsset, wrappedSerialNum).Return(mintTransfer, nil)
+	mocks.MTransferService.On("InitiateNewTransfer", mock.Anything, resolved).Return(&entity.Transfer{TransactionID: mt.TransactionId, Status: status.Initial}, nil)
+	mocks.MTransferRepository.On("UpdateWrappedSerialNumber", mt.TransactionId, wrappedSerialNum).Return(nil)
+	mocks.MTransferService.On("ProcessWrappedNftTransfer", mock.Anything, resolved, wrappedSerialNum).Return(errors.New("some-error"))
+
+	p := mt
+	handler.Handle(context.Background(), &p)
+}
+
+func Test_Handle_Payload_Fails(t *testing.T) {
+	handler := InitializeHandler()
+	handler.Handle(context.Background(), "string")
+	mocks.MTransferService.AssertNotCalled(t, "InitiateNewTransfer", mock.Anything, mock.Anything)
+}
@@ -17,6 +17,8 @@
 package fee_message
 
 import (
+	"context"
+	"fmt"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
@@ -37,27 +39,27 @@ func NewHandler(transfersService service.Transfers) *Handler {
 	}
 }
 
-func (fmh Handler) Handle(p interface{}) {
+func (fmh Handler) Handle(ctx context.Context, p interface{}) error {
 	transferMsg, ok := p.(*payload.Transfer)
 	if !ok {
-		fmh.logger.Errorf("Could not cast payload [%s]", p)
-		return
+		return fmt.Errorf("could not cast payload [%s]", p)
 	}
 
-	transactionRecord, err := fmh.transfersService.InitiateNewTransfer(*transferMsg)
+	transactionRecord, err := fmh.transfersService.InitiateNewTransfer(ctx, *transferMsg)
 	if err != nil {
 		fmh.logger.Errorf("[%s] - Error occurred while initiating processing. Error: [%s]", transferMsg.TransactionId, err)
-		return
+		return err
 	}
 
 	if transactionRecord.Status != status.Initial {
 		fmh.logger.Debugf("[%s] - Previously added with status [%s]. Skipping further execution.", transactionRecord.TransactionID, transactionRecord.Status)
-		return
+		return nil
 	}
 
-	err = fmh.transfersService.ProcessNativeNftTransfer(*transferMsg)
+	err = fmh.transfersService.ProcessNativeNftTransfer(ctx, *transferMsg)
 	if err != nil {
 		fmh.logger.Errorf("[%s] - Processing failed. Error: [%s]", transferMsg.TransactionId, err)
-		return
+		return err
 	}
+	return nil
 }
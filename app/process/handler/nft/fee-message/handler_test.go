@@ -17,7 +17,9 @@
 package fee_message
 
 import (
+	"context"
 	"errors"
+	"math/big"
 	"testing"
 	"time"
 
@@ -29,6 +31,7 @@ import (
 	testConstants "github.com/limechain/hedera-eth-bridge-validator/test/constants"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 var (
@@ -59,7 +62,7 @@ var (
 		TargetAsset:      targetAsset,
 		NativeAsset:      nativeAsset,
 		Receiver:         receiver,
-		Amount:           amount,
+		Amount:           big.NewInt(1000000000000000000),
 		SerialNum:        serialNum,
 		Metadata:         metadata,
 		IsNft:            isNft,
@@ -98,61 +101,61 @@ func Test_NewHandler(t *testing.T) {
 func Test_Handle(t *testing.T) {
 	setup()
 
-	mocks.MTransferService.On("InitiateNewTransfer", *p).Return(resultEntityTransfer, nilErr)
-	mocks.MTransferService.On("ProcessNativeNftTransfer", *p).Return(nilErr)
+	mocks.MTransferService.On("InitiateNewTransfer", mock.Anything, *p).Return(resultEntityTransfer, nilErr)
+	mocks.MTransferService.On("ProcessNativeNftTransfer", mock.Anything, *p).Return(nilErr)
 
-	handler.Handle(p)
+	handler.Handle(context.Background(), p)
 
-	mocks.MTransferService.AssertCalled(t, "InitiateNewTransfer", *p)
-	mocks.MTransferService.AssertCalled(t, "ProcessNativeNftTransfer", *p)
+	mocks.MTransferService.AssertCalled(t, "InitiateNewTransfer", mock.Anything, *p)
+	mocks.MTransferService.AssertCalled(t, "ProcessNativeNftTransfer", mock.Anything, *p)
 }
 
 func Test_Handle_CastError(t *testing.T) {
 	setup()
 	brokenPayload := "Not a transfer"
 
-	handler.Handle(brokenPayload)
+	handler.Handle(context.Background(), brokenPayload)
 
-	mocks.MTransferService.AssertNotCalled(t, "InitiateNewTransfer", *p)
-	mocks.MTransferService.AssertNotCalled(t, "ProcessNativeNftTransfer", *p)
+	mocks.MTransferService.AssertNotCalled(t, "InitiateNewTransfer", mock.Anything, *p)
+	mocks.MTransferService.AssertNotCalled(t, "ProcessNativeNftTransfer", mock.Anything, *p)
 }
 
 func Test_Handle_TransactionError(t *testing.T) {
 	setup()
 
-	mocks.MTransferService.On("InitiateNewTransfer", *p).Return(resultEntityTransfer, errors.New("failed to create record"))
+	mocks.MTransferService.On("InitiateNewTransfer", mock.Anything, *p).Return(resultEntityTransfer, errors.New("failed to create record"))
 
-	handler.Handle(p)
+	handler.Handle(context.Background(), p)
 
-	mocks.MTransferService.AssertCalled(t, "InitiateNewTransfer", *p)
-	mocks.MTransferService.AssertNotCalled(t, "ProcessNativeNftTransfer", *p)
+	mocks.MTransferService.AssertCalled(t, "InitiateNewTransfer", mock.Anything, *p)
+	mocks.MTransferService.AssertNotCalled(t, "ProcessNativeNftTransfer", mock.Anything, *p)
 }
 
 func Test_Handle_ProcessNativeNftTransferError(t *testing.T) {
 	setup()
 
-	mocks.MTransferService.On("InitiateNewTransfer", *p).Return(resultEntityTransfer, nilErr)
-	mocks.MTransferService.On("ProcessNativeNftTransfer", *p).Return(errors.New("failed to process native NFT transfer"))
+	mocks.MTransferService.On("InitiateNewTransfer", mock.Anything, *p).Return(resultEntityTransfer, nilErr)
+	mocks.MTransferService.On("ProcessNativeNftTransfer", mock.Anything, *p).Return(errors.New("failed to process native NFT transfer"))
 
-	handler.Handle(p)
+	handler.Handle(context.Background(), p)
 
-	mocks.MTransferService.AssertCalled(t, "InitiateNewTransfer", *p)
-	mocks.MTransferService.AssertCalled(t, "ProcessNativeNftTransfer", *p)
+	mocks.MTransferService.AssertCalled(t, "InitiateNewTransfer", mock.Anything, *p)
+	mocks.MTransferService.AssertCalled(t, "ProcessNativeNftTransfer", mock.Anything, *p)
 }
 
 func Test_Handle_NotInitial(t *testing.T) {
 	setup()
 
 	resultEntityTransfer.Status = status.Submitted
-	mocks.MTransferService.On("InitiateNewTransfer", *p).Return(resultEntityTransfer, nilErr)
-	mocks.MTransferService.On("ProcessNativeNftTransfer", *p).Return(nilErr)
+	mocks.MTransferService.On("InitiateNewTransfer", mock.Anything, *p).Return(resultEntityTransfer, nilErr)
+	mocks.MTransferService.On("ProcessNativeNftTransfer", mock.Anything, *p).Return(nilErr)
 
-	handler.Handle(p)
+	handler.Handle(context.Background(), p)
 
 	resultEntityTransfer.Status = entityStatus
 
-	mocks.MTransferService.AssertCalled(t, "InitiateNewTransfer", *p)
-	mocks.MTransferService.AssertNotCalled(t, "ProcessNativeNftTransfer", *p)
+	mocks.MTransferService.AssertCalled(t, "InitiateNewTransfer", mock.Anything, *p)
+	mocks.MTransferService.AssertNotCalled(t, "ProcessNativeNftTransfer", mock.Anything, *p)
 
 }
 
@@ -0,0 +1,161 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	hederaHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/hedera"
+	syncHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/sync"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/schedule"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
+	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+)
+
+// Handler is the EVM native NFT lock event handler.
+// It mints the wrapped NFT to the bridge account and approves it for the receiver
+type Handler struct {
+	bridgeAccount      hedera.AccountID
+	repository         repository.Transfer
+	scheduleRepository repository.Schedule
+	scheduledService   service.Scheduled
+	transfersService   service.Transfers
+	mirrorNode         client.MirrorNode
+	logger             *log.Entry
+}
+
+func NewHandler(
+	bridgeAccount string,
+	repository repository.Transfer,
+	scheduleRepository repository.Schedule,
+	transfersService service.Transfers,
+	scheduledService service.Scheduled,
+	mirrorNode client.MirrorNode,
+) *Handler {
+	bridgeAcc, err := hedera.AccountIDFromString(bridgeAccount)
+	if err != nil {
+		log.Fatalf("Invalid account id [%s]. Error: [%s]", bridgeAccount, err)
+	}
+
+	return &Handler{
+		bridgeAccount:      bridgeAcc,
+		repository:         repository,
+		scheduleRepository: scheduleRepository,
+		scheduledService:   scheduledService,
+		transfersService:   transfersService,
+		mirrorNode:         mirrorNode,
+		logger:             config.GetLoggerFor("EVM Native Scheduled Nft Mint Handler"),
+	}
+}
+
+func (mh Handler) Handle(ctx context.Context, p interface{}) error {
+	transfer, ok := p.(*payload.Transfer)
+	if !ok {
+		return fmt.Errorf("could not cast payload [%s]", p)
+	}
+
+	receiver, err := hedera.AccountIDFromString(transfer.Receiver)
+	if err != nil {
+		mh.logger.Errorf("[%s] - Failed to parse event account [%s]. Error [%s].", transfer.TransactionId, transfer.Receiver, err)
+		return err
+	}
+
+	token, err := hedera.TokenIDFromString(transfer.TargetAsset)
+	if err != nil {
+		mh.logger.Errorf("[%s] - Failed to parse token [%s]. Error [%s].", transfer.TransactionId, transfer.TargetAsset, err)
+		return err
+	}
+
+	transactionRecord, err := mh.transfersService.InitiateNewTransfer(ctx, *transfer)
+	if err != nil {
+		mh.logger.Errorf("[%s] - Error occurred while initiating processing. Error: [%s]", transfer.TransactionId, err)
+		return err
+	}
+
+	if transactionRecord.Status != status.Initial {
+		mh.logger.Debugf("[%s] - Previously added with status [%s]. Skipping further execution.", transactionRecord.TransactionID, transactionRecord.Status)
+		return nil
+	}
+
+	serialNum, err := mh.mintWrappedNft(ctx, transfer.TransactionId, token, []byte(transfer.Metadata))
+	if err != nil {
+		mh.logger.Errorf("[%s] - Failed to mint wrapped NFT. Error: [%s]", transfer.TransactionId, err)
+		return err
+	}
+
+	err = mh.repository.UpdateWrappedSerialNumber(transfer.TransactionId, serialNum)
+	if err != nil {
+		mh.logger.Errorf("[%s] - Failed to update wrapped serial number [%d]. Error: [%s]", transfer.TransactionId, serialNum, err)
+		return err
+	}
+
+	nftID := hedera.NftID{
+		TokenID:      token,
+		SerialNumber: serialNum,
+	}
+
+	var statusResult string
+	wg := new(sync.WaitGroup)
+	wg.Add(1)
+	onExecutionSuccess, onExecutionFail := hederaHelper.ScheduledNftTxExecutionCallbacks(mh.repository, mh.scheduleRepository, mh.logger, transfer.TransactionId, true, &statusResult, schedule.APPROVE, wg)
+	onSuccess, onFail := hederaHelper.ScheduledNftTxMinedCallbacks(mh.repository, mh.scheduleRepository, mh.logger, transfer.TransactionId, &statusResult, wg)
+
+	mh.scheduledService.ExecuteScheduledNftAllowTransaction(ctx, transfer.TransactionId, nftID, mh.bridgeAccount, receiver, onExecutionSuccess, onExecutionFail, onSuccess, onFail)
+	return nil
+}
+
+// mintWrappedNft mints the wrapped NFT to the bridge account, awaits the execution of the scheduled transaction
+// and returns the serial number of the minted NFT
+func (mh Handler) mintWrappedNft(ctx context.Context, transferID string, token hedera.TokenID, metadata []byte) (int64, error) {
+	var statusResult string
+	var mintTransactionID string
+	wg := new(sync.WaitGroup)
+	wg.Add(1)
+	onExecutionSuccess, onExecutionFail := hederaHelper.ScheduledNftTxExecutionCallbacks(mh.repository, mh.scheduleRepository, mh.logger, transferID, false, &statusResult, schedule.MINT, wg)
+	onMinedSuccess, onFail := hederaHelper.ScheduledNftTxMinedCallbacks(mh.repository, mh.scheduleRepository, mh.logger, transferID, &statusResult, wg)
+	onSuccess := func(transactionID string) {
+		mintTransactionID = transactionID
+		onMinedSuccess(transactionID)
+	}
+
+	mh.scheduledService.ExecuteScheduledNftMintTransaction(ctx, transferID, token, metadata, onExecutionSuccess, onExecutionFail, onSuccess, onFail)
+	wg.Wait()
+	if statusResult != syncHelper.DONE {
+		return 0, errors.New("failed-scheduled-nft-mint")
+	}
+
+	tx, err := mh.mirrorNode.GetSuccessfulTransaction(mintTransactionID)
+	if err != nil {
+		return 0, err
+	}
+
+	serialNum, ok := tx.GetMintedNftSerialNumber(token.String())
+	if !ok {
+		return 0, errors.New("minted serial number not found")
+	}
+
+	return serialNum, nil
+}
@@ -0,0 +1,173 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mint
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
+	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	testConstants "github.com/limechain/hedera-eth-bridge-validator/test/constants"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	test_config "github.com/limechain/hedera-eth-bridge-validator/test/test-config"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	handler       *Handler
+	bridgeAccount = test_config.TestConfig.Bridge.Hedera.BridgeAccount
+	transactionId = "0xabcdef-1"
+	targetAsset   = "0.0.222222"
+	nativeAsset   = "0x0000000000000000000000000000000000000001"
+	receiver      = "0.0.455300"
+	tokenId       = int64(7)
+	metadata      = "ipfs://metadata"
+
+	p = &payload.Transfer{
+		TransactionId: transactionId,
+		SourceChainId: testConstants.EthereumNetworkId,
+		TargetChainId: constants.HederaNetworkId,
+		NativeChainId: testConstants.EthereumNetworkId,
+		SourceAsset:   nativeAsset,
+		TargetAsset:   targetAsset,
+		NativeAsset:   nativeAsset,
+		Receiver:      receiver,
+		SerialNum:     tokenId,
+		Metadata:      metadata,
+		IsNft:         true,
+	}
+
+	resultEntityTransfer = &entity.Transfer{
+		TransactionID: transactionId,
+		Status:        status.Initial,
+	}
+)
+
+func Test_NewHandler(t *testing.T) {
+	setup(t)
+
+	actualHandler := NewHandler(
+		bridgeAccount,
+		mocks.MTransferRepository,
+		mocks.MScheduleRepository,
+		mocks.MTransferService,
+		mocks.MScheduledService,
+		mocks.MHederaMirrorClient)
+
+	assert.Equal(t, handler, actualHandler)
+}
+
+func Test_NewHandler_BridgeAccountError(t *testing.T) {
+	setup(t)
+
+	defer func() { log.StandardLogger().ExitFunc = nil }()
+	fatal := false
+	log.StandardLogger().ExitFunc = func(int) { fatal = true }
+
+	_ = NewHandler(
+		"",
+		mocks.MTransferRepository,
+		mocks.MScheduleRepository,
+		mocks.MTransferService,
+		mocks.MScheduledService,
+		mocks.MHederaMirrorClient)
+
+	assert.Equal(t, true, fatal)
+}
+
+func Test_Handle_CastError(t *testing.T) {
+	setup(t)
+
+	handler.Handle(context.Background(), "Not a transfer")
+
+	mocks.MTransferService.AssertNotCalled(t, "InitiateNewTransfer", mock.Anything, mock.Anything)
+	mocks.MScheduledService.AssertNotCalled(t, "ExecuteScheduledNftMintTransaction", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Handle_ReceiverError(t *testing.T) {
+	setup(t)
+	p.Receiver = ""
+
+	handler.Handle(context.Background(), p)
+
+	p.Receiver = receiver
+
+	mocks.MTransferService.AssertNotCalled(t, "InitiateNewTransfer", mock.Anything, mock.Anything)
+	mocks.MScheduledService.AssertNotCalled(t, "ExecuteScheduledNftMintTransaction", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Handle_TokenError(t *testing.T) {
+	setup(t)
+	p.TargetAsset = ""
+
+	handler.Handle(context.Background(), p)
+
+	p.TargetAsset = targetAsset
+
+	mocks.MTransferService.AssertNotCalled(t, "InitiateNewTransfer", mock.Anything, mock.Anything)
+	mocks.MScheduledService.AssertNotCalled(t, "ExecuteScheduledNftMintTransaction", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Handle_TransactionError(t *testing.T) {
+	setup(t)
+	mocks.MTransferService.On("InitiateNewTransfer", mock.Anything, *p).Return(nil, errors.New("failed to create record"))
+
+	handler.Handle(context.Background(), p)
+
+	mocks.MTransferService.AssertCalled(t, "InitiateNewTransfer", mock.Anything, *p)
+	mocks.MScheduledService.AssertNotCalled(t, "ExecuteScheduledNftMintTransaction", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Handle_NotInitialStatus(t *testing.T) {
+	setup(t)
+	resultEntityTransfer.Status = status.Completed
+	mocks.MTransferService.On("InitiateNewTransfer", mock.Anything, *p).Return(resultEntityTransfer, nil)
+
+	handler.Handle(context.Background(), p)
+
+	resultEntityTransfer.Status = status.Initial
+
+	mocks.MTransferService.AssertCalled(t, "InitiateNewTransfer", mock.Anything, *p)
+	mocks.MScheduledService.AssertNotCalled(t, "ExecuteScheduledNftMintTransaction", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func setup(t *testing.T) {
+	mocks.Setup()
+
+	bridgeAccountId, err := hedera.AccountIDFromString(bridgeAccount)
+	if err != nil {
+		t.Fatalf("Invalid bridge account id [%s]. Error: [%s]", bridgeAccount, err)
+	}
+
+	handler = &Handler{
+		bridgeAccount:      bridgeAccountId,
+		repository:         mocks.MTransferRepository,
+		scheduleRepository: mocks.MScheduleRepository,
+		scheduledService:   mocks.MScheduledService,
+		transfersService:   mocks.MTransferService,
+		mirrorNode:         mocks.MHederaMirrorClient,
+		logger:             config.GetLoggerFor("EVM Native Scheduled Nft Mint Handler"),
+	}
+}
@@ -17,6 +17,8 @@
 package transfer
 
 import (
+	"context"
+	"fmt"
 	hederaHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/hedera"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/schedule"
 	"sync"
@@ -62,38 +64,37 @@ func NewHandler(
 	}
 }
 
-func (nth Handler) Handle(p interface{}) {
+func (nth Handler) Handle(ctx context.Context, p interface{}) error {
 	transfer, ok := p.(*payload.Transfer)
 	if !ok {
-		nth.logger.Errorf("Could not cast payload [%s]", p)
-		return
+		return fmt.Errorf("could not cast payload [%s]", p)
 	}
 
 	receiver, err := hedera.AccountIDFromString(transfer.Receiver)
 	if err != nil {
 		nth.logger.Errorf("[%s] - Failed to parse event account [%s]. Error [%s].", transfer.TransactionId, transfer.Receiver, err)
-		return
+		return err
 	}
 
 	token, err := hedera.TokenIDFromString(transfer.TargetAsset)
 	if err != nil {
 		nth.logger.Errorf("[%s] - Failed to parse token [%s]. Error [%s].", transfer.TransactionId, transfer.TargetAsset, err)
-		return
+		return err
 	}
 	nftID := hedera.NftID{
 		TokenID:      token,
 		SerialNumber: transfer.SerialNum,
 	}
 
-	transactionRecord, err := nth.transfersService.InitiateNewTransfer(*transfer)
+	transactionRecord, err := nth.transfersService.InitiateNewTransfer(ctx, *transfer)
 	if err != nil {
 		nth.logger.Errorf("[%s] - Error occurred while initiating processing. Error: [%s]", transfer.TransactionId, err)
-		return
+		return err
 	}
 
 	if transactionRecord.Status != status.Initial {
 		nth.logger.Debugf("[%s] - Previously added with status [%s]. Skipping further execution.", transactionRecord.TransactionID, transactionRecord.Status)
-		return
+		return nil
 	}
 
 	var statusResult string
@@ -102,5 +103,6 @@ func (nth Handler) Handle(p interface{}) {
 	onExecutionSuccess, onExecutionFail := hederaHelper.ScheduledNftTxExecutionCallbacks(nth.repository, nth.scheduleRepository, nth.logger, transfer.TransactionId, true, &statusResult, schedule.APPROVE, wg)
 	onSuccess, onFail := hederaHelper.ScheduledNftTxMinedCallbacks(nth.repository, nth.scheduleRepository, nth.logger, transfer.TransactionId, &statusResult, wg)
 
-	nth.scheduledService.ExecuteScheduledNftAllowTransaction(transfer.TransactionId, nftID, nth.bridgeAccount, receiver, onExecutionSuccess, onExecutionFail, onSuccess, onFail)
+	nth.scheduledService.ExecuteScheduledNftAllowTransaction(ctx, transfer.TransactionId, nftID, nth.bridgeAccount, receiver, onExecutionSuccess, onExecutionFail, onSuccess, onFail)
+	return nil
 }
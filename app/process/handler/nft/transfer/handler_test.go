@@ -17,8 +17,10 @@
 package transfer
 
 import (
+	"context"
 	"database/sql"
 	"errors"
+	"math/big"
 	"sync"
 	"testing"
 	"time"
@@ -37,6 +39,7 @@ import (
 	test_config "github.com/limechain/hedera-eth-bridge-validator/test/test-config"
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 var (
@@ -68,7 +71,7 @@ var (
 		TargetAsset:      targetAsset,
 		NativeAsset:      nativeAsset,
 		Receiver:         receiver,
-		Amount:           amount,
+		Amount:           big.NewInt(1000000000000000000),
 		SerialNum:        serialNum,
 		Metadata:         metadata,
 		IsNft:            isNft,
@@ -159,18 +162,20 @@ func Test_Handle(t *testing.T) {
 		SerialNumber: serialNum,
 	}
 
-	mocks.MTransferService.On("InitiateNewTransfer", *p).Return(resultEntityTransfer, nilErr)
+	mocks.MTransferService.On("InitiateNewTransfer", mock.Anything, *p).Return(resultEntityTransfer, nilErr)
 	mocks.MScheduledService.On("ExecuteScheduledNftAllowTransaction",
+		mock.Anything,
 		transactionId,
 		nftID,
 		bridgeAccountId,
 		receiverAccountId,
 	).Return()
 
-	handler.Handle(p)
+	handler.Handle(context.Background(), p)
 
-	mocks.MTransferService.AssertCalled(t, "InitiateNewTransfer", *p)
+	mocks.MTransferService.AssertCalled(t, "InitiateNewTransfer", mock.Anything, *p)
 	mocks.MScheduledService.AssertCalled(t, "ExecuteScheduledNftAllowTransaction",
+		mock.Anything,
 		transactionId,
 		nftID,
 		bridgeAccountId,
@@ -181,7 +186,7 @@ func Test_Handle_CastError(t *testing.T) {
 	setup(t)
 	brokenPayload := "Not a transfer"
 
-	handler.Handle(brokenPayload)
+	handler.Handle(context.Background(), brokenPayload)
 
 	mocks.MTransferService.AssertNotCalled(t, "InitiateNewTransfer")
 	mocks.MScheduledService.AssertNotCalled(t, "ExecuteScheduledNftTransferTransaction")
@@ -191,7 +196,7 @@ func Test_Handle_ReceiverError(t *testing.T) {
 	setup(t)
 	p.Receiver = ""
 
-	handler.Handle(p)
+	handler.Handle(context.Background(), p)
 
 	p.Receiver = receiver
 
@@ -203,7 +208,7 @@ func Test_Handle_TokenError(t *testing.T) {
 	setup(t)
 	p.TargetAsset = ""
 
-	handler.Handle(p)
+	handler.Handle(context.Background(), p)
 
 	p.TargetAsset = targetAsset
 
@@ -213,24 +218,24 @@ func Test_Handle_TokenError(t *testing.T) {
 
 func Test_Handle_TransactionError(t *testing.T) {
 	setup(t)
-	mocks.MTransferService.On("InitiateNewTransfer", *p).Return(resultEntityTransfer, errors.New("failed to create record"))
+	mocks.MTransferService.On("InitiateNewTransfer", mock.Anything, *p).Return(resultEntityTransfer, errors.New("failed to create record"))
 
-	handler.Handle(p)
+	handler.Handle(context.Background(), p)
 
-	mocks.MTransferService.AssertCalled(t, "InitiateNewTransfer", *p)
+	mocks.MTransferService.AssertCalled(t, "InitiateNewTransfer", mock.Anything, *p)
 	mocks.MScheduledService.AssertNotCalled(t, "ExecuteScheduledNftTransferTransaction")
 }
 
 func Test_Handle_NotInitialStatus(t *testing.T) {
 	setup(t)
 	resultEntityTransfer.Status = status.Submitted
-	mocks.MTransferService.On("InitiateNewTransfer", *p).Return(resultEntityTransfer, nilErr)
+	mocks.MTransferService.On("InitiateNewTransfer", mock.Anything, *p).Return(resultEntityTransfer, nilErr)
 
-	handler.Handle(p)
+	handler.Handle(context.Background(), p)
 
 	resultEntityTransfer.Status = entityStatus
 
-	mocks.MTransferService.AssertCalled(t, "InitiateNewTransfer", *p)
+	mocks.MTransferService.AssertCalled(t, "InitiateNewTransfer", mock.Anything, *p)
 	mocks.MScheduledService.AssertNotCalled(t, "ExecuteScheduledNftTransferTransaction")
 }
 
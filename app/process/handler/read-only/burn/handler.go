@@ -17,7 +17,9 @@
 package burn
 
 import (
+	"context"
 	"database/sql"
+	"fmt"
 
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	mirrorNodeTransaction "github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/transaction"
@@ -64,25 +66,24 @@ func NewHandler(
 	}
 }
 
-func (mhh Handler) Handle(p interface{}) {
+func (mhh Handler) Handle(ctx context.Context, p interface{}) error {
 	transferMsg, ok := p.(*payload.Transfer)
 	if !ok {
-		mhh.logger.Errorf("Could not cast payload [%s]", p)
-		return
+		return fmt.Errorf("could not cast payload [%s]", p)
 	}
 
-	transactionRecord, err := mhh.transfersService.InitiateNewTransfer(*transferMsg)
+	transactionRecord, err := mhh.transfersService.InitiateNewTransfer(ctx, *transferMsg)
 	if err != nil {
 		mhh.logger.Errorf("[%s] - Error occurred while initiating processing. Error: [%s]", transferMsg.TransactionId, err)
-		return
+		return err
 	}
 
 	if transactionRecord.Status != status.Initial {
 		mhh.logger.Debugf("[%s] - Previously added with status [%s]. Skipping further execution.", transactionRecord.TransactionID, transactionRecord.Status)
-		return
+		return nil
 	}
 
-	mhh.readOnlyService.FindTransfer(transferMsg.TransactionId,
+	mhh.readOnlyService.FindTransfer(ctx, transferMsg.TransactionId,
 		func() (*mirrorNodeTransaction.Response, error) {
 			return mhh.mirrorNode.GetAccountTokenBurnTransactionsAfterTimestampString(mhh.bridgeAccount, transferMsg.NetworkTimestamp)
 		},
@@ -109,4 +110,5 @@ func (mhh Handler) Handle(p interface{}) {
 				},
 			})
 		})
+	return nil
 }
@@ -17,7 +17,9 @@
 package burn
 
 import (
+	"context"
 	"errors"
+	"math/big"
 	"testing"
 
 	"github.com/hashgraph/hedera-sdk-go/v2"
@@ -42,7 +44,7 @@ var (
 		TargetAsset:      "0xb083879B1e10C8476802016CB12cd2F25a896691",
 		NativeAsset:      constants.Hbar,
 		Receiver:         "0xsomeotherethaddress",
-		Amount:           "100",
+		Amount:           big.NewInt(100),
 		NetworkTimestamp: "1",
 	}
 	accountId = hedera.AccountID{
@@ -59,29 +61,29 @@ func Test_NewHandler(t *testing.T) {
 
 func Test_Handle(t *testing.T) {
 	setup()
-	mocks.MTransferService.On("InitiateNewTransfer", *tr).Return(&entity.Transfer{Status: status.Initial}, nil)
-	mocks.MReadOnlyService.On("FindTransfer", mock.Anything, mock.Anything, mock.Anything)
-	h.Handle(tr)
+	mocks.MTransferService.On("InitiateNewTransfer", mock.Anything, *tr).Return(&entity.Transfer{Status: status.Initial}, nil)
+	mocks.MReadOnlyService.On("FindTransfer", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	h.Handle(context.Background(), tr)
 }
 
 func Test_Handle_NotInitialFails(t *testing.T) {
 	setup()
-	mocks.MTransferService.On("InitiateNewTransfer", *tr).Return(&entity.Transfer{Status: "not-initial"}, nil)
-	h.Handle(tr)
-	mocks.MReadOnlyService.AssertNotCalled(t, "FindTransfer", mock.Anything, mock.Anything, mock.Anything)
+	mocks.MTransferService.On("InitiateNewTransfer", mock.Anything, *tr).Return(&entity.Transfer{Status: "not-initial"}, nil)
+	h.Handle(context.Background(), tr)
+	mocks.MReadOnlyService.AssertNotCalled(t, "FindTransfer", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 }
 
 func Test_Handle_InvalidPayload(t *testing.T) {
 	setup()
-	h.Handle("invalid-payload")
-	mocks.MTransferService.AssertNotCalled(t, "InitiateNewTransfer", *tr)
+	h.Handle(context.Background(), "invalid-payload")
+	mocks.MTransferService.AssertNotCalled(t, "InitiateNewTransfer", mock.Anything, *tr)
 }
 
 func Test_Handle_InitiateNewTransferFails(t *testing.T) {
 	setup()
-	mocks.MTransferService.On("InitiateNewTransfer", *tr).Return(nil, errors.New("some-error"))
-	h.Handle(tr)
-	mocks.MReadOnlyService.AssertNotCalled(t, "FindTransfer", mock.Anything, mock.Anything, mock.Anything)
+	mocks.MTransferService.On("InitiateNewTransfer", mock.Anything, *tr).Return(nil, errors.New("some-error"))
+	h.Handle(context.Background(), tr)
+	mocks.MReadOnlyService.AssertNotCalled(t, "FindTransfer", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 }
 
 func setup() {
@@ -17,8 +17,10 @@
 package fee_transfer
 
 import (
+	"context"
 	"database/sql"
-	"strconv"
+	"fmt"
+	"math/big"
 
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	mirrorNodeTransaction "github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/transaction"
@@ -50,6 +52,7 @@ type Handler struct {
 	distributorService service.Distributor
 	transfersService   service.Transfers
 	readOnlyService    service.ReadOnly
+	batchService       service.Batch
 	prometheusService  service.Prometheus
 	logger             *log.Entry
 }
@@ -64,6 +67,7 @@ func NewHandler(
 	feeService service.Fee,
 	transfersService service.Transfers,
 	readOnlyService service.ReadOnly,
+	batchService service.Batch,
 	prometheusServices service.Prometheus) *Handler {
 	bridgeAcc, err := hedera.AccountIDFromString(bridgeAccount)
 	if err != nil {
@@ -80,58 +84,62 @@ func NewHandler(
 		logger:             config.GetLoggerFor("Hedera Fee and Schedule Transfer Read-only Handler"),
 		transfersService:   transfersService,
 		readOnlyService:    readOnlyService,
+		batchService:       batchService,
 		prometheusService:  prometheusServices,
 	}
 }
 
-func (fmh *Handler) Handle(p interface{}) {
+func (fmh *Handler) Handle(ctx context.Context, p interface{}) error {
 	transferMsg, ok := p.(*payload.Transfer)
 	if !ok {
-		fmh.logger.Errorf("Could not cast payload [%s]", p)
-		return
+		return fmt.Errorf("could not cast payload [%s]", p)
 	}
 
 	receiver, err := hedera.AccountIDFromString(transferMsg.Receiver)
 	if err != nil {
 		fmh.logger.Errorf("[%s] - Failed to parse event account [%s]. Error [%s].", transferMsg.TransactionId, transferMsg.Receiver, err)
-		return
+		return err
 	}
 
-	transactionRecord, err := fmh.transfersService.InitiateNewTransfer(*transferMsg)
+	transactionRecord, err := fmh.transfersService.InitiateNewTransfer(ctx, *transferMsg)
 	if err != nil {
 		fmh.logger.Errorf("[%s] - Error occurred while initiating processing. Error: [%s]", transferMsg.TransactionId, err)
-		return
+		return err
 	}
 
 	if transactionRecord.Status != entityStatus.Initial {
 		fmh.logger.Debugf("[%s] - Previously added with status [%s]. Skipping further execution.", transactionRecord.TransactionID, transactionRecord.Status)
-		return
+		return nil
 	}
 
-	intAmount, err := strconv.ParseInt(transferMsg.Amount, 10, 64)
+	intAmount, err := hederaHelper.Amount(transferMsg.Amount)
 	if err != nil {
-		fmh.logger.Errorf("[%s] - Failed to parse amount. Error: [%s]", transferMsg.TransactionId, err)
-		return
+		fmh.logger.Errorf("[%s] - Invalid amount. Error: [%s]", transferMsg.TransactionId, err)
+		return err
 	}
 
-	calculatedFee, remainder := fmh.feeService.CalculateFee(transferMsg.TargetAsset, intAmount)
+	calculatedFee, remainder := fmh.feeService.CalculateFee(transferMsg.TargetAsset, transferMsg.Amount)
 
 	validFee := fmh.distributorService.ValidAmount(calculatedFee)
-	if validFee != calculatedFee {
-		remainder += calculatedFee - validFee
-	}
+	remainder = new(big.Int).Add(remainder, new(big.Int).Sub(calculatedFee, validFee))
 
-	err = fmh.transferRepository.UpdateFee(transferMsg.TransactionId, strconv.FormatInt(validFee, 10))
+	err = fmh.transferRepository.UpdateFee(transferMsg.TransactionId, validFee.String())
 	if err != nil {
-		fmh.logger.Errorf("[%s] - Failed to update fee [%d]. Error: [%s]", transferMsg.TransactionId, validFee, err)
-		return
+		fmh.logger.Errorf("[%s] - Failed to update fee [%s]. Error: [%s]", transferMsg.TransactionId, validFee, err)
+		return err
 	}
 
-	transfers, _ := fmh.distributorService.CalculateMemberDistribution(validFee)
+	if fmh.batchService.Enabled() {
+		fmh.findBatchedTransfer(ctx, transferMsg)
+		return nil
+	}
+
+	// The fee and the remainder are parts of the amount, so they are in the range of Hedera amounts as well
+	transfers, _ := fmh.distributorService.CalculateMemberDistribution(validFee.Int64())
 	transfers = append(transfers,
 		model.Hedera{
 			AccountID: receiver,
-			Amount:    remainder,
+			Amount:    remainder.Int64(),
 		})
 
 	splitTransfers := distributor.SplitAccountAmounts(transfers,
@@ -153,7 +161,7 @@ func (fmh *Handler) Handle(p interface{}) {
 	for _, splitTransfer := range splitTransfers {
 		feeAmount, hasReceiver := util.TotalFeeFromTransfers(splitTransfer, receiver)
 
-		fmh.readOnlyService.FindAssetTransfer(transferMsg.TransactionId, transferMsg.TargetAsset, splitTransfer, func() (*mirrorNodeTransaction.Response, error) {
+		fmh.readOnlyService.FindAssetTransfer(ctx, transferMsg.TransactionId, transferMsg.TargetAsset, splitTransfer, func() (*mirrorNodeTransaction.Response, error) {
 			return fmh.mirrorNode.GetAccountDebitTransactionsAfterTimestampString(fmh.bridgeAccount, transferMsg.NetworkTimestamp)
 		}, func(transactionID, scheduleID, status string) error {
 			result := false
@@ -199,6 +207,42 @@ func (fmh *Handler) Handle(p interface{}) {
 	}
 
 	fmh.startAwaitingFunctionsForMetrics(userOutParams, transferMsg, feeOutParams)
+	return nil
+}
+
+// findBatchedTransfer awaits the batch scheduled transaction, including the transfer. Batches exceeding the
+// positive transfers per transaction are split, in which case the first found scheduled transaction is recorded
+func (fmh *Handler) findBatchedTransfer(ctx context.Context, transferMsg *payload.Transfer) {
+	fmh.readOnlyService.FindTransfer(
+		ctx,
+		fmh.batchService.ID(transferMsg.TargetAsset, transferMsg.Timestamp),
+		func() (*mirrorNodeTransaction.Response, error) {
+			return fmh.mirrorNode.GetAccountDebitTransactionsAfterTimestampString(fmh.bridgeAccount, transferMsg.NetworkTimestamp)
+		},
+		func(transactionID, scheduleID, status string) error {
+			var err error
+			if status == entityStatus.Completed {
+				fmh.onMinedUserTransactionSetMetrics(transferMsg.SourceChainId, transferMsg.TargetChainId, transferMsg.TargetAsset, transferMsg.TransactionId, true)
+				err = fmh.transferRepository.UpdateStatusCompleted(transferMsg.TransactionId)
+			} else {
+				err = fmh.transferRepository.UpdateStatusFailed(transferMsg.TransactionId)
+			}
+			if err != nil {
+				fmh.logger.Errorf("[%s] - Failed to update status. Error: [%s]", transferMsg.TransactionId, err)
+			}
+
+			return fmh.scheduleRepository.Create(&entity.Schedule{
+				TransactionID: transactionID,
+				ScheduleID:    scheduleID,
+				Operation:     schedule.TRANSFER,
+				HasReceiver:   true,
+				Status:        status,
+				TransferID: sql.NullString{
+					String: transferMsg.TransactionId,
+					Valid:  true,
+				},
+			})
+		})
 }
 
 func (fmh *Handler) startAwaitingFunctionsForMetrics(userOutParams *hederaHelper.UserOutParams, transferMsg *payload.Transfer, feeOutParams *hederaHelper.FeeOutParams) {
@@ -232,7 +276,7 @@ func (fmh *Handler) onMinedFeeTransactionsSetMetrics(sourceChainId, targetChainI
 		return
 	}
 
-	metrics.SetFeeTransferred(sourceChainId, targetChainId, nativeAsset, transferID, fmh.prometheusService, fmh.logger)
+	metrics.ReachStoredTransferStage(constants.FeeTransferredStage, transferID, fmh.transferRepository, fmh.prometheusService, fmh.logger)
 }
 
 func (fmh *Handler) onMinedUserTransactionSetMetrics(sourceChainId, targetChainId uint64, nativeAsset string, transferID string, isTransferSuccessful bool) {
@@ -240,5 +284,5 @@ func (fmh *Handler) onMinedUserTransactionSetMetrics(sourceChainId, targetChainI
 		return
 	}
 
-	metrics.SetUserGetHisTokens(sourceChainId, targetChainId, nativeAsset, transferID, fmh.prometheusService, fmh.logger)
+	metrics.ReachStoredTransferStage(constants.UserGetHisTokensStage, transferID, fmh.transferRepository, fmh.prometheusService, fmh.logger)
 }
@@ -17,7 +17,9 @@
 package fee_transfer
 
 import (
+	"context"
 	"errors"
+	"math/big"
 	"testing"
 
 	"github.com/hashgraph/hedera-sdk-go/v2"
@@ -43,7 +45,7 @@ var (
 		TargetAsset:      "0xb083879B1e10C8476802016CB12cd2F25a896691",
 		NativeAsset:      constants.Hbar,
 		Receiver:         "0xsomeotherethaddress",
-		Amount:           "100",
+		Amount:           big.NewInt(100),
 		NetworkTimestamp: "1",
 	}
 	accountId = hedera.AccountID{
@@ -65,6 +67,7 @@ func Test_NewHandler(t *testing.T) {
 		mocks.MFeeService,
 		mocks.MTransferService,
 		mocks.MReadOnlyService,
+		mocks.MBatchService,
 		mocks.MPrometheusService))
 }
 
@@ -85,47 +88,64 @@ func Test_Handle(t *testing.T) {
 		Fees:          []entity.Fee{},
 		Schedules:     nil,
 	}
-	mocks.MTransferService.On("InitiateNewTransfer", *tr).Return(tr, nil)
-	mocks.MFeeService.On("CalculateFee", tr.TargetAsset, int64(100)).Return(int64(10), int64(0))
-	mocks.MDistributorService.On("ValidAmount", 10).Return(int64(3))
-	mocks.MReadOnlyService.On("FindAssetTransfer", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
-	h.Handle(tr)
+	mocks.MTransferService.On("InitiateNewTransfer", mock.Anything, *tr).Return(tr, nil)
+	mocks.MFeeService.On("CalculateFee", tr.TargetAsset, big.NewInt(100)).Return(big.NewInt(10), big.NewInt(0))
+	mocks.MDistributorService.On("ValidAmount", big.NewInt(10)).Return(big.NewInt(3))
+	mocks.MReadOnlyService.On("FindAssetTransfer", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	h.Handle(context.Background(), tr)
 }
 
 func Test_Handle_FindTransfer(t *testing.T) {
 	setup()
-	mocks.MTransferService.On("InitiateNewTransfer", *tr).Return(&entity.Transfer{Status: status.Initial}, nil)
-	mocks.MFeeService.On("CalculateFee", tr.TargetAsset, int64(100)).Return(int64(10), int64(0))
-	mocks.MDistributorService.On("ValidAmount", int64(10)).Return(int64(3))
+	mocks.MTransferService.On("InitiateNewTransfer", mock.Anything, *tr).Return(&entity.Transfer{Status: status.Initial}, nil)
+	mocks.MFeeService.On("CalculateFee", tr.TargetAsset, big.NewInt(100)).Return(big.NewInt(10), big.NewInt(0))
+	mocks.MDistributorService.On("ValidAmount", big.NewInt(10)).Return(big.NewInt(3))
 	mocks.MTransferRepository.On("UpdateFee", tr.TransactionId, "3").Return(nil)
 	mocks.MDistributorService.On("CalculateMemberDistribution", int64(3)).Return([]model.Hedera{})
-	mocks.MReadOnlyService.On("FindAssetTransfer", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
-	h.Handle(tr)
+	mocks.MReadOnlyService.On("FindAssetTransfer", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	h.Handle(context.Background(), tr)
+}
+
+func Test_Handle_FindBatchedTransfer(t *testing.T) {
+	setup()
+	batchedTr := *tr
+	batchedTr.Receiver = "0.0.1337"
+	mocks.MBatchService.ExpectedCalls = nil
+	mocks.MBatchService.On("Enabled").Return(true)
+	mocks.MBatchService.On("ID", batchedTr.TargetAsset, batchedTr.Timestamp).Return("some-batch-id")
+	mocks.MTransferService.On("InitiateNewTransfer", mock.Anything, batchedTr).Return(&entity.Transfer{Status: status.Initial}, nil)
+	mocks.MFeeService.On("CalculateFee", batchedTr.TargetAsset, big.NewInt(100)).Return(big.NewInt(10), big.NewInt(0))
+	mocks.MDistributorService.On("ValidAmount", big.NewInt(10)).Return(big.NewInt(3))
+	mocks.MTransferRepository.On("UpdateFee", batchedTr.TransactionId, "3").Return(nil)
+	mocks.MReadOnlyService.On("FindTransfer", mock.Anything, "some-batch-id", mock.Anything, mock.Anything)
+	h.Handle(context.Background(), &batchedTr)
+	mocks.MReadOnlyService.AssertCalled(t, "FindTransfer", mock.Anything, "some-batch-id", mock.Anything, mock.Anything)
+	mocks.MReadOnlyService.AssertNotCalled(t, "FindAssetTransfer", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 }
 
 func Test_Handle_NotInitialFails(t *testing.T) {
 	setup()
-	mocks.MTransferService.On("InitiateNewTransfer", *tr).Return(&entity.Transfer{Status: "not-initial"}, nil)
-	h.Handle(tr)
-	mocks.MReadOnlyService.AssertNotCalled(t, "FindTransfer", mock.Anything, mock.Anything, mock.Anything)
+	mocks.MTransferService.On("InitiateNewTransfer", mock.Anything, *tr).Return(&entity.Transfer{Status: "not-initial"}, nil)
+	h.Handle(context.Background(), tr)
+	mocks.MReadOnlyService.AssertNotCalled(t, "FindTransfer", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 	mocks.MFeeService.AssertNotCalled(t, "CalculateFee", mock.Anything, mock.Anything)
 	mocks.MDistributorService.AssertNotCalled(t, "ValidAmount", mock.Anything)
 }
 
 func Test_Handle_InvalidPayload(t *testing.T) {
 	setup()
-	h.Handle("invalid-payload")
-	mocks.MTransferService.AssertNotCalled(t, "InitiateNewTransfer", *tr)
-	mocks.MReadOnlyService.AssertNotCalled(t, "FindTransfer", mock.Anything, mock.Anything, mock.Anything)
+	h.Handle(context.Background(), "invalid-payload")
+	mocks.MTransferService.AssertNotCalled(t, "InitiateNewTransfer", mock.Anything, *tr)
+	mocks.MReadOnlyService.AssertNotCalled(t, "FindTransfer", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 	mocks.MFeeService.AssertNotCalled(t, "CalculateFee", mock.Anything, mock.Anything)
 	mocks.MDistributorService.AssertNotCalled(t, "ValidAmount", mock.Anything)
 }
 
 func Test_Handle_InitiateNewTransferFails(t *testing.T) {
 	setup()
-	mocks.MTransferService.On("InitiateNewTransfer", *tr).Return(nil, errors.New("some-error"))
-	h.Handle(tr)
-	mocks.MReadOnlyService.AssertNotCalled(t, "FindTransfer", mock.Anything, mock.Anything, mock.Anything)
+	mocks.MTransferService.On("InitiateNewTransfer", mock.Anything, *tr).Return(nil, errors.New("some-error"))
+	h.Handle(context.Background(), tr)
+	mocks.MReadOnlyService.AssertNotCalled(t, "FindTransfer", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 	mocks.MFeeService.AssertNotCalled(t, "CalculateFee", mock.Anything, mock.Anything)
 	mocks.MDistributorService.AssertNotCalled(t, "ValidAmount", mock.Anything)
 }
@@ -134,6 +154,7 @@ func setup() {
 	mocks.Setup()
 
 	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+	mocks.MBatchService.On("Enabled").Return(false)
 
 	h = &Handler{
 		transferRepository: mocks.MTransferRepository,
@@ -145,6 +166,7 @@ func setup() {
 		distributorService: mocks.MDistributorService,
 		transfersService:   mocks.MTransferService,
 		readOnlyService:    mocks.MReadOnlyService,
+		batchService:       mocks.MBatchService,
 		prometheusService:  mocks.MPrometheusService,
 		logger:             config.GetLoggerFor("Hedera Fee and Schedule Transfer Read-only Handler"),
 	}
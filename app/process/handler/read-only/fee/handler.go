@@ -17,7 +17,9 @@
 package fee
 
 import (
+	"context"
 	"database/sql"
+	"fmt"
 	"strconv"
 
 	"github.com/hashgraph/hedera-sdk-go/v2"
@@ -83,37 +85,34 @@ func NewHandler(
 	}
 }
 
-func (fmh Handler) Handle(p interface{}) {
+func (fmh Handler) Handle(ctx context.Context, p interface{}) error {
 	transferMsg, ok := p.(*payload.Transfer)
 	if !ok {
-		fmh.logger.Errorf("Could not cast payload [%s]", p)
-		return
+		return fmt.Errorf("could not cast payload [%s]", p)
 	}
 
-	transactionRecord, err := fmh.transfersService.InitiateNewTransfer(*transferMsg)
+	transactionRecord, err := fmh.transfersService.InitiateNewTransfer(ctx, *transferMsg)
 	if err != nil {
 		fmh.logger.Errorf("[%s] - Error occurred while initiating processing. Error: [%s]", transferMsg.TransactionId, err)
-		return
+		return err
 	}
 
 	if transactionRecord.Status != entityStatus.Initial {
 		fmh.logger.Debugf("[%s] - Previously added with status [%s]. Skipping further execution.", transactionRecord.TransactionID, transactionRecord.Status)
-		return
+		return nil
 	}
 
-	intAmount, err := strconv.ParseInt(transferMsg.Amount, 10, 64)
+	calculatedFee, _ := fmh.feeService.CalculateFee(transferMsg.SourceAsset, transferMsg.Amount)
+	validFee, err := hederaHelper.Amount(fmh.distributor.ValidAmount(calculatedFee))
 	if err != nil {
-		fmh.logger.Errorf("[%s] - Failed to parse amount. Error: [%s]", transferMsg.TransactionId, err)
-		return
+		fmh.logger.Errorf("[%s] - Invalid fee. Error: [%s]", transferMsg.TransactionId, err)
+		return err
 	}
 
-	calculatedFee, _ := fmh.feeService.CalculateFee(transferMsg.SourceAsset, intAmount)
-	validFee := fmh.distributor.ValidAmount(calculatedFee)
-
 	err = fmh.transferRepository.UpdateFee(transferMsg.TransactionId, strconv.FormatInt(validFee, 10))
 	if err != nil {
 		fmh.logger.Errorf("[%s] - Failed to update fee [%d]. Error: [%s]", transferMsg.TransactionId, validFee, err)
-		return
+		return err
 	}
 
 	transfers, _ := fmh.distributor.CalculateMemberDistribution(validFee)
@@ -135,7 +134,7 @@ func (fmh Handler) Handle(p interface{}) {
 	for _, splitTransfer := range splitTransfers {
 		feeAmount := -splitTransfer[len(splitTransfer)-1].Amount
 
-		fmh.readOnlyService.FindAssetTransfer(transferMsg.TransactionId, transferMsg.NativeAsset, splitTransfer,
+		fmh.readOnlyService.FindAssetTransfer(ctx, transferMsg.TransactionId, transferMsg.NativeAsset, splitTransfer,
 			func() (*mirrorNodeTransaction.Response, error) {
 				return fmh.mirrorNode.GetAccountDebitTransactionsAfterTimestampString(fmh.bridgeAccount, transferMsg.NetworkTimestamp)
 			},
@@ -191,6 +190,7 @@ func (fmh Handler) Handle(p interface{}) {
 			fmh.onMinedFeeTransactionsSetMetrics,
 		)
 	}
+	return nil
 }
 
 func (fmh *Handler) onMinedFeeTransactionsSetMetrics(sourceChainId, targetChainId uint64, nativeAsset string, transferID string, isTransferSuccessful bool) {
@@ -198,5 +198,5 @@ func (fmh *Handler) onMinedFeeTransactionsSetMetrics(sourceChainId, targetChainI
 		return
 	}
 
-	metrics.SetFeeTransferred(sourceChainId, targetChainId, nativeAsset, transferID, fmh.prometheusService, fmh.logger)
+	metrics.ReachStoredTransferStage(constants.FeeTransferredStage, transferID, fmh.transferRepository, fmh.prometheusService, fmh.logger)
 }
@@ -17,7 +17,9 @@
 package mint_hts
 
 import (
+	"context"
 	"database/sql"
+	"fmt"
 
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
 
@@ -31,6 +33,7 @@ import (
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/schedule"
 	entityStatus "github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -43,6 +46,7 @@ type Handler struct {
 	payerAccount       hedera.AccountID
 	transfersService   service.Transfers
 	readOnlyService    service.ReadOnly
+	batchService       service.Batch
 	prometheusService  service.Prometheus
 	logger             *log.Entry
 }
@@ -55,6 +59,7 @@ func NewHandler(
 	mirrorNode client.MirrorNode,
 	transfersService service.Transfers,
 	readOnlyService service.ReadOnly,
+	batchService service.Batch,
 	prometheusService service.Prometheus) *Handler {
 
 	bridgeAcc, err := hedera.AccountIDFromString(bridgeAccount)
@@ -75,30 +80,31 @@ func NewHandler(
 		logger:             config.GetLoggerFor("Hedera Mint and Transfer Handler"),
 		transfersService:   transfersService,
 		readOnlyService:    readOnlyService,
+		batchService:       batchService,
 		transferRepository: transferRepository,
 		prometheusService:  prometheusService,
 	}
 }
 
-func (fmh *Handler) Handle(p interface{}) {
+func (fmh *Handler) Handle(ctx context.Context, p interface{}) error {
 	transferMsg, ok := p.(*payload.Transfer)
 	if !ok {
-		fmh.logger.Errorf("Could not cast payload [%s]", p)
-		return
+		return fmt.Errorf("could not cast payload [%s]", p)
 	}
 
-	transactionRecord, err := fmh.transfersService.InitiateNewTransfer(*transferMsg)
+	transactionRecord, err := fmh.transfersService.InitiateNewTransfer(ctx, *transferMsg)
 	if err != nil {
 		fmh.logger.Errorf("[%s] - Error occurred while initiating processing. Error: [%s]", transferMsg.TransactionId, err)
-		return
+		return err
 	}
 
 	if transactionRecord.Status != entityStatus.Initial {
 		fmh.logger.Debugf("[%s] - Previously added with status [%s]. Skipping further execution.", transactionRecord.TransactionID, transactionRecord.Status)
-		return
+		return nil
 	}
 
 	fmh.readOnlyService.FindTransfer(
+		ctx,
 		transferMsg.TransactionId,
 		func() (*mirrorNode.Response, error) {
 			return fmh.mirrorNode.GetAccountTokenMintTransactionsAfterTimestampString(fmh.bridgeAccount, transferMsg.NetworkTimestamp)
@@ -116,21 +122,28 @@ func (fmh *Handler) Handle(p interface{}) {
 			})
 		})
 
+	// Batched transfers are paid out by the scheduled transaction of their batch
+	transferID := transferMsg.TransactionId
+	if fmh.batchService.Enabled() {
+		transferID = fmh.batchService.ID(transferMsg.TargetAsset, transferMsg.Timestamp)
+	}
+
 	fmh.readOnlyService.FindTransfer(
-		transferMsg.TransactionId,
+		ctx,
+		transferID,
 		func() (*mirrorNode.Response, error) {
 			return fmh.mirrorNode.GetAccountDebitTransactionsAfterTimestampString(fmh.payerAccount, transferMsg.NetworkTimestamp)
 		},
 		func(transactionID, scheduleID, status string) error {
 
 			if status == entityStatus.Completed {
-				metrics.SetUserGetHisTokens(
+				metrics.ReachTransferStage(
+					constants.UserGetHisTokensStage,
 					transferMsg.SourceChainId,
 					transferMsg.TargetChainId,
 					transferMsg.SourceAsset,
-					transferMsg.TransactionId,
+					transferMsg.Timestamp,
 					fmh.prometheusService,
-					fmh.logger,
 				)
 
 				err = fmh.transferRepository.UpdateStatusCompleted(transferMsg.TransactionId)
@@ -154,4 +167,5 @@ func (fmh *Handler) Handle(p interface{}) {
 				},
 			})
 		})
+	return nil
 }
@@ -17,7 +17,9 @@
 package mint_hts
 
 import (
+	"context"
 	"errors"
+	"math/big"
 	"testing"
 
 	"github.com/hashgraph/hedera-sdk-go/v2"
@@ -42,7 +44,7 @@ var (
 		TargetAsset:      "0xb083879B1e10C8476802016CB12cd2F25a896691",
 		NativeAsset:      constants.Hbar,
 		Receiver:         "0xsomeotherethaddress",
-		Amount:           "100",
+		Amount:           big.NewInt(100),
 		NetworkTimestamp: "1",
 	}
 	accountId = hedera.AccountID{
@@ -58,45 +60,58 @@ func Test_NewHandler(t *testing.T) {
 		mocks.MScheduleRepository, mocks.MTransferRepository,
 		accountId.String(), accountId.String(),
 		mocks.MHederaMirrorClient, mocks.MTransferService,
-		mocks.MReadOnlyService, mocks.MPrometheusService))
+		mocks.MReadOnlyService, mocks.MBatchService, mocks.MPrometheusService))
 }
 
 func Test_Handle(t *testing.T) {
 	setup()
-	mocks.MTransferService.On("InitiateNewTransfer", *tr).Return(&entity.Transfer{Status: status.Initial}, nil)
-	mocks.MReadOnlyService.On("FindTransfer", mock.Anything, mock.Anything, mock.Anything)
-	h.Handle(tr)
+	mocks.MTransferService.On("InitiateNewTransfer", mock.Anything, *tr).Return(&entity.Transfer{Status: status.Initial}, nil)
+	mocks.MReadOnlyService.On("FindTransfer", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	h.Handle(context.Background(), tr)
 }
 
 func Test_Handle_FindTransfer(t *testing.T) {
 	setup()
-	mocks.MTransferService.On("InitiateNewTransfer", *tr).Return(&entity.Transfer{Status: status.Initial}, nil)
-	mocks.MReadOnlyService.On("FindTransfer", mock.Anything, mock.Anything, mock.Anything)
-	h.Handle(tr)
+	mocks.MTransferService.On("InitiateNewTransfer", mock.Anything, *tr).Return(&entity.Transfer{Status: status.Initial}, nil)
+	mocks.MReadOnlyService.On("FindTransfer", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	h.Handle(context.Background(), tr)
+}
+
+func Test_Handle_FindBatchedTransfer(t *testing.T) {
+	setup()
+	mocks.MBatchService.ExpectedCalls = nil
+	mocks.MBatchService.On("Enabled").Return(true)
+	mocks.MBatchService.On("ID", tr.TargetAsset, tr.Timestamp).Return("some-batch-id")
+	mocks.MTransferService.On("InitiateNewTransfer", mock.Anything, *tr).Return(&entity.Transfer{Status: status.Initial}, nil)
+	mocks.MReadOnlyService.On("FindTransfer", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	h.Handle(context.Background(), tr)
+	mocks.MReadOnlyService.AssertCalled(t, "FindTransfer", mock.Anything, tr.TransactionId, mock.Anything, mock.Anything)
+	mocks.MReadOnlyService.AssertCalled(t, "FindTransfer", mock.Anything, "some-batch-id", mock.Anything, mock.Anything)
 }
 
 func Test_Handle_NotInitialFails(t *testing.T) {
 	setup()
-	mocks.MTransferService.On("InitiateNewTransfer", *tr).Return(&entity.Transfer{Status: "not-initial"}, nil)
-	h.Handle(tr)
+	mocks.MTransferService.On("InitiateNewTransfer", mock.Anything, *tr).Return(&entity.Transfer{Status: "not-initial"}, nil)
+	h.Handle(context.Background(), tr)
 }
 
 func Test_Handle_InvalidPayload(t *testing.T) {
 	setup()
-	h.Handle("invalid-payload")
-	mocks.MTransferService.AssertNotCalled(t, "InitiateNewTransfer", *tr)
+	h.Handle(context.Background(), "invalid-payload")
+	mocks.MTransferService.AssertNotCalled(t, "InitiateNewTransfer", mock.Anything, *tr)
 }
 
 func Test_Handle_InitiateNewTransferFails(t *testing.T) {
 	setup()
-	mocks.MTransferService.On("InitiateNewTransfer", *tr).Return(nil, errors.New("some-error"))
-	h.Handle(tr)
+	mocks.MTransferService.On("InitiateNewTransfer", mock.Anything, *tr).Return(nil, errors.New("some-error"))
+	h.Handle(context.Background(), tr)
 }
 
 func setup() {
 	mocks.Setup()
 
 	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+	mocks.MBatchService.On("Enabled").Return(false)
 
 	h = &Handler{
 		bridgeAccount:      accountId,
@@ -106,6 +121,7 @@ func setup() {
 		scheduleRepository: mocks.MScheduleRepository,
 		mirrorNode:         mocks.MHederaMirrorClient,
 		readOnlyService:    mocks.MReadOnlyService,
+		batchService:       mocks.MBatchService,
 		prometheusService:  mocks.MPrometheusService,
 		logger:             config.GetLoggerFor("Hedera Mint and Transfer Handler"),
 	}
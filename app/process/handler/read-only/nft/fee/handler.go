@@ -17,7 +17,10 @@
 package fee
 
 import (
+	"context"
 	"database/sql"
+	"fmt"
+	"math/big"
 	"strconv"
 
 	"github.com/hashgraph/hedera-sdk-go/v2"
@@ -78,25 +81,25 @@ func NewHandler(
 	return instance
 }
 
-func (fmh Handler) Handle(p interface{}) {
+func (fmh Handler) Handle(ctx context.Context, p interface{}) error {
 	transferMsg, ok := p.(*payload.Transfer)
 	if !ok {
-		fmh.logger.Errorf("Could not cast payload [%s]", p)
-		return
+		return fmt.Errorf("could not cast payload [%s]", p)
 	}
 
-	transactionRecord, err := fmh.transfersService.InitiateNewTransfer(*transferMsg)
+	transactionRecord, err := fmh.transfersService.InitiateNewTransfer(ctx, *transferMsg)
 	if err != nil {
 		fmh.logger.Errorf("[%s] - Error occurred while initiating processing. Error: [%s]", transferMsg.TransactionId, err)
-		return
+		return err
 	}
 
 	if transactionRecord.Status != status.Initial {
 		fmh.logger.Debugf("[%s] - Previously added with status [%s]. Skipping further execution.", transactionRecord.TransactionID, transactionRecord.Status)
-		return
+		return nil
 	}
 
 	fmh.readOnlyService.FindNftTransfer(
+		ctx,
 		transferMsg.TransactionId,
 		transferMsg.SourceAsset,
 		transferMsg.SerialNum,
@@ -123,11 +126,11 @@ func (fmh Handler) Handle(p interface{}) {
 		},
 	)
 
-	validFee := fmh.distributor.ValidAmount(transferMsg.Fee)
+	validFee := fmh.distributor.ValidAmount(big.NewInt(transferMsg.Fee)).Int64()
 	err = fmh.transferRepository.UpdateFee(transferMsg.TransactionId, strconv.FormatInt(validFee, 10))
 	if err != nil {
 		fmh.logger.Errorf("[%s] - Failed to update fee [%d]. Error: [%s]", transferMsg.TransactionId, validFee, err)
-		return
+		return err
 	}
 
 	transfers, _ := fmh.distributor.CalculateMemberDistribution(validFee)
@@ -140,7 +143,7 @@ func (fmh Handler) Handle(p interface{}) {
 
 	for _, splitTransfer := range splitTransfers {
 		feeAmount := -splitTransfer[len(splitTransfer)-1].Amount
-		fmh.readOnlyService.FindAssetTransfer(transferMsg.TransactionId, constants.Hbar, splitTransfer,
+		fmh.readOnlyService.FindAssetTransfer(ctx, transferMsg.TransactionId, constants.Hbar, splitTransfer,
 			func() (*mirror_node.Response, error) {
 				return fmh.feeTransfersFetch(transferMsg)
 			},
@@ -148,6 +151,7 @@ func (fmh Handler) Handle(p interface{}) {
 				return fmh.feeTransfersSave(transactionID, scheduleID, status, transferMsg, feeAmount)
 			})
 	}
+	return nil
 }
 
 func (fmh Handler) feeTransfersFetch(transferMsg *payload.Transfer) (*mirror_node.Response, error) {
@@ -17,8 +17,10 @@
 package fee
 
 import (
+	"context"
 	"database/sql"
 	"errors"
+	"math/big"
 	"strconv"
 	"testing"
 	"time"
@@ -70,7 +72,7 @@ var (
 		TargetAsset:      targetAsset,
 		NativeAsset:      nativeAsset,
 		Receiver:         receiver,
-		Amount:           amount,
+		Amount:           big.NewInt(1000000000000000000),
 		SerialNum:        serialNum,
 		Metadata:         metadata,
 		IsNft:            isNft,
@@ -166,82 +168,82 @@ func Test_NewHandler_ErrOnBridgeAccountParse(t *testing.T) {
 func Test_Handle(t *testing.T) {
 	setup(t, true)
 
-	mocks.MTransferService.On("InitiateNewTransfer", *p).Return(entityTransfer, nil)
-	mocks.MDistributorService.On("ValidAmount", hederaFeeForSourceAsset).Return(validFee)
+	mocks.MTransferService.On("InitiateNewTransfer", mock.Anything, *p).Return(entityTransfer, nil)
+	mocks.MDistributorService.On("ValidAmount", big.NewInt(hederaFeeForSourceAsset)).Return(big.NewInt(validFee))
 	mocks.MTransferRepository.On("UpdateFee", transactionId, formattedValidFee).Return(nil)
 	mocks.MDistributorService.On("CalculateMemberDistribution", validFee).Return(hederaTransfers, nilErr)
-	mocks.MReadOnlyService.On("FindNftTransfer", transactionId, sourceAsset, serialNum, mock.Anything, bridgeAccountAsStr, mock.Anything)
-	mocks.MReadOnlyService.On("FindAssetTransfer", transactionId, constants.Hbar, splitTransfers[0], mock.Anything, mock.Anything)
+	mocks.MReadOnlyService.On("FindNftTransfer", mock.Anything, transactionId, sourceAsset, serialNum, mock.Anything, bridgeAccountAsStr, mock.Anything)
+	mocks.MReadOnlyService.On("FindAssetTransfer", mock.Anything, transactionId, constants.Hbar, splitTransfers[0], mock.Anything, mock.Anything)
 
-	handler.Handle(p)
+	handler.Handle(context.Background(), p)
 
-	mocks.MTransferService.AssertCalled(t, "InitiateNewTransfer", *p)
-	mocks.MDistributorService.AssertCalled(t, "ValidAmount", hederaFeeForSourceAsset)
+	mocks.MTransferService.AssertCalled(t, "InitiateNewTransfer", mock.Anything, *p)
+	mocks.MDistributorService.AssertCalled(t, "ValidAmount", big.NewInt(hederaFeeForSourceAsset))
 	mocks.MTransferRepository.AssertCalled(t, "UpdateFee", transactionId, formattedValidFee)
 	mocks.MDistributorService.AssertCalled(t, "CalculateMemberDistribution", validFee)
-	mocks.MReadOnlyService.AssertCalled(t, "FindNftTransfer", transactionId, sourceAsset, serialNum, mock.Anything, bridgeAccountAsStr, mock.Anything)
-	mocks.MReadOnlyService.AssertCalled(t, "FindAssetTransfer", transactionId, constants.Hbar, splitTransfers[0], mock.Anything, mock.Anything)
+	mocks.MReadOnlyService.AssertCalled(t, "FindNftTransfer", mock.Anything, transactionId, sourceAsset, serialNum, mock.Anything, bridgeAccountAsStr, mock.Anything)
+	mocks.MReadOnlyService.AssertCalled(t, "FindAssetTransfer", mock.Anything, transactionId, constants.Hbar, splitTransfers[0], mock.Anything, mock.Anything)
 }
 
 func Test_Handle_ErrOnCast(t *testing.T) {
 	setup(t, true)
 	brokenPayload := "not a transfer"
 
-	handler.Handle(brokenPayload)
+	handler.Handle(context.Background(), brokenPayload)
 
-	mocks.MTransferService.AssertNotCalled(t, "InitiateNewTransfer", *p)
-	mocks.MDistributorService.AssertNotCalled(t, "ValidAmount", hederaFeeForSourceAsset)
+	mocks.MTransferService.AssertNotCalled(t, "InitiateNewTransfer", mock.Anything, *p)
+	mocks.MDistributorService.AssertNotCalled(t, "ValidAmount", big.NewInt(hederaFeeForSourceAsset))
 	mocks.MTransferRepository.AssertNotCalled(t, "UpdateFee", transactionId, formattedValidFee)
 	mocks.MDistributorService.AssertNotCalled(t, "CalculateMemberDistribution", validFee)
-	mocks.MReadOnlyService.AssertNotCalled(t, "FindAssetTransfer", transactionId, constants.Hbar, splitTransfers[0], mock.Anything, mock.Anything)
+	mocks.MReadOnlyService.AssertNotCalled(t, "FindAssetTransfer", mock.Anything, transactionId, constants.Hbar, splitTransfers[0], mock.Anything, mock.Anything)
 }
 
 func Test_Handle_ErrOnTransactionRecord(t *testing.T) {
 	setup(t, true)
 	var nilTransfer *entity.Transfer
-	mocks.MTransferService.On("InitiateNewTransfer", *p).Return(nilTransfer, errors.New("failed to create transaction record"))
+	mocks.MTransferService.On("InitiateNewTransfer", mock.Anything, *p).Return(nilTransfer, errors.New("failed to create transaction record"))
 
-	handler.Handle(p)
+	handler.Handle(context.Background(), p)
 
-	mocks.MTransferService.AssertCalled(t, "InitiateNewTransfer", *p)
-	mocks.MDistributorService.AssertNotCalled(t, "ValidAmount", hederaFeeForSourceAsset)
+	mocks.MTransferService.AssertCalled(t, "InitiateNewTransfer", mock.Anything, *p)
+	mocks.MDistributorService.AssertNotCalled(t, "ValidAmount", big.NewInt(hederaFeeForSourceAsset))
 	mocks.MTransferRepository.AssertNotCalled(t, "UpdateFee", transactionId, formattedValidFee)
 	mocks.MDistributorService.AssertNotCalled(t, "CalculateMemberDistribution", validFee)
-	mocks.MReadOnlyService.AssertNotCalled(t, "FindAssetTransfer", transactionId, constants.Hbar, splitTransfers[0], mock.Anything, mock.Anything)
+	mocks.MReadOnlyService.AssertNotCalled(t, "FindAssetTransfer", mock.Anything, transactionId, constants.Hbar, splitTransfers[0], mock.Anything, mock.Anything)
 }
 
 func Test_Handle_TransactionRecordNotInitialStatus(t *testing.T) {
 	setup(t, true)
 	entityTransfer.Status = status.Completed
-	mocks.MTransferService.On("InitiateNewTransfer", *p).Return(entityTransfer, nilErr)
+	mocks.MTransferService.On("InitiateNewTransfer", mock.Anything, *p).Return(entityTransfer, nilErr)
 
-	handler.Handle(p)
+	handler.Handle(context.Background(), p)
 
 	entityTransfer.Status = entityStatus
 
-	mocks.MTransferService.AssertCalled(t, "InitiateNewTransfer", *p)
-	mocks.MDistributorService.AssertNotCalled(t, "ValidAmount", hederaFeeForSourceAsset)
+	mocks.MTransferService.AssertCalled(t, "InitiateNewTransfer", mock.Anything, *p)
+	mocks.MDistributorService.AssertNotCalled(t, "ValidAmount", big.NewInt(hederaFeeForSourceAsset))
 	mocks.MTransferRepository.AssertNotCalled(t, "UpdateFee", transactionId, formattedValidFee)
 	mocks.MDistributorService.AssertNotCalled(t, "CalculateMemberDistribution", validFee)
-	mocks.MReadOnlyService.AssertNotCalled(t, "FindAssetTransfer", transactionId, constants.Hbar, splitTransfers[0], mock.Anything, mock.Anything)
+	mocks.MReadOnlyService.AssertNotCalled(t, "FindAssetTransfer", mock.Anything, transactionId, constants.Hbar, splitTransfers[0], mock.Anything, mock.Anything)
 }
 
 func Test_Handle_ErrOnUpdateFee(t *testing.T) {
 	setup(t, true)
 
-	mocks.MTransferService.On("InitiateNewTransfer", *p).Return(entityTransfer, nil)
-	mocks.MDistributorService.On("ValidAmount", hederaFeeForSourceAsset).Return(validFee)
+	mocks.MTransferService.On("InitiateNewTransfer", mock.Anything, *p).Return(entityTransfer, nil)
+	mocks.MDistributorService.On("ValidAmount", big.NewInt(hederaFeeForSourceAsset)).Return(big.NewInt(validFee))
 	mocks.MTransferRepository.On("UpdateFee", transactionId, formattedValidFee).Return(errors.New("failed to create transaction record"))
-	mocks.MReadOnlyService.On("FindNftTransfer", transactionId, sourceAsset, serialNum, mock.Anything, bridgeAccountAsStr, mock.Anything)
+	mocks.MReadOnlyService.On("FindNftTransfer", mock.Anything, transactionId, sourceAsset, serialNum, mock.Anything, bridgeAccountAsStr, mock.Anything)
 
-	handler.Handle(p)
+	handler.Handle(context.Background(), p)
 
-	mocks.MTransferService.AssertCalled(t, "InitiateNewTransfer", *p)
-	mocks.MDistributorService.AssertCalled(t, "ValidAmount", hederaFeeForSourceAsset)
+	mocks.MTransferService.AssertCalled(t, "InitiateNewTransfer", mock.Anything, *p)
+	mocks.MDistributorService.AssertCalled(t, "ValidAmount", big.NewInt(hederaFeeForSourceAsset))
 	mocks.MTransferRepository.AssertCalled(t, "UpdateFee", transactionId, formattedValidFee)
 	mocks.MDistributorService.AssertNotCalled(t, "CalculateMemberDistribution", validFee)
-	mocks.MReadOnlyService.AssertNotCalled(t, "FindAssetTransfer", transactionId, constants.Hbar, splitTransfers[0], mock.Anything, mock.Anything)
-	mocks.MReadOnlyService.AssertCalled(t, "FindNftTransfer", transactionId, sourceAsset, serialNum, mock.Anything, bridgeAccountAsStr, mock.Anything)
+	mocks.MReadOnlyService.AssertNotCalled(t, "FindAssetTransfer", mock.Anything, transactionId, constants.Hbar, splitTransfers[0], mock.Anything, mock.Anything)
+	mocks.MReadOnlyService.AssertCalled(t, "FindNftTransfer", mock.Anything, transactionId, sourceAsset, serialNum, mock.Anything, bridgeAccountAsStr, mock.Anything)
 }
 
 func Test_fetch(t *testing.T) {
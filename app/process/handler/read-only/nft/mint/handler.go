@@ -0,0 +1,174 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mint
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	mirrorNode "github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/transaction"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/schedule"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
+	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+)
+
+// Handler is the read-only EVM native NFT lock event handler
+type Handler struct {
+	transferRepository repository.Transfer
+	scheduleRepository repository.Schedule
+	mirrorNode         client.MirrorNode
+	bridgeAccount      hedera.AccountID
+	payerAccount       hedera.AccountID
+	transfersService   service.Transfers
+	readOnlyService    service.ReadOnly
+	logger             *log.Entry
+}
+
+func NewHandler(
+	bridgeAccount string,
+	payerAccount string,
+	transferRepository repository.Transfer,
+	scheduleRepository repository.Schedule,
+	mirrorNode client.MirrorNode,
+	readOnlyService service.ReadOnly,
+	transfersService service.Transfers) *Handler {
+	bridgeAcc, err := hedera.AccountIDFromString(bridgeAccount)
+	if err != nil {
+		log.Fatalf("Invalid account id [%s]. Error: [%s]", bridgeAccount, err)
+	}
+	payerAcc, err := hedera.AccountIDFromString(payerAccount)
+	if err != nil {
+		log.Fatalf("Invalid account id [%s]. Error: [%s]", payerAccount, err)
+	}
+
+	return &Handler{
+		bridgeAccount:      bridgeAcc,
+		payerAccount:       payerAcc,
+		transferRepository: transferRepository,
+		scheduleRepository: scheduleRepository,
+		mirrorNode:         mirrorNode,
+		readOnlyService:    readOnlyService,
+		transfersService:   transfersService,
+		logger:             config.GetLoggerFor("Read-only EVM Native NFT Mint"),
+	}
+}
+
+func (rnmh Handler) Handle(ctx context.Context, p interface{}) error {
+	transfer, ok := p.(*payload.Transfer)
+	if !ok {
+		return fmt.Errorf("could not cast payload [%s]", p)
+	}
+
+	transactionRecord, err := rnmh.transfersService.InitiateNewTransfer(ctx, *transfer)
+	if err != nil {
+		rnmh.logger.Errorf("[%s] - Error occurred while initiating processing. Error: [%s]", transfer.TransactionId, err)
+		return err
+	}
+
+	if transactionRecord.Status != status.Initial {
+		rnmh.logger.Debugf("[%s] - Previously added with status [%s]. Skipping further execution.", transactionRecord.TransactionID, transactionRecord.Status)
+		return nil
+	}
+
+	minted := false
+	rnmh.readOnlyService.FindTransfer(
+		ctx,
+		transfer.TransactionId,
+		func() (*mirrorNode.Response, error) {
+			return rnmh.mirrorNode.GetAccountTokenMintTransactionsAfterTimestampString(rnmh.bridgeAccount, transfer.NetworkTimestamp)
+		},
+		func(transactionID, scheduleID, txStatus string) error {
+			err := rnmh.scheduleRepository.Create(&entity.Schedule{
+				TransactionID: transactionID,
+				ScheduleID:    scheduleID,
+				Operation:     schedule.MINT,
+				Status:        txStatus,
+				TransferID: sql.NullString{
+					String: transfer.TransactionId,
+					Valid:  true,
+				},
+			})
+			if err != nil {
+				rnmh.logger.Errorf("[%s] - Error to create scheduled entity. Error: [%s]", transactionID, err)
+				return err
+			}
+			if txStatus != status.Completed {
+				return rnmh.transferRepository.UpdateStatusFailed(transfer.TransactionId)
+			}
+
+			err = rnmh.updateWrappedSerialNumber(transfer, transactionID)
+			if err != nil {
+				rnmh.logger.Errorf("[%s] - Failed to update wrapped serial number. Error: [%s]", transfer.TransactionId, err)
+				return err
+			}
+			minted = true
+			return nil
+		},
+	)
+
+	if !minted {
+		return nil
+	}
+
+	rnmh.readOnlyService.FindScheduledNftAllowanceApprove(
+		ctx,
+		transfer,
+		rnmh.payerAccount,
+		func(transactionID, scheduleID, status string) error {
+			err := rnmh.scheduleRepository.Create(&entity.Schedule{
+				TransactionID: transactionID,
+				ScheduleID:    scheduleID,
+				Operation:     schedule.APPROVE,
+				Status:        status,
+				HasReceiver:   true,
+				TransferID: sql.NullString{
+					String: transfer.TransactionId,
+					Valid:  true,
+				},
+			})
+			if err != nil {
+				rnmh.logger.Errorf("[%s] - Error to create scheduled entity. Error: [%s]", transactionID, err)
+				return err
+			}
+			return rnmh.transferRepository.UpdateStatusCompleted(transfer.TransactionId)
+		},
+	)
+	return nil
+}
+
+// updateWrappedSerialNumber stores the serial number of the NFT, minted by the given transaction
+func (rnmh Handler) updateWrappedSerialNumber(transfer *payload.Transfer, mintTransactionID string) error {
+	tx, err := rnmh.mirrorNode.GetSuccessfulTransaction(mintTransactionID)
+	if err != nil {
+		return err
+	}
+
+	serialNum, ok := tx.GetMintedNftSerialNumber(transfer.TargetAsset)
+	if !ok {
+		return fmt.Errorf("minted serial number of [%s] not found in [%s]", transfer.TargetAsset, mintTransactionID)
+	}
+
+	return rnmh.transferRepository.UpdateWrappedSerialNumber(transfer.TransactionId, serialNum)
+}
@@ -17,7 +17,9 @@
 package transfer
 
 import (
+	"context"
 	"database/sql"
+	"fmt"
 
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
@@ -68,25 +70,25 @@ func NewHandler(
 	}
 }
 
-func (rnth Handler) Handle(p interface{}) {
+func (rnth Handler) Handle(ctx context.Context, p interface{}) error {
 	transfer, ok := p.(*payload.Transfer)
 	if !ok {
-		rnth.logger.Errorf("Could not cast payload [%s]", p)
-		return
+		return fmt.Errorf("could not cast payload [%s]", p)
 	}
 
-	transactionRecord, err := rnth.transfersService.InitiateNewTransfer(*transfer)
+	transactionRecord, err := rnth.transfersService.InitiateNewTransfer(ctx, *transfer)
 	if err != nil {
 		rnth.logger.Errorf("[%s] - Error occurred while initiating processing. Error: [%s]", transfer.TransactionId, err)
-		return
+		return err
 	}
 
 	if transactionRecord.Status != status.Initial {
 		rnth.logger.Debugf("[%s] - Previously added with status [%s]. Skipping further execution.", transactionRecord.TransactionID, transactionRecord.Status)
-		return
+		return nil
 	}
 
 	rnth.readOnlyService.FindScheduledNftAllowanceApprove(
+		ctx,
 		transfer,
 		rnth.payerAccount,
 		func(transactionID, scheduleID, status string) error {
@@ -108,4 +110,5 @@ func (rnth Handler) Handle(p interface{}) {
 			return rnth.transferRepository.UpdateStatusCompleted(transfer.TransactionId)
 		},
 	)
+	return nil
 }
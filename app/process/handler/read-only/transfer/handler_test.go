@@ -17,7 +17,9 @@
 package transfer
 
 import (
+	"context"
 	"errors"
+	"math/big"
 	"testing"
 
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
@@ -27,6 +29,7 @@ import (
 	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 var (
@@ -40,7 +43,7 @@ var (
 		TargetAsset:      "0xb083879B1e10C8476802016CB12cd2F25a896691",
 		NativeAsset:      constants.Hbar,
 		Receiver:         "0xsomeotherethaddress",
-		Amount:           "100",
+		Amount:           big.NewInt(100),
 		NetworkTimestamp: "1",
 	}
 )
@@ -52,26 +55,26 @@ func Test_NewHandler(t *testing.T) {
 
 func Test_Handle(t *testing.T) {
 	setup()
-	mocks.MTransferService.On("InitiateNewTransfer", *tr).Return(&entity.Transfer{Status: status.Initial}, nil)
-	h.Handle(tr)
+	mocks.MTransferService.On("InitiateNewTransfer", mock.Anything, *tr).Return(&entity.Transfer{Status: status.Initial}, nil)
+	h.Handle(context.Background(), tr)
 }
 
 func Test_Handle_NotInitialFails(t *testing.T) {
 	setup()
-	mocks.MTransferService.On("InitiateNewTransfer", *tr).Return(&entity.Transfer{Status: "not-initial"}, nil)
-	h.Handle(tr)
+	mocks.MTransferService.On("InitiateNewTransfer", mock.Anything, *tr).Return(&entity.Transfer{Status: "not-initial"}, nil)
+	h.Handle(context.Background(), tr)
 }
 
 func Test_Handle_InvalidPayload(t *testing.T) {
 	setup()
-	h.Handle("invalid-payload")
-	mocks.MTransferService.AssertNotCalled(t, "InitiateNewTransfer", *tr)
+	h.Handle(context.Background(), "invalid-payload")
+	mocks.MTransferService.AssertNotCalled(t, "InitiateNewTransfer", mock.Anything, *tr)
 }
 
 func Test_Handle_InitiateNewTransferFails(t *testing.T) {
 	setup()
-	mocks.MTransferService.On("InitiateNewTransfer", *tr).Return(nil, errors.New("some-error"))
-	h.Handle(tr)
+	mocks.MTransferService.On("InitiateNewTransfer", mock.Anything, *tr).Return(nil, errors.New("some-error"))
+	h.Handle(context.Background(), tr)
 }
 
 func setup() {
@@ -0,0 +1,49 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package refund
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+)
+
+// Handler refunds rejected Hedera transfers and transfers, which cannot be delivered, to their originator
+type Handler struct {
+	refundService service.Refund
+	logger        *log.Entry
+}
+
+func NewHandler(refundService service.Refund) *Handler {
+	return &Handler{
+		refundService: refundService,
+		logger:        config.GetLoggerFor("Hedera Refund Handler"),
+	}
+}
+
+func (rh Handler) Handle(ctx context.Context, p interface{}) error {
+	transferMsg, ok := p.(*payload.Transfer)
+	if !ok {
+		return fmt.Errorf("could not cast payload [%s]", p)
+	}
+
+	return rh.refundService.Refund(ctx, transferMsg.TransactionId, transferMsg.SourceAsset, transferMsg.Amount)
+}
@@ -0,0 +1,70 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package refund
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	refundHandler *Handler
+	tr            = &payload.Transfer{
+		TransactionId: "0.0.1337-1650000000-000000001",
+		SourceAsset:   "0.0.22222",
+		Amount:        big.NewInt(100),
+	}
+)
+
+func Test_NewHandler(t *testing.T) {
+	setup()
+	assert.Equal(t, refundHandler, NewHandler(mocks.MRefundService))
+}
+
+func Test_Handle(t *testing.T) {
+	setup()
+	mocks.MRefundService.On("Refund", mock.Anything, tr.TransactionId, tr.SourceAsset, big.NewInt(100)).Return(nil)
+
+	err := refundHandler.Handle(context.Background(), tr)
+	assert.Nil(t, err)
+
+	mocks.MRefundService.AssertCalled(t, "Refund", mock.Anything, tr.TransactionId, tr.SourceAsset, big.NewInt(100))
+}
+
+func Test_Handle_Encoding_Fails(t *testing.T) {
+	setup()
+
+	err := refundHandler.Handle(context.Background(), []byte{1, 2, 1})
+	assert.NotNil(t, err)
+
+	mocks.MRefundService.AssertNotCalled(t, "Refund")
+}
+
+func setup() {
+	mocks.Setup()
+	refundHandler = &Handler{
+		refundService: mocks.MRefundService,
+		logger:        config.GetLoggerFor("Hedera Refund Handler"),
+	}
+}
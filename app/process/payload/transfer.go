@@ -16,7 +16,10 @@
 
 package payload
 
-import "time"
+import (
+	"math/big"
+	"time"
+)
 
 // Transfer serves as a model between Transfer Watcher and Handler
 type Transfer struct {
@@ -28,7 +31,7 @@ type Transfer struct {
 	TargetAsset      string
 	NativeAsset      string
 	Receiver         string
-	Amount           string
+	Amount           *big.Int
 	SerialNum        int64
 	Metadata         string
 	IsNft            bool
@@ -41,7 +44,7 @@ type Transfer struct {
 // New instantiates Transfer struct ready for submission to the handler
 func New(txId string,
 	sourceChainId, targetChainId, nativeChainId uint64,
-	receiver, sourceAsset, targetAsset, nativeAsset, amount string) *Transfer {
+	receiver, sourceAsset, targetAsset, nativeAsset string, amount *big.Int) *Transfer {
 	return &Transfer{
 		TransactionId: txId,
 		SourceChainId: sourceChainId,
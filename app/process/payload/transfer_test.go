@@ -1,6 +1,7 @@
 package payload
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -12,7 +13,6 @@ const (
 	targetChainId = 1
 	nativeChainId = 0
 	receiver      = "0xreceiver"
-	amount        = "100"
 	sourceAsset   = "0.0.123"
 	nativeAsset   = "0.0.123"
 	targetAsset   = "0xwrapped00123"
@@ -21,6 +21,8 @@ const (
 	nftFee        = 10
 )
 
+var amount = big.NewInt(100)
+
 func Test_New(t *testing.T) {
 	expectedTransfer := &Transfer{
 		TransactionId: txId,
@@ -57,7 +59,7 @@ func Test_NewNft(t *testing.T) {
 		SerialNum:        serialNum,
 		Metadata:         metadata,
 		IsNft:            true,
-		Amount:           "",
+		Amount:           nil,
 		NetworkTimestamp: "",
 		Fee:              nftFee,
 	}
@@ -17,8 +17,13 @@
 package recovery
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/failure"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	log "github.com/sirupsen/logrus"
 )
@@ -56,7 +61,7 @@ func (r Recovery) checkSubmittedFees() {
 
 	for _, fee := range fees {
 		onSuccess, onRevert := r.callbacks(fee.TransactionID, true)
-		r.mirrorClient.WaitForScheduledTransaction(fee.TransactionID, onSuccess, onRevert)
+		r.mirrorClient.WaitForScheduledTransaction(context.Background(), fee.TransactionID, onSuccess, onRevert)
 	}
 }
 
@@ -69,22 +74,22 @@ func (r Recovery) checkSubmittedSchedules() {
 
 	for _, schedule := range schedules {
 		onSuccess, onRevert := r.callbacks(schedule.TransactionID, false)
-		r.mirrorClient.WaitForScheduledTransaction(schedule.TransactionID, onSuccess, onRevert)
+		r.mirrorClient.WaitForScheduledTransaction(context.Background(), schedule.TransactionID, onSuccess, onRevert)
 	}
 }
 
-func (r Recovery) callbacks(transactionID string, isFee bool) (onSuccess, onRevert func()) {
+func (r Recovery) callbacks(transactionID string, isFee bool) (onSuccess func(), onRevert func(result string)) {
 	if isFee {
 		onSuccess = func() {
-			err := r.feeRepository.UpdateStatusCompleted(transactionID)
+			err := r.feeRepository.UpdateStatus(transactionID, status.Completed, status.ActorRecovery, "fee transfer executed, as found on the mirror node")
 			if err != nil {
 				r.logger.Errorf("[%s] - Failed to update fee status completed. Error [%s].", transactionID, err)
 				return
 			}
 		}
 
-		onRevert = func() {
-			err := r.feeRepository.UpdateStatusFailed(transactionID)
+		onRevert = func(result string) {
+			err := r.feeRepository.UpdateStatus(transactionID, status.Failed, status.ActorRecovery, "fee transfer failed, as found on the mirror node")
 			if err != nil {
 				r.logger.Errorf("[%s] - Failed to update fee status failed. Error [%s].", transactionID, err)
 				return
@@ -92,15 +97,16 @@ func (r Recovery) callbacks(transactionID string, isFee bool) (onSuccess, onReve
 		}
 	} else {
 		onSuccess = func() {
-			err := r.scheduleRepository.UpdateStatusCompleted(transactionID)
+			err := r.scheduleRepository.UpdateStatus(transactionID, status.Completed, status.ActorRecovery, "scheduled transaction executed, as found on the mirror node")
 			if err != nil {
 				r.logger.Errorf("[%s] - Failed to update schedule status completed. Error [%s].", transactionID, err)
 				return
 			}
 		}
 
-		onRevert = func() {
-			err := r.scheduleRepository.UpdateStatusFailed(transactionID)
+		onRevert = func(result string) {
+			reason := fmt.Sprintf("scheduled transaction resolved with [%s], as found on the mirror node", result)
+			err := r.scheduleRepository.Fail(transactionID, failure.FromHederaResult(result), reason, status.ActorRecovery)
 			if err != nil {
 				r.logger.Errorf("[%s] - Failed to update schedule status failed. Error [%s].", transactionID, err)
 				return
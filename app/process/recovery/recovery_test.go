@@ -19,6 +19,8 @@ package recovery
 import (
 	"errors"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/failure"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
 	"github.com/stretchr/testify/assert"
@@ -27,7 +29,8 @@ import (
 )
 
 var (
-	r Recovery
+	r            Recovery
+	revertReason = "scheduled transaction resolved with [TOKEN_NOT_ASSOCIATED_TO_ACCOUNT], as found on the mirror node"
 )
 
 func Test_New(t *testing.T) {
@@ -43,16 +46,16 @@ func Test_CheckSubmittedFees(t *testing.T) {
 		Amount:        "100",
 		Status:        "some-status",
 	}}, nil)
-	mocks.MHederaMirrorClient.On("WaitForScheduledTransaction", "some-tx-id", mock.Anything, mock.Anything)
+	mocks.MHederaMirrorClient.On("WaitForScheduledTransaction", mock.Anything, "some-tx-id", mock.Anything, mock.Anything)
 	r.checkSubmittedFees()
-	mocks.MHederaMirrorClient.AssertCalled(t, "WaitForScheduledTransaction", "some-tx-id", mock.Anything, mock.Anything)
+	mocks.MHederaMirrorClient.AssertCalled(t, "WaitForScheduledTransaction", mock.Anything, "some-tx-id", mock.Anything, mock.Anything)
 }
 
 func Test_CheckSubmittedFees_GetAllSubmitedIds_Fails(t *testing.T) {
 	setup()
 	mocks.MFeeRepository.On("GetAllSubmittedIds").Return(nil, errors.New("some-error"))
 	r.checkSubmittedFees()
-	mocks.MHederaMirrorClient.AssertNotCalled(t, "WaitForScheduledTransaction", mock.Anything, mock.Anything, mock.Anything)
+	mocks.MHederaMirrorClient.AssertNotCalled(t, "WaitForScheduledTransaction", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 }
 
 func Test_CheckSubmittedSchedules(t *testing.T) {
@@ -63,16 +66,16 @@ func Test_CheckSubmittedSchedules(t *testing.T) {
 		Operation:     "some-operation",
 		Status:        "some-status",
 	}}, nil)
-	mocks.MHederaMirrorClient.On("WaitForScheduledTransaction", "some-tx-id", mock.Anything, mock.Anything)
+	mocks.MHederaMirrorClient.On("WaitForScheduledTransaction", mock.Anything, "some-tx-id", mock.Anything, mock.Anything)
 	r.checkSubmittedSchedules()
-	mocks.MHederaMirrorClient.AssertCalled(t, "WaitForScheduledTransaction", "some-tx-id", mock.Anything, mock.Anything)
+	mocks.MHederaMirrorClient.AssertCalled(t, "WaitForScheduledTransaction", mock.Anything, "some-tx-id", mock.Anything, mock.Anything)
 }
 
 func Test_CheckSubmittedSchedules_GetAllSubmitedIds_Fails(t *testing.T) {
 	setup()
 	mocks.MScheduleRepository.On("GetAllSubmittedIds").Return(nil, errors.New("some-error"))
 	r.checkSubmittedSchedules()
-	mocks.MHederaMirrorClient.AssertNotCalled(t, "WaitForScheduledTransaction", mock.Anything, mock.Anything, mock.Anything)
+	mocks.MHederaMirrorClient.AssertNotCalled(t, "WaitForScheduledTransaction", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 }
 
 func Test_CallBacks_IsFee(t *testing.T) {
@@ -80,13 +83,13 @@ func Test_CallBacks_IsFee(t *testing.T) {
 	txId := "some-id"
 	onSuccess, onRevert := r.callbacks(txId, true)
 
-	mocks.MFeeRepository.On("UpdateStatusCompleted", txId).Return(nil)
+	mocks.MFeeRepository.On("UpdateStatus", txId, status.Completed, status.ActorRecovery, "fee transfer executed, as found on the mirror node").Return(nil)
 	onSuccess()
-	mocks.MFeeRepository.AssertCalled(t, "UpdateStatusCompleted", txId)
+	mocks.MFeeRepository.AssertCalled(t, "UpdateStatus", txId, status.Completed, status.ActorRecovery, "fee transfer executed, as found on the mirror node")
 
-	mocks.MFeeRepository.On("UpdateStatusFailed", txId).Return(nil)
-	onRevert()
-	mocks.MFeeRepository.AssertCalled(t, "UpdateStatusFailed", txId)
+	mocks.MFeeRepository.On("UpdateStatus", txId, status.Failed, status.ActorRecovery, "fee transfer failed, as found on the mirror node").Return(nil)
+	onRevert("INSUFFICIENT_ACCOUNT_BALANCE")
+	mocks.MFeeRepository.AssertCalled(t, "UpdateStatus", txId, status.Failed, status.ActorRecovery, "fee transfer failed, as found on the mirror node")
 }
 
 func Test_CallBacks_IsFee_Fails(t *testing.T) {
@@ -94,13 +97,13 @@ func Test_CallBacks_IsFee_Fails(t *testing.T) {
 	txId := "some-id"
 	onSuccess, onRevert := r.callbacks(txId, true)
 
-	mocks.MFeeRepository.On("UpdateStatusCompleted", txId).Return(errors.New("some-error"))
+	mocks.MFeeRepository.On("UpdateStatus", txId, status.Completed, status.ActorRecovery, "fee transfer executed, as found on the mirror node").Return(errors.New("some-error"))
 	onSuccess()
-	mocks.MFeeRepository.AssertCalled(t, "UpdateStatusCompleted", txId)
+	mocks.MFeeRepository.AssertCalled(t, "UpdateStatus", txId, status.Completed, status.ActorRecovery, "fee transfer executed, as found on the mirror node")
 
-	mocks.MFeeRepository.On("UpdateStatusFailed", txId).Return(errors.New("some-error"))
-	onRevert()
-	mocks.MFeeRepository.AssertCalled(t, "UpdateStatusFailed", txId)
+	mocks.MFeeRepository.On("UpdateStatus", txId, status.Failed, status.ActorRecovery, "fee transfer failed, as found on the mirror node").Return(errors.New("some-error"))
+	onRevert("INSUFFICIENT_ACCOUNT_BALANCE")
+	mocks.MFeeRepository.AssertCalled(t, "UpdateStatus", txId, status.Failed, status.ActorRecovery, "fee transfer failed, as found on the mirror node")
 }
 
 func Test_CallBacks_IsNotFee(t *testing.T) {
@@ -108,13 +111,13 @@ func Test_CallBacks_IsNotFee(t *testing.T) {
 	txId := "some-id"
 	onSuccess, onRevert := r.callbacks(txId, false)
 
-	mocks.MScheduleRepository.On("UpdateStatusCompleted", txId).Return(nil)
+	mocks.MScheduleRepository.On("UpdateStatus", txId, status.Completed, status.ActorRecovery, "scheduled transaction executed, as found on the mirror node").Return(nil)
 	onSuccess()
-	mocks.MScheduleRepository.AssertCalled(t, "UpdateStatusCompleted", txId)
+	mocks.MScheduleRepository.AssertCalled(t, "UpdateStatus", txId, status.Completed, status.ActorRecovery, "scheduled transaction executed, as found on the mirror node")
 
-	mocks.MScheduleRepository.On("UpdateStatusFailed", txId).Return(nil)
-	onRevert()
-	mocks.MScheduleRepository.AssertCalled(t, "UpdateStatusFailed", txId)
+	mocks.MScheduleRepository.On("Fail", txId, failure.ReceiverNotAssociated, revertReason, status.ActorRecovery).Return(nil)
+	onRevert("TOKEN_NOT_ASSOCIATED_TO_ACCOUNT")
+	mocks.MScheduleRepository.AssertCalled(t, "Fail", txId, failure.ReceiverNotAssociated, revertReason, status.ActorRecovery)
 }
 
 func Test_CallBacks_IsNotFee_Fails(t *testing.T) {
@@ -122,13 +125,13 @@ func Test_CallBacks_IsNotFee_Fails(t *testing.T) {
 	txId := "some-id"
 	onSuccess, onRevert := r.callbacks(txId, false)
 
-	mocks.MScheduleRepository.On("UpdateStatusCompleted", txId).Return(errors.New("some-error"))
+	mocks.MScheduleRepository.On("UpdateStatus", txId, status.Completed, status.ActorRecovery, "scheduled transaction executed, as found on the mirror node").Return(errors.New("some-error"))
 	onSuccess()
-	mocks.MScheduleRepository.AssertCalled(t, "UpdateStatusCompleted", txId)
+	mocks.MScheduleRepository.AssertCalled(t, "UpdateStatus", txId, status.Completed, status.ActorRecovery, "scheduled transaction executed, as found on the mirror node")
 
-	mocks.MScheduleRepository.On("UpdateStatusFailed", txId).Return(errors.New("some-error"))
-	onRevert()
-	mocks.MScheduleRepository.AssertCalled(t, "UpdateStatusFailed", txId)
+	mocks.MScheduleRepository.On("Fail", txId, failure.ReceiverNotAssociated, revertReason, status.ActorRecovery).Return(errors.New("some-error"))
+	onRevert("TOKEN_NOT_ASSOCIATED_TO_ACCOUNT")
+	mocks.MScheduleRepository.AssertCalled(t, "Fail", txId, failure.ReceiverNotAssociated, revertReason, status.ActorRecovery)
 }
 
 func setup() {
@@ -17,6 +17,7 @@
 package assets
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"github.com/gookit/event"
@@ -24,6 +25,7 @@ import (
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
 	qi "github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	syncHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/sync"
 	bridge_config_event "github.com/limechain/hedera-eth-bridge-validator/app/model/bridge-config-event"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/constants"
@@ -75,16 +77,19 @@ func NewWatcher(
 	return instance
 }
 
-func (pw *Watcher) Watch(q qi.Queue) {
+func (pw *Watcher) Watch(ctx context.Context, q qi.Queue) {
 
 	// there will be no handler, so the q is to implement the interface
 	go func() {
 		for {
+			sleepDuration := pausedSleepTime
 			if !pw.paused {
 				pw.watchIteration()
-				time.Sleep(sleepTime)
-			} else {
-				time.Sleep(pausedSleepTime)
+				sleepDuration = sleepTime
+			}
+
+			if !syncHelper.Sleep(ctx, sleepDuration) {
+				return
 			}
 		}
 	}()
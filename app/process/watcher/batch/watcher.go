@@ -0,0 +1,59 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package batch
+
+import (
+	"context"
+	"time"
+
+	qi "github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	syncHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/sync"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+)
+
+// interval is how often due batches are checked for. Kept short, so that validators execute a batch at about the same time
+const interval = 5 * time.Second
+
+// Watcher periodically executes the persisted batches, whose windows and delays have passed
+type Watcher struct {
+	batchService service.Batch
+	interval     time.Duration
+	logger       *log.Entry
+}
+
+func NewWatcher(batchService service.Batch) *Watcher {
+	return &Watcher{
+		batchService: batchService,
+		interval:     interval,
+		logger:       config.GetLoggerFor("Batch Watcher"),
+	}
+}
+
+func (bw *Watcher) Watch(ctx context.Context, q qi.Queue) {
+	// the batch service schedules the transactions itself, so the q is to implement the interface
+	go func() {
+		for {
+			if !syncHelper.Sleep(ctx, bw.interval) {
+				return
+			}
+			bw.logger.Debugf("Executing due batches ...")
+			bw.batchService.ExecuteDue()
+		}
+	}()
+}
@@ -17,9 +17,11 @@
 package bridge_config
 
 import (
+	"context"
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	qi "github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	syncHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/sync"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	log "github.com/sirupsen/logrus"
 	"time"
@@ -41,12 +43,14 @@ func NewWatcher(svc service.BridgeConfig, topicID hedera.TopicID, pollingInterva
 	}
 }
 
-func (w *Watcher) Watch(q qi.Queue) {
+func (w *Watcher) Watch(ctx context.Context, q qi.Queue) {
 	// there will be no handler, so the q is to implement the interface
 	go func() {
 		for {
 			w.watchIteration()
-			time.Sleep(w.pollingInterval * time.Second)
+			if !syncHelper.Sleep(ctx, w.pollingInterval*time.Second) {
+				return
+			}
 		}
 	}()
 }
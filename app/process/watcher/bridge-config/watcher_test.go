@@ -17,6 +17,7 @@
 package bridge_config
 
 import (
+	"context"
 	"errors"
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	qi "github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
@@ -70,7 +71,7 @@ func Test_Watch(t *testing.T) {
 	setup()
 	mocks.MBridgeConfigService.On("ProcessLatestConfig", topicId).Return(&testConstants.ParserBridge, nil)
 
-	watcher.Watch(qi.Queue(nil))
+	watcher.Watch(context.Background(), qi.Queue(nil))
 }
 
 func setup() {
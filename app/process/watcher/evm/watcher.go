@@ -17,6 +17,7 @@
 package evm
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/big"
@@ -29,6 +30,7 @@ import (
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/evm/contracts/router"
 	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
@@ -36,20 +38,30 @@ import (
 	qi "github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
-	bigNumbersHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/big-numbers"
 	"github.com/limechain/hedera-eth-bridge-validator/app/helper/blacklist"
 	"github.com/limechain/hedera-eth-bridge-validator/app/helper/decimal"
 	"github.com/limechain/hedera-eth-bridge-validator/app/helper/evm"
+	hederaHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/hedera"
 	"github.com/limechain/hedera-eth-bridge-validator/app/helper/metrics"
+	syncHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/sync"
 	"github.com/limechain/hedera-eth-bridge-validator/app/helper/timestamp"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/tracing"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/failure"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
 	c "github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/prometheus/client_golang/prometheus"
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"gorm.io/gorm"
 )
 
 type Watcher struct {
-	repository repository.Status
+	repository         repository.Status
+	blockRepository    repository.Block
+	transferRepository repository.Transfer
 	// A unique database identifier, used as a key to track the progress
 	// of the given EVM watcher. Given that addresses between different
 	// EVM networks might be the same, a concatenation between
@@ -63,9 +75,12 @@ type Watcher struct {
 	assetsService       service.Assets
 	targetBlock         uint64
 	sleepDuration       time.Duration
+	subscribe           bool
 	validator           bool
 	filterConfig        FilterConfig
 	blacklistedAccounts []string
+	reorgsCounter       prometheus.Counter
+	metrics             *metrics.Watcher
 }
 
 // Certain node providers (Alchemy, Infura) have a limitation on how many blocks
@@ -78,6 +93,10 @@ const defaultMaxLogsBlocks = int64(500)
 // The default polling interval (in seconds) when querying for upcoming events/logs
 const defaultSleepDuration = 15 * time.Second
 
+// The amount of processed block ranges, whose last block hash is kept for detecting chain reorganisations.
+// Reorganisations deeper than the kept history cannot be rewound to their common ancestor
+const blockHistory = 128
+
 type FilterConfig struct {
 	abi               abi.ABI
 	topics            [][]common.Hash
@@ -87,12 +106,17 @@ type FilterConfig struct {
 	lockHash          common.Hash
 	unlockHash        common.Hash
 	burnERC721Hash    common.Hash
+	lockERC721Hash    common.Hash
+	unlockERC721Hash  common.Hash
+	mintERC721Hash    common.Hash
 	memberUpdatedHash common.Hash
 	maxLogsBlocks     int64
 }
 
 func NewWatcher(
 	repository repository.Status,
+	blockRepository repository.Block,
+	transferRepository repository.Transfer,
 	contracts service.Contracts,
 	prometheusService service.Prometheus,
 	pricingService service.Pricing,
@@ -103,12 +127,12 @@ func NewWatcher(
 	validator bool,
 	pollingInterval time.Duration,
 	maxLogsBlocks int64,
-	blacklistedAccounts []string) *Watcher {
-	currentBlock, err := evmClient.RetryBlockNumber()
+	blacklistedAccounts []string,
+	subscribe bool) *Watcher {
+	targetBlock, err := evmClient.RetryConfirmedBlockNumber(context.Background())
 	if err != nil {
-		log.Fatalf("Could not retrieve latest block. Error: [%s].", err)
+		log.Fatalf("Could not retrieve latest confirmed block. Error: [%s].", err)
 	}
-	targetBlock := bigNumbersHelper.Max(0, currentBlock-evmClient.BlockConfirmations())
 
 	abi, err := abi.JSON(strings.NewReader(router.RouterABI))
 	if err != nil {
@@ -121,6 +145,9 @@ func NewWatcher(
 	unlockHash := abi.Events["Unlock"].ID
 	memberUpdatedHash := abi.Events["MemberUpdated"].ID
 	burnERC721Hash := abi.Events["BurnERC721"].ID
+	lockERC721Hash := abi.Events["LockERC721"].ID
+	unlockERC721Hash := abi.Events["UnlockERC721"].ID
+	mintERC721Hash := abi.Events["MintERC721"].ID
 
 	topics := [][]common.Hash{
 		{
@@ -130,6 +157,9 @@ func NewWatcher(
 			unlockHash,
 			memberUpdatedHash,
 			burnERC721Hash,
+			lockERC721Hash,
+			unlockERC721Hash,
+			mintERC721Hash,
 		},
 	}
 
@@ -150,6 +180,9 @@ func NewWatcher(
 		lockHash:          lockHash,
 		unlockHash:        unlockHash,
 		burnERC721Hash:    burnERC721Hash,
+		lockERC721Hash:    lockERC721Hash,
+		unlockERC721Hash:  unlockERC721Hash,
+		mintERC721Hash:    mintERC721Hash,
 		memberUpdatedHash: memberUpdatedHash,
 		maxLogsBlocks:     maxLogsBlocks,
 	}
@@ -178,11 +211,27 @@ func NewWatcher(
 		if err != nil {
 			log.Fatalf("[%s] - Failed to update Transfer Watcher Status timestamp. Error [%s]", dbIdentifier, err)
 		}
+		// The processed blocks from the start block onwards are no longer known to be processed
+		err = blockRepository.DeleteFrom(dbIdentifier, startBlock)
+		if err != nil {
+			log.Fatalf("[%s] - Failed to delete processed blocks from [%d]. Error [%s]", dbIdentifier, startBlock, err)
+		}
 		targetBlock = uint64(startBlock)
 		log.Tracef("[%s] - Updated Transfer Watcher timestamp to [%s]", dbIdentifier, timestamp.ToHumanReadable(startBlock))
 	}
+
+	var reorgsCounter prometheus.Counter
+	if prometheusService.GetIsMonitoringEnabled() {
+		reorgsCounter = prometheusService.CreateCounterVecIfNotExists(prometheus.CounterOpts{
+			Name: constants.EvmReorgsCounterName,
+			Help: constants.EvmReorgsCounterHelp,
+		}, []string{constants.ChainIdMetricLabelKey}).WithLabelValues(strconv.FormatUint(evmClient.GetChainID(), 10))
+	}
+
 	return &Watcher{
 		repository:          repository,
+		blockRepository:     blockRepository,
+		transferRepository:  transferRepository,
 		dbIdentifier:        dbIdentifier,
 		contracts:           contracts,
 		prometheusService:   prometheusService,
@@ -193,45 +242,60 @@ func NewWatcher(
 		targetBlock:         targetBlock,
 		validator:           validator,
 		sleepDuration:       pollingInterval,
+		subscribe:           subscribe,
 		filterConfig:        filterConfig,
 		blacklistedAccounts: blacklistedAccounts,
+		reorgsCounter:       reorgsCounter,
+		metrics:             metrics.NewWatcher(prometheusService, evmClient.GetChainID(), dbIdentifier),
 	}
 }
 
-func (ew *Watcher) Watch(queue qi.Queue) {
-	go ew.beginWatching(queue)
+func (ew *Watcher) Watch(ctx context.Context, queue qi.Queue) {
+	go ew.beginWatching(ctx, queue)
 
 	ew.logger.Infof("Listening for events at contract [%s]", ew.dbIdentifier)
 }
 
-func (ew Watcher) beginWatching(queue qi.Queue) {
+func (ew Watcher) beginWatching(ctx context.Context, queue qi.Queue) {
 	fromBlock, err := ew.repository.Get(ew.dbIdentifier)
 	if err != nil {
 		ew.logger.Errorf("Failed to retrieve EVM Watcher Status fromBlock. Error: [%s]", err)
-		time.Sleep(ew.sleepDuration)
-		ew.beginWatching(queue)
+		if syncHelper.Sleep(ctx, ew.sleepDuration) {
+			ew.beginWatching(ctx, queue)
+		}
 		return
 	}
 
 	ew.logger.Infof("Processing events from [%d]", fromBlock)
 
-	for {
+	// In subscription mode, new heads wake the watcher up before the polling interval passes
+	var wake chan struct{}
+	if ew.subscribe {
+		wake = make(chan struct{}, 1)
+		go ew.subscribeNewHeads(ctx, wake)
+	}
+
+	for syncHelper.WaitIfPaused(ctx) {
+		pollStart := time.Now()
 		fromBlock, err := ew.repository.Get(ew.dbIdentifier)
 		if err != nil {
 			ew.logger.Errorf("Failed to retrieve EVM Watcher Status fromBlock. Error: [%s]", err)
+			ew.metrics.PollFailed(pollStart)
 			continue
 		}
 
-		currentBlock, err := ew.evmClient.RetryBlockNumber()
+		confirmedBlock, err := ew.evmClient.RetryConfirmedBlockNumber(ctx)
 		if err != nil {
-			ew.logger.Errorf("Failed to retrieve latest block number. Error [%s]", err)
-			time.Sleep(ew.sleepDuration)
+			ew.logger.Errorf("Failed to retrieve latest confirmed block number. Error [%s]", err)
+			ew.metrics.PollFailed(pollStart)
+			syncHelper.Sleep(ctx, ew.sleepDuration)
 			continue
 		}
 
-		toBlock := int64(currentBlock - ew.evmClient.BlockConfirmations())
+		toBlock := int64(confirmedBlock)
 		if fromBlock > toBlock {
-			time.Sleep(ew.sleepDuration)
+			ew.metrics.PolledEvm(pollStart, 0, fromBlock-1, int64(confirmedBlock))
+			syncHelper.SleepOrWake(ctx, ew.sleepDuration, wake)
 			continue
 		}
 
@@ -239,19 +303,144 @@ func (ew Watcher) beginWatching(queue qi.Queue) {
 			toBlock = fromBlock + ew.filterConfig.maxLogsBlocks
 		}
 
-		err = ew.processLogs(fromBlock, toBlock, queue)
+		logs, reorged, err := ew.processLogs(ctx, fromBlock, toBlock, queue)
 		if err != nil {
 			ew.logger.Errorf("Failed to process logs. Error: [%s].", err)
-			time.Sleep(ew.sleepDuration)
+			ew.metrics.PollFailed(pollStart)
+			syncHelper.Sleep(ctx, ew.sleepDuration)
+			continue
+		}
+		if reorged {
+			continue
+		}
+		ew.metrics.PolledEvm(pollStart, logs, toBlock, int64(confirmedBlock))
+
+		syncHelper.SleepOrWake(ctx, ew.sleepDuration, wake)
+	}
+
+	ew.logger.Infof("Stopped listening for events at contract [%s]", ew.dbIdentifier)
+}
+
+// subscribeNewHeads wakes the watcher up on every new head received over WebSocket.
+// While unsubscribed the watcher falls back to polling and the subscription is retried every polling interval.
+// Blocks missed in the meantime are backfilled, as the watcher always processes from the last processed block
+func (ew Watcher) subscribeNewHeads(ctx context.Context, wake chan<- struct{}) {
+	for ctx.Err() == nil {
+		heads := make(chan *types.Header)
+		subscription, err := ew.evmClient.SubscribeNewHead(ctx, heads)
+		if err != nil {
+			ew.logger.Warnf("Failed to subscribe for new heads. Falling back to polling. Error: [%s]", err)
+			syncHelper.Sleep(ctx, ew.sleepDuration)
 			continue
 		}
 
-		time.Sleep(ew.sleepDuration)
+		ew.logger.Infof("Subscribed for new heads")
+		notify(wake)
+		ew.forwardNewHeads(ctx, subscription, heads, wake)
+	}
+}
+
+// forwardNewHeads wakes the watcher up on every new head until the subscription fails or the context is cancelled
+func (ew Watcher) forwardNewHeads(ctx context.Context, subscription ethereum.Subscription, heads <-chan *types.Header, wake chan<- struct{}) {
+	defer subscription.Unsubscribe()
+
+	for {
+		select {
+		case <-heads:
+			notify(wake)
+		case err := <-subscription.Err():
+			ew.logger.Warnf("New heads subscription failed. Falling back to polling. Error: [%s]", err)
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// notify wakes the watcher up without blocking. Pending wake ups are coalesced into one
+func notify(wake chan<- struct{}) {
+	select {
+	case wake <- struct{}{}:
+	default:
 	}
 }
 
-func (ew Watcher) CheckBlacklistedOriginator(hash common.Hash) (*string, error) {
-	tx, err := ew.evmClient.RetryTransactionByHash(hash)
+// checkReorg compares the parent hash of the next block to be processed with the hash of the last processed block.
+// On mismatch, the watcher is rewound to the block after the latest processed block still part of the chain
+// and the transfers from blocks after it are marked as reorged, while the claims from these blocks are reverted.
+// Returns whether a reorganisation was handled
+func (ew Watcher) checkReorg(ctx context.Context, fromBlock int64) (bool, error) {
+	blocks, err := ew.blockRepository.GetLatest(ew.dbIdentifier, blockHistory)
+	if err != nil {
+		return false, err
+	}
+	// The previous block has not been processed by this watcher (e.g. the start block has been changed)
+	if len(blocks) == 0 || blocks[0].Number != fromBlock-1 {
+		return false, nil
+	}
+
+	header, err := ew.evmClient.RetryHeaderByNumber(ctx, big.NewInt(fromBlock))
+	if err != nil {
+		return false, err
+	}
+	if header.ParentHash.String() == blocks[0].Hash {
+		return false, nil
+	}
+
+	ew.logger.Warnf("Chain reorganisation detected at block [%d]. Expected parent hash [%s], got [%s].", fromBlock, blocks[0].Hash, header.ParentHash)
+
+	var ancestor *entity.Block
+	for _, block := range blocks[1:] {
+		header, err := ew.evmClient.RetryHeaderByNumber(ctx, big.NewInt(block.Number))
+		if err != nil {
+			return false, err
+		}
+		if header.Hash().String() == block.Hash {
+			ancestor = block
+			break
+		}
+	}
+
+	var rewindTo, since int64
+	if ancestor != nil {
+		rewindTo, since = ancestor.Number+1, ancestor.Timestamp
+	} else {
+		oldest := blocks[len(blocks)-1]
+		rewindTo, since = oldest.Number, oldest.Timestamp-1
+		ew.logger.Errorf("No common ancestor found in the last [%d] processed blocks. Rewinding to the oldest one [%d].", len(blocks), oldest.Number)
+	}
+
+	err = ew.blockRepository.DeleteFrom(ew.dbIdentifier, rewindTo)
+	if err != nil {
+		return false, err
+	}
+
+	txIds, err := ew.transferRepository.UpdateStatusReorged(ew.evmClient.GetChainID(), time.Unix(since, 0))
+	if err != nil {
+		return false, err
+	}
+
+	// The claims from the removed blocks are recorded again, if included in the new chain
+	claimTxIds, err := ew.transferRepository.UpdateStatusClaimReorged(ew.evmClient.GetChainID(), uint64(rewindTo))
+	if err != nil {
+		return false, err
+	}
+
+	err = ew.repository.Update(ew.dbIdentifier, rewindTo)
+	if err != nil {
+		return false, err
+	}
+
+	if ew.reorgsCounter != nil {
+		ew.reorgsCounter.Inc()
+	}
+	ew.logger.Warnf("Rewound to block [%d]. Marked [%d] transfers as [%s] and reverted the claims of [%d] transfers.", rewindTo, len(txIds), status.Reorged, len(claimTxIds))
+
+	return true, nil
+}
+
+func (ew Watcher) CheckBlacklistedOriginator(ctx context.Context, hash common.Hash) (*string, error) {
+	tx, err := ew.evmClient.RetryTransactionByHash(ctx, hash)
 	if err != nil {
 		err := fmt.Errorf("[%s] - Failed to get transaction by hash. Error: [%s]", hash, err)
 		return nil, err
@@ -264,14 +453,17 @@ func (ew Watcher) CheckBlacklistedOriginator(hash common.Hash) (*string, error)
 	}
 
 	if blacklist.IsBlacklistedAccount(ew.blacklistedAccounts, originator) {
-		err := fmt.Errorf("[%s] - Found blacklisted transfer receiver [%s]", hash, originator)
-		return nil, err
+		return nil, failure.Errorf(failure.Blacklisted, "[%s] - Found blacklisted transfer receiver [%s]", hash, originator)
 	}
 
 	return &originator, nil
 }
 
-func (ew Watcher) processLogs(fromBlock, endBlock int64, queue qi.Queue) error {
+// processLogs processes the logs of the router contract between the blocks, returning the number of processed logs.
+// The logs are processed only if the range follows the last processed block and its last block has not changed while
+// retrieving them, as otherwise they may come from a different chain than the one checked for reorganisations.
+// Returns whether the logs were left unprocessed due to a reorganisation
+func (ew Watcher) processLogs(ctx context.Context, fromBlock, endBlock int64, queue qi.Queue) (int, bool, error) {
 	query := ethereum.FilterQuery{
 		FromBlock: new(big.Int).SetInt64(fromBlock),
 		ToBlock:   new(big.Int).SetInt64(endBlock),
@@ -279,53 +471,40 @@ func (ew Watcher) processLogs(fromBlock, endBlock int64, queue qi.Queue) error {
 		Topics:    ew.filterConfig.topics,
 	}
 
-	logs, err := ew.evmClient.RetryFilterLogs(query)
+	// Retrieved before the logs, so that a failure does not lead to processing the same logs twice
+	header, err := ew.evmClient.RetryHeaderByNumber(ctx, query.ToBlock)
+	if err != nil {
+		ew.logger.Errorf("Failed to retrieve block [%d]. Error: [%s]", endBlock, err)
+		return 0, false, err
+	}
+
+	logs, err := ew.evmClient.RetryFilterLogs(ctx, query)
 	if err != nil {
 		ew.logger.Errorf("Failed to filter logs. Error: [%s]", err)
-		return err
+		return 0, false, err
+	}
+
+	reorged, err := ew.checkReorg(ctx, fromBlock)
+	if err != nil {
+		ew.logger.Errorf("Failed to check for chain reorganisation at block [%d]. Error: [%s]", fromBlock, err)
+		return 0, false, err
+	}
+	if reorged {
+		return 0, true, nil
+	}
+
+	current, err := ew.evmClient.RetryHeaderByNumber(ctx, query.ToBlock)
+	if err != nil {
+		ew.logger.Errorf("Failed to retrieve block [%d]. Error: [%s]", endBlock, err)
+		return 0, false, err
+	}
+	if current.Hash() != header.Hash() {
+		ew.logger.Warnf("Chain reorganisation detected at block [%d] while retrieving logs. Expected hash [%s], got [%s].", endBlock, header.Hash(), current.Hash())
+		return 0, true, nil
 	}
 
 	for _, log := range logs {
-		if len(log.Topics) > 0 {
-			if log.Topics[0] == ew.filterConfig.lockHash {
-				lock, err := ew.contracts.ParseLockLog(log)
-				if err != nil {
-					ew.logger.Errorf("Could not parse lock log [%s]. Error [%s].", lock.Raw.TxHash.String(), err)
-					continue
-				}
-				ew.handleLockLog(lock, queue)
-			} else if log.Topics[0] == ew.filterConfig.unlockHash {
-				unlock, err := ew.contracts.ParseUnlockLog(log)
-				if err != nil {
-					ew.logger.Errorf("Could not parse unlock log [%s]. Error [%s].", unlock.Raw.TxHash.String(), err)
-					continue
-				}
-				ew.handleUnlockLog(unlock)
-			} else if log.Topics[0] == ew.filterConfig.mintHash {
-				mint, err := ew.contracts.ParseMintLog(log)
-				if err != nil {
-					ew.logger.Errorf("Could not parse mint log [%s]. Error [%s].", mint.Raw.TxHash.String(), err)
-					continue
-				}
-				ew.handleMintLog(mint)
-			} else if log.Topics[0] == ew.filterConfig.burnHash {
-				burn, err := ew.contracts.ParseBurnLog(log)
-				if err != nil {
-					ew.logger.Errorf("Could not parse burn log [%s]. Error [%s].", burn.Raw.TxHash.String(), err)
-					continue
-				}
-				ew.handleBurnLog(burn, queue)
-			} else if log.Topics[0] == ew.filterConfig.memberUpdatedHash {
-				go ew.contracts.ReloadMembers()
-			} else if log.Topics[0] == ew.filterConfig.burnERC721Hash {
-				event, err := ew.contracts.ParseBurnERC721Log(log)
-				if err != nil {
-					ew.logger.Errorf("Could not parse burn ERC-721 log [%s]. Error [%s].", event.Raw.TxHash.String(), err)
-					continue
-				}
-				ew.handleBurnERC721(event, queue)
-			}
-		}
+		ew.processLog(ctx, log, queue)
 	}
 
 	// Given that the log filtering boundaries are inclusive,
@@ -333,16 +512,145 @@ func (ew Watcher) processLogs(fromBlock, endBlock int64, queue qi.Queue) error {
 	// so that processing of duplicate events does not occur
 	blockToBeUpdated := endBlock + 1
 
+	ew.saveProcessedBlock(endBlock, header.Hash().String(), int64(header.Time))
+
 	err = ew.repository.Update(ew.dbIdentifier, blockToBeUpdated)
 	if err != nil {
 		ew.logger.Errorf("Failed to update latest processed block [%d]. Error: [%s]", blockToBeUpdated, err)
-		return err
+		return 0, false, err
 	}
+	ew.metrics.ProcessedBlock(header.Time)
 
-	return nil
+	return len(logs), false, nil
+}
+
+// processLog handles the log within a span, which starts the trace of the transfer, discovered in it
+func (ew Watcher) processLog(ctx context.Context, log types.Log, queue qi.Queue) {
+	if len(log.Topics) == 0 {
+		return
+	}
+
+	ctx, span := tracing.Start(ctx, "discover log",
+		attribute.String("evm.transaction.hash", log.TxHash.String()),
+		attribute.Int64("evm.block.number", int64(log.BlockNumber)))
+	defer span.End()
+
+	if log.Topics[0] == ew.filterConfig.lockHash {
+		lock, err := ew.contracts.ParseLockLog(log)
+		if err != nil {
+			ew.logger.Errorf("Could not parse lock log [%s]. Error [%s].", lock.Raw.TxHash.String(), err)
+			return
+		}
+		ew.handleLockLog(ctx, lock, queue)
+	} else if log.Topics[0] == ew.filterConfig.unlockHash {
+		unlock, err := ew.contracts.ParseUnlockLog(log)
+		if err != nil {
+			ew.logger.Errorf("Could not parse unlock log [%s]. Error [%s].", unlock.Raw.TxHash.String(), err)
+			return
+		}
+		ew.handleUnlockLog(ctx, unlock)
+	} else if log.Topics[0] == ew.filterConfig.mintHash {
+		mint, err := ew.contracts.ParseMintLog(log)
+		if err != nil {
+			ew.logger.Errorf("Could not parse mint log [%s]. Error [%s].", mint.Raw.TxHash.String(), err)
+			return
+		}
+		ew.handleMintLog(ctx, mint)
+	} else if log.Topics[0] == ew.filterConfig.burnHash {
+		burn, err := ew.contracts.ParseBurnLog(log)
+		if err != nil {
+			ew.logger.Errorf("Could not parse burn log [%s]. Error [%s].", burn.Raw.TxHash.String(), err)
+			return
+		}
+		ew.handleBurnLog(ctx, burn, queue)
+	} else if log.Topics[0] == ew.filterConfig.memberUpdatedHash {
+		go ew.contracts.ReloadMembers()
+	} else if log.Topics[0] == ew.filterConfig.burnERC721Hash {
+		event, err := ew.contracts.ParseBurnERC721Log(log)
+		if err != nil {
+			ew.logger.Errorf("Could not parse burn ERC-721 log [%s]. Error [%s].", event.Raw.TxHash.String(), err)
+			return
+		}
+		ew.handleBurnERC721(ctx, event, queue)
+	} else if log.Topics[0] == ew.filterConfig.lockERC721Hash {
+		event, err := ew.contracts.ParseLockERC721Log(log)
+		if err != nil {
+			ew.logger.Errorf("Could not parse lock ERC-721 log [%s]. Error [%s].", log.TxHash.String(), err)
+			return
+		}
+		ew.handleLockERC721(ctx, event, queue)
+	} else if log.Topics[0] == ew.filterConfig.unlockERC721Hash {
+		event, err := ew.contracts.ParseUnlockERC721Log(log)
+		if err != nil {
+			ew.logger.Errorf("Could not parse unlock ERC-721 log [%s]. Error [%s].", log.TxHash.String(), err)
+			return
+		}
+		ew.handleUnlockERC721(ctx, event)
+	} else if log.Topics[0] == ew.filterConfig.mintERC721Hash {
+		event, err := ew.contracts.ParseMintERC721Log(log)
+		if err != nil {
+			ew.logger.Errorf("Could not parse mint ERC-721 log [%s]. Error [%s].", log.TxHash.String(), err)
+			return
+		}
+		ew.handleMintERC721(ctx, event)
+	}
+}
+
+// push pushes the transfer to the topic, along with the trace, in which it was discovered
+func (ew *Watcher) push(ctx context.Context, q qi.Queue, transfer *payload.Transfer, topic string) {
+	if !ew.reinclude(transfer) {
+		return
+	}
+
+	trace.SpanFromContext(ctx).SetAttributes(tracing.TransferAttributes(transfer)...)
+	err := q.Push(&queue.Message{Payload: transfer, Topic: topic, TraceContext: tracing.Inject(ctx)})
+	if err != nil {
+		ew.logger.Errorf("[%s] - Failed to push to topic [%s]. Error: [%s].", transfer.TransactionId, topic, err)
+	}
+}
+
+// reinclude returns a reorged transfer, whose source transaction is included again, to the status it had before the
+// reorganisation. This is the only way out of the reorged status. Returns false if the transfer could not be restored
+func (ew *Watcher) reinclude(transfer *payload.Transfer) bool {
+	existing, err := ew.transferRepository.GetByTransactionId(transfer.TransactionId)
+	if err != nil {
+		ew.logger.Errorf("[%s] - Failed to get db record. Error: [%s].", transfer.TransactionId, err)
+		return false
+	}
+	if existing == nil || existing.Status != status.Reorged {
+		return true
+	}
+
+	ew.logger.Infof("[%s] - Transaction included again after a chain reorganisation.", transfer.TransactionId)
+	_, err = ew.transferRepository.UpdateStatusReincluded(transfer)
+	if err != nil {
+		ew.logger.Errorf("[%s] - Failed to restore the reorged transaction record. Error: [%s].", transfer.TransactionId, err)
+		return false
+	}
+	return true
+}
+
+// saveProcessedBlock keeps the hash of the last processed block for detecting chain reorganisations.
+// Failures only disable the detection for the next range, hence are not returned
+func (ew Watcher) saveProcessedBlock(number int64, hash string, timestamp int64) {
+	err := ew.blockRepository.Save(&entity.Block{
+		EntityID:  ew.dbIdentifier,
+		Number:    number,
+		Hash:      hash,
+		Timestamp: timestamp,
+	})
+	if err != nil {
+		ew.logger.Errorf("Failed to save processed block [%d]. Error: [%s]", number, err)
+		return
+	}
+
+	err = ew.blockRepository.Prune(ew.dbIdentifier, blockHistory)
+	if err != nil {
+		ew.logger.Errorf("Failed to prune processed blocks. Error: [%s]", err)
+	}
 }
 
-func (ew *Watcher) handleMintLog(eventLog *router.RouterMint) {
+func (ew *Watcher) handleMintLog(ctx context.Context, eventLog *router.RouterMint) {
 	ew.logger.Infof("[%s] - New Mint Event Log received [%s]", eventLog.TransactionId, eventLog.Raw.TxHash)
 
 	if eventLog.Raw.Removed {
@@ -350,15 +658,10 @@ func (ew *Watcher) handleMintLog(eventLog *router.RouterMint) {
 		return
 	}
 
-	transactionId := string(eventLog.TransactionId)
-	sourceChainId := eventLog.SourceChain.Uint64()
-	targetChainId := ew.evmClient.GetChainID()
-	oppositeToken := ew.assetsService.OppositeAsset(sourceChainId, targetChainId, eventLog.Token.String())
-
-	metrics.SetUserGetHisTokens(sourceChainId, targetChainId, oppositeToken, transactionId, ew.prometheusService, ew.logger)
+	ew.recordClaim(ctx, string(eventLog.TransactionId), eventLog.Raw)
 }
 
-func (ew *Watcher) handleBurnLog(eventLog *router.RouterBurn, q qi.Queue) {
+func (ew *Watcher) handleBurnLog(ctx context.Context, eventLog *router.RouterBurn, q qi.Queue) {
 	ew.logger.Debugf("[%s] - New Burn Event Log received.", eventLog.Raw.TxHash)
 
 	if eventLog.Raw.Removed {
@@ -366,37 +669,51 @@ func (ew *Watcher) handleBurnLog(eventLog *router.RouterBurn, q qi.Queue) {
 		return
 	}
 
+	targetChainId := eventLog.TargetChain.Uint64()
+	transactionId := fmt.Sprintf("%s-%d", eventLog.Raw.TxHash, eventLog.Raw.Index)
+	token := eventLog.Token.String()
+	// The fields of the transfer, known at the time it is rejected
+	rejected := &payload.Transfer{
+		TargetChainId: targetChainId,
+		SourceAsset:   token,
+		Amount:        eventLog.Amount,
+	}
+
 	if len(eventLog.Receiver) == 0 {
 		ew.logger.Errorf("[%s] - Empty receiver account.", eventLog.Raw.TxHash)
+		ew.reject(ctx, eventLog.Raw, rejected, failure.InvalidReceiver, "empty receiver account")
 		return
 	}
 
 	sourceChainId := ew.evmClient.GetChainID()
-	nativeAsset := ew.assetsService.WrappedToNative(eventLog.Token.String(), sourceChainId)
+	nativeAsset := ew.assetsService.WrappedToNative(token, sourceChainId)
 	if nativeAsset == nil {
 		ew.logger.Errorf("[%s] - Failed to retrieve native asset of [%s].", eventLog.Raw.TxHash, eventLog.Token)
+		ew.reject(ctx, eventLog.Raw, rejected, failure.UnsupportedAsset, fmt.Sprintf("asset [%s] has no native asset", token))
 		return
 	}
+	rejected.NativeChainId = nativeAsset.ChainId
+	rejected.NativeAsset = nativeAsset.Asset
 
-	targetChainId := eventLog.TargetChain.Uint64()
-	transactionId := fmt.Sprintf("%s-%d", eventLog.Raw.TxHash, eventLog.Raw.Index)
-	token := eventLog.Token.String()
-
-	if ew.prometheusService.GetIsMonitoringEnabled() {
-		if targetChainId != constants.HederaNetworkId {
-			metrics.CreateMajorityReachedIfNotExists(sourceChainId, targetChainId, token, transactionId, ew.prometheusService, ew.logger)
-		} else {
-			metrics.CreateFeeTransferredIfNotExists(sourceChainId, targetChainId, token, transactionId, ew.prometheusService, ew.logger)
-		}
-
-		metrics.CreateUserGetHisTokensIfNotExists(sourceChainId, targetChainId, token, transactionId, ew.prometheusService, ew.logger)
+	if targetChainId != constants.HederaNetworkId {
+		metrics.ExpectTransferStages(sourceChainId, targetChainId, token, ew.prometheusService, constants.MajorityReachedStage, constants.UserGetHisTokensStage)
+	} else if nativeAsset.ChainId == constants.HederaNetworkId {
+		metrics.ExpectTransferStages(sourceChainId, targetChainId, token, ew.prometheusService, constants.FeeTransferredStage, constants.UserGetHisTokensStage)
+	} else {
+		metrics.ExpectTransferStages(sourceChainId, targetChainId, token, ew.prometheusService, constants.UserGetHisTokensStage)
 	}
 
+	targetAsset := nativeAsset.Asset
 	// This is the case when you are bridging wrapped to wrapped
 	if targetChainId != nativeAsset.ChainId {
-		ew.logger.Errorf("[%s] - Wrapped to Wrapped transfers currently not supported [%s] - [%d] for [%d]", eventLog.Raw.TxHash, nativeAsset.Asset, nativeAsset.ChainId, eventLog.TargetChain.Int64())
-		return
+		targetAsset = ew.assetsService.NativeToWrapped(nativeAsset.Asset, nativeAsset.ChainId, targetChainId)
+		if targetAsset == "" {
+			ew.logger.Errorf("[%s] - Failed to retrieve wrapped asset of [%s] - [%d] for [%d].", eventLog.Raw.TxHash, nativeAsset.Asset, nativeAsset.ChainId, targetChainId)
+			ew.reject(ctx, eventLog.Raw, rejected, failure.UnsupportedAsset, fmt.Sprintf("asset [%s] has no wrapped asset on chain [%d]", nativeAsset.Asset, targetChainId))
+			return
+		}
 	}
+	rejected.TargetAsset = targetAsset
 
 	recipientAccount := ""
 	var err error
@@ -404,34 +721,47 @@ func (ew *Watcher) handleBurnLog(eventLog *router.RouterBurn, q qi.Queue) {
 		recipient, err := hedera.AccountIDFromBytes(eventLog.Receiver)
 		if err != nil {
 			ew.logger.Errorf("[%s] - Failed to parse account from bytes [%v]. Error: [%s].", eventLog.Raw.TxHash, eventLog.Receiver, err)
+			ew.reject(ctx, eventLog.Raw, rejected, failure.InvalidReceiver, fmt.Sprintf("receiver [%v] is not a valid Hedera account", eventLog.Receiver))
 			return
 		}
 		recipientAccount = recipient.String()
 	} else {
 		recipientAccount = common.BytesToAddress(eventLog.Receiver).String()
 	}
+	rejected.Receiver = recipientAccount
 
-	targetAmount, err := ew.convertTargetAmount(sourceChainId, targetChainId, token, nativeAsset.Asset, eventLog.Amount)
+	targetAmount, err := ew.convertTargetAmount(sourceChainId, targetChainId, token, targetAsset, eventLog.Amount)
 	if err != nil {
 		ew.logger.Errorf("[%s] - Failed to convert to target amount. Error: [%s]", eventLog.Raw.TxHash, err)
+		var rejection *failure.Error
+		if errors.As(err, &rejection) {
+			ew.reject(ctx, eventLog.Raw, rejected, rejection.Code, rejection.Reason)
+		}
 		return
 	}
+	rejected.Amount = targetAmount
 
-	tokenPriceInfo, exist := ew.pricingService.GetTokenPriceInfo(targetChainId, nativeAsset.Asset)
+	tokenPriceInfo, exist := ew.pricingService.GetTokenPriceInfo(targetChainId, targetAsset)
 	if !exist {
-		ew.logger.Errorf("[%s] - Couldn't get price info in USD for asset [%s].", eventLog.Raw.TxHash, nativeAsset.Asset)
+		ew.logger.Errorf("[%s] - Couldn't get price info in USD for asset [%s].", eventLog.Raw.TxHash, targetAsset)
+		ew.reject(ctx, eventLog.Raw, rejected, failure.PriceUnavailable, fmt.Sprintf("couldn't get price info in USD for asset [%s]", targetAsset))
 		return
 	}
 
 	if targetAmount.Cmp(tokenPriceInfo.MinAmountWithFee) < 0 {
 		ew.logger.Errorf("[%s] - Transfer Amount [%s] less than Minimum Amount [%s].", eventLog.Raw.TxHash, targetAmount, tokenPriceInfo.MinAmountWithFee)
+		ew.reject(ctx, eventLog.Raw, rejected, failure.AmountBelowMinimum, fmt.Sprintf("transfer amount [%s] is less than minimum amount [%s]", targetAmount, tokenPriceInfo.MinAmountWithFee))
 		return
 	}
 
 	blockTimestamp := ew.evmClient.GetBlockTimestamp(big.NewInt(int64(eventLog.Raw.BlockNumber)))
-	originator, err := ew.CheckBlacklistedOriginator(eventLog.Raw.TxHash)
+	originator, err := ew.CheckBlacklistedOriginator(ctx, eventLog.Raw.TxHash)
 	if err != nil {
 		ew.logger.Error(err)
+		var rejection *failure.Error
+		if errors.As(err, &rejection) {
+			ew.reject(ctx, eventLog.Raw, rejected, rejection.Code, rejection.Reason)
+		}
 		return
 	}
 
@@ -441,10 +771,10 @@ func (ew *Watcher) handleBurnLog(eventLog *router.RouterBurn, q qi.Queue) {
 		TargetChainId: targetChainId,
 		NativeChainId: nativeAsset.ChainId,
 		SourceAsset:   token,
-		TargetAsset:   nativeAsset.Asset,
+		TargetAsset:   targetAsset,
 		NativeAsset:   nativeAsset.Asset,
 		Receiver:      recipientAccount,
-		Amount:        targetAmount.String(),
+		Amount:        targetAmount,
 		Originator:    *originator,
 		Timestamp:     time.Unix(int64(blockTimestamp), 0).UTC(),
 	}
@@ -456,23 +786,53 @@ func (ew *Watcher) handleBurnLog(eventLog *router.RouterBurn, q qi.Queue) {
 
 	currentBlockNumber := eventLog.Raw.BlockNumber
 
+	// Wrapped to wrapped transfers to Hedera are minted, as the native asset is not held by the bridge account
+	wrappedToHedera := burnEvent.TargetChainId == constants.HederaNetworkId && burnEvent.NativeChainId != constants.HederaNetworkId
 	if ew.validator && currentBlockNumber >= ew.targetBlock {
-		if burnEvent.TargetChainId == constants.HederaNetworkId {
-			q.Push(&queue.Message{Payload: burnEvent, Topic: constants.HederaFeeTransfer})
+		if wrappedToHedera {
+			ew.push(ctx, q, burnEvent, constants.HederaMintHtsTransfer)
+		} else if burnEvent.TargetChainId == constants.HederaNetworkId {
+			ew.push(ctx, q, burnEvent, constants.HederaFeeTransfer)
 		} else {
-			q.Push(&queue.Message{Payload: burnEvent, Topic: constants.TopicMessageSubmission})
+			ew.push(ctx, q, burnEvent, constants.TopicMessageSubmission)
 		}
 	} else {
 		burnEvent.NetworkTimestamp = strconv.FormatUint(blockTimestamp, 10)
-		if burnEvent.TargetChainId == constants.HederaNetworkId {
-			q.Push(&queue.Message{Payload: burnEvent, Topic: constants.ReadOnlyHederaTransfer})
+		if wrappedToHedera {
+			ew.push(ctx, q, burnEvent, constants.ReadOnlyHederaMintHtsTransfer)
+		} else if burnEvent.TargetChainId == constants.HederaNetworkId {
+			ew.push(ctx, q, burnEvent, constants.ReadOnlyHederaTransfer)
 		} else {
-			q.Push(&queue.Message{Payload: burnEvent, Topic: constants.ReadOnlyTransferSave})
+			ew.push(ctx, q, burnEvent, constants.ReadOnlyTransferSave)
 		}
 	}
 }
 
-func (ew *Watcher) handleLockLog(eventLog *router.RouterLock, q qi.Queue) {
+// reject records the event as a transfer, failed with the given code, instead of dropping it
+func (ew *Watcher) reject(ctx context.Context, raw types.Log, rejected *payload.Transfer, code failure.Code, reason string) {
+	tx, err := ew.evmClient.RetryTransactionByHash(ctx, raw.TxHash)
+	if err != nil {
+		ew.logger.Errorf("[%s] - Failed to get transaction by hash. Error: [%s]", raw.TxHash, err)
+		return
+	}
+	originator, err := evm.OriginatorFromTx(tx)
+	if err != nil {
+		ew.logger.Errorf("[%s] - Failed to get originator. Error: [%s]", raw.TxHash, err)
+		return
+	}
+	blockTimestamp := ew.evmClient.GetBlockTimestamp(big.NewInt(int64(raw.BlockNumber)))
+
+	rejected.TransactionId = fmt.Sprintf("%s-%d", raw.TxHash, raw.Index)
+	rejected.SourceChainId = ew.evmClient.GetChainID()
+	rejected.Originator = originator
+	rejected.Timestamp = time.Unix(int64(blockTimestamp), 0).UTC()
+	_, err = ew.transferRepository.Reject(rejected, code, reason)
+	if err != nil {
+		ew.logger.Errorf("[%s] - Failed to record rejected transfer. Error: [%s]", rejected.TransactionId, err)
+	}
+}
+
+func (ew *Watcher) handleLockLog(ctx context.Context, eventLog *router.RouterLock, q qi.Queue) {
 	ew.logger.Debugf("[%s] - New Lock Event Log received.", eventLog.Raw.TxHash)
 
 	transactionId := fmt.Sprintf("%s-%d", eventLog.Raw.TxHash, eventLog.Raw.Index)
@@ -491,9 +851,10 @@ func (ew *Watcher) handleLockLog(eventLog *router.RouterLock, q qi.Queue) {
 
 	sourceChainId := ew.evmClient.GetChainID()
 	if targetChainId != constants.HederaNetworkId {
-		metrics.CreateMajorityReachedIfNotExists(sourceChainId, targetChainId, token, transactionId, ew.prometheusService, ew.logger)
+		metrics.ExpectTransferStages(sourceChainId, targetChainId, token, ew.prometheusService, constants.MajorityReachedStage, constants.UserGetHisTokensStage)
+	} else {
+		metrics.ExpectTransferStages(sourceChainId, targetChainId, token, ew.prometheusService, constants.UserGetHisTokensStage)
 	}
-	metrics.CreateUserGetHisTokensIfNotExists(sourceChainId, targetChainId, token, transactionId, ew.prometheusService, ew.logger)
 
 	recipientAccount := ""
 	var err error
@@ -518,6 +879,19 @@ func (ew *Watcher) handleLockLog(eventLog *router.RouterLock, q qi.Queue) {
 	targetAmount, err := ew.convertTargetAmount(sourceChainId, targetChainId, token, wrappedAsset, amount)
 	if err != nil {
 		ew.logger.Errorf("[%s] - Failed to convert to target amount. Error: [%s]", eventLog.Raw.TxHash, err)
+		var rejection *failure.Error
+		if errors.As(err, &rejection) {
+			rejected := &payload.Transfer{
+				TargetChainId: targetChainId,
+				NativeChainId: sourceChainId,
+				SourceAsset:   token,
+				TargetAsset:   wrappedAsset,
+				NativeAsset:   token,
+				Receiver:      recipientAccount,
+				Amount:        amount,
+			}
+			ew.reject(ctx, eventLog.Raw, rejected, rejection.Code, rejection.Reason)
+		}
 		return
 	}
 
@@ -534,7 +908,7 @@ func (ew *Watcher) handleLockLog(eventLog *router.RouterLock, q qi.Queue) {
 	}
 
 	blockTimestamp := ew.evmClient.GetBlockTimestamp(big.NewInt(int64(eventLog.Raw.BlockNumber)))
-	originator, err := ew.CheckBlacklistedOriginator(eventLog.Raw.TxHash)
+	originator, err := ew.CheckBlacklistedOriginator(ctx, eventLog.Raw.TxHash)
 	if err != nil {
 		ew.logger.Error(err)
 		return
@@ -549,7 +923,7 @@ func (ew *Watcher) handleLockLog(eventLog *router.RouterLock, q qi.Queue) {
 		TargetAsset:   wrappedAsset,
 		NativeAsset:   token,
 		Receiver:      recipientAccount,
-		Amount:        targetAmount.String(),
+		Amount:        targetAmount,
 		Originator:    *originator,
 		Timestamp:     time.Unix(int64(blockTimestamp), 0).UTC(),
 	}
@@ -565,21 +939,21 @@ func (ew *Watcher) handleLockLog(eventLog *router.RouterLock, q qi.Queue) {
 
 	if ew.validator && currentBlockNumber >= ew.targetBlock {
 		if tr.TargetChainId == constants.HederaNetworkId {
-			q.Push(&queue.Message{Payload: tr, Topic: constants.HederaMintHtsTransfer})
+			ew.push(ctx, q, tr, constants.HederaMintHtsTransfer)
 		} else {
-			q.Push(&queue.Message{Payload: tr, Topic: constants.TopicMessageSubmission})
+			ew.push(ctx, q, tr, constants.TopicMessageSubmission)
 		}
 	} else {
 		tr.NetworkTimestamp = strconv.FormatUint(blockTimestamp, 10)
 		if tr.TargetChainId == constants.HederaNetworkId {
-			q.Push(&queue.Message{Payload: tr, Topic: constants.ReadOnlyHederaMintHtsTransfer})
+			ew.push(ctx, q, tr, constants.ReadOnlyHederaMintHtsTransfer)
 		} else {
-			q.Push(&queue.Message{Payload: tr, Topic: constants.ReadOnlyTransferSave})
+			ew.push(ctx, q, tr, constants.ReadOnlyTransferSave)
 		}
 	}
 }
 
-func (ew *Watcher) handleBurnERC721(eventLog *router.RouterBurnERC721, q qi.Queue) {
+func (ew *Watcher) handleBurnERC721(ctx context.Context, eventLog *router.RouterBurnERC721, q qi.Queue) {
 	ew.logger.Debugf("[%s] - New Burn ERC-721 Event Log received.", eventLog.Raw.TxHash)
 
 	if eventLog.Raw.Removed {
@@ -621,7 +995,7 @@ func (ew *Watcher) handleBurnERC721(eventLog *router.RouterBurnERC721, q qi.Queu
 
 	blockTimestamp := ew.evmClient.GetBlockTimestamp(big.NewInt(int64(eventLog.Raw.BlockNumber)))
 
-	originator, err := ew.CheckBlacklistedOriginator(eventLog.Raw.TxHash)
+	originator, err := ew.CheckBlacklistedOriginator(ctx, eventLog.Raw.TxHash)
 	if err != nil {
 		ew.logger.Error(err)
 		return
@@ -651,7 +1025,7 @@ func (ew *Watcher) handleBurnERC721(eventLog *router.RouterBurnERC721, q qi.Queu
 
 	if ew.validator && currentBlockNumber >= ew.targetBlock {
 		if transfer.TargetChainId == constants.HederaNetworkId {
-			q.Push(&queue.Message{Payload: transfer, Topic: constants.HederaNftTransfer})
+			ew.push(ctx, q, transfer, constants.HederaNftTransfer)
 		} else {
 			ew.logger.Errorf("[%s] - NFT Transfer to TargetChain different than [%d]. Not supported.", transfer.TransactionId, constants.HederaNetworkId)
 			return
@@ -659,7 +1033,7 @@ func (ew *Watcher) handleBurnERC721(eventLog *router.RouterBurnERC721, q qi.Queu
 	} else {
 		transfer.NetworkTimestamp = strconv.FormatUint(blockTimestamp, 10)
 		if transfer.TargetChainId == constants.HederaNetworkId {
-			q.Push(&queue.Message{Payload: transfer, Topic: constants.ReadOnlyHederaUnlockNftTransfer})
+			ew.push(ctx, q, transfer, constants.ReadOnlyHederaUnlockNftTransfer)
 		} else {
 			ew.logger.Errorf("[%s] - Read-only NFT Transfer to TargetChain different than [%d]. Not supported.", transfer.TransactionId, constants.HederaNetworkId)
 			return
@@ -667,7 +1041,122 @@ func (ew *Watcher) handleBurnERC721(eventLog *router.RouterBurnERC721, q qi.Queu
 	}
 }
 
-func (ew *Watcher) handleUnlockLog(eventLog *router.RouterUnlock) {
+func (ew *Watcher) handleLockERC721(ctx context.Context, eventLog *router.RouterLockERC721, q qi.Queue) {
+	ew.logger.Debugf("[%s] - New Lock ERC-721 Event Log received.", eventLog.Raw.TxHash)
+
+	if eventLog.Raw.Removed {
+		ew.logger.Debugf("[%s] - Uncle block transaction was removed.", eventLog.Raw.TxHash)
+		return
+	}
+
+	if len(eventLog.Receiver) == 0 {
+		ew.logger.Errorf("[%s] - Empty receiver account.", eventLog.Raw.TxHash)
+		return
+	}
+
+	transactionId := fmt.Sprintf("%s-%d", eventLog.Raw.TxHash, eventLog.Raw.Index)
+	sourceChainId := ew.evmClient.GetChainID()
+	targetChainId := eventLog.TargetChain.Uint64()
+	token := eventLog.Token.String()
+
+	// EVM native NFTs are bridged only as wrapped HTS NFTs
+	if targetChainId != constants.HederaNetworkId {
+		ew.logger.Errorf("[%s] - NFT Lock to TargetChain different than [%d]. Not supported.", transactionId, constants.HederaNetworkId)
+		return
+	}
+
+	wrappedAsset := ew.assetsService.NativeToWrapped(token, sourceChainId, targetChainId)
+	if wrappedAsset == "" {
+		ew.logger.Errorf("[%s] - Failed to retrieve wrapped asset of [%s].", eventLog.Raw.TxHash, eventLog.Token)
+		return
+	}
+
+	// HTS serial numbers are 64-bit, so larger ERC-721 token ids cannot be minted as wrapped NFTs
+	if !eventLog.TokenId.IsInt64() || eventLog.TokenId.Sign() < 0 {
+		ew.logger.Errorf("[%s] - Token ID [%s] does not fit a Hedera serial number.", eventLog.Raw.TxHash, eventLog.TokenId)
+		rejected := &payload.Transfer{
+			TargetChainId: targetChainId,
+			NativeChainId: sourceChainId,
+			SourceAsset:   token,
+			TargetAsset:   wrappedAsset,
+			NativeAsset:   token,
+			IsNft:         true,
+			Metadata:      eventLog.Metadata,
+		}
+		ew.reject(ctx, eventLog.Raw, rejected, failure.InvalidTokenId, fmt.Sprintf("token id [%s] does not fit a Hedera serial number", eventLog.TokenId))
+		return
+	}
+
+	recipient, err := hedera.AccountIDFromBytes(eventLog.Receiver)
+	if err != nil {
+		ew.logger.Errorf("[%s] - Failed to parse account from bytes [%v]. Error: [%s].", eventLog.Raw.TxHash, eventLog.Receiver, err)
+		return
+	}
+
+	metrics.ExpectTransferStages(sourceChainId, targetChainId, token, ew.prometheusService, constants.UserGetHisTokensStage)
+
+	blockTimestamp := ew.evmClient.GetBlockTimestamp(big.NewInt(int64(eventLog.Raw.BlockNumber)))
+
+	originator, err := ew.CheckBlacklistedOriginator(ctx, eventLog.Raw.TxHash)
+	if err != nil {
+		ew.logger.Error(err)
+		return
+	}
+
+	transfer := &payload.Transfer{
+		TransactionId: transactionId,
+		SourceChainId: sourceChainId,
+		TargetChainId: targetChainId,
+		NativeChainId: sourceChainId,
+		SourceAsset:   token,
+		TargetAsset:   wrappedAsset,
+		NativeAsset:   token,
+		Receiver:      recipient.String(),
+		IsNft:         true,
+		SerialNum:     eventLog.TokenId.Int64(),
+		Metadata:      eventLog.Metadata,
+		Originator:    *originator,
+		Timestamp:     time.Unix(int64(blockTimestamp), 0).UTC(),
+	}
+
+	ew.logger.Infof("[%s] - New Lock ERC-721 Event Log with TokenId [%d], Receiver Address [%s] has been found.",
+		eventLog.Raw.TxHash.String(),
+		eventLog.TokenId.Int64(),
+		transfer.Receiver)
+
+	currentBlockNumber := eventLog.Raw.BlockNumber
+
+	if ew.validator && currentBlockNumber >= ew.targetBlock {
+		ew.push(ctx, q, transfer, constants.HederaMintNftTransfer)
+	} else {
+		transfer.NetworkTimestamp = strconv.FormatUint(blockTimestamp, 10)
+		ew.push(ctx, q, transfer, constants.ReadOnlyHederaMintNftTransfer)
+	}
+}
+
+func (ew *Watcher) handleUnlockERC721(ctx context.Context, eventLog *router.RouterUnlockERC721) {
+	ew.logger.Infof("[%s] - New Unlock ERC-721 Event Log received [%s].", eventLog.TransactionId, eventLog.Raw.TxHash)
+
+	if eventLog.Raw.Removed {
+		ew.logger.Errorf("[%s] - Uncle block transaction was removed.", eventLog.Raw.TxHash)
+		return
+	}
+
+	ew.recordClaim(ctx, string(eventLog.TransactionId), eventLog.Raw)
+}
+
+func (ew *Watcher) handleMintERC721(ctx context.Context, eventLog *router.RouterMintERC721) {
+	ew.logger.Infof("[%s] - New Mint ERC-721 Event Log received [%s].", eventLog.TransactionId, eventLog.Raw.TxHash)
+
+	if eventLog.Raw.Removed {
+		ew.logger.Errorf("[%s] - Uncle block transaction was removed.", eventLog.Raw.TxHash)
+		return
+	}
+
+	ew.recordClaim(ctx, string(eventLog.TransactionId), eventLog.Raw)
+}
+
+func (ew *Watcher) handleUnlockLog(ctx context.Context, eventLog *router.RouterUnlock) {
 	ew.logger.Infof("[%s] - New Unlock Event Log received [%s].", eventLog.TransactionId, eventLog.Raw.TxHash)
 
 	if eventLog.Raw.Removed {
@@ -675,28 +1164,54 @@ func (ew *Watcher) handleUnlockLog(eventLog *router.RouterUnlock) {
 		return
 	}
 
-	transactionId := string(eventLog.TransactionId)
-	sourceChainId := eventLog.SourceChain.Uint64()
-	targetChainId := ew.evmClient.GetChainID()
-	oppositeToken := ew.assetsService.OppositeAsset(sourceChainId, targetChainId, eventLog.Token.String())
+	ew.recordClaim(ctx, string(eventLog.TransactionId), eventLog.Raw)
+}
+
+// recordClaim persists the transaction, in which the receiver claimed the given transfer on the EVM chain
+func (ew *Watcher) recordClaim(ctx context.Context, transactionId string, raw types.Log) {
+	tx, err := ew.evmClient.RetryTransactionByHash(ctx, raw.TxHash)
+	if err != nil {
+		ew.logger.Errorf("[%s] - Failed to get claim transaction [%s]. Error: [%s]", transactionId, raw.TxHash, err)
+		return
+	}
+
+	claimer, err := evm.OriginatorFromTx(tx)
+	if err != nil {
+		ew.logger.Errorf("[%s] - Failed to get sender of claim transaction [%s]. Error: [%s]", transactionId, raw.TxHash, err)
+		return
+	}
 
-	metrics.SetUserGetHisTokens(sourceChainId, targetChainId, oppositeToken, transactionId, ew.prometheusService, ew.logger)
+	blockTimestamp := ew.evmClient.GetBlockTimestamp(new(big.Int).SetUint64(raw.BlockNumber))
+	err = ew.transferRepository.UpdateStatusClaimed(transactionId, raw.TxHash.String(), raw.BlockNumber, int64(blockTimestamp), claimer)
+	if err != nil {
+		ew.logger.Errorf("[%s] - Failed to update status claimed. Error: [%s]", transactionId, err)
+		return
+	}
+
+	metrics.ReachStoredTransferStage(constants.UserGetHisTokensStage, transactionId, ew.transferRepository, ew.prometheusService, ew.logger)
 }
 
 func (ew *Watcher) convertTargetAmount(sourceChainId, targetChainId uint64, sourceAsset, targetAsset string, amount *big.Int) (*big.Int, error) {
 	sourceAssetInfo, exists := ew.assetsService.FungibleAssetInfo(sourceChainId, sourceAsset)
 	if !exists {
-		return nil, fmt.Errorf("failed to retrieve fungible asset info of [%s]", sourceAsset)
+		return nil, failure.Errorf(failure.UnsupportedAsset, "failed to retrieve fungible asset info of [%s]", sourceAsset)
 	}
 
 	targetAssetInfo, exists := ew.assetsService.FungibleAssetInfo(targetChainId, targetAsset)
 	if !exists {
-		return nil, fmt.Errorf("failed to retrieve fungible asset info of [%s]", targetAsset)
+		return nil, failure.Errorf(failure.UnsupportedAsset, "failed to retrieve fungible asset info of [%s]", targetAsset)
 	}
 
 	targetAmount := decimal.TargetAmount(sourceAssetInfo.Decimals, targetAssetInfo.Decimals, amount)
 	if targetAmount.Cmp(big.NewInt(0)) == 0 {
-		return nil, fmt.Errorf("insufficient amount provided: Event Amount [%s] and Target Amount [%s]", amount, targetAmount)
+		return nil, failure.Errorf(failure.AmountBelowMinimum, "insufficient amount provided: Event Amount [%s] and Target Amount [%s]", amount, targetAmount)
+	}
+
+	// Amounts to Hedera must fit the 64-bit amounts of the scheduled transactions, transferring or minting them
+	if targetChainId == constants.HederaNetworkId {
+		if _, err := hederaHelper.Amount(targetAmount); err != nil {
+			return nil, err
+		}
 	}
 
 	return targetAmount, nil
@@ -17,28 +17,37 @@
 package evm
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/big"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/evm/contracts/router"
 	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/tracing"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/asset"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/pricing"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/failure"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/limechain/hedera-eth-bridge-validator/test/helper"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var (
@@ -62,16 +71,20 @@ var (
 		Amount:      big.NewInt(1),
 	}
 
-	hederaAcc, _   = hedera.AccountIDFromString("0.0.123456")
-	hederaBytes    = hederaAcc.ToBytes()
-	dbIdentifier   = "3-0x0000000000000000000000000000000000000001"
-	mintHash       = common.HexToHash("0579df6e9dbf066ba9fbd51ef5241e2b9f9c042a70289e8e5333d714ed4e5787")
-	burnHash       = common.HexToHash("97715804dcd62a721835eaba4356dc90eaf6d442a12fe944f01bbf5f8c0b8992")
-	lockHash       = common.HexToHash("aa3a3bc72b8c754ca6ee8425a5531bafec37569ec012d62d5f682ca909ae06f1")
-	unlockHash     = common.HexToHash("483dd9d090112259cd3c44a9af4b3386be4b4b87145e6bf85bc0964a06062a73")
-	membersHash    = common.HexToHash("30f1d11f11278ba2cc669fd4c95ee8d46ede2c82f6af0b74e4f427369b3522d3")
-	burnERC721Hash = common.HexToHash("eb703661daf51ce0c247ebbf71a8747e6a79f36b2e93a4e5a22f191321e5750e")
-	topics         = [][]common.Hash{
+	hederaAcc, _     = hedera.AccountIDFromString("0.0.123456")
+	hederaBytes      = hederaAcc.ToBytes()
+	dbIdentifier     = "3-0x0000000000000000000000000000000000000001"
+	claimedTxId      = "0.0.123456-1650000000-000000001"
+	mintHash         = common.HexToHash("0579df6e9dbf066ba9fbd51ef5241e2b9f9c042a70289e8e5333d714ed4e5787")
+	burnHash         = common.HexToHash("97715804dcd62a721835eaba4356dc90eaf6d442a12fe944f01bbf5f8c0b8992")
+	lockHash         = common.HexToHash("aa3a3bc72b8c754ca6ee8425a5531bafec37569ec012d62d5f682ca909ae06f1")
+	unlockHash       = common.HexToHash("483dd9d090112259cd3c44a9af4b3386be4b4b87145e6bf85bc0964a06062a73")
+	membersHash      = common.HexToHash("30f1d11f11278ba2cc669fd4c95ee8d46ede2c82f6af0b74e4f427369b3522d3")
+	burnERC721Hash   = common.HexToHash("eb703661daf51ce0c247ebbf71a8747e6a79f36b2e93a4e5a22f191321e5750e")
+	lockERC721Hash   = common.HexToHash("3566f658a89ec549e0d6a88e80a82f613f1235ce3ae9076b5cc63a489215ab2e")
+	unlockERC721Hash = common.HexToHash("17b46aeb516812acd151ad4cb2b080d0a2773efbc8699cd37f050e3274dd75b5")
+	mintERC721Hash   = common.HexToHash("554e454827c1d5586725e215a4b857d15071ba1d639f920082c5bf63b68de8b8")
+	topics           = [][]common.Hash{
 		{
 			mintHash,
 			burnHash,
@@ -79,6 +92,9 @@ var (
 			unlockHash,
 			membersHash,
 			burnERC721Hash,
+			lockERC721Hash,
+			unlockERC721Hash,
+			mintERC721Hash,
 		},
 	}
 	filterConfig = FilterConfig{
@@ -95,17 +111,19 @@ var (
 	}
 
 	nilNativeAsset       *asset.NativeAsset
+	nilTransfer          *entity.Transfer
 	hbarNativeAsset      = &asset.NativeAsset{ChainId: targetChainId, Asset: constants.Hbar}
 	fungibleAssetInfo    = &asset.FungibleAssetInfo{Decimals: 8}
 	evmFungibleAssetInfo = &asset.FungibleAssetInfo{Decimals: 18}
 	tokenPriceInfo       = pricing.TokenPriceInfo{decimal.NewFromFloat(20), big.NewInt(10000), big.NewInt(10000)}
+	header               = &types.Header{Number: big.NewInt(0), Time: 1}
 )
 
 func Test_HandleLockLog_Removed_Fails(t *testing.T) {
 	setup()
 
 	lockLog.Raw.Removed = true
-	w.handleLockLog(lockLog, mocks.MQueue)
+	w.handleLockLog(context.Background(), lockLog, mocks.MQueue)
 	lockLog.Raw.Removed = false
 
 	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
@@ -115,7 +133,7 @@ func Test_HandleLockLog_EmptyReceiver_Fails(t *testing.T) {
 	setup()
 
 	lockLog.Receiver = []byte{}
-	w.handleLockLog(lockLog, mocks.MQueue)
+	w.handleLockLog(context.Background(), lockLog, mocks.MQueue)
 	lockLog.Receiver = hederaBytes
 
 	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
@@ -126,7 +144,7 @@ func Test_HandleLockLog_InvalidReceiver_Fails(t *testing.T) {
 	mocks.MEVMClient.On("GetChainID").Return(uint64(1))
 
 	lockLog.Receiver = []byte{1}
-	w.handleLockLog(lockLog, mocks.MQueue)
+	w.handleLockLog(context.Background(), lockLog, mocks.MQueue)
 	lockLog.Receiver = hederaBytes
 
 	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
@@ -137,7 +155,7 @@ func Test_HandleLockLog_EmptyWrappedAsset_Fails(t *testing.T) {
 	mocks.MEVMClient.On("GetChainID").Return(uint64(2))
 
 	mocks.MAssetsService.On("NativeToWrapped", tokenAddressString, uint64(2), targetChainId).Return("")
-	w.handleLockLog(lockLog, mocks.MQueue)
+	w.handleLockLog(context.Background(), lockLog, mocks.MQueue)
 
 	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
 }
@@ -157,13 +175,69 @@ func Test_HandleLockLog_HappyPath(t *testing.T) {
 		TargetAsset:   constants.Hbar,
 		NativeAsset:   lockLog.Token.String(),
 		Receiver:      hederaAcc.String(),
-		Amount:        lockLog.Amount.String(),
+		Amount:        lockLog.Amount,
 	}
 
 	mocks.MStatusRepository.On("Update", mocks.MBridgeContractService.Address().String(), int64(0)).Return(nil)
-	mocks.MQueue.On("Push", &queue.Message{Payload: parsedLockLog, Topic: constants.HederaMintHtsTransfer}).Return()
+	mocks.MQueue.On("Push", &queue.Message{Payload: parsedLockLog, Topic: constants.HederaMintHtsTransfer}).Return(nil)
 
-	w.handleLockLog(lockLog, mocks.MQueue)
+	w.handleLockLog(context.Background(), lockLog, mocks.MQueue)
+}
+
+func Test_Push_CarriesTrace(t *testing.T) {
+	setup()
+	exporter := helper.SetupTracing(t)
+	transfer := &payload.Transfer{TransactionId: "0xhash-0", SourceChainId: sourceChainId, TargetChainId: targetChainId}
+	mocks.MQueue.On("Push", mock.Anything).Return(nil)
+
+	ctx, span := tracing.Start(context.Background(), "discover log")
+	w.push(ctx, mocks.MQueue, transfer, constants.HederaMintHtsTransfer)
+	span.End()
+
+	message := mocks.MQueue.Calls[0].Arguments.Get(0).(*queue.Message)
+	assert.Equal(t, transfer, message.Payload)
+	assert.Equal(t, constants.HederaMintHtsTransfer, message.Topic)
+	assert.Equal(t, span.SpanContext(), trace.SpanContextFromContext(tracing.Extract(context.Background(), message.TraceContext)).WithRemote(false))
+	assert.Contains(t, exporter.GetSpans()[0].Attributes, tracing.TransferIdKey.String("0xhash-0"))
+}
+
+func Test_HandleLockLog_AmountExceedsLimit(t *testing.T) {
+	setup()
+	txHash, originator := newSignedTransaction(t)
+	amount := new(big.Int).Lsh(big.NewInt(1), 64)
+	largeLockLog := &router.RouterLock{
+		TargetChain: targetChainIdBigInt,
+		Token:       tokenAddress,
+		Receiver:    hederaBytes,
+		Amount:      amount,
+		ServiceFee:  big.NewInt(0),
+		Raw:         types.Log{TxHash: txHash},
+	}
+	mocks.MEVMClient.On("GetChainID").Return(sourceChainId)
+	mocks.MEVMClient.On("GetBlockTimestamp", big.NewInt(0)).Return(uint64(1))
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+	mocks.MAssetsService.On("NativeToWrapped", tokenAddressString, sourceChainId, targetChainId).Return(constants.Hbar)
+	mocks.MAssetsService.On("FungibleAssetInfo", sourceChainId, tokenAddressString).Return(fungibleAssetInfo, true)
+	mocks.MAssetsService.On("FungibleAssetInfo", targetChainId, constants.Hbar).Return(fungibleAssetInfo, true)
+	rejected := &payload.Transfer{
+		TransactionId: fmt.Sprintf("%s-%d", txHash, 0),
+		SourceChainId: sourceChainId,
+		TargetChainId: targetChainId,
+		NativeChainId: sourceChainId,
+		SourceAsset:   tokenAddressString,
+		TargetAsset:   constants.Hbar,
+		NativeAsset:   tokenAddressString,
+		Receiver:      hederaAcc.String(),
+		Amount:        amount,
+		Originator:    originator,
+		Timestamp:     time.Unix(1, 0).UTC(),
+	}
+	mocks.MTransferRepository.On("Reject", rejected, failure.AmountExceedsLimit, mock.Anything).Return(&entity.Transfer{}, nil)
+
+	w.handleLockLog(context.Background(), largeLockLog, mocks.MQueue)
+
+	mocks.MTransferRepository.AssertCalled(t, "Reject", rejected, failure.AmountExceedsLimit, mock.Anything)
+	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
 }
 
 func Test_HandleLockLog_ReadOnlyHederaMintHtsTransfer(t *testing.T) {
@@ -171,16 +245,18 @@ func Test_HandleLockLog_ReadOnlyHederaMintHtsTransfer(t *testing.T) {
 	mocks.MEVMClient.On("GetBlockTimestamp", big.NewInt(0)).Return(uint64(1))
 	mocks.MStatusRepository.On("Get", mock.Anything).Return(int64(0), nil)
 	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+	mocks.MTransferRepository.On("GetByTransactionId", mock.Anything).Return(nilTransfer, nil)
 	mocks.MAssetsService.On("NativeToWrapped", tokenAddressString, sourceChainId, lockLog.TargetChain.Uint64()).Return("")
 
 	w = &Watcher{
-		repository:        mocks.MStatusRepository,
-		contracts:         mocks.MBridgeContractService,
-		evmClient:         mocks.MEVMClient,
-		logger:            config.GetLoggerFor(fmt.Sprintf("EVM Router Watcher [%s]", dbIdentifier)),
-		assetsService:     mocks.MAssetsService,
-		validator:         false,
-		prometheusService: mocks.MPrometheusService,
+		repository:         mocks.MStatusRepository,
+		transferRepository: mocks.MTransferRepository,
+		contracts:          mocks.MBridgeContractService,
+		evmClient:          mocks.MEVMClient,
+		logger:             config.GetLoggerFor(fmt.Sprintf("EVM Router Watcher [%s]", dbIdentifier)),
+		assetsService:      mocks.MAssetsService,
+		validator:          false,
+		prometheusService:  mocks.MPrometheusService,
 	}
 
 	mocks.MEVMClient.On("GetChainID").Return(sourceChainId)
@@ -193,14 +269,14 @@ func Test_HandleLockLog_ReadOnlyHederaMintHtsTransfer(t *testing.T) {
 		TargetAsset:      constants.Hbar,
 		NativeAsset:      lockLog.Token.String(),
 		Receiver:         hederaAcc.String(),
-		Amount:           lockLog.Amount.String(),
+		Amount:           lockLog.Amount,
 		NetworkTimestamp: "1",
 	}
 
 	mocks.MStatusRepository.On("Update", mocks.MBridgeContractService.Address().String(), int64(0)).Return(nil)
-	mocks.MQueue.On("Push", &queue.Message{Payload: parsedLockLog, Topic: constants.ReadOnlyHederaMintHtsTransfer}).Return()
+	mocks.MQueue.On("Push", &queue.Message{Payload: parsedLockLog, Topic: constants.ReadOnlyHederaMintHtsTransfer}).Return(nil)
 
-	w.handleLockLog(lockLog, mocks.MQueue)
+	w.handleLockLog(context.Background(), lockLog, mocks.MQueue)
 }
 
 func Test_HandleLockLog_ReadOnlyTransferSave(t *testing.T) {
@@ -208,16 +284,18 @@ func Test_HandleLockLog_ReadOnlyTransferSave(t *testing.T) {
 	mocks.MEVMClient.On("GetBlockTimestamp", big.NewInt(0)).Return(uint64(1))
 	mocks.MStatusRepository.On("Get", mock.Anything).Return(int64(0), nil)
 	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+	mocks.MTransferRepository.On("GetByTransactionId", mock.Anything).Return(nilTransfer, nil)
 
 	lockLog.TargetChain = big.NewInt(1)
 	w = &Watcher{
-		repository:        mocks.MStatusRepository,
-		contracts:         mocks.MBridgeContractService,
-		prometheusService: mocks.MPrometheusService,
-		evmClient:         mocks.MEVMClient,
-		logger:            config.GetLoggerFor(fmt.Sprintf("EVM Router Watcher [%s]", dbIdentifier)),
-		assetsService:     mocks.MAssetsService,
-		validator:         false,
+		repository:         mocks.MStatusRepository,
+		transferRepository: mocks.MTransferRepository,
+		contracts:          mocks.MBridgeContractService,
+		prometheusService:  mocks.MPrometheusService,
+		evmClient:          mocks.MEVMClient,
+		logger:             config.GetLoggerFor(fmt.Sprintf("EVM Router Watcher [%s]", dbIdentifier)),
+		assetsService:      mocks.MAssetsService,
+		validator:          false,
 	}
 
 	mocks.MAssetsService.On("NativeToWrapped", tokenAddressString, sourceChainId, lockLog.TargetChain.Uint64()).Return("")
@@ -231,14 +309,14 @@ func Test_HandleLockLog_ReadOnlyTransferSave(t *testing.T) {
 		TargetAsset:      "0xsome-other-eth-address",
 		NativeAsset:      lockLog.Token.String(),
 		Receiver:         common.BytesToAddress(hederaAcc.ToBytes()).String(),
-		Amount:           lockLog.Amount.String(),
+		Amount:           lockLog.Amount,
 		NetworkTimestamp: "1",
 	}
 
 	mocks.MStatusRepository.On("Update", mocks.MBridgeContractService.Address().String(), int64(0)).Return(nil)
-	mocks.MQueue.On("Push", &queue.Message{Payload: parsedLockLog, Topic: constants.ReadOnlyTransferSave}).Return()
+	mocks.MQueue.On("Push", &queue.Message{Payload: parsedLockLog, Topic: constants.ReadOnlyTransferSave}).Return(nil)
 
-	w.handleLockLog(lockLog, mocks.MQueue)
+	w.handleLockLog(context.Background(), lockLog, mocks.MQueue)
 	lockLog.TargetChain = big.NewInt(0)
 }
 
@@ -258,32 +336,37 @@ func Test_HandleLockLog_TopicMessageSubmission(t *testing.T) {
 		TargetAsset:   "0xsome-other-eth-address",
 		NativeAsset:   lockLog.Token.String(),
 		Receiver:      common.BytesToAddress(hederaAcc.ToBytes()).String(),
-		Amount:        lockLog.Amount.String(),
+		Amount:        lockLog.Amount,
 	}
 	mocks.MAssetsService.On("NativeToWrapped", tokenAddressString, sourceChainId, lockLog.TargetChain.Uint64()).Return("")
 
 	mocks.MStatusRepository.On("Update", mocks.MBridgeContractService.Address().String(), int64(0)).Return(nil)
-	mocks.MQueue.On("Push", &queue.Message{Payload: parsedLockLog, Topic: constants.TopicMessageSubmission}).Return()
+	mocks.MQueue.On("Push", &queue.Message{Payload: parsedLockLog, Topic: constants.TopicMessageSubmission}).Return(nil)
 
-	w.handleLockLog(lockLog, mocks.MQueue)
+	w.handleLockLog(context.Background(), lockLog, mocks.MQueue)
 	lockLog.TargetChain = big.NewInt(0)
 }
 
 func Test_HandleBurnLog_HappyPath(t *testing.T) {
 	setup()
+	// 10^14 in the 18 decimals of the wrapped token is the minimum amount of 10^4 in the 8 decimals of HBAR
+	happyBurnLog, originator := newWrappedBurnLog(t, targetChainIdBigInt, hederaAcc.ToBytes(), big.NewInt(100000000000000))
 	mocks.MEVMClient.On("GetChainID").Return(sourceChainId)
+	mocks.MEVMClient.On("GetBlockTimestamp", big.NewInt(0)).Return(uint64(1))
 	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
 
 	parsedBurnLog := &payload.Transfer{
-		TransactionId: fmt.Sprintf("%s-%d", burnLog.Raw.TxHash, burnLog.Raw.Index),
+		TransactionId: fmt.Sprintf("%s-%d", happyBurnLog.Raw.TxHash, happyBurnLog.Raw.Index),
 		SourceChainId: sourceChainId,
-		TargetChainId: burnLog.TargetChain.Uint64(),
-		NativeChainId: 0,
-		SourceAsset:   burnLog.Token.String(),
+		TargetChainId: happyBurnLog.TargetChain.Uint64(),
+		NativeChainId: hbarNativeAsset.ChainId,
+		SourceAsset:   happyBurnLog.Token.String(),
 		TargetAsset:   constants.Hbar,
 		NativeAsset:   constants.Hbar,
 		Receiver:      hederaAcc.String(),
-		Amount:        burnLog.Amount.String(),
+		Amount:        big.NewInt(10000),
+		Originator:    originator,
+		Timestamp:     time.Unix(1, 0).UTC(),
 	}
 
 	mocks.MAssetsService.On("WrappedToNative", tokenAddressString, sourceChainId).Return(hbarNativeAsset)
@@ -292,54 +375,79 @@ func Test_HandleBurnLog_HappyPath(t *testing.T) {
 	mocks.MAssetsService.On("FungibleAssetInfo", targetChainId, constants.Hbar).Return(fungibleAssetInfo, true)
 
 	mocks.MStatusRepository.On("Update", mocks.MBridgeContractService.Address().String(), int64(0)).Return(nil)
-	mocks.MQueue.On("Push", &queue.Message{Payload: parsedBurnLog, Topic: constants.HederaFeeTransfer}).Return()
+	mocks.MQueue.On("Push", mock.Anything).Return(nil)
+
+	w.handleBurnLog(context.Background(), happyBurnLog, mocks.MQueue)
 
-	w.handleBurnLog(burnLog, mocks.MQueue)
+	message := mocks.MQueue.Calls[0].Arguments.Get(0).(*queue.Message)
+	assert.Equal(t, parsedBurnLog, message.Payload)
+	assert.Equal(t, constants.HederaFeeTransfer, message.Topic)
 }
 
 func Test_HandleBurnLog_InvalidHederaRecipient(t *testing.T) {
 	setup()
-	defaultReceiver := burnLog.Receiver
-	burnLog.Receiver = []byte{1, 2, 3, 4}
+	invalidBurnLog, originator := newWrappedBurnLog(t, targetChainIdBigInt, []byte{1, 2, 3, 4}, big.NewInt(1))
 	mocks.MEVMClient.On("GetChainID").Return(sourceChainId)
+	mocks.MEVMClient.On("GetBlockTimestamp", big.NewInt(0)).Return(uint64(1))
 	mocks.MAssetsService.On("WrappedToNative", tokenAddressString, sourceChainId).Return(hbarNativeAsset)
-	w.handleBurnLog(burnLog, mocks.MQueue)
-	burnLog.Receiver = defaultReceiver
+	rejected := &payload.Transfer{
+		TransactionId: fmt.Sprintf("%s-%d", invalidBurnLog.Raw.TxHash, invalidBurnLog.Raw.Index),
+		SourceChainId: sourceChainId,
+		TargetChainId: targetChainId,
+		NativeChainId: hbarNativeAsset.ChainId,
+		SourceAsset:   tokenAddressString,
+		TargetAsset:   hbarNativeAsset.Asset,
+		NativeAsset:   hbarNativeAsset.Asset,
+		Amount:        big.NewInt(1),
+		Originator:    originator,
+		Timestamp:     time.Unix(1, 0).UTC(),
+	}
+	mocks.MTransferRepository.On("Reject", rejected, failure.InvalidReceiver, mock.Anything).Return(&entity.Transfer{}, nil)
+
+	w.handleBurnLog(context.Background(), invalidBurnLog, mocks.MQueue)
+
+	mocks.MTransferRepository.AssertCalled(t, "Reject", rejected, failure.InvalidReceiver, mock.Anything)
+	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
 }
 
 func Test_HandleBurnLog_TopicMessageSubmission(t *testing.T) {
 	setup()
 	mocks.MEVMClient.On("GetChainID").Return(sourceChainId)
+	mocks.MEVMClient.On("GetBlockTimestamp", big.NewInt(0)).Return(uint64(1))
 	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
 
-	burnLog.TargetChain = big.NewInt(1)
-	defaultToken := burnLog.Token
-	burnLog.Token = common.HexToAddress("0x123")
-	receiver := common.BytesToAddress(burnLog.Receiver).String()
+	// 10^14 in the 18 decimals of the wrapped token is the minimum amount of 10^4 in the 8 decimals of the native one
+	evmBurnLog, originator := newWrappedBurnLog(t, big.NewInt(1), burnLog.Receiver, big.NewInt(100000000000000))
+	evmBurnLog.Token = common.HexToAddress("0x123")
+	receiver := common.BytesToAddress(evmBurnLog.Receiver).String()
 	nativeChainId := uint64(1)
 	nativeAssetAddress := "0xb083879B1e10C8476802016CB12cd2F25a896691"
 	parsedBurnLog := &payload.Transfer{
-		TransactionId: fmt.Sprintf("%s-%d", burnLog.Raw.TxHash, burnLog.Raw.Index),
+		TransactionId: fmt.Sprintf("%s-%d", evmBurnLog.Raw.TxHash, evmBurnLog.Raw.Index),
 		SourceChainId: sourceChainId,
 		TargetChainId: nativeChainId,
 		NativeChainId: nativeChainId,
-		SourceAsset:   burnLog.Token.String(),
+		SourceAsset:   evmBurnLog.Token.String(),
 		TargetAsset:   nativeAssetAddress,
 		NativeAsset:   nativeAssetAddress,
 		Receiver:      receiver,
-		Amount:        burnLog.Amount.String(),
+		Amount:        big.NewInt(10000),
+		Originator:    originator,
+		Timestamp:     time.Unix(1, 0).UTC(),
 	}
 
-	mocks.MAssetsService.On("WrappedToNative", burnLog.Token.String(), sourceChainId).Return(&asset.NativeAsset{ChainId: nativeChainId, Asset: nativeAssetAddress})
+	mocks.MAssetsService.On("WrappedToNative", evmBurnLog.Token.String(), sourceChainId).Return(&asset.NativeAsset{ChainId: nativeChainId, Asset: nativeAssetAddress})
 	mocks.MPricingService.On("GetTokenPriceInfo", nativeChainId, nativeAssetAddress).Return(tokenPriceInfo, true)
-	mocks.MAssetsService.On("FungibleAssetInfo", sourceChainId, burnLog.Token.String()).Return(evmFungibleAssetInfo, true)
+	mocks.MAssetsService.On("FungibleAssetInfo", sourceChainId, evmBurnLog.Token.String()).Return(evmFungibleAssetInfo, true)
 	mocks.MAssetsService.On("FungibleAssetInfo", nativeChainId, nativeAssetAddress).Return(fungibleAssetInfo, true)
 	mocks.MStatusRepository.On("Update", mocks.MBridgeContractService.Address().String(), int64(0)).Return(nil)
-	mocks.MQueue.On("Push", &queue.Message{Payload: parsedBurnLog, Topic: constants.TopicMessageSubmission}).Return()
+	mocks.MQueue.On("Push", mock.Anything).Return(nil)
 
-	w.handleBurnLog(burnLog, mocks.MQueue)
-	burnLog.TargetChain = big.NewInt(0)
-	burnLog.Token = defaultToken
+	w.handleBurnLog(context.Background(), evmBurnLog, mocks.MQueue)
+
+	message := mocks.MQueue.Calls[0].Arguments.Get(0).(*queue.Message)
+	assert.Equal(t, parsedBurnLog, message.Payload)
+	assert.Equal(t, constants.TopicMessageSubmission, message.Topic)
 }
 
 func Test_HandleBurnLog_ReadOnlyTransferSave(t *testing.T) {
@@ -347,50 +455,55 @@ func Test_HandleBurnLog_ReadOnlyTransferSave(t *testing.T) {
 	mocks.MEVMClient.On("GetBlockTimestamp", big.NewInt(0)).Return(uint64(1))
 	mocks.MStatusRepository.On("Get", mock.Anything).Return(int64(0), nil)
 	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+	mocks.MTransferRepository.On("GetByTransactionId", mock.Anything).Return(nilTransfer, nil)
 
-	burnLog.TargetChain = big.NewInt(1)
 	w = &Watcher{
-		repository:        mocks.MStatusRepository,
-		contracts:         mocks.MBridgeContractService,
-		prometheusService: mocks.MPrometheusService,
-		evmClient:         mocks.MEVMClient,
-		logger:            config.GetLoggerFor(fmt.Sprintf("EVM Router Watcher [%s]", dbIdentifier)),
-		assetsService:     mocks.MAssetsService,
-		pricingService:    mocks.MPricingService,
-		validator:         false,
+		repository:         mocks.MStatusRepository,
+		transferRepository: mocks.MTransferRepository,
+		contracts:          mocks.MBridgeContractService,
+		prometheusService:  mocks.MPrometheusService,
+		evmClient:          mocks.MEVMClient,
+		logger:             config.GetLoggerFor(fmt.Sprintf("EVM Router Watcher [%s]", dbIdentifier)),
+		assetsService:      mocks.MAssetsService,
+		pricingService:     mocks.MPricingService,
+		validator:          false,
 	}
 
 	mocks.MEVMClient.On("GetChainID").Return(sourceChainId)
 
-	burnLog.TargetChain = big.NewInt(1)
-	defaultToken := burnLog.Token
-	burnLog.Token = common.HexToAddress("0x123")
-	receiver := common.BytesToAddress(burnLog.Receiver).String()
+	// 10^14 in the 18 decimals of the wrapped token is the minimum amount of 10^4 in the 8 decimals of the native one
+	evmBurnLog, originator := newWrappedBurnLog(t, big.NewInt(1), burnLog.Receiver, big.NewInt(100000000000000))
+	evmBurnLog.Token = common.HexToAddress("0x123")
+	receiver := common.BytesToAddress(evmBurnLog.Receiver).String()
 	nativeChainId := uint64(1)
 	nativeAssetAddress := "0xb083879B1e10C8476802016CB12cd2F25a896691"
 	parsedBurnLog := &payload.Transfer{
-		TransactionId:    fmt.Sprintf("%s-%d", burnLog.Raw.TxHash, burnLog.Raw.Index),
+		TransactionId:    fmt.Sprintf("%s-%d", evmBurnLog.Raw.TxHash, evmBurnLog.Raw.Index),
 		SourceChainId:    sourceChainId,
 		TargetChainId:    nativeChainId,
 		NativeChainId:    nativeChainId,
-		SourceAsset:      burnLog.Token.String(),
+		SourceAsset:      evmBurnLog.Token.String(),
 		TargetAsset:      nativeAssetAddress,
 		NativeAsset:      nativeAssetAddress,
 		Receiver:         receiver,
-		Amount:           burnLog.Amount.String(),
+		Amount:           big.NewInt(10000),
+		Originator:       originator,
+		Timestamp:        time.Unix(1, 0).UTC(),
 		NetworkTimestamp: "1",
 	}
 
-	mocks.MAssetsService.On("WrappedToNative", burnLog.Token.String(), sourceChainId).Return(&asset.NativeAsset{ChainId: nativeChainId, Asset: nativeAssetAddress})
+	mocks.MAssetsService.On("WrappedToNative", evmBurnLog.Token.String(), sourceChainId).Return(&asset.NativeAsset{ChainId: nativeChainId, Asset: nativeAssetAddress})
 	mocks.MPricingService.On("GetTokenPriceInfo", nativeChainId, nativeAssetAddress).Return(tokenPriceInfo, true)
-	mocks.MAssetsService.On("FungibleAssetInfo", sourceChainId, burnLog.Token.String()).Return(evmFungibleAssetInfo, true)
+	mocks.MAssetsService.On("FungibleAssetInfo", sourceChainId, evmBurnLog.Token.String()).Return(evmFungibleAssetInfo, true)
 	mocks.MAssetsService.On("FungibleAssetInfo", nativeChainId, nativeAssetAddress).Return(fungibleAssetInfo, true)
 	mocks.MStatusRepository.On("Update", mocks.MBridgeContractService.Address().String(), int64(0)).Return(nil)
-	mocks.MQueue.On("Push", &queue.Message{Payload: parsedBurnLog, Topic: constants.ReadOnlyTransferSave}).Return()
+	mocks.MQueue.On("Push", mock.Anything).Return(nil)
+
+	w.handleBurnLog(context.Background(), evmBurnLog, mocks.MQueue)
 
-	w.handleBurnLog(burnLog, mocks.MQueue)
-	burnLog.TargetChain = big.NewInt(0)
-	burnLog.Token = defaultToken
+	message := mocks.MQueue.Calls[0].Arguments.Get(0).(*queue.Message)
+	assert.Equal(t, parsedBurnLog, message.Payload)
+	assert.Equal(t, constants.ReadOnlyTransferSave, message.Topic)
 }
 
 func Test_HandleBurnLog_ReadOnlyHederaTransfer(t *testing.T) {
@@ -398,74 +511,158 @@ func Test_HandleBurnLog_ReadOnlyHederaTransfer(t *testing.T) {
 	mocks.MEVMClient.On("GetBlockTimestamp", big.NewInt(0)).Return(uint64(1))
 	mocks.MStatusRepository.On("Get", mock.Anything).Return(int64(0), nil)
 	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+	mocks.MTransferRepository.On("GetByTransactionId", mock.Anything).Return(nilTransfer, nil)
 
 	w = &Watcher{
-		repository:        mocks.MStatusRepository,
-		contracts:         mocks.MBridgeContractService,
-		prometheusService: mocks.MPrometheusService,
-		evmClient:         mocks.MEVMClient,
-		logger:            config.GetLoggerFor(fmt.Sprintf("EVM Router Watcher [%s]", dbIdentifier)),
-		assetsService:     mocks.MAssetsService,
-		pricingService:    mocks.MPricingService,
-		validator:         false,
+		repository:         mocks.MStatusRepository,
+		transferRepository: mocks.MTransferRepository,
+		contracts:          mocks.MBridgeContractService,
+		prometheusService:  mocks.MPrometheusService,
+		evmClient:          mocks.MEVMClient,
+		logger:             config.GetLoggerFor(fmt.Sprintf("EVM Router Watcher [%s]", dbIdentifier)),
+		assetsService:      mocks.MAssetsService,
+		pricingService:     mocks.MPricingService,
+		validator:          false,
 	}
 
 	mocks.MEVMClient.On("GetChainID").Return(sourceChainId)
+	hederaBurnLog, originator := newWrappedBurnLog(t, targetChainIdBigInt, hederaAcc.ToBytes(), big.NewInt(10000))
 	parsedBurnLog := &payload.Transfer{
-		TransactionId:    fmt.Sprintf("%s-%d", burnLog.Raw.TxHash, burnLog.Raw.Index),
+		TransactionId:    fmt.Sprintf("%s-%d", hederaBurnLog.Raw.TxHash, hederaBurnLog.Raw.Index),
 		SourceChainId:    sourceChainId,
-		TargetChainId:    0,
-		NativeChainId:    0,
-		SourceAsset:      burnLog.Token.String(),
+		TargetChainId:    targetChainId,
+		NativeChainId:    targetChainId,
+		SourceAsset:      hederaBurnLog.Token.String(),
 		TargetAsset:      constants.Hbar,
 		NativeAsset:      constants.Hbar,
 		Receiver:         hederaAcc.String(),
-		Amount:           burnLog.Amount.String(),
+		Amount:           big.NewInt(10000),
+		Originator:       originator,
+		Timestamp:        time.Unix(1, 0).UTC(),
 		NetworkTimestamp: "1",
 	}
 
-	mocks.MAssetsService.On("WrappedToNative", burnLog.Token.String(), sourceChainId).Return(hbarNativeAsset)
+	mocks.MAssetsService.On("WrappedToNative", hederaBurnLog.Token.String(), sourceChainId).Return(hbarNativeAsset)
 	mocks.MPricingService.On("GetTokenPriceInfo", constants.HederaNetworkId, constants.Hbar).Return(tokenPriceInfo, true)
-	mocks.MAssetsService.On("FungibleAssetInfo", sourceChainId, burnLog.Token.String()).Return(fungibleAssetInfo, true)
+	mocks.MAssetsService.On("FungibleAssetInfo", sourceChainId, hederaBurnLog.Token.String()).Return(fungibleAssetInfo, true)
 	mocks.MAssetsService.On("FungibleAssetInfo", constants.HederaNetworkId, constants.Hbar).Return(fungibleAssetInfo, true)
 	mocks.MStatusRepository.On("Update", mocks.MBridgeContractService.Address().String(), int64(0)).Return(nil)
-	mocks.MQueue.On("Push", &queue.Message{Payload: parsedBurnLog, Topic: constants.ReadOnlyHederaTransfer}).Return()
+	mocks.MQueue.On("Push", mock.Anything).Return(nil)
 
-	w.handleBurnLog(burnLog, mocks.MQueue)
+	w.handleBurnLog(context.Background(), hederaBurnLog, mocks.MQueue)
+
+	message := mocks.MQueue.Calls[0].Arguments.Get(0).(*queue.Message)
+	assert.Equal(t, parsedBurnLog, message.Payload)
+	assert.Equal(t, constants.ReadOnlyHederaTransfer, message.Topic)
 }
 
 func Test_HandleBurnLog_Token_Not_Supported(t *testing.T) {
 	setup()
 	mocks.MEVMClient.On("GetChainID").Return(sourceChainId)
 
-	defaultToken := burnLog.Token
-	burnLog.Token = common.HexToAddress("0x0123123")
-	mocks.MAssetsService.On("WrappedToNative", burnLog.Token.String(), sourceChainId).Return(nilNativeAsset)
-	w.handleBurnLog(burnLog, mocks.MQueue)
+	unsupportedBurnLog, _ := newWrappedBurnLog(t, targetChainIdBigInt, hederaBytes, big.NewInt(1))
+	unsupportedBurnLog.Token = common.HexToAddress("0x0123123")
+	mocks.MEVMClient.On("GetBlockTimestamp", big.NewInt(0)).Return(uint64(1))
+	mocks.MAssetsService.On("WrappedToNative", unsupportedBurnLog.Token.String(), sourceChainId).Return(nilNativeAsset)
+	mocks.MTransferRepository.On("Reject", mock.Anything, failure.UnsupportedAsset, mock.Anything).Return(&entity.Transfer{}, nil)
+
+	w.handleBurnLog(context.Background(), unsupportedBurnLog, mocks.MQueue)
 
+	mocks.MTransferRepository.AssertCalled(t, "Reject", mock.Anything, failure.UnsupportedAsset, mock.Anything)
 	mocks.MStatusRepository.AssertNotCalled(t, "Update", mocks.MBridgeContractService.Address().String(), int64(0))
 	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
-	burnLog.Token = defaultToken
 }
 
-func Test_HandleBurnLog_WrappedToWrapped_Not_Supported(t *testing.T) {
+func Test_HandleBurnLog_WrappedToWrapped(t *testing.T) {
 	setup()
 	mocks.MEVMClient.On("GetChainID").Return(sourceChainId)
 
-	defaultTargetChain := burnLog.TargetChain
-	mocks.MAssetsService.On("WrappedToNative", burnLog.Token.String(), sourceChainId).Return(nilNativeAsset)
-	burnLog.TargetChain = big.NewInt(1)
-	w.handleBurnLog(burnLog, mocks.MQueue)
-	mocks.MStatusRepository.AssertNotCalled(t, "Update", mocks.MBridgeContractService.Address().String(), int64(0))
+	wrappedBurnLog, originator := newWrappedBurnLog(t, big.NewInt(1), common.HexToAddress("0xb083879B1e10C8476802016CB12cd2F25a896691").Bytes(), big.NewInt(100000))
+	nativeAsset := &asset.NativeAsset{ChainId: 5, Asset: "0x0000000000000000000000000000000000000005"}
+	targetAsset := "0x0000000000000000000000000000000000000001"
+	parsedBurnLog := &payload.Transfer{
+		TransactionId: fmt.Sprintf("%s-%d", wrappedBurnLog.Raw.TxHash, wrappedBurnLog.Raw.Index),
+		SourceChainId: sourceChainId,
+		TargetChainId: 1,
+		NativeChainId: nativeAsset.ChainId,
+		SourceAsset:   tokenAddressString,
+		TargetAsset:   targetAsset,
+		NativeAsset:   nativeAsset.Asset,
+		Receiver:      common.BytesToAddress(wrappedBurnLog.Receiver).String(),
+		Amount:        big.NewInt(100000),
+		Originator:    originator,
+		Timestamp:     time.Unix(1, 0).UTC(),
+	}
+
+	mocks.MAssetsService.On("WrappedToNative", tokenAddressString, sourceChainId).Return(nativeAsset)
+	mocks.MAssetsService.On("NativeToWrapped", nativeAsset.Asset, nativeAsset.ChainId, uint64(1)).Return(targetAsset)
+	mocks.MAssetsService.On("FungibleAssetInfo", sourceChainId, tokenAddressString).Return(evmFungibleAssetInfo, true)
+	mocks.MAssetsService.On("FungibleAssetInfo", uint64(1), targetAsset).Return(evmFungibleAssetInfo, true)
+	mocks.MPricingService.On("GetTokenPriceInfo", uint64(1), targetAsset).Return(tokenPriceInfo, true)
+	mocks.MEVMClient.On("GetBlockTimestamp", big.NewInt(0)).Return(uint64(1))
+	mocks.MQueue.On("Push", &queue.Message{Payload: parsedBurnLog, Topic: constants.TopicMessageSubmission}).Return(nil)
+
+	w.handleBurnLog(context.Background(), wrappedBurnLog, mocks.MQueue)
+
+	mocks.MQueue.AssertCalled(t, "Push", &queue.Message{Payload: parsedBurnLog, Topic: constants.TopicMessageSubmission})
+}
+
+func Test_HandleBurnLog_WrappedToWrapped_Hedera(t *testing.T) {
+	setup()
+	mocks.MEVMClient.On("GetChainID").Return(sourceChainId)
+
+	wrappedBurnLog, originator := newWrappedBurnLog(t, targetChainIdBigInt, hederaAcc.ToBytes(), big.NewInt(1000000000000000000))
+	nativeAsset := &asset.NativeAsset{ChainId: 5, Asset: "0x0000000000000000000000000000000000000005"}
+	targetAsset := "0.0.2"
+	parsedBurnLog := &payload.Transfer{
+		TransactionId: fmt.Sprintf("%s-%d", wrappedBurnLog.Raw.TxHash, wrappedBurnLog.Raw.Index),
+		SourceChainId: sourceChainId,
+		TargetChainId: targetChainId,
+		NativeChainId: nativeAsset.ChainId,
+		SourceAsset:   tokenAddressString,
+		TargetAsset:   targetAsset,
+		NativeAsset:   nativeAsset.Asset,
+		Receiver:      hederaAcc.String(),
+		Amount:        big.NewInt(100000000),
+		Originator:    originator,
+		Timestamp:     time.Unix(1, 0).UTC(),
+	}
+
+	mocks.MAssetsService.On("WrappedToNative", tokenAddressString, sourceChainId).Return(nativeAsset)
+	mocks.MAssetsService.On("NativeToWrapped", nativeAsset.Asset, nativeAsset.ChainId, targetChainId).Return(targetAsset)
+	mocks.MAssetsService.On("FungibleAssetInfo", sourceChainId, tokenAddressString).Return(evmFungibleAssetInfo, true)
+	mocks.MAssetsService.On("FungibleAssetInfo", targetChainId, targetAsset).Return(fungibleAssetInfo, true)
+	mocks.MPricingService.On("GetTokenPriceInfo", targetChainId, targetAsset).Return(tokenPriceInfo, true)
+	mocks.MEVMClient.On("GetBlockTimestamp", big.NewInt(0)).Return(uint64(1))
+	mocks.MQueue.On("Push", &queue.Message{Payload: parsedBurnLog, Topic: constants.HederaMintHtsTransfer}).Return(nil)
+
+	w.handleBurnLog(context.Background(), wrappedBurnLog, mocks.MQueue)
+
+	mocks.MQueue.AssertCalled(t, "Push", &queue.Message{Payload: parsedBurnLog, Topic: constants.HederaMintHtsTransfer})
+}
+
+func Test_HandleBurnLog_WrappedToWrapped_AssetNotFound(t *testing.T) {
+	setup()
+	mocks.MEVMClient.On("GetChainID").Return(sourceChainId)
+
+	nativeAsset := &asset.NativeAsset{ChainId: 5, Asset: "0x0000000000000000000000000000000000000005"}
+	wrappedBurnLog, _ := newWrappedBurnLog(t, big.NewInt(1), hederaBytes, big.NewInt(1))
+	mocks.MEVMClient.On("GetBlockTimestamp", big.NewInt(0)).Return(uint64(1))
+	mocks.MAssetsService.On("WrappedToNative", wrappedBurnLog.Token.String(), sourceChainId).Return(nativeAsset)
+	mocks.MAssetsService.On("NativeToWrapped", nativeAsset.Asset, nativeAsset.ChainId, uint64(1)).Return("")
+	mocks.MTransferRepository.On("Reject", mock.Anything, failure.UnsupportedAsset, mock.Anything).Return(&entity.Transfer{}, nil)
+
+	w.handleBurnLog(context.Background(), wrappedBurnLog, mocks.MQueue)
+
+	mocks.MTransferRepository.AssertCalled(t, "Reject", mock.Anything, failure.UnsupportedAsset, mock.Anything)
 	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
-	burnLog.TargetChain = defaultTargetChain
 }
 
 func Test_HandleBurnLog_Raw_Removed(t *testing.T) {
 	setup()
 	burnLog.Raw.Removed = true
 
-	w.handleBurnLog(burnLog, mocks.MQueue)
+	w.handleBurnLog(context.Background(), burnLog, mocks.MQueue)
 
 	mocks.MStatusRepository.AssertNotCalled(t, "Update", mocks.MBridgeContractService.Address().String(), int64(0))
 	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
@@ -474,23 +671,203 @@ func Test_HandleBurnLog_Raw_Removed(t *testing.T) {
 
 func Test_HandleBurnLog_No_Receivers(t *testing.T) {
 	setup()
-	receiver := burnLog.Receiver
-	burnLog.Receiver = []byte{}
+	noReceiverBurnLog, _ := newWrappedBurnLog(t, targetChainIdBigInt, []byte{}, big.NewInt(1))
+	mocks.MEVMClient.On("GetChainID").Return(sourceChainId)
+	mocks.MEVMClient.On("GetBlockTimestamp", big.NewInt(0)).Return(uint64(1))
+	mocks.MTransferRepository.On("Reject", mock.Anything, failure.InvalidReceiver, "empty receiver account").Return(&entity.Transfer{}, nil)
 
-	w.handleBurnLog(burnLog, mocks.MQueue)
+	w.handleBurnLog(context.Background(), noReceiverBurnLog, mocks.MQueue)
 
+	mocks.MTransferRepository.AssertCalled(t, "Reject", mock.Anything, failure.InvalidReceiver, "empty receiver account")
 	mocks.MStatusRepository.AssertNotCalled(t, "Update", mocks.MBridgeContractService.Address().String(), int64(0))
 	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
-	burnLog.Receiver = receiver
+}
+
+func Test_HandleLockERC721(t *testing.T) {
+	setup()
+	mocks.MEVMClient.On("GetChainID").Return(sourceChainId)
+
+	lockERC721Log, originator := newLockERC721Log(t, targetChainIdBigInt)
+	wrappedAsset := "0.0.222222"
+	expected := &payload.Transfer{
+		TransactionId: fmt.Sprintf("%s-%d", lockERC721Log.Raw.TxHash, lockERC721Log.Raw.Index),
+		SourceChainId: sourceChainId,
+		TargetChainId: targetChainId,
+		NativeChainId: sourceChainId,
+		SourceAsset:   tokenAddressString,
+		TargetAsset:   wrappedAsset,
+		NativeAsset:   tokenAddressString,
+		Receiver:      hederaAcc.String(),
+		IsNft:         true,
+		SerialNum:     lockERC721Log.TokenId.Int64(),
+		Metadata:      lockERC721Log.Metadata,
+		Originator:    originator,
+		Timestamp:     time.Unix(1, 0).UTC(),
+	}
+
+	mocks.MAssetsService.On("NativeToWrapped", tokenAddressString, sourceChainId, targetChainId).Return(wrappedAsset)
+	mocks.MEVMClient.On("GetBlockTimestamp", big.NewInt(0)).Return(uint64(1))
+	mocks.MQueue.On("Push", &queue.Message{Payload: expected, Topic: constants.HederaMintNftTransfer}).Return(nil)
+
+	w.handleLockERC721(context.Background(), lockERC721Log, mocks.MQueue)
+
+	mocks.MQueue.AssertCalled(t, "Push", &queue.Message{Payload: expected, Topic: constants.HederaMintNftTransfer})
+}
+
+func Test_HandleLockERC721_ReadOnly(t *testing.T) {
+	setup()
+	w.validator = false
+	mocks.MEVMClient.On("GetChainID").Return(sourceChainId)
+
+	lockERC721Log, originator := newLockERC721Log(t, targetChainIdBigInt)
+	wrappedAsset := "0.0.222222"
+	expected := &payload.Transfer{
+		TransactionId:    fmt.Sprintf("%s-%d", lockERC721Log.Raw.TxHash, lockERC721Log.Raw.Index),
+		SourceChainId:    sourceChainId,
+		TargetChainId:    targetChainId,
+		NativeChainId:    sourceChainId,
+		SourceAsset:      tokenAddressString,
+		TargetAsset:      wrappedAsset,
+		NativeAsset:      tokenAddressString,
+		Receiver:         hederaAcc.String(),
+		IsNft:            true,
+		SerialNum:        lockERC721Log.TokenId.Int64(),
+		Metadata:         lockERC721Log.Metadata,
+		Originator:       originator,
+		Timestamp:        time.Unix(1, 0).UTC(),
+		NetworkTimestamp: "1",
+	}
+
+	mocks.MAssetsService.On("NativeToWrapped", tokenAddressString, sourceChainId, targetChainId).Return(wrappedAsset)
+	mocks.MEVMClient.On("GetBlockTimestamp", big.NewInt(0)).Return(uint64(1))
+	mocks.MQueue.On("Push", &queue.Message{Payload: expected, Topic: constants.ReadOnlyHederaMintNftTransfer}).Return(nil)
+
+	w.handleLockERC721(context.Background(), lockERC721Log, mocks.MQueue)
+
+	mocks.MQueue.AssertCalled(t, "Push", &queue.Message{Payload: expected, Topic: constants.ReadOnlyHederaMintNftTransfer})
+}
+
+func Test_HandleLockERC721_TargetNotHedera(t *testing.T) {
+	setup()
+	mocks.MEVMClient.On("GetChainID").Return(sourceChainId)
+
+	lockERC721Log := &router.RouterLockERC721{
+		TargetChain: big.NewInt(1),
+		Token:       tokenAddress,
+		TokenId:     big.NewInt(7),
+		Receiver:    common.HexToAddress("0xb083879B1e10C8476802016CB12cd2F25a896691").Bytes(),
+	}
+	w.handleLockERC721(context.Background(), lockERC721Log, mocks.MQueue)
+
+	mocks.MAssetsService.AssertNotCalled(t, "NativeToWrapped", mock.Anything, mock.Anything, mock.Anything)
+	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
+}
+
+func Test_HandleLockERC721_WrappedAssetNotFound(t *testing.T) {
+	setup()
+	mocks.MEVMClient.On("GetChainID").Return(sourceChainId)
+
+	lockERC721Log := &router.RouterLockERC721{
+		TargetChain: targetChainIdBigInt,
+		Token:       tokenAddress,
+		TokenId:     big.NewInt(7),
+		Receiver:    hederaBytes,
+	}
+	mocks.MAssetsService.On("NativeToWrapped", tokenAddressString, sourceChainId, targetChainId).Return("")
+	w.handleLockERC721(context.Background(), lockERC721Log, mocks.MQueue)
+
+	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
+}
+
+func Test_HandleLockERC721_TokenIdExceedsSerialNumber(t *testing.T) {
+	setup()
+	txHash, originator := newSignedTransaction(t)
+	tokenId := new(big.Int).Lsh(big.NewInt(1), 64)
+	lockERC721Log := &router.RouterLockERC721{
+		TargetChain: targetChainIdBigInt,
+		Token:       tokenAddress,
+		TokenId:     tokenId,
+		Metadata:    "metadata",
+		Receiver:    hederaBytes,
+		Raw:         types.Log{TxHash: txHash},
+	}
+	wrappedAsset := "0.0.222222"
+	mocks.MEVMClient.On("GetChainID").Return(sourceChainId)
+	mocks.MEVMClient.On("GetBlockTimestamp", big.NewInt(0)).Return(uint64(1))
+	mocks.MAssetsService.On("NativeToWrapped", tokenAddressString, sourceChainId, targetChainId).Return(wrappedAsset)
+	rejected := &payload.Transfer{
+		TransactionId: fmt.Sprintf("%s-%d", txHash, 0),
+		SourceChainId: sourceChainId,
+		TargetChainId: targetChainId,
+		NativeChainId: sourceChainId,
+		SourceAsset:   tokenAddressString,
+		TargetAsset:   wrappedAsset,
+		NativeAsset:   tokenAddressString,
+		IsNft:         true,
+		Metadata:      "metadata",
+		Originator:    originator,
+		Timestamp:     time.Unix(1, 0).UTC(),
+	}
+	mocks.MTransferRepository.On("Reject", rejected, failure.InvalidTokenId, mock.Anything).Return(&entity.Transfer{}, nil)
+
+	w.handleLockERC721(context.Background(), lockERC721Log, mocks.MQueue)
+
+	mocks.MTransferRepository.AssertCalled(t, "Reject", rejected, failure.InvalidTokenId, mock.Anything)
+	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
+}
+
+func Test_HandleUnlockLog_RecordsClaim(t *testing.T) {
+	setup()
+	txHash, claimer := newSignedTransaction(t)
+	eventLog := &router.RouterUnlock{
+		SourceChain:   big.NewInt(0),
+		TransactionId: []byte(claimedTxId),
+		Token:         tokenAddress,
+		Raw:           types.Log{TxHash: txHash, BlockNumber: 42},
+	}
+
+	mocks.MEVMClient.On("GetBlockTimestamp", big.NewInt(42)).Return(uint64(1650000000))
+	mocks.MTransferRepository.On("UpdateStatusClaimed", claimedTxId, txHash.String(), uint64(42), int64(1650000000), claimer).Return(nil)
+
+	w.handleUnlockLog(context.Background(), eventLog)
+
+	mocks.MTransferRepository.AssertCalled(t, "UpdateStatusClaimed", claimedTxId, txHash.String(), uint64(42), int64(1650000000), claimer)
+}
+
+func Test_HandleMintERC721_RecordsClaim(t *testing.T) {
+	setup()
+	txHash, claimer := newSignedTransaction(t)
+	eventLog := &router.RouterMintERC721{
+		SourceChain:   big.NewInt(0),
+		TransactionId: []byte(claimedTxId),
+		Token:         tokenAddress,
+		TokenId:       big.NewInt(7),
+		Raw:           types.Log{TxHash: txHash, BlockNumber: 42},
+	}
+
+	mocks.MEVMClient.On("GetBlockTimestamp", big.NewInt(42)).Return(uint64(1650000000))
+	mocks.MTransferRepository.On("UpdateStatusClaimed", claimedTxId, txHash.String(), uint64(42), int64(1650000000), claimer).Return(nil)
+
+	w.handleMintERC721(context.Background(), eventLog)
+
+	mocks.MTransferRepository.AssertCalled(t, "UpdateStatusClaimed", claimedTxId, txHash.String(), uint64(42), int64(1650000000), claimer)
+}
+
+func Test_HandleUnlockLog_Removed(t *testing.T) {
+	setup()
+
+	w.handleUnlockLog(context.Background(), &router.RouterUnlock{Raw: types.Log{Removed: true}})
+
+	mocks.MTransferRepository.AssertNotCalled(t, "UpdateStatusClaimed", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 }
 
 func TestNewWatcher(t *testing.T) {
 	mocks.Setup()
 
 	mocks.MStatusRepository.On("Get", mock.Anything).Return(int64(0), nil)
-	mocks.MEVMClient.On("RetryBlockNumber").Return(uint64(10), nil)
-	mocks.MEVMClient.On("BlockConfirmations", mock.Anything).Return(uint64(5))
+	mocks.MEVMClient.On("RetryConfirmedBlockNumber", mock.Anything).Return(uint64(5), nil)
 	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+	mocks.MEVMClient.On("GetChainID").Return(sourceChainId)
 
 	abi, err := abi.JSON(strings.NewReader(router.RouterABI))
 	if err != nil {
@@ -501,6 +878,9 @@ func TestNewWatcher(t *testing.T) {
 	lockHashFromAbi := abi.Events["Lock"].ID
 	unlockHashFromAbi := abi.Events["Unlock"].ID
 	burnERC721HashAbi := abi.Events["BurnERC721"].ID
+	lockERC721HashAbi := abi.Events["LockERC721"].ID
+	unlockERC721HashAbi := abi.Events["UnlockERC721"].ID
+	mintERC721HashAbi := abi.Events["MintERC721"].ID
 	memberUpdatedHash := abi.Events["MemberUpdated"].ID
 
 	addresses := []common.Address{
@@ -516,6 +896,9 @@ func TestNewWatcher(t *testing.T) {
 		lockHash:          lockHashFromAbi,
 		unlockHash:        unlockHashFromAbi,
 		burnERC721Hash:    burnERC721HashAbi,
+		lockERC721Hash:    lockERC721HashAbi,
+		unlockERC721Hash:  unlockERC721HashAbi,
+		mintERC721Hash:    mintERC721HashAbi,
 		memberUpdatedHash: memberUpdatedHash,
 		maxLogsBlocks:     220,
 	}
@@ -524,6 +907,8 @@ func TestNewWatcher(t *testing.T) {
 	blacklist := []string{"0.0.444", "0x0123"}
 	w = &Watcher{
 		repository:          mocks.MStatusRepository,
+		blockRepository:     mocks.MBlockRepository,
+		transferRepository:  mocks.MTransferRepository,
 		contracts:           mocks.MBridgeContractService,
 		prometheusService:   mocks.MPrometheusService,
 		pricingService:      mocks.MPricingService,
@@ -538,7 +923,7 @@ func TestNewWatcher(t *testing.T) {
 		blacklistedAccounts: blacklist,
 	}
 
-	actual := NewWatcher(mocks.MStatusRepository, mocks.MBridgeContractService, mocks.MPrometheusService, mocks.MPricingService, mocks.MEVMClient, assets, dbIdentifier, 0, true, 15, 220, blacklist)
+	actual := NewWatcher(mocks.MStatusRepository, mocks.MBlockRepository, mocks.MTransferRepository, mocks.MBridgeContractService, mocks.MPrometheusService, mocks.MPricingService, mocks.MEVMClient, assets, dbIdentifier, 0, true, 15, 220, blacklist, false)
 	assert.Equal(t, w, actual)
 }
 
@@ -556,7 +941,7 @@ func Test_ProcessLogs_ParseBurnLogFails(t *testing.T) {
 		Topics:  topics,
 	}
 
-	mocks.MEVMClient.On("RetryFilterLogs", *query).
+	mocks.MEVMClient.On("RetryFilterLogs", mock.Anything, *query).
 		Return([]types.Log{
 			{
 				Topics: []common.Hash{
@@ -570,8 +955,12 @@ func Test_ProcessLogs_ParseBurnLogFails(t *testing.T) {
 			burnHash,
 		},
 	}).Return(burnLog, errors.New("some-error"))
+	mocks.MEVMClient.On("RetryHeaderByNumber", mock.Anything, big.NewInt(0)).Return(header, nil)
+	mocks.MBlockRepository.On("Save", &entity.Block{EntityID: dbIdentifier, Number: 0, Hash: header.Hash().String(), Timestamp: 1}).Return(nil)
+	mocks.MBlockRepository.On("Prune", dbIdentifier, blockHistory).Return(nil)
+	mocks.MBlockRepository.On("GetLatest", dbIdentifier, blockHistory).Return([]*entity.Block{}, nil)
 	mocks.MStatusRepository.On("Update", dbIdentifier, int64(1)).Return(nil)
-	w.processLogs(0, 0, mocks.MQueue)
+	w.processLogs(context.Background(), 0, 0, mocks.MQueue)
 	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
 }
 
@@ -587,7 +976,7 @@ func Test_ProcessLogs_ParseLockLogFails(t *testing.T) {
 		Topics:  topics,
 	}
 
-	mocks.MEVMClient.On("RetryFilterLogs", *query).
+	mocks.MEVMClient.On("RetryFilterLogs", mock.Anything, *query).
 		Return([]types.Log{
 			{
 				Topics: []common.Hash{
@@ -601,8 +990,15 @@ func Test_ProcessLogs_ParseLockLogFails(t *testing.T) {
 			lockHash,
 		},
 	}).Return(lockLog, errors.New("some-error"))
+	mocks.MEVMClient.On("RetryHeaderByNumber", mock.Anything, big.NewInt(0)).Return(header, nil)
+	mocks.MBlockRepository.On("Save", &entity.Block{EntityID: dbIdentifier, Number: 0, Hash: header.Hash().String(), Timestamp: 1}).Return(nil)
+	mocks.MBlockRepository.On("Prune", dbIdentifier, blockHistory).Return(nil)
+	mocks.MBlockRepository.On("GetLatest", dbIdentifier, blockHistory).Return([]*entity.Block{}, nil)
 	mocks.MStatusRepository.On("Update", dbIdentifier, int64(1)).Return(nil)
-	w.processLogs(0, 0, mocks.MQueue)
+	logs, reorged, err := w.processLogs(context.Background(), 0, 0, mocks.MQueue)
+	assert.Nil(t, err)
+	assert.False(t, reorged)
+	assert.Equal(t, 1, logs)
 	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
 }
 
@@ -618,10 +1014,25 @@ func Test_ProcessLogs_FilterLogsFails(t *testing.T) {
 		Topics:  topics,
 	}
 
-	mocks.MEVMClient.On("RetryFilterLogs", *query).
+	mocks.MEVMClient.On("RetryHeaderByNumber", mock.Anything, big.NewInt(5)).Return(header, nil)
+	mocks.MEVMClient.On("RetryFilterLogs", mock.Anything, *query).
 		Return([]types.Log{}, errors.New("some-error"))
 
-	w.processLogs(0, 5, mocks.MQueue)
+	w.processLogs(context.Background(), 0, 5, mocks.MQueue)
+	mocks.MBlockRepository.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func Test_ProcessLogs_HeaderByNumberFails(t *testing.T) {
+	setup()
+
+	expectedErr := errors.New("some-error")
+	mocks.MEVMClient.On("RetryHeaderByNumber", mock.Anything, big.NewInt(5)).Return(nil, expectedErr)
+
+	_, _, err := w.processLogs(context.Background(), 0, 5, mocks.MQueue)
+
+	assert.Equal(t, expectedErr, err)
+	mocks.MEVMClient.AssertNotCalled(t, "RetryFilterLogs", mock.Anything, mock.Anything)
+	mocks.MStatusRepository.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
 }
 
 func Test_ProcessLogs_RepoUpdateFails(t *testing.T) {
@@ -638,11 +1049,261 @@ func Test_ProcessLogs_RepoUpdateFails(t *testing.T) {
 	}
 	expectedErr := errors.New("some-error")
 
-	mocks.MEVMClient.On("RetryFilterLogs", *query).
+	mocks.MEVMClient.On("RetryFilterLogs", mock.Anything, *query).
 		Return([]types.Log{}, nil)
+	mocks.MEVMClient.On("RetryHeaderByNumber", mock.Anything, big.NewInt(0)).Return(header, nil)
+	mocks.MBlockRepository.On("Save", &entity.Block{EntityID: dbIdentifier, Number: 0, Hash: header.Hash().String(), Timestamp: 1}).Return(nil)
+	mocks.MBlockRepository.On("Prune", dbIdentifier, blockHistory).Return(nil)
+	mocks.MBlockRepository.On("GetLatest", dbIdentifier, blockHistory).Return([]*entity.Block{}, nil)
 	mocks.MStatusRepository.On("Update", dbIdentifier, int64(1)).Return(expectedErr)
-	res := w.processLogs(0, 0, mocks.MQueue)
-	assert.Equal(t, expectedErr, res)
+	_, _, err := w.processLogs(context.Background(), 0, 0, mocks.MQueue)
+	assert.Equal(t, expectedErr, err)
+}
+
+func Test_ProcessLogs_BlockChangedWhileRetrievingLogs(t *testing.T) {
+	setup()
+	query := &ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetInt64(0),
+		Addresses: []common.Address{
+			common.HexToAddress("0x0000000000000000000000000000000000000000"),
+		},
+		ToBlock: new(big.Int).SetInt64(5),
+		Topics:  topics,
+	}
+	mocks.MEVMClient.On("RetryHeaderByNumber", mock.Anything, big.NewInt(5)).Return(header, nil).Once()
+	mocks.MEVMClient.On("RetryHeaderByNumber", mock.Anything, big.NewInt(5)).Return(&types.Header{Number: big.NewInt(5), Time: 2}, nil)
+	mocks.MEVMClient.On("RetryFilterLogs", mock.Anything, *query).Return([]types.Log{{Topics: []common.Hash{lockHash}}}, nil)
+	mocks.MBlockRepository.On("GetLatest", dbIdentifier, blockHistory).Return([]*entity.Block{}, nil)
+
+	logs, reorged, err := w.processLogs(context.Background(), 0, 5, mocks.MQueue)
+
+	assert.Nil(t, err)
+	assert.True(t, reorged)
+	assert.Zero(t, logs)
+	mocks.MBridgeContractService.AssertNotCalled(t, "ParseLockLog", mock.Anything)
+	mocks.MBlockRepository.AssertNotCalled(t, "Save", mock.Anything)
+	mocks.MStatusRepository.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func Test_ProcessLogs_ReorgedWhileRetrievingLogs(t *testing.T) {
+	setup()
+	query := &ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetInt64(11),
+		Addresses: []common.Address{
+			common.HexToAddress("0x0000000000000000000000000000000000000000"),
+		},
+		ToBlock: new(big.Int).SetInt64(20),
+		Topics:  topics,
+	}
+	ancestor := &types.Header{Number: big.NewInt(5), Time: 50}
+	mocks.MEVMClient.On("RetryHeaderByNumber", mock.Anything, big.NewInt(20)).Return(&types.Header{Number: big.NewInt(20), Time: 200}, nil)
+	mocks.MEVMClient.On("RetryFilterLogs", mock.Anything, *query).Return([]types.Log{{Topics: []common.Hash{lockHash}}}, nil)
+	// The range no longer follows the last processed block, even though its last block has not changed
+	mocks.MBlockRepository.On("GetLatest", dbIdentifier, blockHistory).Return([]*entity.Block{
+		{EntityID: dbIdentifier, Number: 10, Hash: common.HexToHash("0x1").String(), Timestamp: 100},
+		{EntityID: dbIdentifier, Number: 5, Hash: ancestor.Hash().String(), Timestamp: 50},
+	}, nil)
+	mocks.MEVMClient.On("RetryHeaderByNumber", mock.Anything, big.NewInt(11)).Return(&types.Header{Number: big.NewInt(11), ParentHash: common.HexToHash("0x2")}, nil)
+	mocks.MEVMClient.On("RetryHeaderByNumber", mock.Anything, big.NewInt(5)).Return(ancestor, nil)
+	mocks.MEVMClient.On("GetChainID").Return(sourceChainId)
+	mocks.MBlockRepository.On("DeleteFrom", dbIdentifier, int64(6)).Return(nil)
+	mocks.MTransferRepository.On("UpdateStatusReorged", sourceChainId, time.Unix(50, 0)).Return([]string{}, nil)
+	mocks.MTransferRepository.On("UpdateStatusClaimReorged", sourceChainId, uint64(6)).Return([]string{}, nil)
+	mocks.MStatusRepository.On("Update", dbIdentifier, int64(6)).Return(nil)
+
+	logs, reorged, err := w.processLogs(context.Background(), 11, 20, mocks.MQueue)
+
+	assert.Nil(t, err)
+	assert.True(t, reorged)
+	assert.Zero(t, logs)
+	mocks.MBridgeContractService.AssertNotCalled(t, "ParseLockLog", mock.Anything)
+	mocks.MBlockRepository.AssertNotCalled(t, "Save", mock.Anything)
+	mocks.MStatusRepository.AssertCalled(t, "Update", dbIdentifier, int64(6))
+}
+
+func Test_CheckReorg_NoProcessedBlocks(t *testing.T) {
+	setup()
+	mocks.MBlockRepository.On("GetLatest", dbIdentifier, blockHistory).Return([]*entity.Block{}, nil)
+
+	reorged, err := w.checkReorg(context.Background(), 11)
+
+	assert.Nil(t, err)
+	assert.False(t, reorged)
+	mocks.MEVMClient.AssertNotCalled(t, "RetryHeaderByNumber", mock.Anything, mock.Anything)
+}
+
+func Test_CheckReorg_SameParent(t *testing.T) {
+	setup()
+	parent := &types.Header{Number: big.NewInt(10), Time: 100}
+	mocks.MBlockRepository.On("GetLatest", dbIdentifier, blockHistory).Return([]*entity.Block{
+		{EntityID: dbIdentifier, Number: 10, Hash: parent.Hash().String(), Timestamp: 100},
+	}, nil)
+	mocks.MEVMClient.On("RetryHeaderByNumber", mock.Anything, big.NewInt(11)).Return(&types.Header{Number: big.NewInt(11), ParentHash: parent.Hash()}, nil)
+
+	reorged, err := w.checkReorg(context.Background(), 11)
+
+	assert.Nil(t, err)
+	assert.False(t, reorged)
+	mocks.MBlockRepository.AssertNotCalled(t, "DeleteFrom", mock.Anything, mock.Anything)
+}
+
+func Test_CheckReorg_RewindsToCommonAncestor(t *testing.T) {
+	setup()
+	ancestor := &types.Header{Number: big.NewInt(5), Time: 50}
+	mocks.MBlockRepository.On("GetLatest", dbIdentifier, blockHistory).Return([]*entity.Block{
+		{EntityID: dbIdentifier, Number: 10, Hash: common.HexToHash("0x1").String(), Timestamp: 100},
+		{EntityID: dbIdentifier, Number: 5, Hash: ancestor.Hash().String(), Timestamp: 50},
+	}, nil)
+	mocks.MEVMClient.On("RetryHeaderByNumber", mock.Anything, big.NewInt(11)).Return(&types.Header{Number: big.NewInt(11), ParentHash: common.HexToHash("0x2")}, nil)
+	mocks.MEVMClient.On("RetryHeaderByNumber", mock.Anything, big.NewInt(5)).Return(ancestor, nil)
+	mocks.MEVMClient.On("GetChainID").Return(sourceChainId)
+	mocks.MBlockRepository.On("DeleteFrom", dbIdentifier, int64(6)).Return(nil)
+	mocks.MTransferRepository.On("UpdateStatusReorged", sourceChainId, time.Unix(50, 0)).Return([]string{"0xtx-0"}, nil)
+	mocks.MTransferRepository.On("UpdateStatusClaimReorged", sourceChainId, uint64(6)).Return([]string{"0xtx-1"}, nil)
+	mocks.MStatusRepository.On("Update", dbIdentifier, int64(6)).Return(nil)
+
+	reorged, err := w.checkReorg(context.Background(), 11)
+
+	assert.Nil(t, err)
+	assert.True(t, reorged)
+	mocks.MTransferRepository.AssertCalled(t, "UpdateStatusReorged", sourceChainId, time.Unix(50, 0))
+	mocks.MTransferRepository.AssertCalled(t, "UpdateStatusClaimReorged", sourceChainId, uint64(6))
+	mocks.MStatusRepository.AssertCalled(t, "Update", dbIdentifier, int64(6))
+}
+
+func Test_CheckReorg_NoCommonAncestor(t *testing.T) {
+	setup()
+	mocks.MBlockRepository.On("GetLatest", dbIdentifier, blockHistory).Return([]*entity.Block{
+		{EntityID: dbIdentifier, Number: 10, Hash: common.HexToHash("0x1").String(), Timestamp: 100},
+		{EntityID: dbIdentifier, Number: 5, Hash: common.HexToHash("0x3").String(), Timestamp: 50},
+	}, nil)
+	mocks.MEVMClient.On("RetryHeaderByNumber", mock.Anything, big.NewInt(11)).Return(&types.Header{Number: big.NewInt(11), ParentHash: common.HexToHash("0x2")}, nil)
+	mocks.MEVMClient.On("RetryHeaderByNumber", mock.Anything, big.NewInt(5)).Return(&types.Header{Number: big.NewInt(5), Time: 51}, nil)
+	mocks.MEVMClient.On("GetChainID").Return(sourceChainId)
+	mocks.MBlockRepository.On("DeleteFrom", dbIdentifier, int64(5)).Return(nil)
+	mocks.MTransferRepository.On("UpdateStatusReorged", sourceChainId, time.Unix(49, 0)).Return([]string{}, nil)
+	mocks.MTransferRepository.On("UpdateStatusClaimReorged", sourceChainId, uint64(5)).Return([]string{}, nil)
+	mocks.MStatusRepository.On("Update", dbIdentifier, int64(5)).Return(nil)
+
+	reorged, err := w.checkReorg(context.Background(), 11)
+
+	assert.Nil(t, err)
+	assert.True(t, reorged)
+	mocks.MStatusRepository.AssertCalled(t, "Update", dbIdentifier, int64(5))
+}
+
+func Test_CheckReorg_UpdateStatusReorgedFails(t *testing.T) {
+	setup()
+	ancestor := &types.Header{Number: big.NewInt(5), Time: 50}
+	mocks.MBlockRepository.On("GetLatest", dbIdentifier, blockHistory).Return([]*entity.Block{
+		{EntityID: dbIdentifier, Number: 10, Hash: common.HexToHash("0x1").String(), Timestamp: 100},
+		{EntityID: dbIdentifier, Number: 5, Hash: ancestor.Hash().String(), Timestamp: 50},
+	}, nil)
+	mocks.MEVMClient.On("RetryHeaderByNumber", mock.Anything, big.NewInt(11)).Return(&types.Header{Number: big.NewInt(11), ParentHash: common.HexToHash("0x2")}, nil)
+	mocks.MEVMClient.On("RetryHeaderByNumber", mock.Anything, big.NewInt(5)).Return(ancestor, nil)
+	mocks.MEVMClient.On("GetChainID").Return(sourceChainId)
+	mocks.MBlockRepository.On("DeleteFrom", dbIdentifier, int64(6)).Return(nil)
+	mocks.MTransferRepository.On("UpdateStatusReorged", sourceChainId, time.Unix(50, 0)).Return(nil, errors.New("some-error"))
+
+	reorged, err := w.checkReorg(context.Background(), 11)
+
+	assert.NotNil(t, err)
+	assert.False(t, reorged)
+	mocks.MStatusRepository.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func Test_Reinclude_RestoresReorged(t *testing.T) {
+	setup()
+	transfer := &payload.Transfer{TransactionId: "0xtx-0"}
+	mocks.MTransferRepository.ExpectedCalls = nil
+	mocks.MTransferRepository.On("GetByTransactionId", transfer.TransactionId).Return(&entity.Transfer{Status: status.Reorged}, nil)
+	mocks.MTransferRepository.On("UpdateStatusReincluded", transfer).Return(&entity.Transfer{Status: status.Completed}, nil)
+
+	assert.True(t, w.reinclude(transfer))
+	mocks.MTransferRepository.AssertCalled(t, "UpdateStatusReincluded", transfer)
+}
+
+func Test_Reinclude_NotReorged(t *testing.T) {
+	setup()
+	transfer := &payload.Transfer{TransactionId: "0xtx-0"}
+	mocks.MTransferRepository.ExpectedCalls = nil
+	mocks.MTransferRepository.On("GetByTransactionId", transfer.TransactionId).Return(&entity.Transfer{Status: status.Completed}, nil)
+
+	assert.True(t, w.reinclude(transfer))
+	mocks.MTransferRepository.AssertNotCalled(t, "UpdateStatusReincluded", mock.Anything)
+}
+
+func Test_Push_ReincludeFails(t *testing.T) {
+	setup()
+	transfer := &payload.Transfer{TransactionId: "0xtx-0"}
+	mocks.MTransferRepository.ExpectedCalls = nil
+	mocks.MTransferRepository.On("GetByTransactionId", transfer.TransactionId).Return(&entity.Transfer{Status: status.Reorged}, nil)
+	mocks.MTransferRepository.On("UpdateStatusReincluded", transfer).Return(nil, errors.New("some-error"))
+
+	w.push(context.Background(), mocks.MQueue, transfer, constants.HederaMintHtsTransfer)
+
+	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
+}
+
+func Test_SubscribeNewHeads_WakesOnNewHead(t *testing.T) {
+	setup()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	subscription := newSubscription()
+	subscribed := make(chan chan<- *types.Header, 1)
+	mocks.MEVMClient.On("SubscribeNewHead", mock.Anything, mock.Anything).Return(subscription, nil).Run(func(args mock.Arguments) {
+		subscribed <- args.Get(1).(chan<- *types.Header)
+	}).Once()
+	wake := make(chan struct{}, 1)
+
+	go w.subscribeNewHeads(ctx, wake)
+
+	heads := <-subscribed
+	<-wake
+	heads <- &types.Header{}
+	<-wake
+	cancel()
+	<-subscription.unsubscribed
+}
+
+func Test_SubscribeNewHeads_ResubscribesOnFailure(t *testing.T) {
+	setup()
+	w.sleepDuration = time.Millisecond
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	failed := newSubscription()
+	resubscribed := newSubscription()
+	mocks.MEVMClient.On("SubscribeNewHead", mock.Anything, mock.Anything).Return(nil, errors.New("some-error")).Once()
+	mocks.MEVMClient.On("SubscribeNewHead", mock.Anything, mock.Anything).Return(failed, nil).Once()
+	mocks.MEVMClient.On("SubscribeNewHead", mock.Anything, mock.Anything).Return(resubscribed, nil).Once()
+	wake := make(chan struct{}, 1)
+
+	go w.subscribeNewHeads(ctx, wake)
+
+	<-wake
+	failed.err <- errors.New("connection lost")
+	<-failed.unsubscribed
+	<-wake
+	cancel()
+	<-resubscribed.unsubscribed
+	mocks.MEVMClient.AssertNumberOfCalls(t, "SubscribeNewHead", 3)
+}
+
+type subscription struct {
+	err          chan error
+	unsubscribed chan struct{}
+}
+
+func newSubscription() *subscription {
+	return &subscription{err: make(chan error, 1), unsubscribed: make(chan struct{})}
+}
+
+func (s *subscription) Unsubscribe() {
+	close(s.unsubscribed)
+}
+
+func (s *subscription) Err() <-chan error {
+	return s.err
 }
 
 func setup() {
@@ -650,9 +1311,12 @@ func setup() {
 
 	mocks.MStatusRepository.On("Get", mock.Anything).Return(int64(0), nil)
 	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+	mocks.MTransferRepository.On("GetByTransactionId", mock.Anything).Return(nilTransfer, nil)
 
 	w = &Watcher{
 		repository:          mocks.MStatusRepository,
+		blockRepository:     mocks.MBlockRepository,
+		transferRepository:  mocks.MTransferRepository,
 		contracts:           mocks.MBridgeContractService,
 		prometheusService:   mocks.MPrometheusService,
 		pricingService:      mocks.MPricingService,
@@ -666,3 +1330,45 @@ func setup() {
 		blacklistedAccounts: []string{"0x0123", "0x4567"},
 	}
 }
+
+// newWrappedBurnLog creates a burn log of a signed transaction, returning it along with its originator
+func newWrappedBurnLog(t *testing.T, targetChain *big.Int, receiver []byte, amount *big.Int) (*router.RouterBurn, string) {
+	txHash, originator := newSignedTransaction(t)
+
+	return &router.RouterBurn{
+		TargetChain: targetChain,
+		Token:       tokenAddress,
+		Receiver:    receiver,
+		Amount:      amount,
+		Raw:         types.Log{TxHash: txHash},
+	}, originator
+}
+
+// newLockERC721Log creates a lock ERC-721 log of a signed transaction, returning it along with its originator
+func newLockERC721Log(t *testing.T, targetChain *big.Int) (*router.RouterLockERC721, string) {
+	txHash, originator := newSignedTransaction(t)
+
+	return &router.RouterLockERC721{
+		TargetChain: targetChain,
+		Token:       tokenAddress,
+		TokenId:     big.NewInt(7),
+		Metadata:    "ipfs://metadata",
+		Receiver:    hederaBytes,
+		Raw:         types.Log{TxHash: txHash},
+	}, originator
+}
+
+// newSignedTransaction mocks the retrieval of a signed transaction, returning its hash and originator
+func newSignedTransaction(t *testing.T) (common.Hash, string) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx, err := types.SignTx(types.NewTx(&types.LegacyTx{}), types.LatestSignerForChainID(big.NewInt(1)), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mocks.MEVMClient.On("RetryTransactionByHash", mock.Anything, tx.Hash()).Return(tx, nil)
+
+	return tx.Hash(), crypto.PubkeyToAddress(key.PublicKey).String()
+}
@@ -17,6 +17,7 @@
 package message
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"time"
@@ -27,11 +28,16 @@ import (
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
 	qi "github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/metrics"
+	syncHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/sync"
 	"github.com/limechain/hedera-eth-bridge-validator/app/helper/timestamp"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/tracing"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/message"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 	"gorm.io/gorm"
 )
 
@@ -41,6 +47,7 @@ type Watcher struct {
 	statusRepository repository.Status
 	pollingInterval  time.Duration
 	logger           *log.Entry
+	metrics          *metrics.Watcher
 }
 
 func NewWatcher(
@@ -48,7 +55,8 @@ func NewWatcher(
 	topicID string,
 	repository repository.Status,
 	pollingInterval time.Duration,
-	startTimestamp int64) *Watcher {
+	startTimestamp int64,
+	prometheusService service.Prometheus) *Watcher {
 	id, err := hedera.TopicIDFromString(topicID)
 	if err != nil {
 		log.Fatalf("Could not start Consensus Topic Watcher for topic [%s] - Error: [%s]", topicID, err)
@@ -84,16 +92,17 @@ func NewWatcher(
 		statusRepository: repository,
 		pollingInterval:  pollingInterval,
 		logger:           config.GetLoggerFor(fmt.Sprintf("[%s] Topic Watcher", topicID)),
+		metrics:          metrics.NewWatcher(prometheusService, constants.HederaNetworkId, topicID),
 	}
 }
 
-func (cmw Watcher) Watch(q qi.Queue) {
+func (cmw Watcher) Watch(ctx context.Context, q qi.Queue) {
 	if !cmw.client.TopicExists(cmw.topicID) {
 		cmw.logger.Errorf("Could not start monitoring topic [%s] - Topic not found.", cmw.topicID.String())
 		return
 	}
 
-	cmw.beginWatching(q)
+	cmw.beginWatching(ctx, q)
 }
 
 func (cmw Watcher) updateStatusTimestamp(ts int64) {
@@ -104,7 +113,7 @@ func (cmw Watcher) updateStatusTimestamp(ts int64) {
 	cmw.logger.Tracef("Updated Topic Watcher timestamp to [%s]", timestamp.ToHumanReadable(ts))
 }
 
-func (cmw Watcher) beginWatching(q qi.Queue) {
+func (cmw Watcher) beginWatching(ctx context.Context, q qi.Queue) {
 	milestoneTimestamp, err := cmw.statusRepository.Get(cmw.topicID.String())
 	if err != nil {
 		cmw.logger.Fatalf("Failed to retrieve Topic Watcher Status timestamp. Error [%s]", err)
@@ -112,11 +121,14 @@ func (cmw Watcher) beginWatching(q qi.Queue) {
 	cmw.logger.Infof("Watching for Messages after Timestamp [%s]", timestamp.ToHumanReadable(milestoneTimestamp))
 
 	for {
+		pollStart := time.Now()
 		messages, err := cmw.client.GetMessagesAfterTimestamp(cmw.topicID, milestoneTimestamp, cmw.client.QueryDefaultLimit())
 		if err != nil {
 			cmw.logger.Errorf("Error while retrieving messages from mirror node. Error [%s]", err)
-			time.Sleep(cmw.pollingInterval * time.Second)
-			go cmw.beginWatching(q)
+			cmw.metrics.PollFailed(pollStart)
+			if syncHelper.Sleep(ctx, cmw.pollingInterval*time.Second) {
+				go cmw.beginWatching(ctx, q)
+			}
 			return
 		}
 
@@ -132,12 +144,32 @@ func (cmw Watcher) beginWatching(q qi.Queue) {
 			cmw.processMessage(msg, q)
 			cmw.updateStatusTimestamp(milestoneTimestamp)
 		}
-		time.Sleep(cmw.pollingInterval * time.Second)
+		cmw.metrics.PolledHedera(pollStart, len(messages), milestoneTimestamp)
+
+		if !syncHelper.Sleep(ctx, cmw.pollingInterval*time.Second) || !syncHelper.WaitIfPaused(ctx) {
+			cmw.logger.Infof("Stopped watching for Messages")
+			return
+		}
+
+		// The timestamp might have been rewound while the watcher was paused
+		milestoneTimestamp, err = cmw.statusRepository.Get(cmw.topicID.String())
+		if err != nil {
+			cmw.logger.Errorf("Failed to retrieve Topic Watcher Status timestamp. Error [%s]", err)
+			if syncHelper.Sleep(ctx, cmw.pollingInterval*time.Second) {
+				go cmw.beginWatching(ctx, q)
+			}
+			return
+		}
 	}
 }
 
+// processMessage decodes the topic message within a span, which starts the trace of its validation
 func (cmw Watcher) processMessage(topicMsg mirrorNodeMsg.Message, q qi.Queue) {
 	cmw.logger.Debugf("New Message Received")
+	ctx, span := tracing.Start(context.Background(), "discover message",
+		attribute.String("hedera.topic.id", cmw.topicID.String()),
+		attribute.String("hedera.consensus_timestamp", topicMsg.ConsensusTimestamp))
+	defer span.End()
 
 	msg, err := message.FromString(topicMsg.Contents, topicMsg.ConsensusTimestamp)
 	if err != nil {
@@ -145,5 +177,8 @@ func (cmw Watcher) processMessage(topicMsg mirrorNodeMsg.Message, q qi.Queue) {
 		return
 	}
 
-	q.Push(&queue.Message{Payload: msg, Topic: constants.TopicMessageValidation})
+	err = q.Push(&queue.Message{Payload: msg, Topic: constants.TopicMessageValidation, TraceContext: tracing.Inject(ctx)})
+	if err != nil {
+		cmw.logger.Errorf("Failed to push message [%s]. Error: [%s]", topicMsg.ConsensusTimestamp, err)
+	}
 }
@@ -17,6 +17,7 @@
 package message
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"github.com/hashgraph/hedera-sdk-go/v2"
@@ -58,22 +59,25 @@ func Test_ProcessMessage_FromString_Fails(t *testing.T) {
 
 func Test_NewWatcher(t *testing.T) {
 	mocks.Setup()
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
 	mocks.MStatusRepository.On("Get", topicID.String()).Return(int64(0), nil)
-	NewWatcher(mocks.MHederaMirrorClient, "0.0.1", mocks.MStatusRepository, 1, 0)
+	NewWatcher(mocks.MHederaMirrorClient, "0.0.1", mocks.MStatusRepository, 1, 0, mocks.MPrometheusService)
 }
 
 func Test_NewWatcher_Get_Error(t *testing.T) {
 	mocks.Setup()
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
 	mocks.MStatusRepository.On("Get", topicID.String()).Return(int64(0), gorm.ErrRecordNotFound)
 	mocks.MStatusRepository.On("Create", topicID.String(), mock.Anything).Return(nil)
-	NewWatcher(mocks.MHederaMirrorClient, "0.0.1", mocks.MStatusRepository, 1, 0)
+	NewWatcher(mocks.MHederaMirrorClient, "0.0.1", mocks.MStatusRepository, 1, 0, mocks.MPrometheusService)
 }
 
 func Test_NewWatcher_WithTS(t *testing.T) {
 	mocks.Setup()
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
 	mocks.MStatusRepository.On("Get", topicID.String()).Return(int64(6), nil)
 	mocks.MStatusRepository.On("Update", topicID.String(), int64(6)).Return(nil)
-	NewWatcher(mocks.MHederaMirrorClient, "0.0.1", mocks.MStatusRepository, 1, 6)
+	NewWatcher(mocks.MHederaMirrorClient, "0.0.1", mocks.MStatusRepository, 1, 6, mocks.MPrometheusService)
 }
 
 func Test_BeginWatch_FailsMessagesRetrieval(t *testing.T) {
@@ -81,7 +85,7 @@ func Test_BeginWatch_FailsMessagesRetrieval(t *testing.T) {
 	mocks.MStatusRepository.On("Get", topicID.String()).Return(int64(5), nil)
 	mocks.MHederaMirrorClient.On("GetMessagesAfterTimestamp", topicID, int64(5), queryDefaultLimit).Return([]mirrorNodeMsg.Message{}, errors.New("some-error"))
 	mocks.MHederaMirrorClient.On("QueryDefaultLimit").Return(queryDefaultLimit)
-	w.beginWatching(mocks.MQueue)
+	w.beginWatching(context.Background(), mocks.MQueue)
 
 	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
 	mocks.MStatusRepository.AssertNotCalled(t, "Update", mock.Anything)
@@ -110,10 +114,10 @@ func Test_BeginWatch_SuccessfulExecution(t *testing.T) {
 	mocks.MHederaMirrorClient.On("QueryDefaultLimit").Return(queryDefaultLimit)
 	mocks.MHederaMirrorClient.On("GetMessagesAfterTimestamp", topicID, int64(2), queryDefaultLimit).Return([]mirrorNodeMsg.Message{m}, nil).Once()
 	mocks.MHederaMirrorClient.On("GetMessagesAfterTimestamp", topicID, milestoneTimestamp, queryDefaultLimit).Return([]mirrorNodeMsg.Message{}, errors.New("some-error"))
-	mocks.MQueue.On("Push", queueMessage)
+	mocks.MQueue.On("Push", queueMessage).Return(nil)
 	mocks.MStatusRepository.On("Update", topicID.String(), milestoneTimestamp).Return(nil)
 
-	w.beginWatching(mocks.MQueue)
+	w.beginWatching(context.Background(), mocks.MQueue)
 
 	mocks.MQueue.AssertCalled(t, "Push", queueMessage)
 	mocks.MStatusRepository.AssertCalled(t, "Update", topicID.String(), milestoneTimestamp)
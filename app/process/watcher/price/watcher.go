@@ -17,8 +17,10 @@
 package price
 
 import (
+	"context"
 	qi "github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	syncHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/sync"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	log "github.com/sirupsen/logrus"
 	"time"
@@ -40,12 +42,14 @@ func NewWatcher(pricingService service.Pricing) *Watcher {
 	}
 }
 
-func (pw *Watcher) Watch(q qi.Queue) {
+func (pw *Watcher) Watch(ctx context.Context, q qi.Queue) {
 	// there will be no handler, so the q is to implement the interface
 	go func() {
 		for {
 			pw.watchIteration()
-			time.Sleep(sleepTime)
+			if !syncHelper.Sleep(ctx, sleepTime) {
+				return
+			}
 		}
 	}()
 }
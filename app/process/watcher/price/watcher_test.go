@@ -17,6 +17,7 @@
 package price
 
 import (
+	"context"
 	"errors"
 	qi "github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
@@ -59,7 +60,7 @@ func Test_Watch(t *testing.T) {
 	setup()
 	mocks.MPricingService.On("FetchAndUpdateUsdPrices").Return(nil)
 
-	watcher.Watch(qi.Queue(nil))
+	watcher.Watch(context.Background(), qi.Queue(nil))
 }
 
 func setup() {
@@ -17,6 +17,7 @@
 package prometheus
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/big"
@@ -30,6 +31,7 @@ import (
 	qi "github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
 	"github.com/limechain/hedera-eth-bridge-validator/app/helper/metrics"
+	syncHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/sync"
 	bridgeConfigEvent "github.com/limechain/hedera-eth-bridge-validator/app/model/bridge-config-event"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/constants"
@@ -146,20 +148,20 @@ func initMonitoredAccountsGauges(bridgeCfg *config.Bridge, prometheusService ser
 	return monitoredAccountsGauges
 }
 
-func (pw *Watcher) Watch(q qi.Queue) {
+func (pw *Watcher) Watch(ctx context.Context, q qi.Queue) {
 	if !pw.prometheusService.GetIsMonitoringEnabled() {
 		pw.logger.Warnf("Tried to executed Prometheus watcher, when monitoring is not enabled.")
 		return
 	}
 
 	// there will be no handler, so the q is to implement the interface
-	go pw.beginWatching()
+	go pw.beginWatching(ctx)
 }
 
-func (pw *Watcher) beginWatching() {
+func (pw *Watcher) beginWatching(ctx context.Context) {
 	//The queue will be not used
 	pw.registerAllAssetsMetrics()
-	pw.setMetrics()
+	pw.setMetrics(ctx)
 }
 
 func (pw *Watcher) registerAllAssetsMetrics() {
@@ -293,9 +295,10 @@ func getMetricData(
 	return name, help
 }
 
-func (pw *Watcher) setMetrics() {
+func (pw *Watcher) setMetrics(ctx context.Context) {
 
 	for {
+		sleepDuration := pausedSleepTime
 		if !pw.paused {
 			payerAccount, errPayerAcc := pw.getAccount(pw.bridgeCfg.Hedera.PayerAccount)
 			if errPayerAcc == nil {
@@ -316,9 +319,11 @@ func (pw *Watcher) setMetrics() {
 			pw.setAllAssetsMetrics()
 
 			pw.logger.Infoln("Dashboard Polling interval: ", pw.dashboardPolling)
-			time.Sleep(pw.dashboardPolling)
-		} else {
-			time.Sleep(pausedSleepTime)
+			sleepDuration = pw.dashboardPolling
+		}
+
+		if !syncHelper.Sleep(ctx, sleepDuration) {
+			return
 		}
 	}
 }
@@ -0,0 +1,56 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package redrive
+
+import (
+	"context"
+	"time"
+
+	qi "github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	syncHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/sync"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+)
+
+// Watcher periodically re-drives the transfers, which got stuck in INITIAL
+type Watcher struct {
+	redriveService service.Redrive
+	interval       time.Duration
+	logger         *log.Entry
+}
+
+func NewWatcher(redriveService service.Redrive, interval time.Duration) *Watcher {
+	return &Watcher{
+		redriveService: redriveService,
+		interval:       interval * time.Second,
+		logger:         config.GetLoggerFor("Redrive Watcher"),
+	}
+}
+
+func (rw *Watcher) Watch(ctx context.Context, q qi.Queue) {
+	// the re-drive service pushes to the queue itself, so the q is to implement the interface
+	go func() {
+		for {
+			if !syncHelper.Sleep(ctx, rw.interval) {
+				return
+			}
+			rw.logger.Debugf("Re-driving stuck transfers ...")
+			rw.redriveService.RedriveStuck()
+		}
+	}()
+}
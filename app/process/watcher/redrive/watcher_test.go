@@ -0,0 +1,73 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package redrive
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	qi "github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	watcher *Watcher
+)
+
+func Test_NewWatcher(t *testing.T) {
+	setup()
+
+	actualWatcher := NewWatcher(mocks.MRedriveService, 60)
+
+	assert.Equal(t, watcher, actualWatcher)
+}
+
+func Test_Watch(t *testing.T) {
+	setup()
+	watcher.interval = time.Millisecond
+	called := make(chan struct{}, 1)
+	mocks.MRedriveService.On("RedriveStuck").Run(func(_ mock.Arguments) {
+		select {
+		case called <- struct{}{}:
+		default:
+		}
+	}).Return()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	watcher.Watch(ctx, qi.Queue(nil))
+
+	select {
+	case <-called:
+	case <-time.After(time.Second):
+		t.Fatal("stuck transfers were not re-driven")
+	}
+}
+
+func setup() {
+	mocks.Setup()
+
+	watcher = &Watcher{
+		redriveService: mocks.MRedriveService,
+		interval:       60 * time.Second,
+		logger:         config.GetLoggerFor("Redrive Watcher"),
+	}
+}
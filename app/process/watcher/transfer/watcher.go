@@ -17,6 +17,7 @@
 package cryptotransfer
 
 import (
+	"context"
 	"encoding/base64"
 	"errors"
 	"fmt"
@@ -35,8 +36,11 @@ import (
 	"github.com/limechain/hedera-eth-bridge-validator/app/helper/decimal"
 	hederaHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/hedera"
 	"github.com/limechain/hedera-eth-bridge-validator/app/helper/metrics"
+	syncHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/sync"
 	"github.com/limechain/hedera-eth-bridge-validator/app/helper/timestamp"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/tracing"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/asset"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/failure"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	log "github.com/sirupsen/logrus"
@@ -59,6 +63,7 @@ type Watcher struct {
 	prometheusService   service.Prometheus
 	pricingService      service.Pricing
 	blacklistedAccounts []string
+	metrics             *metrics.Watcher
 }
 
 func NewWatcher(
@@ -117,19 +122,20 @@ func NewWatcher(
 		pricingService:      pricingService,
 		prometheusService:   prometheusService,
 		blacklistedAccounts: blacklistedAccounts,
+		metrics:             metrics.NewWatcher(prometheusService, constants.HederaNetworkId, accountID),
 	}
 
 	return instance
 
 }
 
-func (ctw Watcher) Watch(q qi.Queue) {
+func (ctw Watcher) Watch(ctx context.Context, q qi.Queue) {
 	if !ctw.client.AccountExists(ctw.accountID) {
 		ctw.logger.Errorf("Could not start monitoring account [%s] - Account not found.", ctw.accountID.String())
 		return
 	}
 
-	go ctw.beginWatching(q)
+	go ctw.beginWatching(ctx, q)
 }
 
 func (ctw Watcher) updateStatusTimestamp(ts int64) {
@@ -140,7 +146,7 @@ func (ctw Watcher) updateStatusTimestamp(ts int64) {
 	ctw.logger.Tracef("Updated Transfer Watcher timestamp to [%s]", timestamp.ToHumanReadable(ts))
 }
 
-func (ctw Watcher) beginWatching(q qi.Queue) {
+func (ctw Watcher) beginWatching(ctx context.Context, q qi.Queue) {
 	milestoneTimestamp, err := ctw.statusRepository.Get(ctw.accountID.String())
 	if err != nil {
 		ctw.logger.Fatalf("Failed to retrieve Transfer Watcher Status timestamp. Error [%s]", err)
@@ -148,11 +154,14 @@ func (ctw Watcher) beginWatching(q qi.Queue) {
 	ctw.logger.Infof("Watching for Transfers after Timestamp [%s]", timestamp.ToHumanReadable(milestoneTimestamp))
 
 	for {
+		pollStart := time.Now()
 		transactions, e := ctw.client.GetAccountCreditTransactionsAfterTimestamp(ctw.accountID, milestoneTimestamp)
 		if e != nil {
 			ctw.logger.Errorf("Suddenly stopped monitoring account. Error: [%s]", e)
-			time.Sleep(ctw.pollingInterval * time.Second)
-			go ctw.beginWatching(q)
+			ctw.metrics.PollFailed(pollStart)
+			if syncHelper.Sleep(ctx, ctw.pollingInterval*time.Second) {
+				go ctw.beginWatching(ctx, q)
+			}
 			return
 		}
 
@@ -165,48 +174,84 @@ func (ctw Watcher) beginWatching(q qi.Queue) {
 			milestoneTimestamp, err = timestamp.FromString(transactions.Transactions[len(transactions.Transactions)-1].ConsensusTimestamp)
 			if err != nil {
 				ctw.logger.Errorf("Unable to parse latest transfer timestamp. Error - [%s].", err)
+				ctw.metrics.PollFailed(pollStart)
 				continue
 			}
 
 			ctw.updateStatusTimestamp(milestoneTimestamp)
 		}
-		time.Sleep(ctw.pollingInterval * time.Second)
+		ctw.metrics.PolledHedera(pollStart, len(transactions.Transactions), milestoneTimestamp)
+
+		if !syncHelper.Sleep(ctx, ctw.pollingInterval*time.Second) || !syncHelper.WaitIfPaused(ctx) {
+			ctw.logger.Infof("Stopped watching for Transfers")
+			return
+		}
+
+		// The timestamp might have been rewound while the watcher was paused
+		milestoneTimestamp, err = ctw.statusRepository.Get(ctw.accountID.String())
+		if err != nil {
+			ctw.logger.Errorf("Failed to retrieve Transfer Watcher Status timestamp. Error [%s]", err)
+			if syncHelper.Sleep(ctx, ctw.pollingInterval*time.Second) {
+				go ctw.beginWatching(ctx, q)
+			}
+			return
+		}
 	}
 }
 
+// processTransaction processes the transaction within a span, which starts the trace of the transfer, discovered in it
 func (ctw Watcher) processTransaction(txID string, q qi.Queue) {
 	ctw.logger.Infof("New Transaction with ID: [%s]", txID)
+	ctx, span := tracing.Start(context.Background(), "discover transaction",
+		tracing.TransferIdKey.String(txID),
+		tracing.SourceChainIdKey.Int64(int64(constants.HederaNetworkId)))
+	defer span.End()
 
-	// TX like: [HBAR -> WHBAR || HTS -> WHTS || WEVM -> EVM] (Hereda to EVM)
+	// TX like: [HBAR -> WHBAR || HTS -> WHTS || WEVM -> EVM || WEVM -> WEVM] (Hereda to EVM)
 	tx, err := ctw.client.GetSuccessfulTransaction(txID)
 	if err != nil {
 		ctw.logger.Errorf("[%s] - Failed to get Transaction. Error: [%s]", txID, err)
 		return
 	}
 
+	parsedTransfer, err := tx.GetIncomingTransfer(ctw.accountID.String())
+	if err != nil {
+		ctw.logger.Errorf("[%s] - Could not extract incoming transfer. Error: [%s]", tx.TransactionID, err)
+		return
+	}
+	// The fields of the transfer, known at the time it is rejected
+	rejected := &payload.Transfer{SourceAsset: parsedTransfer.Asset}
+	if !parsedTransfer.IsNft {
+		rejected.Amount = big.NewInt(parsedTransfer.AmountOrSerialNum)
+	}
+
 	blackListError := blacklist.CheckTxForBlacklistedAccounts(ctw.blacklistedAccounts, tx)
 	if blackListError != nil {
 		ctw.logger.Errorf(blackListError.Error())
+		ctw.reject(tx, rejected, failure.Blacklisted, blackListError.Error())
 		return
 	}
 
-	parsedTransfer, err := tx.GetIncomingTransfer(ctw.accountID.String())
-	if err != nil {
-		ctw.logger.Errorf("[%s] - Could not extract incoming transfer. Error: [%s]", tx.TransactionID, err)
-		return
-	}
 	sourceAsset := parsedTransfer.Asset
 	checkResult := ctw.transfers.SanityCheckTransfer(tx)
 	if checkResult.Err != nil {
 		ctw.logger.Errorf("[%s] - Sanity check failed. Error: [%s]", tx.TransactionID, checkResult.Err)
+		if ctw.reject(tx, rejected, failure.InvalidMemo, checkResult.Err.Error()) && !parsedTransfer.IsNft {
+			ctw.refund(ctx, rejected, q)
+		}
 		return
 	}
 	targetChainId := checkResult.ChainId
+	rejected.TargetChainId = targetChainId
+	rejected.Receiver = checkResult.EvmAddress
 
 	if checkResult.NftId == nil {
-		ctw.initSuccessRatePrometheusMetrics(tx, constants.HederaNetworkId, targetChainId, sourceAsset)
+		ctw.expectTransferStages(targetChainId, sourceAsset)
 	} else {
 		sourceAsset = checkResult.NftId.TokenID.String()
+		rejected.SourceAsset = sourceAsset
+		rejected.SerialNum = checkResult.NftId.SerialNumber
+		rejected.IsNft = true
 	}
 
 	nativeAsset := &asset.NativeAsset{
@@ -219,32 +264,52 @@ func (ctw Watcher) processTransaction(txID string, q qi.Queue) {
 		nativeAsset = ctw.assetsService.WrappedToNative(sourceAsset, constants.HederaNetworkId)
 		if nativeAsset == nil {
 			ctw.logger.Errorf("[%s] - Could not parse asset [%s] to its target chain correlation", tx.TransactionID, sourceAsset)
+			ctw.reject(tx, rejected, failure.UnsupportedAsset, fmt.Sprintf("asset [%s] has no correlation on chain [%d]", sourceAsset, targetChainId))
 			return
 		}
 		targetChainAsset = nativeAsset.Asset
+		// This is the case when you are bridging wrapped to wrapped
 		if nativeAsset.ChainId != targetChainId {
-			ctw.logger.Errorf("[%s] - Wrapped to Wrapped transfers currently not supported [%s] - [%d] for [%d]", tx.TransactionID, nativeAsset.Asset, nativeAsset.ChainId, targetChainId)
-			return
+			targetChainAsset = ctw.assetsService.NativeToWrapped(nativeAsset.Asset, nativeAsset.ChainId, targetChainId)
+			if targetChainAsset == "" {
+				ctw.logger.Errorf("[%s] - Could not parse asset [%s] to its wrapped asset on [%d]", tx.TransactionID, sourceAsset, targetChainId)
+				ctw.reject(tx, rejected, failure.UnsupportedAsset, fmt.Sprintf("asset [%s] has no wrapped asset on chain [%d]", sourceAsset, targetChainId))
+				return
+			}
 		}
 	}
+	rejected.NativeChainId = nativeAsset.ChainId
+	rejected.NativeAsset = nativeAsset.Asset
+	rejected.TargetAsset = targetChainAsset
 
 	var transferMessage *payload.Transfer
 	originator := hederaHelper.OriginatorFromTxId(tx.TransactionID)
 	if checkResult.NftId != nil {
-		nftAssetInfo, ok := ctw.assetsService.NonFungibleAssetInfo(constants.HederaNetworkId, sourceAsset)
-		if !ok {
-			ctw.logger.Errorf("[%s] - Failed to get asset info for NFT [%s] not found.", tx.TransactionID, sourceAsset)
-			return
-		}
+		feeForValidators := int64(0)
+		if nativeAsset.ChainId == constants.HederaNetworkId {
+			nftAssetInfo, ok := ctw.assetsService.NonFungibleAssetInfo(constants.HederaNetworkId, sourceAsset)
+			if !ok {
+				ctw.logger.Errorf("[%s] - Failed to get asset info for NFT [%s] not found.", tx.TransactionID, sourceAsset)
+				ctw.reject(tx, rejected, failure.UnsupportedAsset, fmt.Sprintf("asset info for NFT [%s] not found", sourceAsset))
+				return
+			}
 
-		feeSent, found := tx.GetHBARTransfer(ctw.accountID.String())
-		if !found {
-			ctw.logger.Errorf("[%s] - Transfer to [%s] not found.", tx.TransactionID, ctw.accountID.String())
-			return
-		}
+			feeSent, found := tx.GetHBARTransfer(ctw.accountID.String())
+			if !found {
+				ctw.logger.Errorf("[%s] - Transfer to [%s] not found.", tx.TransactionID, ctw.accountID.String())
+				ctw.reject(tx, rejected, failure.InvalidNftFee, fmt.Sprintf("no HBAR fee sent to [%s]", ctw.accountID.String()))
+				return
+			}
 
-		feeForValidators, ok := ctw.validateNFTFeeSent(sourceAsset, tx, originator, nftAssetInfo, feeSent)
-		if !ok {
+			feeForValidators, ok = ctw.validateNFTFeeSent(sourceAsset, tx, originator, nftAssetInfo, feeSent)
+			if !ok {
+				ctw.reject(tx, rejected, failure.InvalidNftFee, fmt.Sprintf("invalid fee [%d] sent for NFT [%s]", feeSent, sourceAsset))
+				return
+			}
+		} else if nativeAsset.ChainId != targetChainId {
+			// Wrapped NFTs are released only on their native chain
+			ctw.logger.Errorf("[%s] - Wrapped NFT [%s] can only be transferred to its native chain [%d].", tx.TransactionID, sourceAsset, nativeAsset.ChainId)
+			ctw.reject(tx, rejected, failure.UnsupportedRoute, fmt.Sprintf("wrapped NFT [%s] can only be transferred to its native chain [%d]", sourceAsset, nativeAsset.ChainId))
 			return
 		}
 
@@ -256,6 +321,10 @@ func (ctw Watcher) processTransaction(txID string, q qi.Queue) {
 
 	if err != nil {
 		ctw.logger.Errorf("[%s] - Failed to create payload. Error: [%s]", tx.TransactionID, err)
+		var rejection *failure.Error
+		if errors.As(err, &rejection) {
+			ctw.reject(tx, rejected, rejection.Code, rejection.Reason)
+		}
 		return
 	}
 
@@ -278,10 +347,10 @@ func (ctw Watcher) processTransaction(txID string, q qi.Queue) {
 			}
 		} else {
 			if checkResult.NftId != nil {
-				ctw.logger.Errorf("[%s] - NFT Transfer not supported", tx.TransactionID)
-				return
+				topic = constants.HederaBurnNftMessageSubmission
+			} else {
+				topic = constants.HederaBurnMessageSubmission
 			}
-			topic = constants.HederaBurnMessageSubmission
 		}
 	} else {
 		transferMessage.NetworkTimestamp = tx.ConsensusTimestamp
@@ -293,14 +362,46 @@ func (ctw Watcher) processTransaction(txID string, q qi.Queue) {
 			}
 		} else {
 			if checkResult.NftId != nil {
-				ctw.logger.Errorf("[%s] - NFT Read-only Transfer not supported", tx.TransactionID)
-				return
+				topic = constants.ReadOnlyHederaBurnNft
+			} else {
+				topic = constants.ReadOnlyHederaBurn
 			}
-			topic = constants.ReadOnlyHederaBurn
 		}
 	}
 
-	q.Push(&queue.Message{Payload: transferMessage, Topic: topic})
+	span.SetAttributes(tracing.TransferAttributes(transferMessage)...)
+	err = q.Push(&queue.Message{Payload: transferMessage, Topic: topic, TraceContext: tracing.Inject(ctx)})
+	if err != nil {
+		ctw.logger.Errorf("[%s] - Failed to push to topic [%s]. Error: [%s]", tx.TransactionID, topic, err)
+	}
+}
+
+// reject records the transaction as a transfer, failed with the given code, instead of dropping it.
+// Returns whether the transfer is recorded
+func (ctw Watcher) reject(tx transaction.Transaction, rejected *payload.Transfer, code failure.Code, reason string) bool {
+	transactionTimestamp, err := timestamp.FromString(tx.ConsensusTimestamp)
+	if err != nil {
+		ctw.logger.Errorf("[%s] - Failed to parse consensus timestamp [%s]. Error: [%s]", tx.TransactionID, tx.ConsensusTimestamp, err)
+		return false
+	}
+
+	rejected.TransactionId = tx.TransactionID
+	rejected.SourceChainId = constants.HederaNetworkId
+	rejected.Originator = hederaHelper.OriginatorFromTxId(tx.TransactionID)
+	rejected.Timestamp = time.Unix(0, transactionTimestamp)
+	return ctw.transfers.RejectTransfer(*rejected, code, reason) == nil
+}
+
+// refund pushes the rejected transfer to be refunded to its originator. Only validators refund
+// transfers and only those, which happened after the watcher started
+func (ctw Watcher) refund(ctx context.Context, rejected *payload.Transfer, q qi.Queue) {
+	if !ctw.validator || rejected.Timestamp.UnixNano() <= ctw.targetTimestamp {
+		return
+	}
+	err := q.Push(&queue.Message{Payload: rejected, Topic: constants.HederaRefund, TraceContext: tracing.Inject(ctx)})
+	if err != nil {
+		ctw.logger.Errorf("[%s] - Failed to push refund. Error: [%s]", rejected.TransactionId, err)
+	}
 }
 
 func (ctw Watcher) validateNFTFeeSent(sourceAsset string, tx transaction.Transaction, originator string, nftAssetInfo *asset.NonFungibleAssetInfo, feeSent int64) (int64, bool) {
@@ -374,31 +475,36 @@ func (ctw Watcher) createFungiblePayload(transactionID string, receiver string,
 
 	sourceAssetInfo, exists := ctw.assetsService.FungibleAssetInfo(constants.HederaNetworkId, sourceAsset)
 	if !exists {
-		return nil, fmt.Errorf("failed to retrieve fungible asset info of [%s]", sourceAsset)
+		return nil, failure.Errorf(failure.UnsupportedAsset, "failed to retrieve fungible asset info of [%s]", sourceAsset)
 	}
 
 	targetAssetInfo, exists := ctw.assetsService.FungibleAssetInfo(targetChainId, targetChainAsset)
 	if !exists {
-		return nil, fmt.Errorf("failed to retrieve fungible asset info of [%s]", targetChainAsset)
+		return nil, failure.Errorf(failure.UnsupportedAsset, "failed to retrieve fungible asset info of [%s]", targetChainAsset)
 	}
 
 	if (nativeAsset.ChainId == constants.HederaNetworkId) && (sourceAssetInfo.Decimals != targetAssetInfo.Decimals) {
-		return nil, fmt.Errorf("decimals of source asset [%s] and target asset [%s] are not equal", sourceAsset, targetChainAsset)
+		return nil, failure.Errorf(failure.UnsupportedAsset, "decimals of source asset [%s] and target asset [%s] are not equal", sourceAsset, targetChainAsset)
 	}
 
 	targetAmount := decimal.TargetAmount(sourceAssetInfo.Decimals, targetAssetInfo.Decimals, big.NewInt(amount))
 	if targetAmount.Cmp(big.NewInt(0)) == 0 {
-		return nil, fmt.Errorf("insufficient amount provided: Amount [%d] and Target Amount [%s]", amount, targetAmount)
+		return nil, failure.Errorf(failure.AmountBelowMinimum, "insufficient amount provided: Amount [%d] and Target Amount [%s]", amount, targetAmount)
 	}
 
-	tokenPriceInfo, exist := ctw.pricingService.GetTokenPriceInfo(asset.ChainId, nativeAsset.Asset)
+	// The min amount of wrapped to wrapped transfers is in the decimals of the target wrapped asset
+	priceInfoChainId, priceInfoAsset := asset.ChainId, nativeAsset.Asset
+	if nativeAsset.ChainId != constants.HederaNetworkId && nativeAsset.ChainId != targetChainId {
+		priceInfoChainId, priceInfoAsset = targetChainId, targetChainAsset
+	}
+
+	tokenPriceInfo, exist := ctw.pricingService.GetTokenPriceInfo(priceInfoChainId, priceInfoAsset)
 	if !exist {
-		errMsg := fmt.Sprintf("[%s] - Couldn't get price info in USD for asset [%s]", transactionID, nativeAsset.Asset)
-		return nil, errors.New(errMsg)
+		return nil, failure.Errorf(failure.PriceUnavailable, "[%s] - Couldn't get price info in USD for asset [%s]", transactionID, priceInfoAsset)
 	}
 
 	if targetAmount.Cmp(tokenPriceInfo.MinAmountWithFee) < 0 {
-		return nil, fmt.Errorf("[%s] - Transfer Amount [%s] is less than Minimum Amount [%s]", transactionID, targetAmount, tokenPriceInfo.MinAmountWithFee)
+		return nil, failure.Errorf(failure.AmountBelowMinimum, "[%s] - Transfer Amount [%s] is less than Minimum Amount [%s]", transactionID, targetAmount, tokenPriceInfo.MinAmountWithFee)
 	}
 
 	return payload.New(
@@ -410,7 +516,7 @@ func (ctw Watcher) createFungiblePayload(transactionID string, receiver string,
 		sourceAsset,
 		targetChainAsset,
 		nativeAsset.Asset,
-		targetAmount.String()), nil
+		targetAmount), nil
 }
 
 func (ctw Watcher) createNonFungiblePayload(
@@ -447,14 +553,16 @@ func (ctw Watcher) createNonFungiblePayload(
 		fee), nil
 }
 
-func (ctw Watcher) initSuccessRatePrometheusMetrics(tx transaction.Transaction, sourceChainId, targetChainId uint64, asset string) {
+func (ctw Watcher) expectTransferStages(targetChainId uint64, asset string) {
 	if !ctw.prometheusService.GetIsMonitoringEnabled() {
 		return
 	}
 
-	metrics.CreateMajorityReachedIfNotExists(sourceChainId, targetChainId, asset, tx.TransactionID, ctw.prometheusService, ctw.logger)
+	stages := []string{constants.MajorityReachedStage}
 	if ctw.assetsService.IsNative(constants.HederaNetworkId, asset) && targetChainId != constants.HederaNetworkId {
-		metrics.CreateFeeTransferredIfNotExists(sourceChainId, targetChainId, asset, tx.TransactionID, ctw.prometheusService, ctw.logger)
+		stages = append(stages, constants.FeeTransferredStage)
 	}
-	metrics.CreateUserGetHisTokensIfNotExists(sourceChainId, targetChainId, asset, tx.TransactionID, ctw.prometheusService, ctw.logger)
+	stages = append(stages, constants.UserGetHisTokensStage)
+
+	metrics.ExpectTransferStages(constants.HederaNetworkId, targetChainId, asset, ctw.prometheusService, stages...)
 }
@@ -17,10 +17,10 @@
 package cryptotransfer
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/big"
-	"strconv"
 	"testing"
 	"time"
 
@@ -30,14 +30,20 @@ import (
 
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/transaction"
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
 	iservice "github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/tracing"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/asset"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/pricing"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/failure"
+	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
 	"github.com/limechain/hedera-eth-bridge-validator/config/parser"
 	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/limechain/hedera-eth-bridge-validator/test/helper"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/mock"
+	"go.opentelemetry.io/otel/trace"
 	"gorm.io/gorm"
 )
 
@@ -89,9 +95,11 @@ func Test_NewMemo_MissingWrappedCorrelation(t *testing.T) {
 	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
 	mocks.MAssetsService.On("NativeToWrapped", nativeTokenAddressNetwork0, network0, network3).Return(emptyString)
 	mocks.MAssetsService.On("WrappedToNative", nativeTokenAddressNetwork0, network0).Return(nilNativeAsset)
+	mocks.MTransferService.On("RejectTransfer", mock.Anything, failure.UnsupportedAsset, mock.Anything).Return(nil)
 
 	w.processTransaction(tx.TransactionID, mocks.MQueue)
 	mocks.MTransferService.AssertCalled(t, "SanityCheckTransfer", tx)
+	mocks.MTransferService.AssertCalled(t, "RejectTransfer", mock.Anything, failure.UnsupportedAsset, mock.Anything)
 	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
 }
 
@@ -150,20 +158,21 @@ func Test_Watch_AccountNotExist(t *testing.T) {
 		Account: 444444,
 	}
 	mocks.MHederaMirrorClient.On("AccountExists", hederaAcc).Return(false)
-	w.Watch(mocks.MQueue)
+	w.Watch(context.Background(), mocks.MQueue)
 }
 
 func Test_ProcessTransaction(t *testing.T) {
 	w := initializeWatcher()
 	mocks.MHederaMirrorClient.On("GetSuccessfulTransaction", tx.TransactionID).Return(tx, nil)
 	mocks.MTransferService.On("SanityCheckTransfer", tx).Return(transfer.SanityCheckResult{ChainId: network3, EvmAddress: evmAddress})
-	mocks.MQueue.On("Push", mock.Anything).Return()
+	mocks.MQueue.On("Push", mock.Anything).Return(nil)
 	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
 	mocks.MAssetsService.On("NativeToWrapped", nativeTokenAddressNetwork0, network0, network3).Return(wrappedTokenAddressNetwork3)
 	mocks.MAssetsService.On("FungibleNativeAsset", network0, nativeTokenAddressNetwork0).Return(nativeAssetNetwork0)
 	mocks.MPricingService.On("GetTokenPriceInfo", network0, nativeTokenAddressNetwork0).Return(tokenPriceInfo, true)
 	mocks.MAssetsService.On("FungibleAssetInfo", network0, nativeTokenAddressNetwork0).Return(fungibleAssetInfoNetwork0, true)
 	mocks.MAssetsService.On("FungibleAssetInfo", network3, wrappedTokenAddressNetwork3).Return(fungibleAssetInfoNetwork3, true)
+	mocks.MTransferService.On("RejectTransfer", mock.Anything, failure.UnsupportedAsset, mock.Anything).Return(nil)
 
 	w.processTransaction(tx.TransactionID, mocks.MQueue)
 }
@@ -181,17 +190,67 @@ func Test_ProcessTransaction_WithTS(t *testing.T) {
 	mocks.MAssetsService.On("FungibleAssetInfo", network0, nativeTokenAddressNetwork0).Return(fungibleAssetInfoNetwork0, true)
 	mocks.MAssetsService.On("FungibleAssetInfo", network3, wrappedTokenAddressNetwork3).Return(fungibleAssetInfoNetwork3, true)
 
-	mocks.MQueue.On("Push", mock.Anything).Return()
+	mocks.MTransferService.On("RejectTransfer", mock.Anything, failure.UnsupportedAsset, mock.Anything).Return(nil)
+
+	mocks.MQueue.On("Push", mock.Anything).Return(nil)
 	w.processTransaction(anotherTx.TransactionID, mocks.MQueue)
 }
 
+func Test_ProcessTransaction_StartsTrace(t *testing.T) {
+	w := initializeWatcher()
+	exporter := helper.SetupTracing(t)
+	tracedTx := tx
+	tracedTx.TokenTransfers = []transaction.Transfer{{Account: txAccountId, Amount: 10000, Token: nativeTokenAddressNetwork0}}
+	mocks.MHederaMirrorClient.On("GetSuccessfulTransaction", tracedTx.TransactionID).Return(tracedTx, nil)
+	mocks.MTransferService.On("SanityCheckTransfer", tracedTx).Return(transfer.SanityCheckResult{ChainId: network3, EvmAddress: evmAddress})
+	mocks.MQueue.On("Push", mock.Anything).Return(nil)
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+	mocks.MAssetsService.On("NativeToWrapped", nativeTokenAddressNetwork0, network0, network3).Return(wrappedTokenAddressNetwork3)
+	mocks.MAssetsService.On("FungibleNativeAsset", network0, nativeTokenAddressNetwork0).Return(nativeAssetNetwork0)
+	mocks.MPricingService.On("GetTokenPriceInfo", network0, nativeTokenAddressNetwork0).Return(tokenPriceInfo, true)
+	mocks.MAssetsService.On("FungibleAssetInfo", network0, nativeTokenAddressNetwork0).Return(fungibleAssetInfoNetwork0, true)
+	mocks.MAssetsService.On("FungibleAssetInfo", network3, wrappedTokenAddressNetwork3).Return(&asset.FungibleAssetInfo{Decimals: 8}, true)
+
+	w.processTransaction(tracedTx.TransactionID, mocks.MQueue)
+
+	spans := exporter.GetSpans()
+	message := mocks.MQueue.Calls[0].Arguments.Get(0).(*queue.Message)
+	assert.Len(t, spans, 1)
+	assert.Equal(t, "discover transaction", spans[0].Name)
+	assert.Contains(t, spans[0].Attributes, tracing.TargetChainIdKey.Int64(int64(network3)))
+	assert.Equal(t, spans[0].SpanContext.SpanID(), trace.SpanContextFromContext(tracing.Extract(context.Background(), message.TraceContext)).SpanID())
+}
+
 func Test_ProcessTransaction_SanityCheckTransfer_Fails(t *testing.T) {
 	w := initializeWatcher()
+	w.targetTimestamp = 0
 	mocks.MHederaMirrorClient.On("GetSuccessfulTransaction", tx.TransactionID).Return(tx, nil)
 	mocks.MTransferService.On("SanityCheckTransfer", tx).Return(transfer.SanityCheckResult{ChainId: network0, EvmAddress: "", Err: errors.New("some-error")})
+	rejected := payload.Transfer{
+		SourceChainId: constants.HederaNetworkId,
+		SourceAsset:   nativeTokenAddressNetwork0,
+		Amount:        big.NewInt(txAmount),
+		Timestamp:     time.Unix(1631092491, 483966000),
+	}
+	mocks.MTransferService.On("RejectTransfer", rejected, failure.InvalidMemo, "some-error").Return(nil)
+	mocks.MQueue.On("Push", mock.Anything).Return(nil)
 
 	w.processTransaction(tx.TransactionID, mocks.MQueue)
 
+	mocks.MTransferService.AssertCalled(t, "RejectTransfer", rejected, failure.InvalidMemo, "some-error")
+	mocks.MQueue.AssertCalled(t, "Push", &queue.Message{Payload: &rejected, Topic: constants.HederaRefund})
+}
+
+func Test_ProcessTransaction_SanityCheckTransfer_Fails_ReadOnly(t *testing.T) {
+	w := initializeWatcher()
+	w.validator = false
+	mocks.MHederaMirrorClient.On("GetSuccessfulTransaction", tx.TransactionID).Return(tx, nil)
+	mocks.MTransferService.On("SanityCheckTransfer", tx).Return(transfer.SanityCheckResult{ChainId: network0, EvmAddress: "", Err: errors.New("some-error")})
+	mocks.MTransferService.On("RejectTransfer", mock.Anything, failure.InvalidMemo, "some-error").Return(nil)
+
+	w.processTransaction(tx.TransactionID, mocks.MQueue)
+
+	mocks.MTransferService.AssertCalled(t, "RejectTransfer", mock.Anything, failure.InvalidMemo, "some-error")
 	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
 }
 
@@ -199,9 +258,40 @@ func Test_ProcessTransaction_Blacklist_Fails(t *testing.T) {
 	w := initializeWatcher()
 	w.blacklistedAccounts = append(w.blacklistedAccounts, tx.TokenTransfers[0].Account)
 	mocks.MHederaMirrorClient.On("GetSuccessfulTransaction", tx.TransactionID).Return(tx, nil)
+	mocks.MTransferService.On("RejectTransfer", mock.Anything, failure.Blacklisted, mock.Anything).Return(nil)
+
+	w.processTransaction(tx.TransactionID, mocks.MQueue)
+
+	mocks.MTransferService.AssertCalled(t, "RejectTransfer", mock.Anything, failure.Blacklisted, mock.Anything)
+	mocks.MTransferService.AssertNotCalled(t, "SanityCheckTransfer", mock.Anything)
+	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
+}
+
+func Test_ProcessTransaction_BelowMinimum_Rejects(t *testing.T) {
+	w := initializeWatcher()
+	mocks.MHederaMirrorClient.On("GetSuccessfulTransaction", tx.TransactionID).Return(tx, nil)
+	mocks.MTransferService.On("SanityCheckTransfer", tx).Return(transfer.SanityCheckResult{ChainId: network3, EvmAddress: evmAddress})
+	mocks.MAssetsService.On("NativeToWrapped", nativeTokenAddressNetwork0, network0, network3).Return(wrappedTokenAddressNetwork3)
+	mocks.MAssetsService.On("FungibleNativeAsset", network0, nativeTokenAddressNetwork0).Return(nativeAssetNetwork0)
+	mocks.MPricingService.On("GetTokenPriceInfo", network0, nativeTokenAddressNetwork0).Return(tokenPriceInfo, true)
+	mocks.MAssetsService.On("FungibleAssetInfo", network0, nativeTokenAddressNetwork0).Return(fungibleAssetInfoNetwork0, true)
+	mocks.MAssetsService.On("FungibleAssetInfo", network3, wrappedTokenAddressNetwork3).Return(fungibleAssetInfoNetwork0, true)
+	rejected := payload.Transfer{
+		SourceChainId: constants.HederaNetworkId,
+		TargetChainId: network3,
+		NativeChainId: constants.HederaNetworkId,
+		SourceAsset:   nativeTokenAddressNetwork0,
+		TargetAsset:   wrappedTokenAddressNetwork3,
+		NativeAsset:   nativeTokenAddressNetwork0,
+		Receiver:      evmAddress,
+		Amount:        big.NewInt(txAmount),
+		Timestamp:     time.Unix(1631092491, 483966000),
+	}
+	mocks.MTransferService.On("RejectTransfer", rejected, failure.AmountBelowMinimum, mock.Anything).Return(nil)
 
 	w.processTransaction(tx.TransactionID, mocks.MQueue)
 
+	mocks.MTransferService.AssertCalled(t, "RejectTransfer", rejected, failure.AmountBelowMinimum, mock.Anything)
 	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
 }
 
@@ -383,7 +473,7 @@ func Test_ConsensusTimestamp_Fails(t *testing.T) {
 	anotherTx.ConsensusTimestamp = "asd"
 	mocks.MHederaMirrorClient.On("GetSuccessfulTransaction", anotherTx.TransactionID).Return(anotherTx, nil)
 	mocks.MTransferService.On("SanityCheckTransfer", anotherTx).Return(transfer.SanityCheckResult{ChainId: network3, EvmAddress: evmAddress})
-	mocks.MQueue.On("Push", mock.Anything).Return()
+	mocks.MQueue.On("Push", mock.Anything).Return(nil)
 	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
 	mocks.MAssetsService.On("NativeToWrapped", nativeTokenAddressNetwork0, network0, network3).Return(wrappedTokenAddressNetwork3)
 	mocks.MAssetsService.On("FungibleNativeAsset", network0, nativeTokenAddressNetwork0).Return(nativeAssetNetwork0)
@@ -453,7 +543,7 @@ func Test_createFungiblePayload(t *testing.T) {
 
 	assert.NoError(t, err)
 	assert.Equal(t, transactionID, payload.TransactionId)
-	assert.Equal(t, strconv.FormatInt(amount, 10), payload.Amount)
+	assert.Equal(t, big.NewInt(amount), payload.Amount)
 	assert.Equal(t, receiver, payload.Receiver)
 }
 
@@ -490,6 +580,37 @@ func Test_createFungiblePayload_ErrorWrongDecimals(t *testing.T) {
 	assert.Contains(t, err.Error(), "decimals of source asset")
 }
 
+func Test_createFungiblePayload_WrappedToWrapped(t *testing.T) {
+	w := initializeWatcher()
+
+	transactionID := "0.0.111-1-1"
+	receiver := "0.0.111"
+	sourceAsset := "0.0.222222"
+	assetNative := &asset.NativeAsset{ChainId: 5, Asset: "0x0000000000000000000000000000000000000005"}
+	amount := int64(10000)
+
+	mocks.MAssetsService.On("FungibleNativeAsset", assetNative.ChainId, assetNative.Asset).Return(assetNative)
+	mocks.MAssetsService.On("FungibleAssetInfo", network0, sourceAsset).Return(&asset.FungibleAssetInfo{Decimals: 8}, true)
+	mocks.MAssetsService.On("FungibleAssetInfo", network3, wrappedTokenAddressNetwork3).Return(&asset.FungibleAssetInfo{Decimals: 8}, true)
+	mocks.MPricingService.On("GetTokenPriceInfo", network3, wrappedTokenAddressNetwork3).Return(tokenPriceInfo, true)
+
+	payload, err := w.createFungiblePayload(
+		transactionID,
+		receiver,
+		sourceAsset,
+		*assetNative,
+		amount,
+		network3,
+		wrappedTokenAddressNetwork3,
+	)
+
+	assert.NoError(t, err)
+	assert.Equal(t, assetNative.ChainId, payload.NativeChainId)
+	assert.Equal(t, wrappedTokenAddressNetwork3, payload.TargetAsset)
+	assert.Equal(t, big.NewInt(amount), payload.Amount)
+	mocks.MPricingService.AssertNotCalled(t, "GetTokenPriceInfo", assetNative.ChainId, assetNative.Asset)
+}
+
 // insufficient amount
 func Test_createFungiblePayload_ErrorInsufficientAmount(t *testing.T) {
 	w := initializeWatcher()
@@ -531,7 +652,6 @@ func setup() {
 
 func initializeWatcher() *Watcher {
 	setup()
-	mocks.Setup()
 	mocks.MStatusRepository.On("Get", mock.Anything).Return(int64(0), nil)
 	blacklist := []string{"0.0.333", "0.0.444"}
 
@@ -0,0 +1,200 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	httpHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/http"
+	adminModel "github.com/limechain/hedera-eth-bridge-validator/app/model/admin"
+	"github.com/limechain/hedera-eth-bridge-validator/app/router/response"
+	"github.com/limechain/hedera-eth-bridge-validator/app/router/webhooks"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+)
+
+var (
+	Route  = "/admin"
+	logger = config.GetLoggerFor(fmt.Sprintf("Router [%s]", Route))
+)
+
+const (
+	defaultAuditLimit = 50
+	maxAuditLimit     = 500
+)
+
+// NewRouter returns the admin API. Every call is authenticated with either a bearer token or an HMAC signature,
+// authorised against the scopes of the credential and logged in the audit log
+func NewRouter(adminService service.Admin, webhooksService service.Webhooks, cfg config.Admin) chi.Router {
+	r := chi.NewRouter()
+	r.Use(audit(adminService), authenticate(cfg))
+
+	r.With(requireScope(adminModel.ScopeTransfers)).Post("/transfers/{id}/status", updateTransferStatus(adminService))
+	r.With(requireScope(adminModel.ScopeTransfers)).Post("/transfers/{id}/redrive", redriveTransfer(adminService))
+	r.With(requireScope(adminModel.ScopeWatchers)).Get("/watchers", getWatchers(adminService))
+	r.With(requireScope(adminModel.ScopeWatchers)).Post("/watchers/{id}/pause", pauseWatcher(adminService))
+	r.With(requireScope(adminModel.ScopeWatchers)).Post("/watchers/{id}/resume", resumeWatcher(adminService))
+	r.With(requireScope(adminModel.ScopeWatchers)).Post("/watchers/{id}/rewind", rewindWatcher(adminService))
+	r.With(requireScope(adminModel.ScopeMembers)).Post("/members/reload", reloadMembers(adminService))
+	r.With(requireScope(adminModel.ScopeAudit)).Get("/audit", getAuditLog(adminService))
+	r.With(requireScope(adminModel.ScopeWebhooks)).Mount(webhooks.Route, webhooks.NewRouter(webhooksService))
+	return r
+}
+
+// POST: .../admin/transfers/:id/status
+func updateTransferStatus(adminService service.Admin) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		req := new(adminModel.TransferStatusRequest)
+		if !decode(w, r, req) {
+			return
+		}
+
+		err := adminService.UpdateTransferStatus(chi.URLParam(r, "id"), req.Status, req.Reason)
+		if err != nil {
+			logger.Errorf("Router resolved with an error. Error [%s].", err)
+			httpHelper.WriteErrorResponse(w, r, err)
+			return
+		}
+
+		render.NoContent(w, r)
+	}
+}
+
+// POST: .../admin/transfers/:id/redrive
+func redriveTransfer(adminService service.Admin) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		err := adminService.RedriveTransfer(chi.URLParam(r, "id"))
+		if err != nil {
+			logger.Errorf("Router resolved with an error. Error [%s].", err)
+			httpHelper.WriteErrorResponse(w, r, err)
+			return
+		}
+
+		// The transfer is queued for its handler
+		w.WriteHeader(http.StatusAccepted)
+	}
+}
+
+// GET: .../admin/watchers
+func getWatchers(adminService service.Admin) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		watchers, err := adminService.Watchers()
+		if err != nil {
+			logger.Errorf("Router resolved with an error. Error [%s].", err)
+			httpHelper.WriteErrorResponse(w, r, err)
+			return
+		}
+
+		render.JSON(w, r, watchers)
+	}
+}
+
+// POST: .../admin/watchers/:id/pause
+func pauseWatcher(adminService service.Admin) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		err := adminService.PauseWatcher(chi.URLParam(r, "id"))
+		if err != nil {
+			logger.Errorf("Router resolved with an error. Error [%s].", err)
+			httpHelper.WriteErrorResponse(w, r, err)
+			return
+		}
+
+		render.NoContent(w, r)
+	}
+}
+
+// POST: .../admin/watchers/:id/resume
+func resumeWatcher(adminService service.Admin) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		err := adminService.ResumeWatcher(chi.URLParam(r, "id"))
+		if err != nil {
+			logger.Errorf("Router resolved with an error. Error [%s].", err)
+			httpHelper.WriteErrorResponse(w, r, err)
+			return
+		}
+
+		render.NoContent(w, r)
+	}
+}
+
+// POST: .../admin/watchers/:id/rewind
+func rewindWatcher(adminService service.Admin) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		req := new(adminModel.RewindRequest)
+		if !decode(w, r, req) {
+			return
+		}
+
+		err := adminService.RewindWatcher(chi.URLParam(r, "id"), req.To)
+		if err != nil {
+			logger.Errorf("Router resolved with an error. Error [%s].", err)
+			httpHelper.WriteErrorResponse(w, r, err)
+			return
+		}
+
+		render.NoContent(w, r)
+	}
+}
+
+// POST: .../admin/members/reload
+func reloadMembers(adminService service.Admin) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		adminService.ReloadMembers()
+
+		render.NoContent(w, r)
+	}
+}
+
+// GET: .../admin/audit?limit=
+func getAuditLog(adminService service.Admin) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		limit := defaultAuditLimit
+		if value := r.URL.Query().Get("limit"); value != "" {
+			parsed, err := strconv.Atoi(value)
+			if err != nil || parsed <= 0 || parsed > maxAuditLimit {
+				render.Status(r, http.StatusBadRequest)
+				render.JSON(w, r, response.ErrorResponse(service.ErrWrongQuery))
+				return
+			}
+			limit = parsed
+		}
+
+		entries, err := adminService.AuditLog(limit)
+		if err != nil {
+			logger.Errorf("Router resolved with an error. Error [%s].", err)
+			httpHelper.WriteErrorResponse(w, r, err)
+			return
+		}
+
+		render.JSON(w, r, entries)
+	}
+}
+
+func decode(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	err := json.NewDecoder(r.Body).Decode(v)
+	if err != nil {
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, response.ErrorResponse(err))
+		return false
+	}
+	return true
+}
@@ -0,0 +1,315 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	adminModel "github.com/limechain/hedera-eth-bridge-validator/app/model/admin"
+	webhookModel "github.com/limechain/hedera-eth-bridge-validator/app/model/webhook"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	transferId = "0.0.123456-1650000000-000000001"
+	watcherId  = "0.0.1234"
+	opsToken   = "ops-token"
+	readToken  = "read-token"
+	keyId      = "automation"
+	keySecret  = "automation-secret"
+	adminCfg   = config.Admin{
+		Tokens: []config.AdminToken{
+			{Name: "ops", Token: opsToken, Scopes: []string{adminModel.ScopeAll}},
+			{Name: "auditor", Token: readToken, Scopes: []string{adminModel.ScopeAudit}},
+		},
+		Keys: []config.AdminKey{
+			{Id: keyId, Secret: keySecret, Scopes: []string{adminModel.ScopeWatchers}},
+		},
+		MaxClockSkew: 300,
+	}
+)
+
+func Test_NewRouter(t *testing.T) {
+	router := NewRouter(mocks.MAdminService, mocks.MWebhooksService, adminCfg)
+
+	assert.NotNil(t, router)
+}
+
+func Test_Unauthorized(t *testing.T) {
+	setup()
+
+	res := serve(withToken(http.MethodPost, "/watchers/"+watcherId+"/pause", nil, "wrong-token"))
+
+	assert.Equal(t, http.StatusUnauthorized, res.Code)
+	mocks.MAdminService.AssertNotCalled(t, "PauseWatcher", watcherId)
+	mocks.MAdminService.AssertCalled(t, "Audit", &adminModel.AuditEntry{
+		Method:     http.MethodPost,
+		Path:       "/watchers/" + watcherId + "/pause",
+		StatusCode: http.StatusUnauthorized,
+		RemoteAddr: "192.0.2.1:1234",
+	})
+}
+
+func Test_Forbidden(t *testing.T) {
+	setup()
+
+	res := serve(withToken(http.MethodPost, "/watchers/"+watcherId+"/pause", nil, readToken))
+
+	assert.Equal(t, http.StatusForbidden, res.Code)
+	mocks.MAdminService.AssertNotCalled(t, "PauseWatcher", watcherId)
+	mocks.MAdminService.AssertCalled(t, "Audit", &adminModel.AuditEntry{
+		Actor:      "auditor",
+		Method:     http.MethodPost,
+		Path:       "/watchers/" + watcherId + "/pause",
+		StatusCode: http.StatusForbidden,
+		RemoteAddr: "192.0.2.1:1234",
+	})
+}
+
+func Test_updateTransferStatus(t *testing.T) {
+	setup()
+	mocks.MAdminService.On("UpdateTransferStatus", transferId, status.Completed, "").Return(nil)
+	body := []byte(`{"status":"COMPLETED"}`)
+
+	res := serve(withToken(http.MethodPost, "/transfers/"+transferId+"/status", body, opsToken))
+
+	assert.Equal(t, http.StatusNoContent, res.Code)
+	mocks.MAdminService.AssertCalled(t, "Audit", &adminModel.AuditEntry{
+		Actor:      "ops",
+		Method:     http.MethodPost,
+		Path:       "/transfers/" + transferId + "/status",
+		Body:       string(body),
+		StatusCode: http.StatusNoContent,
+		RemoteAddr: "192.0.2.1:1234",
+	})
+}
+
+func Test_updateTransferStatus_Invalid(t *testing.T) {
+	setup()
+	mocks.MAdminService.On("UpdateTransferStatus", transferId, status.Submitted, "").Return(service.ErrInvalidTransferStatus)
+
+	res := serve(withToken(http.MethodPost, "/transfers/"+transferId+"/status", []byte(`{"status":"SUBMITTED"}`), opsToken))
+
+	assert.Equal(t, http.StatusBadRequest, res.Code)
+}
+
+func Test_redriveTransfer(t *testing.T) {
+	setup()
+	mocks.MAdminService.On("RedriveTransfer", transferId).Return(nil)
+
+	res := serve(withToken(http.MethodPost, "/transfers/"+transferId+"/redrive", nil, opsToken))
+
+	assert.Equal(t, http.StatusAccepted, res.Code)
+}
+
+func Test_redriveTransfer_RedrivenRecently(t *testing.T) {
+	setup()
+	mocks.MAdminService.On("RedriveTransfer", transferId).Return(service.ErrTransferRedrivenRecently)
+
+	res := serve(withToken(http.MethodPost, "/transfers/"+transferId+"/redrive", nil, opsToken))
+
+	assert.Equal(t, http.StatusConflict, res.Code)
+}
+
+func Test_getWatchers_Signed(t *testing.T) {
+	setup()
+	watchers := []*adminModel.Watcher{{Id: watcherId, Paused: true, Last: 1650000000000000000}}
+	mocks.MAdminService.On("Watchers").Return(watchers, nil)
+
+	res := serve(withSignature(http.MethodGet, "/watchers", nil, keySecret, time.Now()))
+
+	assert.Equal(t, http.StatusOK, res.Code)
+	var actual []*adminModel.Watcher
+	assert.Nil(t, json.Unmarshal(res.Body.Bytes(), &actual))
+	assert.Equal(t, watchers, actual)
+}
+
+func Test_rewindWatcher_Signed(t *testing.T) {
+	setup()
+	mocks.MAdminService.On("RewindWatcher", watcherId, int64(1650000000000000000)).Return(nil)
+
+	res := serve(withSignature(http.MethodPost, "/watchers/"+watcherId+"/rewind", []byte(`{"to":1650000000000000000}`), keySecret, time.Now()))
+
+	assert.Equal(t, http.StatusNoContent, res.Code)
+}
+
+func Test_rewindWatcher_NotPaused(t *testing.T) {
+	setup()
+	mocks.MAdminService.On("RewindWatcher", watcherId, int64(1337)).Return(service.ErrWatcherNotPaused)
+
+	res := serve(withToken(http.MethodPost, "/watchers/"+watcherId+"/rewind", []byte(`{"to":1337}`), opsToken))
+
+	assert.Equal(t, http.StatusConflict, res.Code)
+}
+
+func Test_Signed_WrongSecret(t *testing.T) {
+	setup()
+
+	res := serve(withSignature(http.MethodGet, "/watchers", nil, "wrong-secret", time.Now()))
+
+	assert.Equal(t, http.StatusUnauthorized, res.Code)
+	mocks.MAdminService.AssertNotCalled(t, "Watchers")
+}
+
+func Test_Signed_StaleTimestamp(t *testing.T) {
+	setup()
+
+	res := serve(withSignature(http.MethodGet, "/watchers", nil, keySecret, time.Now().Add(-10*time.Minute)))
+
+	assert.Equal(t, http.StatusUnauthorized, res.Code)
+	mocks.MAdminService.AssertNotCalled(t, "Watchers")
+}
+
+func Test_Signed_TamperedBody(t *testing.T) {
+	setup()
+	req := withSignature(http.MethodPost, "/watchers/"+watcherId+"/rewind", []byte(`{"to":1337}`), keySecret, time.Now())
+	req.Body = io.NopCloser(bytes.NewReader([]byte(`{"to":1}`)))
+
+	res := serve(req)
+
+	assert.Equal(t, http.StatusUnauthorized, res.Code)
+	mocks.MAdminService.AssertNotCalled(t, "RewindWatcher", mock.Anything, mock.Anything)
+}
+
+func Test_Signed_ReplayedNonce(t *testing.T) {
+	setup()
+	mocks.MAdminService.On("Watchers").Return([]*adminModel.Watcher{}, nil)
+	router := NewRouter(mocks.MAdminService, mocks.MWebhooksService, adminCfg)
+
+	first := httptest.NewRecorder()
+	router.ServeHTTP(first, withNonce(http.MethodGet, "/watchers", nil, keySecret, time.Now(), "nonce"))
+	replayed := httptest.NewRecorder()
+	router.ServeHTTP(replayed, withNonce(http.MethodGet, "/watchers", nil, keySecret, time.Now(), "nonce"))
+
+	assert.Equal(t, http.StatusOK, first.Code)
+	assert.Equal(t, http.StatusUnauthorized, replayed.Code)
+	mocks.MAdminService.AssertNumberOfCalls(t, "Watchers", 1)
+}
+
+func Test_Signed_MissingNonce(t *testing.T) {
+	setup()
+
+	res := serve(withNonce(http.MethodGet, "/watchers", nil, keySecret, time.Now(), ""))
+
+	assert.Equal(t, http.StatusUnauthorized, res.Code)
+	mocks.MAdminService.AssertNotCalled(t, "Watchers")
+}
+
+func Test_nonces_Expired(t *testing.T) {
+	seen := newNonces()
+
+	assert.True(t, seen.use(keyId, "nonce", time.Now().Add(-time.Second)))
+	assert.True(t, seen.use(keyId, "nonce", time.Now().Add(time.Minute)))
+	assert.False(t, seen.use(keyId, "nonce", time.Now().Add(time.Minute)))
+	assert.True(t, seen.use("other", "nonce", time.Now().Add(time.Minute)))
+}
+
+func Test_reloadMembers(t *testing.T) {
+	setup()
+	mocks.MAdminService.On("ReloadMembers").Return()
+
+	res := serve(withToken(http.MethodPost, "/members/reload", nil, opsToken))
+
+	assert.Equal(t, http.StatusNoContent, res.Code)
+	mocks.MAdminService.AssertNumberOfCalls(t, "ReloadMembers", 1)
+}
+
+func Test_getAuditLog(t *testing.T) {
+	setup()
+	entries := []*adminModel.AuditEntry{{Id: 1, Actor: "ops", Method: http.MethodPost, Path: "/api/v1/admin/members/reload", StatusCode: 204}}
+	mocks.MAdminService.On("AuditLog", 10).Return(entries, nil)
+
+	res := serve(withToken(http.MethodGet, "/audit?limit=10", nil, readToken))
+
+	assert.Equal(t, http.StatusOK, res.Code)
+	var actual []*adminModel.AuditEntry
+	assert.Nil(t, json.Unmarshal(res.Body.Bytes(), &actual))
+	assert.Equal(t, entries, actual)
+}
+
+func Test_getAuditLog_InvalidLimit(t *testing.T) {
+	setup()
+
+	res := serve(withToken(http.MethodGet, "/audit?limit=100000", nil, readToken))
+
+	assert.Equal(t, http.StatusBadRequest, res.Code)
+	mocks.MAdminService.AssertNotCalled(t, "AuditLog", mock.Anything)
+}
+
+func Test_Webhooks(t *testing.T) {
+	setup()
+	delivery := &webhookModel.Delivery{ID: 7, Status: webhookModel.StatusDelivered}
+	mocks.MWebhooksService.On("Delivery", uint64(7)).Return(delivery, nil)
+
+	res := serve(withToken(http.MethodGet, "/webhooks/deliveries/7", nil, opsToken))
+	forbidden := serve(withToken(http.MethodGet, "/webhooks/deliveries/7", nil, readToken))
+
+	assert.Equal(t, http.StatusOK, res.Code)
+	assert.Equal(t, http.StatusForbidden, forbidden.Code)
+	mocks.MWebhooksService.AssertNumberOfCalls(t, "Delivery", 1)
+}
+
+func Test_Sign(t *testing.T) {
+	expected := "sha256=7e12daa2099d42e431183f2224291810f6f716ccd771b0c8b856545388b04dbb"
+
+	actual := Sign("secret", "1650000000", "nonce", http.MethodPost, "/api/v1/admin/members/reload", nil)
+
+	assert.Equal(t, expected, actual)
+}
+
+func withToken(method, target string, body []byte, token string) *http.Request {
+	req := httptest.NewRequest(method, target, bytes.NewReader(body))
+	req.Header.Set("Authorization", "Bearer "+token)
+	return req
+}
+
+func withSignature(method, target string, body []byte, secret string, at time.Time) *http.Request {
+	return withNonce(method, target, body, secret, at, strconv.FormatInt(time.Now().UnixNano(), 10))
+}
+
+func withNonce(method, target string, body []byte, secret string, at time.Time, nonce string) *http.Request {
+	req := httptest.NewRequest(method, target, bytes.NewReader(body))
+	timestamp := strconv.FormatInt(at.Unix(), 10)
+	req.Header.Set(adminModel.HeaderKey, keyId)
+	req.Header.Set(adminModel.HeaderTimestamp, timestamp)
+	req.Header.Set(adminModel.HeaderNonce, nonce)
+	req.Header.Set(adminModel.HeaderSignature, Sign(secret, timestamp, nonce, method, req.URL.RequestURI(), body))
+	return req
+}
+
+func serve(req *http.Request) *httptest.ResponseRecorder {
+	res := httptest.NewRecorder()
+	NewRouter(mocks.MAdminService, mocks.MWebhooksService, adminCfg).ServeHTTP(res, req)
+	return res
+}
+
+func setup() {
+	mocks.Setup()
+	mocks.MAdminService.On("Audit", mock.Anything).Return()
+}
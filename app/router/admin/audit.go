@@ -0,0 +1,60 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-chi/chi/middleware"
+	"github.com/go-chi/render"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	adminModel "github.com/limechain/hedera-eth-bridge-validator/app/model/admin"
+	"github.com/limechain/hedera-eth-bridge-validator/app/router/response"
+)
+
+// audit logs every call to the admin API, including the rejected ones. The actor is set by authenticate
+func audit(adminService service.Admin) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			entry := &adminModel.AuditEntry{
+				Method:     r.Method,
+				Path:       r.URL.RequestURI(),
+				RemoteAddr: r.RemoteAddr,
+			}
+
+			body, err := readBody(r)
+			if err != nil {
+				entry.StatusCode = http.StatusRequestEntityTooLarge
+				adminService.Audit(entry)
+				render.Status(r, http.StatusRequestEntityTooLarge)
+				render.JSON(w, r, response.ErrorResponse(err))
+				return
+			}
+			if len(body) > maxAuditedBodySize {
+				body = body[:maxAuditedBodySize]
+			}
+			entry.Body = string(body)
+
+			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			next.ServeHTTP(ww, r.WithContext(context.WithValue(r.Context(), auditKey, entry)))
+
+			entry.StatusCode = ww.Status()
+			adminService.Audit(entry)
+		})
+	}
+}
@@ -0,0 +1,213 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-chi/render"
+	adminModel "github.com/limechain/hedera-eth-bridge-validator/app/model/admin"
+	"github.com/limechain/hedera-eth-bridge-validator/app/router/response"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+)
+
+type contextKey string
+
+const (
+	principalKey contextKey = "admin-principal"
+	auditKey     contextKey = "admin-audit-entry"
+	// maxBodySize limits the size of the bodies, read for signature verification and auditing
+	maxBodySize = 64 * 1024
+	// maxAuditedBodySize limits the size of the bodies, stored in the audit log
+	maxAuditedBodySize = 4 * 1024
+	// maxNonceLength limits the size of the nonces of signed requests
+	maxNonceLength = 128
+)
+
+var (
+	errUnauthorized = errors.New("unauthorized")
+	errForbidden    = errors.New("forbidden")
+)
+
+// Sign returns the value of the X-Admin-Signature header for a request, signed with the given secret
+func Sign(secret, timestamp, nonce, method, requestURI string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(fmt.Sprintf("%s\n%s\n%s\n%s\n", timestamp, nonce, method, requestURI)))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// authenticate resolves the principal of the request either from its bearer token or from its HMAC signature
+func authenticate(cfg config.Admin) func(http.Handler) http.Handler {
+	skew := cfg.MaxClockSkew * time.Second
+	seen := newNonces()
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			principal, ok := authenticateToken(cfg.Tokens, r)
+			if !ok {
+				principal, ok = authenticateSignature(cfg.Keys, skew, seen, r)
+			}
+			if !ok {
+				logger.Warnf("Unauthorized [%s %s] from [%s].", r.Method, r.URL.RequestURI(), r.RemoteAddr)
+				render.Status(r, http.StatusUnauthorized)
+				render.JSON(w, r, response.ErrorResponse(errUnauthorized))
+				return
+			}
+
+			if entry, ok := r.Context().Value(auditKey).(*adminModel.AuditEntry); ok {
+				entry.Actor = principal.Name
+			}
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), principalKey, principal)))
+		})
+	}
+}
+
+// requireScope rejects requests, whose principal is not granted the given scope
+func requireScope(scope string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			principal, ok := r.Context().Value(principalKey).(adminModel.Principal)
+			if !ok || !principal.HasScope(scope) {
+				render.Status(r, http.StatusForbidden)
+				render.JSON(w, r, response.ErrorResponse(errForbidden))
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func authenticateToken(tokens []config.AdminToken, r *http.Request) (adminModel.Principal, bool) {
+	header := r.Header.Get("Authorization")
+	if !strings.HasPrefix(header, "Bearer ") {
+		return adminModel.Principal{}, false
+	}
+
+	presented := []byte(strings.TrimPrefix(header, "Bearer "))
+	for _, token := range tokens {
+		if subtle.ConstantTimeCompare(presented, []byte(token.Token)) == 1 {
+			return adminModel.Principal{Name: token.Name, Scopes: token.Scopes}, true
+		}
+	}
+	return adminModel.Principal{}, false
+}
+
+// authenticateSignature verifies the HMAC signature of the request. Every nonce is accepted once per key
+// within the clock skew window, so that signed requests cannot be replayed
+func authenticateSignature(keys []config.AdminKey, skew time.Duration, seen *nonces, r *http.Request) (adminModel.Principal, bool) {
+	keyId := r.Header.Get(adminModel.HeaderKey)
+	timestamp := r.Header.Get(adminModel.HeaderTimestamp)
+	nonce := r.Header.Get(adminModel.HeaderNonce)
+	signature := r.Header.Get(adminModel.HeaderSignature)
+	if keyId == "" || timestamp == "" || nonce == "" || len(nonce) > maxNonceLength || signature == "" {
+		return adminModel.Principal{}, false
+	}
+
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return adminModel.Principal{}, false
+	}
+	drift := time.Since(time.Unix(seconds, 0))
+	if drift > skew || drift < -skew {
+		return adminModel.Principal{}, false
+	}
+
+	body, err := readBody(r)
+	if err != nil {
+		return adminModel.Principal{}, false
+	}
+
+	for _, key := range keys {
+		if key.Id != keyId {
+			continue
+		}
+		expected := Sign(key.Secret, timestamp, nonce, r.Method, r.URL.RequestURI(), body)
+		if !hmac.Equal([]byte(expected), []byte(signature)) {
+			continue
+		}
+		// The nonce is recorded only for valid signatures, so that it cannot be spent by others
+		if !seen.use(key.Id, nonce, time.Unix(seconds, 0).Add(skew)) {
+			logger.Warnf("Replayed nonce [%s] of key [%s].", nonce, key.Id)
+			return adminModel.Principal{}, false
+		}
+		return adminModel.Principal{Name: key.Id, Scopes: key.Scopes}, true
+	}
+	return adminModel.Principal{}, false
+}
+
+// nonces keeps the nonces of signed requests until their timestamp leaves the clock skew window
+type nonces struct {
+	mu      sync.Mutex
+	expires map[string]time.Time
+}
+
+func newNonces() *nonces {
+	return &nonces{expires: make(map[string]time.Time)}
+}
+
+// use records the nonce of the key until the given expiry, returning false if it is already recorded.
+// Expired nonces are pruned, since their requests are rejected by the timestamp check
+func (n *nonces) use(keyId, nonce string, expiry time.Time) bool {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	now := time.Now()
+	for k, e := range n.expires {
+		if now.After(e) {
+			delete(n.expires, k)
+		}
+	}
+
+	k := keyId + "\n" + nonce
+	if _, ok := n.expires[k]; ok {
+		return false
+	}
+	n.expires[k] = expiry
+	return true
+}
+
+// readBody reads the body of the request and restores it for the next handlers
+func readBody(r *http.Request) ([]byte, error) {
+	if r.Body == nil {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxBodySize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(body) > maxBodySize {
+		return nil, fmt.Errorf("body exceeds [%d] bytes", maxBodySize)
+	}
+
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
@@ -19,6 +19,8 @@ package healthcheck
 import (
 	"github.com/go-chi/chi"
 	"github.com/go-chi/render"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/health"
 	"github.com/limechain/hedera-eth-bridge-validator/app/router/response"
 	"net/http"
 )
@@ -27,10 +29,12 @@ var (
 	Route = "/health"
 )
 
-//Router for health check
-func NewRouter() http.Handler {
+// Router for health check
+func NewRouter(healthService service.Health) http.Handler {
 	r := chi.NewRouter()
 	r.Get("/", healthResponse())
+	r.Get("/live", reportResponse(healthService.Live))
+	r.Get("/ready", reportResponse(healthService.Ready))
 	return r
 }
 
@@ -42,3 +46,14 @@ func healthResponse() func(w http.ResponseWriter, r *http.Request) {
 		})
 	}
 }
+
+// GET: .../health/live and .../health/ready
+func reportResponse(check func() *health.Report) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		report := check()
+		if report.Status == health.StatusDown {
+			render.Status(r, http.StatusServiceUnavailable)
+		}
+		render.JSON(w, r, report)
+	}
+}
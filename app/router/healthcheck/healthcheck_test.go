@@ -19,15 +19,18 @@ package healthcheck
 import (
 	"bytes"
 	"encoding/json"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/health"
 	"github.com/limechain/hedera-eth-bridge-validator/app/router/response"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
 	"github.com/stretchr/testify/assert"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
 func Test_NewRouter(t *testing.T) {
-	router := NewRouter()
+	mocks.Setup()
+	router := NewRouter(mocks.MHealthService)
 
 	assert.NotNil(t, router)
 }
@@ -58,3 +61,33 @@ func Test_configBridgeResponse(t *testing.T) {
 	assert.NotNil(t, healthCheckResponseHandler)
 	assert.NotNil(t, healthCheckResponseAsBytes)
 }
+
+func Test_Live(t *testing.T) {
+	mocks.Setup()
+	report := health.NewReport()
+	report.Add("database", &health.Component{Status: health.StatusDegraded, LatencyMs: 1500})
+	mocks.MHealthService.On("Live").Return(report)
+
+	recorder := httptest.NewRecorder()
+	NewRouter(mocks.MHealthService).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/live", nil))
+
+	actual := &health.Report{}
+	assert.Nil(t, json.Unmarshal(recorder.Body.Bytes(), actual))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, report, actual)
+}
+
+func Test_Ready_Down(t *testing.T) {
+	mocks.Setup()
+	report := health.NewReport()
+	report.Add("hedera-node", &health.Component{Status: health.StatusDown, Error: "no response within [5s]"})
+	mocks.MHealthService.On("Ready").Return(report)
+
+	recorder := httptest.NewRecorder()
+	NewRouter(mocks.MHealthService).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/ready", nil))
+
+	actual := &health.Report{}
+	assert.Nil(t, json.Unmarshal(recorder.Body.Bytes(), actual))
+	assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+	assert.Equal(t, report, actual)
+}
@@ -1,193 +0,0 @@
-package transfer_reset
-
-import (
-	"bytes"
-	"encoding/json"
-	"errors"
-	"io"
-	"net/http"
-	"net/http/httptest"
-	"testing"
-
-	transferModel "github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
-	"github.com/limechain/hedera-eth-bridge-validator/config"
-	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
-	"github.com/stretchr/testify/assert"
-)
-
-var (
-	transferId = "123"
-	node       = config.Node{
-		GaugeResetPassword: "password",
-	}
-)
-
-func Test_NewRouter(t *testing.T) {
-	router := NewRouter(mocks.MTransferService, mocks.MPrometheusService, node)
-
-	assert.NotNil(t, router)
-}
-
-func Test_GetTransferSuccess(t *testing.T) {
-	mocks.Setup()
-
-	// Set up request body
-	body := transferModel.TransferReset{
-		TransactionId: transferId,
-		SourceChainId: 1,
-		TargetChainId: 2,
-		SourceToken:   "token",
-		Password:      "password",
-	}
-	reqBody, _ := json.Marshal(body)
-
-	mocks.MTransferService.On("UpdateTransferStatusCompleted", transferId).Return(nil)
-	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
-
-	handler := transferReset(mocks.MTransferService, mocks.MPrometheusService, node)
-
-	req := httptest.NewRequest(http.MethodPost, "/transfer-reset", bytes.NewBuffer(reqBody))
-	w := httptest.NewRecorder()
-	handler(w, req)
-	res := w.Result()
-	defer res.Body.Close()
-	data, _ := io.ReadAll(res.Body)
-
-	assert.Equal(t, http.StatusOK, res.StatusCode)
-	assert.Equal(t, "OK", string(data))
-
-}
-
-func Test_GetTransferSuccess_FailsWithDB(t *testing.T) {
-	mocks.Setup()
-
-	// Set up request body
-	body := transferModel.TransferReset{
-		TransactionId: transferId,
-		SourceChainId: 1,
-		TargetChainId: 2,
-		SourceToken:   "token",
-		Password:      "password",
-	}
-	reqBody, _ := json.Marshal(body)
-
-	mocks.MTransferService.On("UpdateTransferStatusCompleted", transferId).Return(errors.New("error"))
-	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
-
-	handler := transferReset(mocks.MTransferService, mocks.MPrometheusService, node)
-
-	req := httptest.NewRequest(http.MethodPost, "/transfer-reset", bytes.NewBuffer(reqBody))
-	w := httptest.NewRecorder()
-	handler(w, req)
-	res := w.Result()
-	defer res.Body.Close()
-
-	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
-}
-
-func Test_GetTransferSuccess_FailsWithWrongParams(t *testing.T) {
-	mocks.Setup()
-
-	body := map[string]interface{}{
-		"TransactionId": transferId,
-		"SourceChainId": 1,
-		"TargetChainId": "2",
-		"SourceToken":   "token",
-		"Password":      "password",
-	}
-
-	reqBody, _ := json.Marshal(body)
-
-	mocks.MTransferService.On("UpdateTransferStatusCompleted", transferId).Return(nil)
-	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
-
-	handler := transferReset(mocks.MTransferService, mocks.MPrometheusService, node)
-
-	req := httptest.NewRequest(http.MethodPost, "/transfer-reset", bytes.NewBuffer(reqBody))
-	w := httptest.NewRecorder()
-	handler(w, req)
-	res := w.Result()
-	defer res.Body.Close()
-
-	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
-}
-
-func Test_GetTransferSuccess_FailsWithNoPassword(t *testing.T) {
-	mocks.Setup()
-
-	body := map[string]interface{}{
-		"TransactionId": transferId,
-		"SourceChainId": 1,
-		"TargetChainId": 2,
-		"SourceToken":   "token",
-	}
-
-	reqBody, _ := json.Marshal(body)
-
-	mocks.MTransferService.On("UpdateTransferStatusCompleted", transferId).Return(nil)
-	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
-
-	handler := transferReset(mocks.MTransferService, mocks.MPrometheusService, node)
-
-	req := httptest.NewRequest(http.MethodPost, "/transfer-reset", bytes.NewBuffer(reqBody))
-	w := httptest.NewRecorder()
-	handler(w, req)
-	res := w.Result()
-	defer res.Body.Close()
-
-	assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
-}
-
-func Test_GetTransferSuccess_FailsWithEmptyPassword(t *testing.T) {
-	mocks.Setup()
-
-	body := map[string]interface{}{
-		"TransactionId": transferId,
-		"SourceChainId": 1,
-		"TargetChainId": 2,
-		"SourceToken":   "token",
-		"Password":      "",
-	}
-
-	reqBody, _ := json.Marshal(body)
-
-	mocks.MTransferService.On("UpdateTransferStatusCompleted", transferId).Return(nil)
-	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
-
-	handler := transferReset(mocks.MTransferService, mocks.MPrometheusService, node)
-
-	req := httptest.NewRequest(http.MethodPost, "/transfer-reset", bytes.NewBuffer(reqBody))
-	w := httptest.NewRecorder()
-	handler(w, req)
-	res := w.Result()
-	defer res.Body.Close()
-
-	assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
-}
-
-func Test_GetTransferSuccess_FailsWithWrongPassword(t *testing.T) {
-	mocks.Setup()
-
-	body := map[string]interface{}{
-		"TransactionId": transferId,
-		"SourceChainId": 1,
-		"TargetChainId": 2,
-		"SourceToken":   "token",
-		"Password":      "wrongPassword",
-	}
-
-	reqBody, _ := json.Marshal(body)
-
-	mocks.MTransferService.On("UpdateTransferStatusCompleted", transferId).Return(nil)
-	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
-
-	handler := transferReset(mocks.MTransferService, mocks.MPrometheusService, node)
-
-	req := httptest.NewRequest(http.MethodPost, "/transfer-reset", bytes.NewBuffer(reqBody))
-	w := httptest.NewRecorder()
-	handler(w, req)
-	res := w.Result()
-	defer res.Body.Close()
-
-	assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
-}
@@ -0,0 +1,180 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transfer
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/render"
+	"github.com/gorilla/websocket"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	transferModel "github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+	"github.com/limechain/hedera-eth-bridge-validator/app/router/response"
+)
+
+const (
+	maxStreamTransferIds = 50
+	keepAliveInterval    = 15 * time.Second
+	writeTimeout         = 10 * time.Second
+)
+
+var upgrader = websocket.Upgrader{
+	// The API allows requests from all origins
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+// GET: .../transfers/stream?transferId=...&receiver=...&originator=...
+// Streams the matching transfer events as Server-Sent Events
+func stream(transferEvents service.TransferEvents) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		subscription, err := subscriptionFromQuery(r)
+		if err != nil {
+			render.Status(r, http.StatusBadRequest)
+			render.JSON(w, r, response.ErrorResponse(err))
+			return
+		}
+
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			logger.Errorf("Response writer does not support streaming.")
+			render.Status(r, http.StatusInternalServerError)
+			render.JSON(w, r, response.ErrorResponse(response.ErrorInternalServerError))
+			return
+		}
+
+		events, unsubscribe := transferEvents.Subscribe(subscription)
+		defer unsubscribe()
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Connection", "keep-alive")
+		w.WriteHeader(http.StatusOK)
+		flusher.Flush()
+
+		ticker := time.NewTicker(keepAliveInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-r.Context().Done():
+				return
+			case event, ok := <-events:
+				if !ok {
+					return
+				}
+				data, err := json.Marshal(event)
+				if err != nil {
+					logger.Errorf("[%s] - Failed to marshal event. Error: [%s]", event.TransferId, err)
+					continue
+				}
+				fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Type, data)
+				flusher.Flush()
+			case <-ticker.C:
+				fmt.Fprint(w, ": keep-alive\n\n")
+				flusher.Flush()
+			}
+		}
+	}
+}
+
+// GET: .../transfers/ws?transferId=...&receiver=...&originator=...
+// Streams the matching transfer events as JSON messages over WebSocket. Messages from the client are ignored
+func streamWebSocket(transferEvents service.TransferEvents) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		subscription, err := subscriptionFromQuery(r)
+		if err != nil {
+			render.Status(r, http.StatusBadRequest)
+			render.JSON(w, r, response.ErrorResponse(err))
+			return
+		}
+
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			// The upgrader has already replied to the client
+			logger.Errorf("Failed to upgrade to WebSocket. Error: [%s]", err)
+			return
+		}
+		defer conn.Close()
+
+		events, unsubscribe := transferEvents.Subscribe(subscription)
+		defer unsubscribe()
+
+		closed := make(chan struct{})
+		go func() {
+			defer close(closed)
+			for {
+				if _, _, err := conn.ReadMessage(); err != nil {
+					return
+				}
+			}
+		}()
+
+		ticker := time.NewTicker(keepAliveInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-closed:
+				return
+			case event, ok := <-events:
+				if !ok {
+					return
+				}
+				conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+				if err := conn.WriteJSON(event); err != nil {
+					return
+				}
+			case <-ticker.C:
+				if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(writeTimeout)); err != nil {
+					return
+				}
+			}
+		}
+	}
+}
+
+// subscriptionFromQuery parses the subscription from the query params.
+// Transfer IDs can be passed either as repeated params or as a comma separated list
+func subscriptionFromQuery(r *http.Request) (transferModel.Subscription, error) {
+	query := r.URL.Query()
+
+	var transferIds []string
+	for _, param := range query["transferId"] {
+		for _, id := range strings.Split(param, ",") {
+			if id = strings.TrimSpace(id); id != "" {
+				transferIds = append(transferIds, id)
+			}
+		}
+	}
+
+	subscription := transferModel.Subscription{
+		TransferIds: transferIds,
+		Receiver:    query.Get("receiver"),
+		Originator:  query.Get("originator"),
+	}
+	if subscription.IsEmpty() {
+		return subscription, fmt.Errorf("at least one of transferId, receiver or originator is required")
+	}
+	if len(transferIds) > maxStreamTransferIds {
+		return subscription, fmt.Errorf("maximum number of transfer IDs is %d", maxStreamTransferIds)
+	}
+
+	return subscription, nil
+}
@@ -0,0 +1,91 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transfer
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	transferModel "github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	streamEvent  = &transferModel.Event{Type: transferModel.EventStatusChanged, TransferId: transferId, Status: "COMPLETED"}
+	subscription = transferModel.Subscription{TransferIds: []string{transferId, "2"}, Receiver: "0x1"}
+)
+
+func Test_stream(t *testing.T) {
+	mocks.Setup()
+	events := make(chan *transferModel.Event, 1)
+	events <- streamEvent
+	close(events)
+	mocks.MTransferEventsService.On("Subscribe", subscription).Return((<-chan *transferModel.Event)(events), func() {})
+
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/stream?transferId=1,2&receiver=0x1", nil)
+	stream(mocks.MTransferEventsService)(recorder, request)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "text/event-stream", recorder.Header().Get("Content-Type"))
+	assert.True(t, strings.HasPrefix(recorder.Body.String(), "event: STATUS_CHANGED\ndata: {\"type\":\"STATUS_CHANGED\",\"transferId\":\"1\""))
+}
+
+func Test_stream_EmptySubscription(t *testing.T) {
+	mocks.Setup()
+
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/stream", nil)
+	stream(mocks.MTransferEventsService)(recorder, request)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	mocks.MTransferEventsService.AssertNotCalled(t, "Subscribe", subscription)
+}
+
+func Test_streamWebSocket(t *testing.T) {
+	mocks.Setup()
+	events := make(chan *transferModel.Event, 1)
+	events <- streamEvent
+	mocks.MTransferEventsService.On("Subscribe", subscription).Return((<-chan *transferModel.Event)(events), func() {})
+
+	server := httptest.NewServer(http.HandlerFunc(streamWebSocket(mocks.MTransferEventsService)))
+	defer server.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+"?transferId=1&transferId=2&receiver=0x1", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	actual := &transferModel.Event{}
+	err = conn.ReadJSON(actual)
+
+	assert.Nil(t, err)
+	assert.Equal(t, streamEvent, actual)
+}
+
+func Test_subscriptionFromQuery_TooManyTransferIds(t *testing.T) {
+	request := httptest.NewRequest(http.MethodGet, "/stream?transferId="+strings.Repeat("1,", maxStreamTransferIds+1), nil)
+
+	_, err := subscriptionFromQuery(request)
+
+	assert.NotNil(t, err)
+}
@@ -3,6 +3,7 @@ package transfer
 import (
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"net/http"
 	"strings"
 
@@ -26,9 +27,13 @@ var (
 
 const maxHistoryPageSize = 50
 
-func NewRouter(service service.Transfers) chi.Router {
+func NewRouter(service service.Transfers, transferEvents service.TransferEvents) chi.Router {
 	r := chi.NewRouter()
+	r.Get("/stream", stream(transferEvents))
+	r.Get("/ws", streamWebSocket(transferEvents))
 	r.Get("/{id}", getTransfer(service))
+	r.Get("/{id}/timeline", getTransferTimeline(service))
+	r.Get("/{id}/status-history", getTransferStatusHistory(service))
 	r.Post("/history", history(service))
 	return r
 }
@@ -49,6 +54,38 @@ func getTransfer(transfersService service.Transfers) func(w http.ResponseWriter,
 	}
 }
 
+// GET: .../transfers/:id/timeline
+func getTransferTimeline(transfersService service.Transfers) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		transferID := chi.URLParam(r, "id")
+
+		timeline, err := transfersService.Timeline(transferID)
+		if err != nil {
+			logger.Errorf("Router resolved with an error. Error [%s].", err)
+			httpHelper.WriteErrorResponse(w, r, err)
+			return
+		}
+
+		render.JSON(w, r, timeline)
+	}
+}
+
+// GET: .../transfers/:id/status-history
+func getTransferStatusHistory(transfersService service.Transfers) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		transferID := chi.URLParam(r, "id")
+
+		history, err := transfersService.StatusHistory(transferID)
+		if err != nil {
+			logger.Errorf("Router resolved with an error. Error [%s].", err)
+			httpHelper.WriteErrorResponse(w, r, err)
+			return
+		}
+
+		render.JSON(w, r, history)
+	}
+}
+
 // POST: .../history
 func history(transferService service.Transfers) func(w http.ResponseWriter, r *http.Request) {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -59,11 +96,18 @@ func history(transferService service.Transfers) func(w http.ResponseWriter, r *h
 			render.JSON(w, r, response.ErrorResponse(err))
 			return
 		}
-		if req.Page <= 0 {
+		if req.Page > 0 && req.Cursor != "" {
 			render.Status(r, http.StatusBadRequest)
-			render.JSON(w, r, response.ErrorResponse(fmt.Errorf("page must be greater than 0")))
+			render.JSON(w, r, response.ErrorResponse(fmt.Errorf("page and cursor cannot be used together")))
 			return
 		}
+		if req.Cursor != "" {
+			if _, _, err := transferModel.DecodeCursor(req.Cursor); err != nil {
+				render.Status(r, http.StatusBadRequest)
+				render.JSON(w, r, response.ErrorResponse(err))
+				return
+			}
+		}
 		if req.PageSize <= 0 {
 			render.Status(r, http.StatusBadRequest)
 			render.JSON(w, r, response.ErrorResponse(fmt.Errorf("page size must be greater than 0")))
@@ -82,6 +126,12 @@ func history(transferService service.Transfers) func(w http.ResponseWriter, r *h
 			}
 		}
 
+		if err := validateFilter(req.Filter); err != nil {
+			render.Status(r, http.StatusBadRequest)
+			render.JSON(w, r, response.ErrorResponse(err))
+			return
+		}
+
 		res, err := transferService.Paged(req)
 		if err != nil {
 			logger.Errorf("Router resolved with an error. Error [%v]", err)
@@ -92,3 +142,35 @@ func history(transferService service.Transfers) func(w http.ResponseWriter, r *h
 		render.JSON(w, r, res)
 	}
 }
+
+func validateFilter(f transferModel.Filter) error {
+	minAmount, err := parseAmount("minAmount", f.MinAmount)
+	if err != nil {
+		return err
+	}
+	maxAmount, err := parseAmount("maxAmount", f.MaxAmount)
+	if err != nil {
+		return err
+	}
+	if minAmount != nil && maxAmount != nil && minAmount.Cmp(maxAmount) > 0 {
+		return fmt.Errorf("minAmount must not be greater than maxAmount")
+	}
+	if f.From != nil && f.To != nil && !f.From.Before(*f.To) {
+		return fmt.Errorf("from must be before to")
+	}
+
+	return nil
+}
+
+func parseAmount(name, amount string) (*big.Int, error) {
+	if amount == "" {
+		return nil, nil
+	}
+
+	res, ok := new(big.Int).SetString(amount, 10)
+	if !ok || res.Sign() < 0 {
+		return nil, fmt.Errorf("%s must be a non-negative integer", name)
+	}
+
+	return res, nil
+}
@@ -23,11 +23,14 @@ import (
 	"errors"
 	"github.com/go-chi/chi"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	transferModel "github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
 	"github.com/limechain/hedera-eth-bridge-validator/app/router/response"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
 	"github.com/stretchr/testify/assert"
 	"net/http"
 	"testing"
+	"time"
 )
 
 var (
@@ -37,7 +40,7 @@ var (
 )
 
 func Test_NewRouter(t *testing.T) {
-	router := NewRouter(mocks.MTransferService)
+	router := NewRouter(mocks.MTransferService, mocks.MTransferEventsService)
 
 	assert.NotNil(t, router)
 }
@@ -127,6 +130,111 @@ func Test_getTransfer_InternalServerErr(t *testing.T) {
 	mocks.MResponseWriter.AssertCalled(t, "WriteHeader", http.StatusInternalServerError)
 }
 
+func Test_getTransferTimeline(t *testing.T) {
+	mocks.Setup()
+
+	buf := &bytes.Buffer{}
+	enc := json.NewEncoder(buf)
+
+	timeline := &transferModel.Timeline{
+		TransactionId: transferId,
+		Steps: []*transferModel.TimelineStep{
+			{Type: transferModel.StepSourceTransaction, TransactionId: transferId},
+		},
+	}
+	if err := enc.Encode(timeline); err != nil {
+		t.Fatalf("Failed to encode response for ResponseWriter. Err: [%s]", err.Error())
+	}
+	timelineResponseAsBytes := buf.Bytes()
+	request := prepareRequest()
+
+	mocks.MTransferService.On("Timeline", transferId).Return(timeline, nil)
+	mocks.MResponseWriter.On("Header").Return(http.Header{})
+	mocks.MResponseWriter.On("Write", timelineResponseAsBytes).Return(len(timelineResponseAsBytes), nil)
+
+	timelineResponseHandler := getTransferTimeline(mocks.MTransferService)
+	timelineResponseHandler(mocks.MResponseWriter, request)
+
+	mocks.MTransferService.AssertCalled(t, "Timeline", transferId)
+	mocks.MResponseWriter.AssertCalled(t, "Write", timelineResponseAsBytes)
+}
+
+func Test_getTransferTimeline_ErrNotFound(t *testing.T) {
+	mocks.Setup()
+
+	buf := &bytes.Buffer{}
+	enc := json.NewEncoder(buf)
+
+	if err := enc.Encode(response.ErrorResponse(service.ErrNotFound)); err != nil {
+		t.Fatalf("Failed to encode response for ResponseWriter. Err: [%s]", err.Error())
+	}
+	timelineResponseAsBytes := buf.Bytes()
+	request := prepareRequest()
+
+	mocks.MTransferService.On("Timeline", transferId).Return(nil, service.ErrNotFound)
+	mocks.MResponseWriter.On("Header").Return(http.Header{})
+	mocks.MResponseWriter.On("Write", timelineResponseAsBytes).Return(len(timelineResponseAsBytes), nil)
+	mocks.MResponseWriter.On("WriteHeader", http.StatusNotFound).Return()
+
+	timelineResponseHandler := getTransferTimeline(mocks.MTransferService)
+	timelineResponseHandler(mocks.MResponseWriter, request)
+
+	mocks.MTransferService.AssertCalled(t, "Timeline", transferId)
+	mocks.MResponseWriter.AssertCalled(t, "Write", timelineResponseAsBytes)
+	mocks.MResponseWriter.AssertCalled(t, "WriteHeader", http.StatusNotFound)
+}
+
+func Test_getTransferStatusHistory(t *testing.T) {
+	mocks.Setup()
+
+	buf := &bytes.Buffer{}
+	enc := json.NewEncoder(buf)
+
+	history := []*transferModel.StatusChange{
+		{Kind: status.KindTransfer, Id: transferId, To: status.Initial, Reason: "transfer created", Actor: status.ActorHandler},
+		{Kind: status.KindTransfer, Id: transferId, From: status.Initial, To: status.Completed, Reason: "transfer processed", Actor: status.ActorHandler},
+	}
+	if err := enc.Encode(history); err != nil {
+		t.Fatalf("Failed to encode response for ResponseWriter. Err: [%s]", err.Error())
+	}
+	historyResponseAsBytes := buf.Bytes()
+	request := prepareRequest()
+
+	mocks.MTransferService.On("StatusHistory", transferId).Return(history, nil)
+	mocks.MResponseWriter.On("Header").Return(http.Header{})
+	mocks.MResponseWriter.On("Write", historyResponseAsBytes).Return(len(historyResponseAsBytes), nil)
+
+	historyResponseHandler := getTransferStatusHistory(mocks.MTransferService)
+	historyResponseHandler(mocks.MResponseWriter, request)
+
+	mocks.MTransferService.AssertCalled(t, "StatusHistory", transferId)
+	mocks.MResponseWriter.AssertCalled(t, "Write", historyResponseAsBytes)
+}
+
+func Test_getTransferStatusHistory_ErrNotFound(t *testing.T) {
+	mocks.Setup()
+
+	buf := &bytes.Buffer{}
+	enc := json.NewEncoder(buf)
+
+	if err := enc.Encode(response.ErrorResponse(service.ErrNotFound)); err != nil {
+		t.Fatalf("Failed to encode response for ResponseWriter. Err: [%s]", err.Error())
+	}
+	historyResponseAsBytes := buf.Bytes()
+	request := prepareRequest()
+
+	mocks.MTransferService.On("StatusHistory", transferId).Return(nil, service.ErrNotFound)
+	mocks.MResponseWriter.On("Header").Return(http.Header{})
+	mocks.MResponseWriter.On("Write", historyResponseAsBytes).Return(len(historyResponseAsBytes), nil)
+	mocks.MResponseWriter.On("WriteHeader", http.StatusNotFound).Return()
+
+	historyResponseHandler := getTransferStatusHistory(mocks.MTransferService)
+	historyResponseHandler(mocks.MResponseWriter, request)
+
+	mocks.MTransferService.AssertCalled(t, "StatusHistory", transferId)
+	mocks.MResponseWriter.AssertCalled(t, "WriteHeader", http.StatusNotFound)
+}
+
 func prepareRequest() *http.Request {
 	request := new(http.Request)
 	chiCtx := &chi.Context{
@@ -139,3 +247,14 @@ func prepareRequest() *http.Request {
 	request = request.WithContext(ctx)
 	return request
 }
+
+func Test_validateFilter(t *testing.T) {
+	from := time.Unix(1650000000, 0)
+	to := from.Add(time.Hour)
+
+	assert.Nil(t, validateFilter(transferModel.Filter{MinAmount: "1", MaxAmount: "1", From: &from, To: &to}))
+	assert.NotNil(t, validateFilter(transferModel.Filter{MinAmount: "-1"}))
+	assert.NotNil(t, validateFilter(transferModel.Filter{MaxAmount: "1.5"}))
+	assert.NotNil(t, validateFilter(transferModel.Filter{MinAmount: "2", MaxAmount: "1"}))
+	assert.NotNil(t, validateFilter(transferModel.Filter{From: &to, To: &from}))
+}
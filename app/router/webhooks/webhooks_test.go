@@ -0,0 +1,115 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package webhooks
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	webhookModel "github.com/limechain/hedera-eth-bridge-validator/app/model/webhook"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	deliveryIdParam = uint64(7)
+	delivery        = &webhookModel.Delivery{
+		ID:         8,
+		Url:        "https://integrator/hooks",
+		Event:      webhookModel.EventTransferClaimed,
+		TransferId: "0.0.123456-1650000000-000000001",
+		Status:     webhookModel.StatusPending,
+		ReplayOf:   7,
+	}
+)
+
+func Test_NewRouter(t *testing.T) {
+	router := NewRouter(mocks.MWebhooksService)
+
+	assert.NotNil(t, router)
+}
+
+func Test_getDelivery(t *testing.T) {
+	mocks.Setup()
+	mocks.MWebhooksService.On("Delivery", deliveryIdParam).Return(delivery, nil)
+
+	res := serve(http.MethodGet, "/deliveries/7")
+
+	assert.Equal(t, http.StatusOK, res.Code)
+	actual := &webhookModel.Delivery{}
+	assert.Nil(t, json.Unmarshal(res.Body.Bytes(), actual))
+	assert.Equal(t, delivery, actual)
+}
+
+func Test_getDelivery_ErrNotFound(t *testing.T) {
+	mocks.Setup()
+	mocks.MWebhooksService.On("Delivery", deliveryIdParam).Return(nil, service.ErrNotFound)
+
+	res := serve(http.MethodGet, "/deliveries/7")
+
+	assert.Equal(t, http.StatusNotFound, res.Code)
+}
+
+func Test_getDelivery_InvalidId(t *testing.T) {
+	mocks.Setup()
+
+	res := serve(http.MethodGet, "/deliveries/abc")
+
+	assert.Equal(t, http.StatusBadRequest, res.Code)
+	mocks.MWebhooksService.AssertNotCalled(t, "Delivery", mock.Anything)
+}
+
+func Test_replayDelivery(t *testing.T) {
+	mocks.Setup()
+	mocks.MWebhooksService.On("Replay", deliveryIdParam).Return(delivery, nil)
+
+	res := serve(http.MethodPost, "/deliveries/7/replay")
+
+	assert.Equal(t, http.StatusAccepted, res.Code)
+	actual := &webhookModel.Delivery{}
+	assert.Nil(t, json.Unmarshal(res.Body.Bytes(), actual))
+	assert.Equal(t, delivery, actual)
+}
+
+func Test_replayDelivery_NotConfigured(t *testing.T) {
+	mocks.Setup()
+	mocks.MWebhooksService.On("Replay", deliveryIdParam).Return(nil, service.ErrWebhookNotConfigured)
+
+	res := serve(http.MethodPost, "/deliveries/7/replay")
+
+	assert.Equal(t, http.StatusBadRequest, res.Code)
+}
+
+func Test_replayDelivery_InternalServerErr(t *testing.T) {
+	mocks.Setup()
+	mocks.MWebhooksService.On("Replay", deliveryIdParam).Return(nil, errors.New("some-error"))
+
+	res := serve(http.MethodPost, "/deliveries/7/replay")
+
+	assert.Equal(t, http.StatusInternalServerError, res.Code)
+}
+
+func serve(method, target string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	NewRouter(mocks.MWebhooksService).ServeHTTP(recorder, httptest.NewRequest(method, target, nil))
+	return recorder
+}
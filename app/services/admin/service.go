@@ -0,0 +1,186 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/metrics"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/admin"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/failure"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	log "github.com/sirupsen/logrus"
+)
+
+type Service struct {
+	transferRepository repository.Transfer
+	statusRepository   repository.Status
+	auditRepository    repository.AuditLog
+	contractServices   map[uint64]service.Contracts
+	prometheusService  service.Prometheus
+	redriveService     service.Redrive
+	watchers           service.WatcherControl
+	logger             *log.Entry
+}
+
+func NewService(
+	transferRepository repository.Transfer,
+	statusRepository repository.Status,
+	auditRepository repository.AuditLog,
+	contractServices map[uint64]service.Contracts,
+	prometheusService service.Prometheus,
+	redriveService service.Redrive,
+	watchers service.WatcherControl) *Service {
+	return &Service{
+		transferRepository: transferRepository,
+		statusRepository:   statusRepository,
+		auditRepository:    auditRepository,
+		contractServices:   contractServices,
+		prometheusService:  prometheusService,
+		redriveService:     redriveService,
+		watchers:           watchers,
+		logger:             config.GetLoggerFor("Admin Service"),
+	}
+}
+
+func (s *Service) UpdateTransferStatus(transferID, newStatus, reason string) error {
+	if newStatus != status.Completed && newStatus != status.Failed {
+		return service.ErrInvalidTransferStatus
+	}
+
+	t, err := s.transferRepository.GetByTransactionId(transferID)
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to query transfer. Error: [%s]", transferID, err)
+		return err
+	}
+	if t == nil {
+		return service.ErrNotFound
+	}
+
+	if reason == "" {
+		reason = "manually updated"
+	}
+	if newStatus == status.Failed {
+		err = s.transferRepository.Fail(transferID, failure.ManuallyFailed, reason, status.ActorAdmin)
+	} else {
+		err = s.transferRepository.UpdateStatus(transferID, newStatus, status.ActorAdmin, reason)
+	}
+	if errors.Is(err, status.ErrInvalidTransition) {
+		return service.ErrInvalidStatusTransition
+	}
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to update status to [%s]. Error: [%s]", transferID, newStatus, err)
+		return err
+	}
+	if newStatus == status.Completed {
+		// The latency of a manual completion is not the one of the bridge
+		metrics.ReachTransferStage(constants.UserGetHisTokensStage, t.SourceChainID, t.TargetChainID, t.SourceAsset, time.Time{}, s.prometheusService)
+	}
+
+	s.logger.Infof("[%s] - Status manually updated from [%s] to [%s].", transferID, t.Status, newStatus)
+	return nil
+}
+
+func (s *Service) RedriveTransfer(transferID string) error {
+	return s.redriveService.Redrive(transferID)
+}
+
+func (s *Service) Watchers() ([]*admin.Watcher, error) {
+	watchers := s.watchers.Watchers()
+	result := make([]*admin.Watcher, 0, len(watchers))
+	for id, paused := range watchers {
+		last, err := s.statusRepository.Get(id)
+		if err != nil {
+			s.logger.Errorf("Failed to get status of watcher [%s]. Error: [%s]", id, err)
+			return nil, err
+		}
+		result = append(result, &admin.Watcher{Id: id, Paused: paused, Last: last})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Id < result[j].Id
+	})
+	return result, nil
+}
+
+func (s *Service) PauseWatcher(id string) error {
+	return s.watchers.PauseWatcher(id)
+}
+
+func (s *Service) ResumeWatcher(id string) error {
+	return s.watchers.ResumeWatcher(id)
+}
+
+func (s *Service) RewindWatcher(id string, to int64) error {
+	paused, ok := s.watchers.Watchers()[id]
+	if !ok {
+		return service.ErrNotFound
+	}
+	if !paused {
+		return service.ErrWatcherNotPaused
+	}
+
+	err := s.statusRepository.Update(id, to)
+	if err != nil {
+		s.logger.Errorf("Failed to rewind watcher [%s] to [%d]. Error: [%s]", id, to, err)
+		return err
+	}
+
+	s.logger.Infof("Rewound watcher [%s] to [%d].", id, to)
+	return nil
+}
+
+func (s *Service) ReloadMembers() {
+	for _, contractService := range s.contractServices {
+		contractService.ReloadMembers()
+	}
+}
+
+func (s *Service) Audit(entry *admin.AuditEntry) {
+	err := s.auditRepository.Create(&entity.AuditLog{
+		Actor:      entry.Actor,
+		Method:     entry.Method,
+		Path:       entry.Path,
+		Body:       entry.Body,
+		StatusCode: entry.StatusCode,
+		RemoteAddr: entry.RemoteAddr,
+	})
+	if err != nil {
+		s.logger.Errorf("Failed to log [%s %s] by [%s]. Error: [%s]", entry.Method, entry.Path, entry.Actor, err)
+	}
+}
+
+func (s *Service) AuditLog(limit int) ([]*admin.AuditEntry, error) {
+	entries, err := s.auditRepository.GetLatest(limit)
+	if err != nil {
+		s.logger.Errorf("Failed to get audit log. Error: [%s]", err)
+		return nil, err
+	}
+
+	result := make([]*admin.AuditEntry, len(entries))
+	for i, e := range entries {
+		result[i] = e.ToDto()
+	}
+	return result, nil
+}
@@ -0,0 +1,231 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/admin"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/failure"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	s          *Service
+	transferId = "0.0.123456-1650000000-000000001"
+	watcherId  = "0.0.1234"
+	evmWatcher = "3-0x0000000000000000000000000000000000000001"
+	transfer   = &entity.Transfer{
+		TransactionID: transferId,
+		SourceChainID: 296,
+		TargetChainID: 3,
+		SourceAsset:   "0.0.111111",
+		Status:        status.Initial,
+	}
+)
+
+func Test_NewService(t *testing.T) {
+	setup()
+
+	actual := NewService(mocks.MTransferRepository, mocks.MStatusRepository, mocks.MAuditLogRepository,
+		s.contractServices, mocks.MPrometheusService, mocks.MRedriveService, mocks.MWatcherControl)
+
+	assert.Equal(t, s, actual)
+}
+
+func Test_UpdateTransferStatus_Completed(t *testing.T) {
+	setup()
+	mocks.MTransferRepository.On("GetByTransactionId", transferId).Return(transfer, nil)
+	mocks.MTransferRepository.On("UpdateStatus", transferId, status.Completed, status.ActorAdmin, "funds sent manually").Return(nil)
+	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+
+	err := s.UpdateTransferStatus(transferId, status.Completed, "funds sent manually")
+
+	assert.Nil(t, err)
+	mocks.MTransferRepository.AssertCalled(t, "UpdateStatus", transferId, status.Completed, status.ActorAdmin, "funds sent manually")
+	mocks.MPrometheusService.AssertCalled(t, "GetIsMonitoringEnabled")
+}
+
+func Test_UpdateTransferStatus_Failed(t *testing.T) {
+	setup()
+	mocks.MTransferRepository.On("GetByTransactionId", transferId).Return(transfer, nil)
+	mocks.MTransferRepository.On("Fail", transferId, failure.ManuallyFailed, "manually updated", status.ActorAdmin).Return(nil)
+
+	err := s.UpdateTransferStatus(transferId, status.Failed, "")
+
+	assert.Nil(t, err)
+	mocks.MTransferRepository.AssertCalled(t, "Fail", transferId, failure.ManuallyFailed, "manually updated", status.ActorAdmin)
+	mocks.MPrometheusService.AssertNotCalled(t, "GetIsMonitoringEnabled")
+}
+
+func Test_UpdateTransferStatus_InvalidStatus(t *testing.T) {
+	setup()
+
+	err := s.UpdateTransferStatus(transferId, status.Submitted, "")
+
+	assert.Equal(t, service.ErrInvalidTransferStatus, err)
+	mocks.MTransferRepository.AssertNotCalled(t, "GetByTransactionId", transferId)
+}
+
+func Test_UpdateTransferStatus_InvalidTransition(t *testing.T) {
+	setup()
+	mocks.MTransferRepository.On("GetByTransactionId", transferId).Return(transfer, nil)
+	mocks.MTransferRepository.On("UpdateStatus", transferId, status.Completed, status.ActorAdmin, "manually updated").
+		Return(fmt.Errorf("%w from [%s] to [%s]", status.ErrInvalidTransition, status.Failed, status.Completed))
+
+	err := s.UpdateTransferStatus(transferId, status.Completed, "")
+
+	assert.Equal(t, service.ErrInvalidStatusTransition, err)
+	mocks.MPrometheusService.AssertNotCalled(t, "GetIsMonitoringEnabled")
+}
+
+func Test_UpdateTransferStatus_NotFound(t *testing.T) {
+	setup()
+	mocks.MTransferRepository.On("GetByTransactionId", transferId).Return((*entity.Transfer)(nil), nil)
+
+	err := s.UpdateTransferStatus(transferId, status.Failed, "")
+
+	assert.Equal(t, service.ErrNotFound, err)
+	mocks.MTransferRepository.AssertNotCalled(t, "Fail", transferId, failure.ManuallyFailed, "manually updated", status.ActorAdmin)
+}
+
+func Test_UpdateTransferStatus_UpdateFails(t *testing.T) {
+	setup()
+	expectedErr := errors.New("connection refused")
+	mocks.MTransferRepository.On("GetByTransactionId", transferId).Return(transfer, nil)
+	mocks.MTransferRepository.On("UpdateStatus", transferId, status.Completed, status.ActorAdmin, "manually updated").Return(expectedErr)
+
+	err := s.UpdateTransferStatus(transferId, status.Completed, "")
+
+	assert.Equal(t, expectedErr, err)
+	mocks.MPrometheusService.AssertNotCalled(t, "GetIsMonitoringEnabled")
+}
+
+func Test_RedriveTransfer(t *testing.T) {
+	setup()
+	mocks.MRedriveService.On("Redrive", transferId).Return(service.ErrTransferRedrivenRecently)
+
+	err := s.RedriveTransfer(transferId)
+
+	assert.Equal(t, service.ErrTransferRedrivenRecently, err)
+}
+
+func Test_Watchers(t *testing.T) {
+	setup()
+	mocks.MWatcherControl.On("Watchers").Return(map[string]bool{watcherId: true, evmWatcher: false})
+	mocks.MStatusRepository.On("Get", watcherId).Return(int64(1650000000000000000), nil)
+	mocks.MStatusRepository.On("Get", evmWatcher).Return(int64(1337), nil)
+
+	actual, err := s.Watchers()
+
+	assert.Nil(t, err)
+	assert.Equal(t, []*admin.Watcher{
+		{Id: watcherId, Paused: true, Last: 1650000000000000000},
+		{Id: evmWatcher, Paused: false, Last: 1337},
+	}, actual)
+}
+
+func Test_Watchers_StatusFails(t *testing.T) {
+	setup()
+	expectedErr := errors.New("connection refused")
+	mocks.MWatcherControl.On("Watchers").Return(map[string]bool{watcherId: true})
+	mocks.MStatusRepository.On("Get", watcherId).Return(int64(0), expectedErr)
+
+	actual, err := s.Watchers()
+
+	assert.Equal(t, expectedErr, err)
+	assert.Nil(t, actual)
+}
+
+func Test_RewindWatcher(t *testing.T) {
+	setup()
+	mocks.MWatcherControl.On("Watchers").Return(map[string]bool{watcherId: true})
+	mocks.MStatusRepository.On("Update", watcherId, int64(1650000000000000000)).Return(nil)
+
+	err := s.RewindWatcher(watcherId, 1650000000000000000)
+
+	assert.Nil(t, err)
+}
+
+func Test_RewindWatcher_NotPaused(t *testing.T) {
+	setup()
+	mocks.MWatcherControl.On("Watchers").Return(map[string]bool{watcherId: false})
+
+	err := s.RewindWatcher(watcherId, 1650000000000000000)
+
+	assert.Equal(t, service.ErrWatcherNotPaused, err)
+	mocks.MStatusRepository.AssertNotCalled(t, "Update", watcherId, int64(1650000000000000000))
+}
+
+func Test_RewindWatcher_NotFound(t *testing.T) {
+	setup()
+	mocks.MWatcherControl.On("Watchers").Return(map[string]bool{})
+
+	err := s.RewindWatcher(watcherId, 1650000000000000000)
+
+	assert.Equal(t, service.ErrNotFound, err)
+}
+
+func Test_ReloadMembers(t *testing.T) {
+	setup()
+	mocks.MBridgeContractService.On("ReloadMembers").Return()
+
+	s.ReloadMembers()
+
+	mocks.MBridgeContractService.AssertNumberOfCalls(t, "ReloadMembers", 1)
+}
+
+func Test_Audit(t *testing.T) {
+	setup()
+	entry := &admin.AuditEntry{Actor: "ops", Method: "POST", Path: "/api/v1/admin/members/reload", StatusCode: 204, RemoteAddr: "127.0.0.1:1234"}
+	mocks.MAuditLogRepository.On("Create", &entity.AuditLog{Actor: "ops", Method: "POST", Path: "/api/v1/admin/members/reload", StatusCode: 204, RemoteAddr: "127.0.0.1:1234"}).Return(nil)
+
+	s.Audit(entry)
+
+	mocks.MAuditLogRepository.AssertNumberOfCalls(t, "Create", 1)
+}
+
+func Test_AuditLog(t *testing.T) {
+	setup()
+	mocks.MAuditLogRepository.On("GetLatest", 10).Return([]*entity.AuditLog{{ID: 2, Actor: "ops", Method: "POST", StatusCode: 204, CreatedAt: 1650000000000000000}}, nil)
+
+	actual, err := s.AuditLog(10)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []*admin.AuditEntry{{Id: 2, Actor: "ops", Method: "POST", StatusCode: 204, CreatedAt: 1650000000000000000}}, actual)
+}
+
+func setup() {
+	mocks.Setup()
+	s = &Service{
+		transferRepository: mocks.MTransferRepository,
+		statusRepository:   mocks.MStatusRepository,
+		auditRepository:    mocks.MAuditLogRepository,
+		contractServices:   map[uint64]service.Contracts{3: mocks.MBridgeContractService},
+		prometheusService:  mocks.MPrometheusService,
+		redriveService:     mocks.MRedriveService,
+		watchers:           mocks.MWatcherControl,
+		logger:             config.GetLoggerFor("Admin Service"),
+	}
+}
@@ -0,0 +1,534 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package batch
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/schedule"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
+	"github.com/limechain/hedera-eth-bridge-validator/app/services/fee/distributor"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+)
+
+// item is a single transfer, included in a batch
+type item struct {
+	transferID string
+	receiver   hedera.AccountID
+	transfers  []transfer.Hedera
+	onSuccess  func()
+	onFail     func()
+}
+
+// callbacks are the functions, called once the scheduled transaction crediting the receiver of a transfer is mined
+type callbacks struct {
+	onSuccess func()
+	onFail    func()
+}
+
+// Service batches pending transfers. The membership of the batches is persisted, so that batches survive restarts.
+// A batch is executed only once the EVM watchers have processed all blocks of its window and all of its transfers
+// have been added, so that all validators build it from the same transfers. The callbacks of the transfers are kept
+// in memory only
+type Service struct {
+	enabled            bool
+	window             time.Duration
+	delay              time.Duration
+	bridgeAccount      hedera.AccountID
+	evmWatchers        []string
+	repository         repository.Batch
+	blockRepository    repository.Block
+	transferRepository repository.Transfer
+	scheduleRepository repository.Schedule
+	feeRepository      repository.Fee
+	scheduledService   service.Scheduled
+	mutex              sync.Mutex
+	callbacks          map[string]callbacks
+	now                func() time.Time
+	logger             *log.Entry
+}
+
+// NewService creates the batch service. The evmWatchers are the IDs of the EVM watchers, whose processed blocks
+// mark the windows, all of whose events are known to the validator
+func NewService(
+	bridgeAccount string,
+	cfg config.Batching,
+	evmWatchers []string,
+	repository repository.Batch,
+	blockRepository repository.Block,
+	transferRepository repository.Transfer,
+	scheduleRepository repository.Schedule,
+	feeRepository repository.Fee,
+	scheduledService service.Scheduled) *Service {
+
+	bridgeAcc, err := hedera.AccountIDFromString(bridgeAccount)
+	if err != nil {
+		log.Fatalf("Invalid bridge account: [%s].", bridgeAccount)
+	}
+
+	return &Service{
+		enabled:            cfg.Enabled,
+		window:             cfg.Window * time.Second,
+		delay:              cfg.Delay * time.Second,
+		bridgeAccount:      bridgeAcc,
+		evmWatchers:        evmWatchers,
+		repository:         repository,
+		blockRepository:    blockRepository,
+		transferRepository: transferRepository,
+		scheduleRepository: scheduleRepository,
+		feeRepository:      feeRepository,
+		scheduledService:   scheduledService,
+		callbacks:          make(map[string]callbacks),
+		now:                time.Now,
+		logger:             config.GetLoggerFor("Batch Service"),
+	}
+}
+
+// Enabled returns whether scheduled transfers are batched
+func (s *Service) Enabled() bool {
+	return s.enabled
+}
+
+// ID returns the ID of the batch, including transfers of the given token with events at the given timestamp
+func (s *Service) ID(token string, timestamp time.Time) string {
+	return fmt.Sprintf("batch-%s-%d", token, timestamp.Truncate(s.window).Unix())
+}
+
+// Add persists the positive transfers of the given transfer in its batch. The batch is executed once its window
+// has ended and the configured delay has passed
+func (s *Service) Add(transferID, token string, timestamp time.Time, receiver hedera.AccountID, transfers []transfer.Hedera, onSuccess, onFail func()) error {
+	id := s.ID(token, timestamp)
+	executeAt := timestamp.Truncate(s.window).Add(s.window)
+
+	batchTransfers := make([]entity.BatchTransfer, 0, len(transfers))
+	for _, t := range transfers {
+		batchTransfers = append(batchTransfers, entity.BatchTransfer{
+			AccountID: t.AccountID.String(),
+			Amount:    t.Amount,
+		})
+	}
+
+	err := s.repository.Save(&entity.BatchItem{
+		TransferID: transferID,
+		BatchID:    id,
+		Token:      token,
+		Receiver:   receiver.String(),
+		Transfers:  batchTransfers,
+		ExecuteAt:  executeAt.Add(s.delay).UnixNano(),
+	})
+	if err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	s.callbacks[transferID] = callbacks{onSuccess: onSuccess, onFail: onFail}
+	s.mutex.Unlock()
+
+	s.logger.Debugf("[%s] - Added to batch [%s].", transferID, id)
+	return nil
+}
+
+// ExecuteDue executes the pending batches, whose windows and delays have passed. Windows, whose blocks are not
+// processed by all EVM watchers yet, are not due regardless of the delay
+func (s *Service) ExecuteDue() {
+	at, ok := s.dueAt()
+	if !ok {
+		return
+	}
+
+	batches, err := s.repository.GetDue(at)
+	if err != nil {
+		s.logger.Errorf("Failed to get due batches. Error: [%s]", err)
+		return
+	}
+
+	for _, batch := range batches {
+		s.execute(batch)
+	}
+}
+
+// Batched returns whether the transfer was added to a batch
+func (s *Service) Batched(transferID string) (bool, error) {
+	item, err := s.repository.Get(transferID)
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to get batch item. Error: [%s]", transferID, err)
+		return false, err
+	}
+	return item != nil, nil
+}
+
+// Requeue makes the transfer pending in its batch again, so that it is executed along with the other re-driven
+// transfers of the batch
+func (s *Service) Requeue(transferID string) error {
+	err := s.repository.Requeue(transferID)
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to requeue batch item. Error: [%s]", transferID, err)
+		return err
+	}
+
+	s.logger.Debugf("[%s] - Requeued in its batch.", transferID)
+	return nil
+}
+
+// dueAt returns the time, against which the batches are due. It is the earlier of now and the timestamp of the
+// latest block, processed by the slowest EVM watcher, delayed by the configured delay. Returns false if any of the
+// EVM watchers has not processed a block yet
+func (s *Service) dueAt() (time.Time, bool) {
+	at := s.now()
+	for _, id := range s.evmWatchers {
+		blocks, err := s.blockRepository.GetLatest(id, 1)
+		if err != nil {
+			s.logger.Errorf("[%s] - Failed to get latest processed block. Error: [%s]", id, err)
+			return time.Time{}, false
+		}
+		if len(blocks) == 0 {
+			s.logger.Debugf("[%s] - No processed blocks yet. Skipping execution of batches.", id)
+			return time.Time{}, false
+		}
+
+		processed := time.Unix(blocks[0].Timestamp, 0).Add(s.delay)
+		if processed.Before(at) {
+			at = processed
+		}
+	}
+	return at, true
+}
+
+// execute executes the due batch, unless some of the transfers of its window are still being processed
+func (s *Service) execute(batch *entity.BatchItem) {
+	id := batch.BatchID
+	end := time.Unix(0, batch.ExecuteAt).Add(-s.delay)
+	unbatched, err := s.repository.CountUnbatched(batch.Token, end.Add(-s.window), end)
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to count transfers, not added to the batch yet. Error: [%s]", id, err)
+		return
+	}
+	if unbatched > 0 {
+		s.logger.Debugf("[%s] - Waiting for [%d] transfers to be added. Skipping execution.", id, unbatched)
+		return
+	}
+
+	pending, err := s.repository.TakePending(id)
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to get pending transfers. Error: [%s]", id, err)
+		return
+	}
+
+	items := s.items(pending)
+	if len(items) == 0 {
+		s.logger.Debugf("[%s] - No pending transfers. Skipping execution.", id)
+		return
+	}
+	token := pending[0].Token
+
+	// Validators must submit identical scheduled transactions, regardless of the order in which they processed the events
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].transferID < items[j].transferID
+	})
+
+	splitTransfers := s.prepareTransfers(items)
+	s.logger.Infof("[%s] - Executing batch of [%d] transfers in [%d] scheduled transactions.", id, len(items), len(splitTransfers))
+
+	// A batch combines transfers from different traces, so it is executed outside of any of them
+	for _, splitTransfer := range splitTransfers {
+		included := includedItems(items, splitTransfer)
+		feeAmount := feeFromTransfers(items, splitTransfer)
+
+		onExecutionSuccess, onExecutionFail := s.scheduledTxExecutionCallbacks(id, included, splitTransfer, feeAmount)
+		onSuccess, onFail := s.scheduledTxMinedCallbacks(id, included, splitTransfer, feeAmount)
+
+		s.scheduledService.ExecuteScheduledTransferTransaction(context.Background(), id, token, splitTransfer, onExecutionSuccess, onExecutionFail, onSuccess, onFail)
+	}
+}
+
+// items converts the persisted batch items, attaching the callbacks of the transfers added since the validator started
+func (s *Service) items(pending []*entity.BatchItem) []*item {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	items := make([]*item, 0, len(pending))
+	for _, p := range pending {
+		cb := s.callbacks[p.TransferID]
+		delete(s.callbacks, p.TransferID)
+
+		receiver, err := hedera.AccountIDFromString(p.Receiver)
+		if err != nil {
+			s.logger.Errorf("[%s] - Invalid receiver [%s]. Error: [%s]", p.TransferID, p.Receiver, err)
+			continue
+		}
+
+		transfers, err := hederaTransfers(p.Transfers)
+		if err != nil {
+			s.logger.Errorf("[%s] - Invalid batch transfers. Error: [%s]", p.TransferID, err)
+			continue
+		}
+
+		items = append(items, &item{
+			transferID: p.TransferID,
+			receiver:   receiver,
+			transfers:  transfers,
+			onSuccess:  cb.onSuccess,
+			onFail:     cb.onFail,
+		})
+	}
+
+	return items
+}
+
+// prepareTransfers aggregates the transfers of the items per account and splits them, debiting the bridge account
+func (s *Service) prepareTransfers(items []*item) [][]transfer.Hedera {
+	var accounts []hedera.AccountID
+	amounts := make(map[hedera.AccountID]int64)
+	total := int64(0)
+	for _, i := range items {
+		for _, t := range i.transfers {
+			if _, ok := amounts[t.AccountID]; !ok {
+				accounts = append(accounts, t.AccountID)
+			}
+			amounts[t.AccountID] += t.Amount
+			total += t.Amount
+		}
+	}
+
+	transfers := make([]transfer.Hedera, 0, len(accounts))
+	for _, account := range accounts {
+		transfers = append(transfers, transfer.Hedera{
+			AccountID: account,
+			Amount:    amounts[account],
+		})
+	}
+
+	return distributor.SplitAccountAmounts(transfers,
+		transfer.Hedera{
+			AccountID: s.bridgeAccount,
+			Amount:    -total,
+		})
+}
+
+func (s *Service) scheduledTxExecutionCallbacks(id string, items []*item, splitTransfer []transfer.Hedera, feeAmount int64) (onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail func(transactionID string)) {
+	onExecutionSuccess = func(transactionID, scheduleID string) {
+		s.logger.Debugf("[%s] - Updating db status to Submitted with TransactionID [%s].", id, transactionID)
+		for _, i := range items {
+			err := s.scheduleRepository.Create(&entity.Schedule{
+				ScheduleID:    scheduleID,
+				Operation:     schedule.TRANSFER,
+				TransactionID: transactionID,
+				HasReceiver:   hasAccount(splitTransfer, i.receiver),
+				Status:        status.Submitted,
+				TransferID: sql.NullString{
+					String: i.transferID,
+					Valid:  true,
+				},
+			})
+			if err != nil {
+				s.logger.Errorf(
+					"[%s] - Failed to update submitted status with TransactionID [%s], ScheduleID [%s]. Error [%s].",
+					i.transferID, transactionID, scheduleID, err)
+			}
+		}
+
+		if feeAmount == 0 {
+			return
+		}
+		err := s.feeRepository.Create(&entity.Fee{
+			TransactionID: transactionID,
+			ScheduleID:    scheduleID,
+			Amount:        strconv.FormatInt(feeAmount, 10),
+			Status:        status.Submitted,
+		})
+		if err != nil {
+			s.logger.Errorf("[%s] - Failed to create Fee Record [%s]. Error [%s].", transactionID, id, err)
+		}
+	}
+
+	onExecutionFail = func(transactionID string) {
+		for _, i := range items {
+			err := s.scheduleRepository.Create(&entity.Schedule{
+				TransactionID: transactionID,
+				Operation:     schedule.TRANSFER,
+				Status:        status.Failed,
+				HasReceiver:   hasAccount(splitTransfer, i.receiver),
+				TransferID: sql.NullString{
+					String: i.transferID,
+					Valid:  true,
+				},
+			})
+			if err != nil {
+				s.logger.Errorf("[%s] - Failed to update status failed. Error [%s].", i.transferID, err)
+			}
+		}
+
+		s.updateTransfersFailed(items, splitTransfer)
+
+		if feeAmount == 0 {
+			return
+		}
+		err := s.feeRepository.Create(&entity.Fee{
+			TransactionID: transactionID,
+			Amount:        strconv.FormatInt(feeAmount, 10),
+			Status:        status.Failed,
+		})
+		if err != nil {
+			s.logger.Errorf("[%s] Fee - Failed to create failed record. Error [%s].", transactionID, err)
+		}
+	}
+
+	return onExecutionSuccess, onExecutionFail
+}
+
+func (s *Service) scheduledTxMinedCallbacks(id string, items []*item, splitTransfer []transfer.Hedera, feeAmount int64) (onSuccess, onFail func(transactionID string)) {
+	onSuccess = func(transactionID string) {
+		s.logger.Debugf("[%s] - Scheduled TX [%s] execution successful.", id, transactionID)
+
+		err := s.scheduleRepository.UpdateStatusCompleted(transactionID)
+		if err != nil {
+			s.logger.Errorf("[%s] - Failed to update status completed. Error [%s].", transactionID, err)
+		}
+
+		if feeAmount != 0 {
+			err = s.feeRepository.UpdateStatusCompleted(transactionID)
+			if err != nil {
+				s.logger.Errorf("[%s] Fee - Failed to update status completed. Error [%s].", transactionID, err)
+			}
+		}
+
+		for _, i := range items {
+			if !hasAccount(splitTransfer, i.receiver) {
+				continue
+			}
+
+			err = s.transferRepository.UpdateStatusCompleted(i.transferID)
+			if err != nil {
+				s.logger.Errorf("[%s] - Failed to update status completed. Error [%s].", i.transferID, err)
+				continue
+			}
+			if i.onSuccess != nil {
+				i.onSuccess()
+			}
+		}
+	}
+
+	onFail = func(transactionID string) {
+		s.logger.Debugf("[%s] - Scheduled TX [%s] execution has failed.", id, transactionID)
+
+		err := s.scheduleRepository.UpdateStatusFailed(transactionID)
+		if err != nil {
+			s.logger.Errorf("[%s] - Failed to update status failed. Error [%s].", transactionID, err)
+		}
+
+		if feeAmount != 0 {
+			err = s.feeRepository.UpdateStatusFailed(transactionID)
+			if err != nil {
+				s.logger.Errorf("[%s] Fee - Failed to update status failed. Error [%s].", transactionID, err)
+			}
+		}
+
+		s.updateTransfersFailed(items, splitTransfer)
+	}
+
+	return onSuccess, onFail
+}
+
+// updateTransfersFailed fails the transfers, whose receivers are credited by the failed scheduled transaction.
+// Transfers, included only with their fees, are left to the scheduled transaction crediting their receivers
+func (s *Service) updateTransfersFailed(items []*item, splitTransfer []transfer.Hedera) {
+	for _, i := range items {
+		if !hasAccount(splitTransfer, i.receiver) {
+			continue
+		}
+
+		err := s.transferRepository.UpdateStatusFailed(i.transferID)
+		if err != nil {
+			s.logger.Errorf("[%s] - Failed to update status failed. Error [%s].", i.transferID, err)
+			continue
+		}
+		if i.onFail != nil {
+			i.onFail()
+		}
+	}
+}
+
+// includedItems returns the items, crediting at least one of the accounts in the given transfers
+func includedItems(items []*item, splitTransfer []transfer.Hedera) []*item {
+	var included []*item
+	for _, i := range items {
+		for _, t := range i.transfers {
+			if hasAccount(splitTransfer, t.AccountID) {
+				included = append(included, i)
+				break
+			}
+		}
+	}
+	return included
+}
+
+// feeFromTransfers returns the total amount credited to accounts, which are not receivers of any of the items
+func feeFromTransfers(items []*item, splitTransfer []transfer.Hedera) int64 {
+	fee := int64(0)
+	for _, t := range splitTransfer {
+		if t.Amount <= 0 {
+			continue
+		}
+		isReceiver := false
+		for _, i := range items {
+			if i.receiver == t.AccountID {
+				isReceiver = true
+				break
+			}
+		}
+		if !isReceiver {
+			fee += t.Amount
+		}
+	}
+	return fee
+}
+
+func hasAccount(transfers []transfer.Hedera, account hedera.AccountID) bool {
+	for _, t := range transfers {
+		if t.AccountID == account {
+			return true
+		}
+	}
+	return false
+}
+
+func hederaTransfers(batchTransfers []entity.BatchTransfer) ([]transfer.Hedera, error) {
+	transfers := make([]transfer.Hedera, 0, len(batchTransfers))
+	for _, t := range batchTransfers {
+		account, err := hedera.AccountIDFromString(t.AccountID)
+		if err != nil {
+			return nil, err
+		}
+		transfers = append(transfers, transfer.Hedera{
+			AccountID: account,
+			Amount:    t.Amount,
+		})
+	}
+	return transfers, nil
+}
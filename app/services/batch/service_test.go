@@ -0,0 +1,385 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package batch
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/schedule"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
+	"github.com/limechain/hedera-eth-bridge-validator/app/services/fee/distributor"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	s             = &Service{}
+	bridgeAccount = hedera.AccountID{Account: 222222}
+	memberAccount = hedera.AccountID{Account: 333333}
+	receiverOne   = hedera.AccountID{Account: 1337}
+	receiverTwo   = hedera.AccountID{Account: 1338}
+	token         = "0.0.22222"
+	timestamp     = time.Unix(1650000015, 0).UTC()
+	batchId       = "batch-0.0.22222-1650000000"
+	txId          = "0.0.123123-123123-321321"
+	scheduleId    = "0.0.666666"
+	evmWatcher    = "80001-0xrouter"
+	windowStart   = time.Unix(1650000000, 0)
+	windowEnd     = time.Unix(1650000030, 0)
+	due           = &entity.BatchItem{BatchID: batchId, Token: token, ExecuteAt: time.Unix(1650000090, 0).UnixNano()}
+	now           time.Time
+)
+
+func Test_New(t *testing.T) {
+	setup()
+
+	actualService := NewService(bridgeAccount.String(), config.Batching{Enabled: true, Window: 30, Delay: 60}, []string{evmWatcher},
+		mocks.MBatchRepository, mocks.MBlockRepository, mocks.MTransferRepository, mocks.MScheduleRepository, mocks.MFeeRepository, mocks.MScheduledService)
+
+	assert.True(t, actualService.Enabled())
+	assert.Equal(t, 30*time.Second, actualService.window)
+	assert.Equal(t, 60*time.Second, actualService.delay)
+	assert.Equal(t, bridgeAccount, actualService.bridgeAccount)
+	assert.Equal(t, []string{evmWatcher}, actualService.evmWatchers)
+}
+
+func Test_ID(t *testing.T) {
+	setup()
+
+	assert.Equal(t, batchId, s.ID(token, timestamp))
+	assert.Equal(t, batchId, s.ID(token, timestamp.Add(14*time.Second)))
+	assert.NotEqual(t, batchId, s.ID(token, timestamp.Add(15*time.Second)))
+	assert.NotEqual(t, batchId, s.ID("0.0.33333", timestamp))
+}
+
+func Test_Add(t *testing.T) {
+	setup()
+	now = timestamp
+	onSuccessCalled := false
+	mocks.MBatchRepository.On("Save", &entity.BatchItem{
+		TransferID: "0xa",
+		BatchID:    batchId,
+		Token:      token,
+		Receiver:   receiverOne.String(),
+		Transfers:  []entity.BatchTransfer{{AccountID: memberAccount.String(), Amount: 1}, {AccountID: receiverOne.String(), Amount: 10}},
+		ExecuteAt:  time.Unix(1650000090, 0).UnixNano(),
+	}).Return(nil)
+
+	err := s.Add("0xa", token, timestamp, receiverOne, []transfer.Hedera{{AccountID: memberAccount, Amount: 1}, {AccountID: receiverOne, Amount: 10}}, func() { onSuccessCalled = true }, nil)
+
+	assert.Nil(t, err)
+	assert.Len(t, s.callbacks, 1)
+	s.callbacks["0xa"].onSuccess()
+	assert.True(t, onSuccessCalled)
+}
+
+func Test_AddAfterWindow(t *testing.T) {
+	setup()
+	now = timestamp.Add(time.Hour)
+	mocks.MBatchRepository.On("Save", mock.MatchedBy(func(i *entity.BatchItem) bool {
+		return i.BatchID == batchId && i.ExecuteAt == due.ExecuteAt
+	})).Return(nil)
+
+	err := s.Add("0xa", token, timestamp, receiverOne, []transfer.Hedera{{AccountID: receiverOne, Amount: 10}}, nil, nil)
+
+	assert.Nil(t, err)
+	mocks.MBatchRepository.AssertNumberOfCalls(t, "Save", 1)
+}
+
+func Test_Add_SaveFails(t *testing.T) {
+	setup()
+	now = timestamp
+	mocks.MBatchRepository.On("Save", mock.Anything).Return(errors.New("some-error"))
+
+	err := s.Add("0xa", token, timestamp, receiverOne, []transfer.Hedera{{AccountID: receiverOne, Amount: 10}}, nil, nil)
+
+	assert.Error(t, err)
+	assert.Len(t, s.callbacks, 0)
+}
+
+func Test_ExecuteDue(t *testing.T) {
+	setup()
+	now = timestamp
+	mocks.MBlockRepository.On("GetLatest", evmWatcher, 1).Return([]*entity.Block{{Timestamp: timestamp.Unix()}}, nil)
+	mocks.MBatchRepository.On("GetDue", now).Return([]*entity.BatchItem{due}, nil)
+	mocks.MBatchRepository.On("CountUnbatched", token, windowStart, windowEnd).Return(int64(0), nil)
+	mocks.MBatchRepository.On("TakePending", batchId).Return([]*entity.BatchItem{}, nil)
+
+	s.ExecuteDue()
+
+	mocks.MBatchRepository.AssertCalled(t, "TakePending", batchId)
+}
+
+func Test_ExecuteDue_WatcherBehind(t *testing.T) {
+	setup()
+	now = timestamp.Add(time.Hour)
+	mocks.MBlockRepository.On("GetLatest", evmWatcher, 1).Return([]*entity.Block{{Timestamp: timestamp.Unix()}}, nil)
+	mocks.MBatchRepository.On("GetDue", time.Unix(timestamp.Unix()+60, 0)).Return([]*entity.BatchItem{}, nil)
+
+	s.ExecuteDue()
+
+	mocks.MBatchRepository.AssertCalled(t, "GetDue", time.Unix(timestamp.Unix()+60, 0))
+}
+
+func Test_ExecuteDue_NoProcessedBlocks(t *testing.T) {
+	setup()
+	now = timestamp
+	mocks.MBlockRepository.On("GetLatest", evmWatcher, 1).Return([]*entity.Block{}, nil)
+
+	s.ExecuteDue()
+
+	mocks.MBatchRepository.AssertNotCalled(t, "GetDue", mock.Anything)
+}
+
+func Test_ExecuteDue_GetDueFails(t *testing.T) {
+	setup()
+	now = timestamp
+	mocks.MBlockRepository.On("GetLatest", evmWatcher, 1).Return([]*entity.Block{{Timestamp: timestamp.Unix()}}, nil)
+	mocks.MBatchRepository.On("GetDue", now).Return(nil, errors.New("some-error"))
+
+	s.ExecuteDue()
+
+	mocks.MBatchRepository.AssertNotCalled(t, "TakePending", mock.Anything)
+}
+
+func Test_Execute(t *testing.T) {
+	setup()
+	mocks.MBatchRepository.On("CountUnbatched", token, windowStart, windowEnd).Return(int64(0), nil)
+	mocks.MBatchRepository.On("TakePending", batchId).Return([]*entity.BatchItem{
+		batchItem("0xb", receiverOne, entity.BatchTransfer{AccountID: memberAccount.String(), Amount: 1}, entity.BatchTransfer{AccountID: receiverOne.String(), Amount: 10}),
+		batchItem("0xa", receiverTwo, entity.BatchTransfer{AccountID: memberAccount.String(), Amount: 2}, entity.BatchTransfer{AccountID: receiverTwo.String(), Amount: 20}),
+	}, nil)
+
+	expectedTransfers := []transfer.Hedera{
+		{AccountID: memberAccount, Amount: 3},
+		{AccountID: receiverTwo, Amount: 20},
+		{AccountID: receiverOne, Amount: 10},
+		{AccountID: bridgeAccount, Amount: -33},
+	}
+	mocks.MScheduledService.On("ExecuteScheduledTransferTransaction", mock.Anything, batchId, token, expectedTransfers).Return()
+
+	s.execute(due)
+
+	mocks.MScheduledService.AssertNumberOfCalls(t, "ExecuteScheduledTransferTransaction", 1)
+}
+
+func Test_ExecuteAttachesCallbacks(t *testing.T) {
+	setup()
+	mocks.MBatchRepository.On("CountUnbatched", token, windowStart, windowEnd).Return(int64(0), nil)
+	s.callbacks["0xa"] = callbacks{onSuccess: func() {}}
+	mocks.MBatchRepository.On("TakePending", batchId).Return([]*entity.BatchItem{
+		batchItem("0xa", receiverOne, entity.BatchTransfer{AccountID: receiverOne.String(), Amount: 10}),
+	}, nil)
+	mocks.MScheduledService.On("ExecuteScheduledTransferTransaction", mock.Anything, batchId, token, mock.Anything).Return()
+
+	s.execute(due)
+
+	assert.Len(t, s.callbacks, 0)
+	mocks.MScheduledService.AssertNumberOfCalls(t, "ExecuteScheduledTransferTransaction", 1)
+}
+
+func Test_ExecuteSplitsTransfers(t *testing.T) {
+	setup()
+	mocks.MBatchRepository.On("CountUnbatched", token, windowStart, windowEnd).Return(int64(0), nil)
+
+	var pending []*entity.BatchItem
+	for i := 0; i <= distributor.TotalPositiveTransfersPerTransaction; i++ {
+		receiver := hedera.AccountID{Account: uint64(1000 + i)}
+		pending = append(pending, batchItem(receiver.String(), receiver, entity.BatchTransfer{AccountID: receiver.String(), Amount: 10}))
+	}
+	mocks.MBatchRepository.On("TakePending", batchId).Return(pending, nil)
+	mocks.MScheduledService.On("ExecuteScheduledTransferTransaction", mock.Anything, batchId, token, mock.Anything).Return()
+
+	s.execute(due)
+
+	mocks.MScheduledService.AssertNumberOfCalls(t, "ExecuteScheduledTransferTransaction", 2)
+}
+
+func Test_ExecuteNoPendingTransfers(t *testing.T) {
+	setup()
+	mocks.MBatchRepository.On("CountUnbatched", token, windowStart, windowEnd).Return(int64(0), nil)
+	mocks.MBatchRepository.On("TakePending", batchId).Return([]*entity.BatchItem{}, nil)
+
+	s.execute(due)
+
+	mocks.MScheduledService.AssertNotCalled(t, "ExecuteScheduledTransferTransaction")
+}
+
+func Test_Execute_TakePendingFails(t *testing.T) {
+	setup()
+	mocks.MBatchRepository.On("CountUnbatched", token, windowStart, windowEnd).Return(int64(0), nil)
+	mocks.MBatchRepository.On("TakePending", batchId).Return(nil, errors.New("some-error"))
+
+	s.execute(due)
+
+	mocks.MScheduledService.AssertNotCalled(t, "ExecuteScheduledTransferTransaction")
+}
+
+func Test_Execute_UnbatchedTransfers(t *testing.T) {
+	setup()
+	mocks.MBatchRepository.On("CountUnbatched", token, windowStart, windowEnd).Return(int64(1), nil)
+
+	s.execute(due)
+
+	mocks.MBatchRepository.AssertNotCalled(t, "TakePending", mock.Anything)
+}
+
+func Test_Batched(t *testing.T) {
+	setup()
+	mocks.MBatchRepository.On("Get", "0xa").Return(batchItem("0xa", receiverOne), nil)
+	mocks.MBatchRepository.On("Get", "0xb").Return((*entity.BatchItem)(nil), nil)
+
+	batched, err := s.Batched("0xa")
+	assert.Nil(t, err)
+	assert.True(t, batched)
+
+	batched, err = s.Batched("0xb")
+	assert.Nil(t, err)
+	assert.False(t, batched)
+}
+
+func Test_Requeue(t *testing.T) {
+	setup()
+	mocks.MBatchRepository.On("Requeue", "0xa").Return(nil)
+
+	err := s.Requeue("0xa")
+
+	assert.Nil(t, err)
+	mocks.MBatchRepository.AssertCalled(t, "Requeue", "0xa")
+}
+
+func Test_ScheduledExecutionSuccessCallback(t *testing.T) {
+	setup()
+
+	items := []*item{
+		{transferID: "0xa", receiver: receiverOne, transfers: []transfer.Hedera{{AccountID: memberAccount, Amount: 1}, {AccountID: receiverOne, Amount: 10}}},
+		{transferID: "0xb", receiver: receiverTwo, transfers: []transfer.Hedera{{AccountID: memberAccount, Amount: 1}, {AccountID: receiverTwo, Amount: 10}}},
+	}
+	splitTransfer := []transfer.Hedera{{AccountID: memberAccount, Amount: 2}, {AccountID: receiverOne, Amount: 10}, {AccountID: bridgeAccount, Amount: -12}}
+
+	mocks.MScheduleRepository.On("Create", expectedSchedule("0xa", true)).Return(nil)
+	mocks.MScheduleRepository.On("Create", expectedSchedule("0xb", false)).Return(nil)
+	mocks.MFeeRepository.On("Create", &entity.Fee{
+		TransactionID: txId,
+		ScheduleID:    scheduleId,
+		Amount:        "2",
+		Status:        status.Submitted,
+	}).Return(nil)
+
+	included := includedItems(items, splitTransfer)
+	onExecutionSuccess, _ := s.scheduledTxExecutionCallbacks(batchId, included, splitTransfer, feeFromTransfers(items, splitTransfer))
+	onExecutionSuccess(txId, scheduleId)
+
+	mocks.MScheduleRepository.AssertNumberOfCalls(t, "Create", 2)
+	mocks.MFeeRepository.AssertNumberOfCalls(t, "Create", 1)
+}
+
+func Test_ScheduledTxMinedSuccessCallback(t *testing.T) {
+	setup()
+
+	calls := 0
+	items := []*item{
+		{transferID: "0xa", receiver: receiverOne, onSuccess: func() { calls++ }},
+	}
+	splitTransfer := []transfer.Hedera{{AccountID: receiverOne, Amount: 10}, {AccountID: bridgeAccount, Amount: -10}}
+
+	mocks.MScheduleRepository.On("UpdateStatusCompleted", txId).Return(nil)
+	mocks.MTransferRepository.On("UpdateStatusCompleted", "0xa").Return(nil)
+
+	onSuccess, _ := s.scheduledTxMinedCallbacks(batchId, items, splitTransfer, 0)
+	onSuccess(txId)
+
+	assert.Equal(t, 1, calls)
+	mocks.MFeeRepository.AssertNotCalled(t, "UpdateStatusCompleted", txId)
+}
+
+func Test_ScheduledTxMinedFailCallback(t *testing.T) {
+	setup()
+
+	calls := 0
+	items := []*item{
+		{transferID: "0xa", receiver: receiverOne, onFail: func() { calls++ }},
+		{transferID: "0xb", receiver: receiverTwo, onFail: func() { calls++ }},
+	}
+	splitTransfer := []transfer.Hedera{{AccountID: memberAccount, Amount: 2}, {AccountID: receiverOne, Amount: 10}, {AccountID: bridgeAccount, Amount: -12}}
+
+	mocks.MScheduleRepository.On("UpdateStatusFailed", txId).Return(nil)
+	mocks.MFeeRepository.On("UpdateStatusFailed", txId).Return(nil)
+	mocks.MTransferRepository.On("UpdateStatusFailed", "0xa").Return(nil)
+
+	_, onFail := s.scheduledTxMinedCallbacks(batchId, items, splitTransfer, 2)
+	onFail(txId)
+
+	assert.Equal(t, 1, calls)
+	mocks.MTransferRepository.AssertNumberOfCalls(t, "UpdateStatusFailed", 1)
+	mocks.MTransferRepository.AssertNotCalled(t, "UpdateStatusFailed", "0xb")
+}
+
+func batchItem(transferID string, receiver hedera.AccountID, transfers ...entity.BatchTransfer) *entity.BatchItem {
+	return &entity.BatchItem{
+		TransferID: transferID,
+		BatchID:    batchId,
+		Token:      token,
+		Receiver:   receiver.String(),
+		Transfers:  transfers,
+	}
+}
+
+func expectedSchedule(transferID string, hasReceiver bool) *entity.Schedule {
+	return &entity.Schedule{
+		TransactionID: txId,
+		ScheduleID:    scheduleId,
+		Operation:     schedule.TRANSFER,
+		HasReceiver:   hasReceiver,
+		Status:        status.Submitted,
+		TransferID: sql.NullString{
+			String: transferID,
+			Valid:  true,
+		},
+	}
+}
+
+func setup() {
+	mocks.Setup()
+
+	s = &Service{
+		enabled:            true,
+		window:             30 * time.Second,
+		delay:              60 * time.Second,
+		bridgeAccount:      bridgeAccount,
+		evmWatchers:        []string{evmWatcher},
+		repository:         mocks.MBatchRepository,
+		blockRepository:    mocks.MBlockRepository,
+		transferRepository: mocks.MTransferRepository,
+		scheduleRepository: mocks.MScheduleRepository,
+		feeRepository:      mocks.MFeeRepository,
+		scheduledService:   mocks.MScheduledService,
+		callbacks:          make(map[string]callbacks),
+		now: func() time.Time {
+			return now
+		},
+		logger: config.GetLoggerFor("Batch Service"),
+	}
+}
@@ -17,7 +17,10 @@
 package burn_event
 
 import (
+	"context"
 	"database/sql"
+	"errors"
+	"math/big"
 	"strconv"
 
 	"github.com/hashgraph/hedera-sdk-go/v2"
@@ -28,6 +31,7 @@ import (
 	"github.com/limechain/hedera-eth-bridge-validator/app/helper/metrics"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/failure"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/schedule"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
@@ -46,6 +50,8 @@ type Service struct {
 	feeService         service.Fee
 	scheduledService   service.Scheduled
 	transferService    service.Transfers
+	batchService       service.Batch
+	refundService      service.Refund
 	logger             *log.Entry
 	prometheusService  service.Prometheus
 }
@@ -59,6 +65,8 @@ func NewService(
 	scheduled service.Scheduled,
 	feeService service.Fee,
 	transferService service.Transfers,
+	batchService service.Batch,
+	refundService service.Refund,
 	prometheusService service.Prometheus) *Service {
 
 	bridgeAcc, err := hedera.AccountIDFromString(bridgeAccount)
@@ -75,49 +83,72 @@ func NewService(
 		feeService:         feeService,
 		scheduledService:   scheduled,
 		transferService:    transferService,
+		batchService:       batchService,
+		refundService:      refundService,
 		prometheusService:  prometheusService,
 		logger:             config.GetLoggerFor("Burn Event Service"),
 	}
 }
 
-func (s Service) ProcessEvent(event payload.Transfer) {
-	s.initSuccessRatePrometheusMetrics(event.TransactionId, event.SourceChainId, event.TargetChainId, event.TargetAsset)
-
-	amount, err := strconv.ParseInt(event.Amount, 10, 64)
+func (s Service) ProcessEvent(ctx context.Context, event payload.Transfer) error {
+	amount, err := hederaHelper.Amount(event.Amount)
 	if err != nil {
-		s.logger.Errorf("[%s] - Failed to parse event amount [%s]. Error [%s].", event.TransactionId, event.Amount, err)
-		return
+		s.logger.Errorf("[%s] - Invalid event amount. Error [%s].", event.TransactionId, err)
+		var rejection *failure.Error
+		if errors.As(err, &rejection) {
+			// The amount does not fit on redelivery either, so the transfer is stored as rejected
+			return s.transferService.RejectTransfer(event, rejection.Code, rejection.Reason)
+		}
+		return err
 	}
 
 	receiver, err := hedera.AccountIDFromString(event.Receiver)
 	if err != nil {
 		s.logger.Errorf("[%s] - Failed to parse event account [%s]. Error [%s].", event.TransactionId, event.Receiver, err)
-		return
+		return err
 	}
 
-	transactionRecord, err := s.transferService.InitiateNewTransfer(event)
+	transactionRecord, err := s.transferService.InitiateNewTransfer(ctx, event)
 	if err != nil {
 		s.logger.Errorf("[%s] - Error occurred while initiating processing. Error: [%s]", event.TransactionId, err)
-		return
+		return err
 	}
 
 	if transactionRecord.Status != status.Initial {
 		s.logger.Debugf("[%s] - Previously added with status [%s]. Skipping further execution.", transactionRecord.TransactionID, transactionRecord.Status)
-		return
+		return nil
 	}
 
-	fee, splitTransfers, err := s.prepareTransfers(event.NativeAsset, amount, receiver)
+	fee, transfers, err := s.prepareTransfers(event.NativeAsset, event.Amount, receiver)
 	if err != nil {
 		s.logger.Errorf("[%s] - Failed to prepare transfers. Error [%s].", event.TransactionId, err)
-		return
+		return err
 	}
 
 	err = s.repository.UpdateFee(event.TransactionId, strconv.FormatInt(fee, 10))
 	if err != nil {
 		s.logger.Errorf("[%s] - Failed to update fee [%d]. Error [%s].", event.TransactionId, fee, err)
-		return
+		return err
 	}
 
+	if s.batchService.Enabled() {
+		err = s.batchService.Add(event.TransactionId, event.NativeAsset, event.Timestamp, receiver, transfers,
+			func() {
+				s.onMinedFeeTransactionsSetMetrics(event.SourceChainId, event.TargetChainId, event.NativeAsset, event.TransactionId, true)
+				s.onMinedUserTransactionSetMetrics(event.SourceChainId, event.TargetChainId, event.NativeAsset, event.TransactionId, true)
+			}, nil)
+		if err != nil {
+			s.logger.Errorf("[%s] - Failed to add to batch. Error [%s].", event.TransactionId, err)
+		}
+		return err
+	}
+
+	splitTransfers := distributor.SplitAccountAmounts(transfers,
+		transfer.Hedera{
+			AccountID: s.bridgeAccount,
+			Amount:    -amount,
+		})
+
 	var (
 		feeOutParams  *hederaHelper.FeeOutParams
 		userOutParams *hederaHelper.UserOutParams
@@ -134,16 +165,18 @@ func (s Service) ProcessEvent(event payload.Transfer) {
 
 		onSuccess, onFail := s.scheduledTxMinedCallbacks(
 			event.TransactionId,
+			event.NativeAsset,
 			hasReceiver,
 			splitTransfer,
 			feeOutParams,
 			userOutParams,
 		)
 
-		s.scheduledService.ExecuteScheduledTransferTransaction(event.TransactionId, event.NativeAsset, splitTransfer, onExecutionSuccess, onExecutionFail, onSuccess, onFail)
+		s.scheduledService.ExecuteScheduledTransferTransaction(ctx, event.TransactionId, event.NativeAsset, splitTransfer, onExecutionSuccess, onExecutionFail, onSuccess, onFail)
 	}
 
 	s.startAwaitingFunctionsForMetrics(event, feeOutParams, userOutParams)
+	return nil
 }
 
 func (s Service) startAwaitingFunctionsForMetrics(event payload.Transfer, feeOutParams *hederaHelper.FeeOutParams, userOutParams *hederaHelper.UserOutParams) {
@@ -172,26 +205,13 @@ func (s Service) startAwaitingFunctionsForMetrics(event payload.Transfer, feeOut
 	)
 }
 
-func (s Service) initSuccessRatePrometheusMetrics(transactionId string, sourceChainId, targetChainId uint64, asset string) {
-	if !s.prometheusService.GetIsMonitoringEnabled() {
-		return
-	}
-
-	if sourceChainId != constants.HederaNetworkId {
-		if targetChainId == constants.HederaNetworkId {
-			metrics.CreateFeeTransferredIfNotExists(sourceChainId, targetChainId, asset, transactionId, s.prometheusService, s.logger)
-		}
-		metrics.CreateUserGetHisTokensIfNotExists(sourceChainId, targetChainId, asset, transactionId, s.prometheusService, s.logger)
-	}
-}
-
 func (s *Service) onMinedFeeTransactionsSetMetrics(sourceChainId, targetChainId uint64, nativeAsset string, transactionId string, isTransferSuccessful bool) {
 
 	if !s.prometheusService.GetIsMonitoringEnabled() || targetChainId != constants.HederaNetworkId || !isTransferSuccessful {
 		return
 	}
 
-	metrics.SetFeeTransferred(sourceChainId, targetChainId, nativeAsset, transactionId, s.prometheusService, s.logger)
+	metrics.ReachStoredTransferStage(constants.FeeTransferredStage, transactionId, s.repository, s.prometheusService, s.logger)
 }
 
 func (s *Service) onMinedUserTransactionSetMetrics(sourceChainId, targetChainId uint64, nativeAsset string, transactionId string, isTransferSuccessful bool) {
@@ -200,18 +220,18 @@ func (s *Service) onMinedUserTransactionSetMetrics(sourceChainId, targetChainId
 		return
 	}
 
-	metrics.SetUserGetHisTokens(sourceChainId, targetChainId, nativeAsset, transactionId, s.prometheusService, s.logger)
+	metrics.ReachStoredTransferStage(constants.UserGetHisTokensStage, transactionId, s.repository, s.prometheusService, s.logger)
 }
 
-func (s *Service) prepareTransfers(token string, amount int64, receiver hedera.AccountID) (fee int64, splitTransfers [][]transfer.Hedera, err error) {
-	fee, remainder := s.feeService.CalculateFee(token, amount)
+// prepareTransfers returns the valid fee and the positive transfers to the members and the receiver
+// The amount must be in the range of Hedera amounts, so are the fee and the remainder, being parts of it
+func (s *Service) prepareTransfers(token string, amount *big.Int, receiver hedera.AccountID) (fee int64, transfers []transfer.Hedera, err error) {
+	calculatedFee, remainder := s.feeService.CalculateFee(token, amount)
 
-	validFee := s.distributorService.ValidAmount(fee)
-	if validFee != fee {
-		remainder += fee - validFee
-	}
+	validFee := s.distributorService.ValidAmount(calculatedFee)
+	remainder = new(big.Int).Add(remainder, new(big.Int).Sub(calculatedFee, validFee))
 
-	transfers, err := s.distributorService.CalculateMemberDistribution(validFee)
+	transfers, err = s.distributorService.CalculateMemberDistribution(validFee.Int64())
 	if err != nil {
 		return 0, nil, err
 	}
@@ -219,16 +239,10 @@ func (s *Service) prepareTransfers(token string, amount int64, receiver hedera.A
 	transfers = append(transfers,
 		transfer.Hedera{
 			AccountID: receiver,
-			Amount:    remainder,
+			Amount:    remainder.Int64(),
 		})
 
-	splitTransfers = distributor.SplitAccountAmounts(transfers,
-		transfer.Hedera{
-			AccountID: s.bridgeAccount,
-			Amount:    -amount,
-		})
-
-	return validFee, splitTransfers, nil
+	return validFee.Int64(), transfers, nil
 }
 
 // TransactionID returns the corresponding Scheduled Transaction paying out the
@@ -326,7 +340,7 @@ func (s *Service) scheduledTxExecutionCallbacks(id string, feeAmount string, has
 	return onExecutionSuccess, onExecutionFail
 }
 
-func (s *Service) scheduledTxMinedCallbacks(id string, hasReceiver bool, splitTransfer []transfer.Hedera, feeOutParams *hederaHelper.FeeOutParams, userOutParams *hederaHelper.UserOutParams) (onSuccess, onFail func(transactionID string)) {
+func (s *Service) scheduledTxMinedCallbacks(id, asset string, hasReceiver bool, splitTransfer []transfer.Hedera, feeOutParams *hederaHelper.FeeOutParams, userOutParams *hederaHelper.UserOutParams) (onSuccess, onFail func(transactionID string)) {
 
 	onSuccess = func(transactionID string) {
 
@@ -363,19 +377,24 @@ func (s *Service) scheduledTxMinedCallbacks(id string, hasReceiver bool, splitTr
 			userOutParams.HandleResultForAwaitedTransfer(&result, hasReceiver)
 		}
 
-		err := s.scheduleRepository.UpdateStatusFailed(transactionID)
-		if err != nil {
-			s.logger.Errorf("[%s] - Failed to update status signature failed. Error [%s].", id, err)
-			return
-		}
-
-		err = s.repository.UpdateStatusFailed(id)
-		if err != nil {
-			s.logger.Errorf("[%s] - Failed to update status failed. Error [%s].", transactionID, err)
-			return
+		if hasReceiver {
+			// The receiver may not be able to accept the asset, in which case the amount left in the bridge account is refunded
+			s.refundService.OnDeliveryFailed(id, transactionID, asset, big.NewInt(creditedAmount(splitTransfer)))
+		} else {
+			err := s.scheduleRepository.UpdateStatusFailed(transactionID)
+			if err != nil {
+				s.logger.Errorf("[%s] - Failed to update status signature failed. Error [%s].", id, err)
+				return
+			}
+
+			err = s.repository.UpdateStatusFailed(id)
+			if err != nil {
+				s.logger.Errorf("[%s] - Failed to update status failed. Error [%s].", transactionID, err)
+				return
+			}
 		}
 
-		err = s.feeRepository.UpdateStatusFailed(transactionID)
+		err := s.feeRepository.UpdateStatusFailed(transactionID)
 		if err != nil {
 			s.logger.Errorf("[%s] Fee - Failed to update status failed. Error [%s].", transactionID, err)
 			return
@@ -384,3 +403,14 @@ func (s *Service) scheduledTxMinedCallbacks(id string, hasReceiver bool, splitTr
 
 	return onSuccess, onFail
 }
+
+// creditedAmount returns the total amount, credited to accounts by the given transfers
+func creditedAmount(transfers []transfer.Hedera) int64 {
+	amount := int64(0)
+	for _, t := range transfers {
+		if t.Amount > 0 {
+			amount += t.Amount
+		}
+	}
+	return amount
+}
@@ -17,21 +17,24 @@
 package burn_event
 
 import (
+	"context"
 	"database/sql"
 	"errors"
-	"strconv"
+	"math/big"
 	"testing"
 
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	hederaHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/hedera"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/failure"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/schedule"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 var (
@@ -49,7 +52,7 @@ var (
 		TargetAsset:   "0.0.22222",
 		NativeAsset:   "0.0.22222",
 		Receiver:      "0.0.1337",
-		Amount:        "100",
+		Amount:        big.NewInt(100),
 	}
 	s                    = &Service{}
 	mockBurnEventId      = "some-burnevent-id"
@@ -58,7 +61,7 @@ var (
 	scheduleId           = "0.0.666666"
 	feeAmount            = "10000"
 	burnEventReceiver, _ = hedera.AccountIDFromString(tr.Receiver)
-	burnEventAmount, _   = strconv.ParseInt(tr.Amount, 10, 64)
+	burnEventAmount      = tr.Amount.Int64()
 	entityTransfer       = &entity.Transfer{
 		TransactionID: tr.TransactionId,
 		SourceChainID: tr.SourceChainId,
@@ -68,7 +71,7 @@ var (
 		TargetAsset:   tr.TargetAsset,
 		NativeAsset:   tr.NativeAsset,
 		Receiver:      tr.Receiver,
-		Amount:        tr.Amount,
+		Amount:        tr.Amount.String(),
 		Status:        status.Initial,
 		Messages:      nil,
 		Fees:          []entity.Fee{},
@@ -84,13 +87,13 @@ var (
 func Test_ProcessEvent(t *testing.T) {
 	setup()
 
-	mockFee := int64(12)
-	mockRemainder := int64(1)
-	mockValidFee := int64(11)
+	mockFee := big.NewInt(12)
+	mockRemainder := big.NewInt(1)
+	mockValidFee := big.NewInt(11)
 	mockTransfersAfterPreparation := []transfer.Hedera{
 		{
 			AccountID: burnEventReceiver,
-			Amount:    mockRemainder + (mockFee - mockValidFee),
+			Amount:    mockRemainder.Int64() + (mockFee.Int64() - mockValidFee.Int64()),
 		},
 		{
 			AccountID: s.bridgeAccount,
@@ -98,26 +101,68 @@ func Test_ProcessEvent(t *testing.T) {
 		},
 	}
 
-	mocks.MTransferService.On("InitiateNewTransfer", tr).Return(entityTransfer, nil)
-	mocks.MFeeService.On("CalculateFee", tr.NativeAsset, burnEventAmount).Return(mockFee, mockRemainder)
+	mocks.MTransferService.On("InitiateNewTransfer", mock.Anything, tr).Return(entityTransfer, nil)
+	mocks.MFeeService.On("CalculateFee", tr.NativeAsset, tr.Amount).Return(mockFee, mockRemainder)
 	mocks.MDistributorService.On("ValidAmount", mockFee).Return(mockValidFee)
-	mocks.MDistributorService.On("CalculateMemberDistribution", mockValidFee).Return([]transfer.Hedera{}, nil)
-	mocks.MTransferRepository.On("UpdateFee", tr.TransactionId, strconv.FormatInt(mockValidFee, 10)).Return(nil)
-	mocks.MScheduledService.On("ExecuteScheduledTransferTransaction", tr.TransactionId, tr.NativeAsset, mockTransfersAfterPreparation).Return()
+	mocks.MDistributorService.On("CalculateMemberDistribution", mockValidFee.Int64()).Return([]transfer.Hedera{}, nil)
+	mocks.MTransferRepository.On("UpdateFee", tr.TransactionId, mockValidFee.String()).Return(nil)
+	mocks.MScheduledService.On("ExecuteScheduledTransferTransaction", mock.Anything, tr.TransactionId, tr.NativeAsset, mockTransfersAfterPreparation).Return()
 
-	s.ProcessEvent(tr)
+	s.ProcessEvent(context.Background(), tr)
+}
+
+func Test_ProcessEventBatched(t *testing.T) {
+	setup()
+	mocks.MBatchService.ExpectedCalls = nil
+	mocks.MBatchService.On("Enabled").Return(true)
+
+	mockFee := big.NewInt(12)
+	mockRemainder := big.NewInt(1)
+	mockValidFee := big.NewInt(11)
+	mockTransfersAfterPreparation := []transfer.Hedera{
+		{
+			AccountID: burnEventReceiver,
+			Amount:    mockRemainder.Int64() + (mockFee.Int64() - mockValidFee.Int64()),
+		},
+	}
+
+	mocks.MTransferService.On("InitiateNewTransfer", mock.Anything, tr).Return(entityTransfer, nil)
+	mocks.MFeeService.On("CalculateFee", tr.NativeAsset, tr.Amount).Return(mockFee, mockRemainder)
+	mocks.MDistributorService.On("ValidAmount", mockFee).Return(mockValidFee)
+	mocks.MDistributorService.On("CalculateMemberDistribution", mockValidFee.Int64()).Return([]transfer.Hedera{}, nil)
+	mocks.MTransferRepository.On("UpdateFee", tr.TransactionId, mockValidFee.String()).Return(nil)
+	mocks.MBatchService.On("Add", tr.TransactionId, tr.NativeAsset, tr.Timestamp, burnEventReceiver, mockTransfersAfterPreparation).Return(nil)
+
+	s.ProcessEvent(context.Background(), tr)
+
+	mocks.MScheduledService.AssertNotCalled(t, "ExecuteScheduledTransferTransaction", mock.Anything, tr.TransactionId, tr.NativeAsset, mockTransfersAfterPreparation)
+	mocks.MBatchService.AssertCalled(t, "Add", tr.TransactionId, tr.NativeAsset, tr.Timestamp, burnEventReceiver, mockTransfersAfterPreparation)
+}
+
+func Test_ProcessEventAmountExceedsLimit(t *testing.T) {
+	setup()
+	event := tr
+	event.Amount = new(big.Int).Lsh(big.NewInt(1), 64)
+	mocks.MTransferService.On("RejectTransfer", event, failure.AmountExceedsLimit, mock.Anything).Return(nil)
+
+	err := s.ProcessEvent(context.Background(), event)
+
+	assert.Nil(t, err)
+	mocks.MTransferService.AssertCalled(t, "RejectTransfer", event, failure.AmountExceedsLimit, mock.Anything)
+	mocks.MTransferService.AssertNotCalled(t, "InitiateNewTransfer", mock.Anything, event)
+	mocks.MScheduledService.AssertNotCalled(t, "ExecuteScheduledTransferTransaction")
 }
 
 func Test_ProcessEventCreateFail(t *testing.T) {
 	setup()
 
-	mockFee := int64(11)
-	mockRemainder := int64(1)
-	mockValidFee := int64(11)
+	mockFee := big.NewInt(11)
+	mockRemainder := big.NewInt(1)
+	mockValidFee := big.NewInt(11)
 	mockTransfersAfterPreparation := []transfer.Hedera{
 		{
 			AccountID: burnEventReceiver,
-			Amount:    mockRemainder,
+			Amount:    mockRemainder.Int64(),
 		},
 		{
 			AccountID: s.bridgeAccount,
@@ -125,25 +170,25 @@ func Test_ProcessEventCreateFail(t *testing.T) {
 		},
 	}
 
-	mocks.MTransferService.On("InitiateNewTransfer", tr).Return(nil, errors.New("invalid-result"))
-	mocks.MFeeService.AssertNotCalled(t, "CalculateFee", tr.NativeAsset, burnEventAmount)
+	mocks.MTransferService.On("InitiateNewTransfer", mock.Anything, tr).Return(nil, errors.New("invalid-result"))
+	mocks.MFeeService.AssertNotCalled(t, "CalculateFee", tr.NativeAsset, tr.Amount)
 	mocks.MDistributorService.AssertNotCalled(t, "ValidAmount", mockFee)
-	mocks.MDistributorService.AssertNotCalled(t, "CalculateMemberDistribution", mockValidFee)
-	mocks.MScheduledService.AssertNotCalled(t, "ExecuteScheduledTransferTransaction", tr.TransactionId, tr.NativeAsset, mockTransfersAfterPreparation)
+	mocks.MDistributorService.AssertNotCalled(t, "CalculateMemberDistribution", mockValidFee.Int64())
+	mocks.MScheduledService.AssertNotCalled(t, "ExecuteScheduledTransferTransaction", mock.Anything, tr.TransactionId, tr.NativeAsset, mockTransfersAfterPreparation)
 
-	s.ProcessEvent(tr)
+	s.ProcessEvent(context.Background(), tr)
 }
 
 func Test_ProcessEventCalculateMemberDistributionFails(t *testing.T) {
 	setup()
 
-	mockFee := int64(11)
-	mockRemainder := int64(1)
-	mockValidFee := int64(11)
+	mockFee := big.NewInt(11)
+	mockRemainder := big.NewInt(1)
+	mockValidFee := big.NewInt(11)
 	mockTransfersAfterPreparation := []transfer.Hedera{
 		{
 			AccountID: burnEventReceiver,
-			Amount:    mockRemainder,
+			Amount:    mockRemainder.Int64(),
 		},
 		{
 			AccountID: s.bridgeAccount,
@@ -151,13 +196,13 @@ func Test_ProcessEventCalculateMemberDistributionFails(t *testing.T) {
 		},
 	}
 
-	mocks.MTransferService.On("InitiateNewTransfer", tr).Return(entityTransfer, nil)
-	mocks.MFeeService.On("CalculateFee", tr.NativeAsset, burnEventAmount).Return(mockFee, mockRemainder)
+	mocks.MTransferService.On("InitiateNewTransfer", mock.Anything, tr).Return(entityTransfer, nil)
+	mocks.MFeeService.On("CalculateFee", tr.NativeAsset, tr.Amount).Return(mockFee, mockRemainder)
 	mocks.MDistributorService.On("ValidAmount", mockFee).Return(mockValidFee)
-	mocks.MDistributorService.On("CalculateMemberDistribution", mockValidFee).Return(nil, errors.New("invalid-result"))
-	mocks.MScheduledService.AssertNotCalled(t, "ExecuteScheduledTransferTransaction", tr.TransactionId, tr.NativeAsset, mockTransfersAfterPreparation)
+	mocks.MDistributorService.On("CalculateMemberDistribution", mockValidFee.Int64()).Return(nil, errors.New("invalid-result"))
+	mocks.MScheduledService.AssertNotCalled(t, "ExecuteScheduledTransferTransaction", mock.Anything, tr.TransactionId, tr.NativeAsset, mockTransfersAfterPreparation)
 
-	s.ProcessEvent(tr)
+	s.ProcessEvent(context.Background(), tr)
 }
 
 func Test_New(t *testing.T) {
@@ -170,6 +215,8 @@ func Test_New(t *testing.T) {
 		mocks.MScheduledService,
 		mocks.MFeeService,
 		mocks.MTransferService,
+		mocks.MBatchService,
+		mocks.MRefundService,
 		mocks.MPrometheusService)
 	assert.Equal(t, s, actualService)
 }
@@ -407,7 +454,7 @@ func Test_ScheduledTxMinedExecutionSuccessCallback(t *testing.T) {
 	mocks.MScheduleRepository.On("UpdateStatusCompleted", txId).Return(nil)
 	mocks.MFeeRepository.On("UpdateStatusCompleted", txId).Return(nil)
 
-	onSuccess, _ := s.scheduledTxMinedCallbacks(id, hasReceiver, splitTransfers[0], feeOutParams, userOutParams)
+	onSuccess, _ := s.scheduledTxMinedCallbacks(id, tr.NativeAsset, hasReceiver, splitTransfers[0], feeOutParams, userOutParams)
 	onSuccess(txId)
 }
 
@@ -418,40 +465,58 @@ func Test_ScheduledTxMinedExecutionSuccessUpdateStatusFails(t *testing.T) {
 	mocks.MScheduleRepository.On("UpdateStatusCompleted", txId).Return(errors.New("update-status-fail"))
 	mocks.MFeeRepository.AssertNotCalled(t, "UpdateStatusCompleted", txId)
 
-	onSuccess, _ := s.scheduledTxMinedCallbacks(id, hasReceiver, splitTransfers[0], feeOutParams, userOutParams)
+	onSuccess, _ := s.scheduledTxMinedCallbacks(id, tr.NativeAsset, hasReceiver, splitTransfers[0], feeOutParams, userOutParams)
 	onSuccess(txId)
 }
 
 func Test_ScheduledTxMinedExecutionFailCallback(t *testing.T) {
 	setupScheduledTxMinedCallbacks()
 
+	mocks.MRefundService.On("OnDeliveryFailed", id, txId, tr.NativeAsset, big.NewInt(10000000)).Return()
+	mocks.MFeeRepository.On("UpdateStatusFailed", txId).Return(nil)
+
+	_, onFail := s.scheduledTxMinedCallbacks(id, tr.NativeAsset, hasReceiver, splitTransfers[0], feeOutParams, userOutParams)
+	onFail(txId)
+
+	mocks.MRefundService.AssertCalled(t, "OnDeliveryFailed", id, txId, tr.NativeAsset, big.NewInt(10000000))
+	mocks.MScheduleRepository.AssertNotCalled(t, "UpdateStatusFailed", txId)
+	mocks.MTransferRepository.AssertNotCalled(t, "UpdateStatusFailed", id)
+}
+
+func Test_ScheduledTxMinedExecutionFailWithoutReceiver(t *testing.T) {
+	setupScheduledTxMinedCallbacks()
+	hasReceiver = false
+
 	mocks.MScheduleRepository.On("UpdateStatusFailed", txId).Return(nil)
 	mocks.MTransferRepository.On("UpdateStatusFailed", id).Return(nil)
 	mocks.MFeeRepository.On("UpdateStatusFailed", txId).Return(nil)
 
-	_, onFail := s.scheduledTxMinedCallbacks(id, hasReceiver, splitTransfers[0], feeOutParams, userOutParams)
+	_, onFail := s.scheduledTxMinedCallbacks(id, tr.NativeAsset, hasReceiver, splitTransfers[0], feeOutParams, userOutParams)
 	onFail(txId)
+
+	mocks.MRefundService.AssertNotCalled(t, "OnDeliveryFailed", id, txId, tr.NativeAsset, big.NewInt(10000000))
+	mocks.MTransferRepository.AssertCalled(t, "UpdateStatusFailed", id)
 }
 
 func Test_ScheduledTxMinedExecutionFailUpdateStatusFailedFails(t *testing.T) {
 	setupScheduledTxMinedCallbacks()
+	hasReceiver = false
 
 	mocks.MScheduleRepository.On("UpdateStatusFailed", txId).Return(errors.New("update-status-fail"))
 	mocks.MTransferRepository.AssertNotCalled(t, "UpdateStatusFailed", id)
 	mocks.MFeeRepository.AssertNotCalled(t, "UpdateStatusFailed", txId)
 
-	_, onFail := s.scheduledTxMinedCallbacks(id, hasReceiver, splitTransfers[0], feeOutParams, userOutParams)
+	_, onFail := s.scheduledTxMinedCallbacks(id, tr.NativeAsset, hasReceiver, splitTransfers[0], feeOutParams, userOutParams)
 	onFail(txId)
 }
 
 func Test_ScheduledTxMinedExecutionFailFeeUpdateFails(t *testing.T) {
 	setupScheduledTxMinedCallbacks()
 
-	mocks.MScheduleRepository.On("UpdateStatusFailed", txId).Return(nil)
-	mocks.MTransferRepository.On("UpdateStatusFailed", id).Return(nil)
+	mocks.MRefundService.On("OnDeliveryFailed", id, txId, tr.NativeAsset, big.NewInt(10000000)).Return()
 	mocks.MFeeRepository.On("UpdateStatusFailed", txId).Return(errors.New("update-fail"))
 
-	_, onFail := s.scheduledTxMinedCallbacks(id, hasReceiver, splitTransfers[0], feeOutParams, userOutParams)
+	_, onFail := s.scheduledTxMinedCallbacks(id, tr.NativeAsset, hasReceiver, splitTransfers[0], feeOutParams, userOutParams)
 	onFail(txId)
 }
 
@@ -462,7 +527,7 @@ func Test_ScheduledTxMinedExecutionSuccessFeeUpdateFails(t *testing.T) {
 	mocks.MScheduleRepository.On("UpdateStatusCompleted", txId).Return(nil)
 	mocks.MFeeRepository.On("UpdateStatusCompleted", txId).Return(errors.New("update-fail"))
 
-	onSuccess, _ := s.scheduledTxMinedCallbacks(id, hasReceiver, splitTransfers[0], feeOutParams, userOutParams)
+	onSuccess, _ := s.scheduledTxMinedCallbacks(id, tr.NativeAsset, hasReceiver, splitTransfers[0], feeOutParams, userOutParams)
 	onSuccess(txId)
 }
 
@@ -479,6 +544,7 @@ func setup() {
 	mocks.Setup()
 
 	mocks.MPrometheusService.On("GetIsMonitoringEnabled").Return(false)
+	mocks.MBatchService.On("Enabled").Return(false)
 
 	s = &Service{
 		bridgeAccount:      hederaAccount,
@@ -489,6 +555,8 @@ func setup() {
 		feeService:         mocks.MFeeService,
 		scheduledService:   mocks.MScheduledService,
 		transferService:    mocks.MTransferService,
+		batchService:       mocks.MBatchService,
+		refundService:      mocks.MRefundService,
 		prometheusService:  mocks.MPrometheusService,
 		logger:             config.GetLoggerFor("Burn Event Service"),
 	}
@@ -99,6 +99,21 @@ func (bsc *Service) ParseBurnERC721Log(log types.Log) (*router.RouterBurnERC721,
 	return bsc.contract.ParseBurnERC721(log)
 }
 
+// ParseLockERC721Log parses a general typed log to a RouterLockERC721 event
+func (bsc *Service) ParseLockERC721Log(log types.Log) (*router.RouterLockERC721, error) {
+	return bsc.contract.ParseLockERC721(log)
+}
+
+// ParseUnlockERC721Log parses a general typed log to a RouterUnlockERC721 event
+func (bsc *Service) ParseUnlockERC721Log(log types.Log) (*router.RouterUnlockERC721, error) {
+	return bsc.contract.ParseUnlockERC721(log)
+}
+
+// ParseMintERC721Log parses a general typed log to a RouterMintERC721 event
+func (bsc *Service) ParseMintERC721Log(log types.Log) (*router.RouterMintERC721, error) {
+	return bsc.contract.ParseMintERC721(log)
+}
+
 // WatchBurnEventLogs creates a subscription for Burn Events emitted in the Bridge contract
 func (bsc *Service) WatchBurnEventLogs(opts *bind.WatchOpts, sink chan<- *router.RouterBurn) (event.Subscription, error) {
 	return bsc.contract.WatchBurn(opts, sink)
@@ -19,6 +19,8 @@ package calculator
 import (
 	"errors"
 	"fmt"
+	"math/big"
+
 	"github.com/gookit/event"
 	bridge_config_event "github.com/limechain/hedera-eth-bridge-validator/app/model/bridge-config-event"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
@@ -49,14 +51,10 @@ func New(feePercentages map[string]int64) *Service {
 }
 
 // CalculateFee calculates the fee and remainder of a given token and amount
-func (s Service) CalculateFee(token string, amount int64) (fee, remainder int64) {
-	fee = amount * s.feePercentages[token] / constants.FeeMaxPercentage
-	remainder = amount - fee
-
-	totalAmount := remainder + fee
-	if totalAmount != amount {
-		remainder += amount - totalAmount
-	}
+func (s Service) CalculateFee(token string, amount *big.Int) (fee, remainder *big.Int) {
+	fee = new(big.Int).Mul(amount, big.NewInt(s.feePercentages[token]))
+	fee.Quo(fee, big.NewInt(constants.FeeMaxPercentage))
+	remainder = new(big.Int).Sub(amount, fee)
 
 	return fee, remainder
 }
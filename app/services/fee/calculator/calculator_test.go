@@ -1,6 +1,8 @@
 package calculator
 
 import (
+	"math/big"
+
 	"github.com/gookit/event"
 	bridge_config_event "github.com/limechain/hedera-eth-bridge-validator/app/model/bridge-config-event"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
@@ -28,11 +30,23 @@ func Test_New(t *testing.T) {
 func Test_CalculateFee(t *testing.T) {
 	service := New(feePercentages)
 
-	fee, remainder := service.CalculateFee("hbar", 20)
+	fee, remainder := service.CalculateFee("hbar", big.NewInt(20))
+
+	expectedFee := big.NewInt(2)
+	expectedRemainder := big.NewInt(18)
+
+	assert.Equal(t, expectedFee, fee)
+	assert.Equal(t, expectedRemainder, remainder)
+}
+
+func Test_CalculateFee_Large(t *testing.T) {
+	service := New(feePercentages)
+	amount, _ := new(big.Int).SetString("100000000000000000000000", 10)
 
-	expectedFee := int64(2)
-	expectedRemainder := int64(18)
+	fee, remainder := service.CalculateFee("hbar", amount)
 
+	expectedFee, _ := new(big.Int).SetString("10000000000000000000000", 10)
+	expectedRemainder, _ := new(big.Int).SetString("90000000000000000000000", 10)
 	assert.Equal(t, expectedFee, fee)
 	assert.Equal(t, expectedRemainder, remainder)
 }
@@ -18,6 +18,8 @@ package distributor
 
 import (
 	"errors"
+	"math/big"
+
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/transaction"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
@@ -134,15 +136,11 @@ func (s Service) PrepareTransfers(amount int64, token string) ([]transaction.Tra
 }
 
 // ValidAmount Returns the closest amount, which can be equally divided to members
-func (s Service) ValidAmount(amount int64) int64 {
-	feePerAccount := amount / int64(len(s.accountIDs))
-
-	totalAmount := feePerAccount * int64(len(s.accountIDs))
-	if totalAmount != amount {
-		return totalAmount
-	}
+func (s Service) ValidAmount(amount *big.Int) *big.Int {
+	members := big.NewInt(int64(len(s.accountIDs)))
+	feePerAccount := new(big.Int).Quo(amount, members)
 
-	return amount
+	return feePerAccount.Mul(feePerAccount, members)
 }
 
 // Sums the amounts and returns the opposite
@@ -17,6 +17,8 @@
 package distributor
 
 import (
+	"math/big"
+
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 	"github.com/stretchr/testify/assert"
@@ -174,3 +176,13 @@ func Test_SplitTransfersAboveTotalTransfersEquallyDivided(t *testing.T) {
 		Amount:    int64(-9),
 	}, result[1][expectedChunkTwoLength-1])
 }
+
+func Test_ValidAmount(t *testing.T) {
+	s := Service{accountIDs: []hedera.AccountID{{Account: 1}, {Account: 2}, {Account: 3}}}
+	large, _ := new(big.Int).SetString("100000000000000000000", 10)
+	expectedLarge, _ := new(big.Int).SetString("99999999999999999999", 10)
+
+	assert.Equal(t, big.NewInt(9), s.ValidAmount(big.NewInt(9)))
+	assert.Equal(t, big.NewInt(9), s.ValidAmount(big.NewInt(11)))
+	assert.Equal(t, expectedLarge, s.ValidAmount(large))
+}
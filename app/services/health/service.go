@@ -0,0 +1,390 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package health
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/database"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/timestamp"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/health"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+)
+
+// Names of the components, checked by the service
+const (
+	componentDatabase   = "database"
+	componentMirrorNode = "mirror-node"
+	componentHederaNode = "hedera-node"
+)
+
+// readyTTL is the time, for which the readiness report is reused, so that frequent probes do not load the dependencies
+const readyTTL = 5 * time.Second
+
+type Service struct {
+	database       database.Database
+	mirrorNode     client.MirrorNode
+	hederaNode     client.HederaNode
+	evmClients     map[uint64]client.EVM
+	transferStatus repository.Status
+	messageStatus  repository.Status
+	bridgeAccount  hedera.AccountID
+	topicID        hedera.TopicID
+	evmWatchers    map[uint64]string
+	timeout        time.Duration
+	latency        config.HealthThreshold
+	watcherLag     config.HealthThreshold
+	evmNodeLag     config.HealthThreshold
+	readyMutex     sync.Mutex
+	ready          *health.Report
+	readyAt        time.Time
+	logger         *log.Entry
+}
+
+// NewService creates the health service. The bridge account and the topic ID are the IDs of the Status of the Hedera
+// watchers, while evmWatchers are the IDs of the Status of the EVM watchers, keyed by the chain ID of their network.
+// The transfer watchers persist their Status in transferStatus, while the consensus topic watcher - in messageStatus
+func NewService(
+	cfg config.Health,
+	database database.Database,
+	mirrorNode client.MirrorNode,
+	hederaNode client.HederaNode,
+	evmClients map[uint64]client.EVM,
+	transferStatus repository.Status,
+	messageStatus repository.Status,
+	bridgeAccount string,
+	topicID string,
+	evmWatchers map[uint64]string) *Service {
+	account, err := hedera.AccountIDFromString(bridgeAccount)
+	if err != nil {
+		log.Fatalf("Invalid bridge account [%s]. Error: [%s]", bridgeAccount, err)
+	}
+	topic, err := hedera.TopicIDFromString(topicID)
+	if err != nil {
+		log.Fatalf("Invalid topic ID [%s]. Error: [%s]", topicID, err)
+	}
+
+	return &Service{
+		database:       database,
+		mirrorNode:     mirrorNode,
+		hederaNode:     hederaNode,
+		evmClients:     evmClients,
+		transferStatus: transferStatus,
+		messageStatus:  messageStatus,
+		bridgeAccount:  account,
+		topicID:        topic,
+		evmWatchers:    evmWatchers,
+		timeout:        cfg.Timeout * time.Second,
+		latency:        cfg.Latency,
+		watcherLag:     cfg.WatcherLag,
+		evmNodeLag:     cfg.EvmNodeLag,
+		logger:         config.GetLoggerFor("Health Service"),
+	}
+}
+
+// Live reports only the process itself, since restarting the node does not recover any of its dependencies
+func (s *Service) Live() *health.Report {
+	return health.NewReport()
+}
+
+// Ready returns the report of the last check of the dependencies and the watchers, unless it is older than readyTTL.
+// Concurrent probes wait for the same check instead of running their own
+func (s *Service) Ready() *health.Report {
+	s.readyMutex.Lock()
+	defer s.readyMutex.Unlock()
+
+	if s.ready == nil || time.Since(s.readyAt) >= readyTTL {
+		s.ready = s.checkReady()
+		s.readyAt = time.Now()
+	}
+	return s.ready
+}
+
+func (s *Service) checkReady() *health.Report {
+	checks := []func(ctx context.Context) map[string]*health.Component{
+		s.checkDatabase,
+		s.checkMirrorNode,
+		s.checkHederaNode,
+	}
+	for chainId, evmClient := range s.evmClients {
+		chainId, evmClient := chainId, evmClient
+		checks = append(checks, func(ctx context.Context) map[string]*health.Component {
+			return s.checkEvm(ctx, chainId, evmClient)
+		})
+	}
+
+	return s.run(checks...)
+}
+
+// run runs the checks concurrently, each returning the checked components by name
+func (s *Service) run(checks ...func(ctx context.Context) map[string]*health.Component) *health.Report {
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+
+	var (
+		wg     sync.WaitGroup
+		mutex  sync.Mutex
+		report = health.NewReport()
+	)
+	for _, check := range checks {
+		wg.Add(1)
+		go func(check func(ctx context.Context) map[string]*health.Component) {
+			defer wg.Done()
+			components := check(ctx)
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			for name, component := range components {
+				report.Add(name, component)
+			}
+		}(check)
+	}
+	wg.Wait()
+
+	if report.Status != health.StatusUp {
+		s.logger.Warnf("Node is [%s].", report.Status)
+	}
+	return report
+}
+
+func (s *Service) checkDatabase(ctx context.Context) map[string]*health.Component {
+	latency, err := call(ctx, func() error {
+		return s.database.Ping(ctx)
+	})
+
+	return map[string]*health.Component{componentDatabase: s.dependency(latency, err)}
+}
+
+// checkMirrorNode checks the mirror node together with the Hedera watchers, whose latest events are queried from it
+func (s *Service) checkMirrorNode(ctx context.Context) map[string]*health.Component {
+	var latestTransfer int64
+	latency, err := call(ctx, func() error {
+		tx, err := s.mirrorNode.GetLatestAccountCreditTransaction(s.bridgeAccount)
+		if err != nil || tx == nil {
+			return err
+		}
+		latestTransfer, err = timestamp.FromString(tx.ConsensusTimestamp)
+		return err
+	})
+	components := map[string]*health.Component{componentMirrorNode: s.dependency(latency, err)}
+	if err != nil {
+		components[watcherName(s.bridgeAccount.String())] = s.unknownLag(err)
+	} else {
+		components[watcherName(s.bridgeAccount.String())] = s.hederaWatcher(s.transferStatus, s.bridgeAccount.String(), latestTransfer)
+	}
+
+	var latestMessage int64
+	_, err = call(ctx, func() error {
+		messages, err := s.mirrorNode.GetLatestMessages(s.topicID, 1)
+		if err != nil || len(messages) == 0 {
+			return err
+		}
+		latestMessage, err = timestamp.FromString(messages[0].ConsensusTimestamp)
+		return err
+	})
+	if err != nil {
+		components[watcherName(s.topicID.String())] = s.unknownLag(err)
+	} else {
+		components[watcherName(s.topicID.String())] = s.hederaWatcher(s.messageStatus, s.topicID.String(), latestMessage)
+	}
+
+	return components
+}
+
+func (s *Service) checkHederaNode(ctx context.Context) map[string]*health.Component {
+	latency, err := call(ctx, s.hederaNode.Ping)
+
+	return map[string]*health.Component{componentHederaNode: s.dependency(latency, err)}
+}
+
+// checkEvm checks each node of the EVM network and the watcher of its router contract
+func (s *Service) checkEvm(ctx context.Context, chainId uint64, evmClient client.EVM) map[string]*health.Component {
+	type head struct {
+		header  *types.Header
+		latency time.Duration
+		err     error
+	}
+
+	nodes := evmClient.Nodes()
+	heads := make(map[string]*head, len(nodes))
+	var wg sync.WaitGroup
+	for host, node := range nodes {
+		h := &head{}
+		heads[host] = h
+		wg.Add(1)
+		go func(node client.EVM) {
+			defer wg.Done()
+			var header *types.Header
+			h.latency, h.err = call(ctx, func() error {
+				var err error
+				header, err = node.HeaderByNumber(ctx, nil)
+				return err
+			})
+			if h.err == nil {
+				h.header = header
+			}
+		}(node)
+	}
+	wg.Wait()
+
+	var latest *types.Header
+	for _, h := range heads {
+		if h.err == nil && (latest == nil || h.header.Number.Cmp(latest.Number) > 0) {
+			latest = h.header
+		}
+	}
+
+	components := make(map[string]*health.Component, len(heads)+1)
+	for host, h := range heads {
+		component := s.dependency(h.latency, h.err)
+		if h.err == nil {
+			lag := new(big.Int).Sub(latest.Number, h.header.Number).Int64()
+			component.BlockNumber = h.header.Number.Uint64()
+			component.LagBlocks = &lag
+			component.Status = health.Worst(component.Status, status(s.evmNodeLag, lag))
+		}
+		components[fmt.Sprintf("evm-%d-%s", chainId, host)] = component
+	}
+
+	if id, ok := s.evmWatchers[chainId]; ok {
+		if latest == nil {
+			components[watcherName(id)] = s.unknownLag(fmt.Errorf("none of the nodes of network [%d] is reachable", chainId))
+		} else {
+			components[watcherName(id)] = s.evmWatcher(ctx, id, evmClient, latest)
+		}
+	}
+	return components
+}
+
+// hederaWatcher returns the lag of the Hedera watcher behind the consensus timestamp of the latest event it watches for
+func (s *Service) hederaWatcher(statusRepository repository.Status, id string, latest int64) *health.Component {
+	last, err := statusRepository.Get(id)
+	if err != nil {
+		s.logger.Errorf("Failed to get status of watcher [%s]. Error: [%s]", id, err)
+		return s.unknownLag(err)
+	}
+
+	lag := int64(0)
+	if latest > last {
+		lag = time.Duration(latest-last).Milliseconds() / 1000
+	}
+	return &health.Component{
+		Status:     status(s.watcherLag, lag),
+		Last:       last,
+		LagSeconds: &lag,
+	}
+}
+
+// evmWatcher returns the lag of the EVM watcher behind the latest block of its network
+func (s *Service) evmWatcher(ctx context.Context, id string, evmClient client.EVM, latest *types.Header) *health.Component {
+	last, err := s.transferStatus.Get(id)
+	if err != nil {
+		s.logger.Errorf("Failed to get status of watcher [%s]. Error: [%s]", id, err)
+		return s.unknownLag(err)
+	}
+
+	lagBlocks := new(big.Int).Sub(latest.Number, big.NewInt(last)).Int64()
+	if lagBlocks < 0 {
+		lagBlocks = 0
+	}
+
+	var lastHeader *types.Header
+	_, err = call(ctx, func() error {
+		var err error
+		lastHeader, err = evmClient.HeaderByNumber(ctx, big.NewInt(last))
+		return err
+	})
+	if err != nil {
+		component := s.unknownLag(err)
+		component.Last = last
+		component.LagBlocks = &lagBlocks
+		return component
+	}
+
+	lag := int64(0)
+	if latest.Time > lastHeader.Time {
+		lag = int64(latest.Time - lastHeader.Time)
+	}
+	return &health.Component{
+		Status:     status(s.watcherLag, lag),
+		Last:       last,
+		LagSeconds: &lag,
+		LagBlocks:  &lagBlocks,
+	}
+}
+
+// dependency returns the component of a dependency, which responded within the given latency or failed with the error
+func (s *Service) dependency(latency time.Duration, err error) *health.Component {
+	component := &health.Component{
+		Status:    status(s.latency, latency.Milliseconds()),
+		LatencyMs: latency.Milliseconds(),
+	}
+	if err != nil {
+		component.Status = health.StatusDown
+		component.Error = err.Error()
+	}
+	return component
+}
+
+// unknownLag returns the component of a watcher, whose lag could not be checked
+func (s *Service) unknownLag(err error) *health.Component {
+	return &health.Component{
+		Status: health.StatusDown,
+		Error:  err.Error(),
+	}
+}
+
+// call calls the dependency, returning its latency. Once the context is done, returns without waiting for the call
+func call(ctx context.Context, fn func() error) (time.Duration, error) {
+	start := time.Now()
+	done := make(chan error, 1)
+	go func() {
+		done <- fn()
+	}()
+
+	select {
+	case err := <-done:
+		return time.Since(start), err
+	case <-ctx.Done():
+		return time.Since(start), fmt.Errorf("no response within [%s]", time.Since(start).Round(time.Millisecond))
+	}
+}
+
+// status returns the status of a component, whose check measured the given value
+func status(threshold config.HealthThreshold, value int64) string {
+	switch {
+	case value > threshold.Down:
+		return health.StatusDown
+	case value > threshold.Degraded:
+		return health.StatusDegraded
+	default:
+		return health.StatusUp
+	}
+}
+
+func watcherName(id string) string {
+	return fmt.Sprintf("watcher-%s", id)
+}
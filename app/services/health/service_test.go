@@ -0,0 +1,223 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package health
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/message"
+	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/transaction"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/health"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	testClient "github.com/limechain/hedera-eth-bridge-validator/test/mocks/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	s             = &Service{}
+	bridgeAccount = hedera.AccountID{Account: 222222}
+	topicID       = hedera.TopicID{Topic: 333333}
+	chainId       = uint64(80001)
+	evmWatcher    = "80001-0xrouter"
+	nodeA         *testClient.MockEVM
+	nodeB         *testClient.MockEVM
+	cfg           = config.Health{
+		Timeout:    5,
+		Latency:    config.HealthThreshold{Degraded: 1000, Down: 3000},
+		WatcherLag: config.HealthThreshold{Degraded: 1800, Down: 7200},
+		EvmNodeLag: config.HealthThreshold{Degraded: 5, Down: 50},
+	}
+)
+
+func Test_NewService(t *testing.T) {
+	setup()
+
+	actualService := NewService(cfg, mocks.MDatabase, mocks.MHederaMirrorClient, mocks.MHederaNodeClient,
+		map[uint64]client.EVM{chainId: mocks.MEVMClient}, mocks.MStatusRepository, mocks.MStatusRepository,
+		bridgeAccount.String(), topicID.String(), map[uint64]string{chainId: evmWatcher})
+
+	assert.Equal(t, 5*time.Second, actualService.timeout)
+	assert.Equal(t, bridgeAccount, actualService.bridgeAccount)
+	assert.Equal(t, topicID, actualService.topicID)
+	assert.Equal(t, cfg.WatcherLag, actualService.watcherLag)
+}
+
+func Test_Live(t *testing.T) {
+	setup()
+
+	report := s.Live()
+
+	assert.Equal(t, health.StatusUp, report.Status)
+	assert.Empty(t, report.Components)
+	mocks.MDatabase.AssertNotCalled(t, "Ping", mock.Anything)
+	mocks.MHederaNodeClient.AssertNotCalled(t, "Ping")
+}
+
+func Test_Ready(t *testing.T) {
+	setup()
+	setupReady(0, 0)
+
+	report := s.Ready()
+
+	assert.Equal(t, health.StatusUp, report.Status)
+	assert.Len(t, report.Components, 8)
+	for name, component := range report.Components {
+		assert.Equal(t, health.StatusUp, component.Status, name)
+	}
+	assert.Equal(t, uint64(1000), report.Components["evm-80001-node-a"].BlockNumber)
+	assert.Equal(t, int64(0), *report.Components["watcher-80001-0xrouter"].LagSeconds)
+	assert.Equal(t, int64(10), *report.Components["watcher-80001-0xrouter"].LagBlocks)
+	assert.Equal(t, int64(0), *report.Components["watcher-0.0.222222"].LagSeconds)
+}
+
+func Test_Ready_Cached(t *testing.T) {
+	setup()
+	setupReady(0, 0)
+
+	first := s.Ready()
+	second := s.Ready()
+
+	assert.Same(t, first, second)
+	mocks.MHederaNodeClient.AssertNumberOfCalls(t, "Ping", 1)
+}
+
+func Test_Ready_Expired(t *testing.T) {
+	setup()
+	setupReady(0, 0)
+
+	first := s.Ready()
+	s.readyAt = time.Now().Add(-readyTTL)
+	second := s.Ready()
+
+	assert.NotSame(t, first, second)
+	mocks.MHederaNodeClient.AssertNumberOfCalls(t, "Ping", 2)
+}
+
+func Test_Ready_WatcherLag(t *testing.T) {
+	setup()
+	setupReady(3600, 10000)
+
+	report := s.Ready()
+
+	assert.Equal(t, health.StatusDown, report.Status)
+	assert.Equal(t, health.StatusDegraded, report.Components["watcher-0.0.222222"].Status)
+	assert.Equal(t, int64(3600), *report.Components["watcher-0.0.222222"].LagSeconds)
+	assert.Equal(t, health.StatusDown, report.Components["watcher-0.0.333333"].Status)
+	assert.Equal(t, health.StatusUp, report.Components[componentMirrorNode].Status)
+}
+
+func Test_Ready_EvmNodeDown(t *testing.T) {
+	setup()
+	setupReady(0, 0)
+	nodeB.ExpectedCalls = nil
+	nodeB.On("HeaderByNumber", mock.Anything, (*big.Int)(nil)).Return((*types.Header)(nil), errors.New("connection refused"))
+
+	report := s.Ready()
+
+	assert.Equal(t, health.StatusDown, report.Status)
+	assert.Equal(t, health.StatusDown, report.Components["evm-80001-node-b"].Status)
+	assert.Equal(t, "connection refused", report.Components["evm-80001-node-b"].Error)
+	assert.Equal(t, health.StatusUp, report.Components["evm-80001-node-a"].Status)
+	assert.Equal(t, health.StatusUp, report.Components["watcher-80001-0xrouter"].Status)
+}
+
+func Test_Ready_EvmNodeBehind(t *testing.T) {
+	setup()
+	setupReady(0, 0)
+	nodeB.ExpectedCalls = nil
+	nodeB.On("HeaderByNumber", mock.Anything, (*big.Int)(nil)).Return(&types.Header{Number: big.NewInt(990), Time: 1650000000}, nil)
+
+	report := s.Ready()
+
+	assert.Equal(t, health.StatusDegraded, report.Status)
+	assert.Equal(t, health.StatusDegraded, report.Components["evm-80001-node-b"].Status)
+	assert.Equal(t, int64(10), *report.Components["evm-80001-node-b"].LagBlocks)
+}
+
+func Test_Ready_Timeout(t *testing.T) {
+	setup()
+	setupReady(0, 0)
+	s.timeout = 50 * time.Millisecond
+	mocks.MHederaNodeClient.ExpectedCalls = nil
+	mocks.MHederaNodeClient.On("Ping").After(time.Second).Return(nil)
+
+	report := s.Ready()
+
+	assert.Equal(t, health.StatusDown, report.Status)
+	assert.Equal(t, health.StatusDown, report.Components[componentHederaNode].Status)
+	assert.Contains(t, report.Components[componentHederaNode].Error, "no response within")
+}
+
+func Test_Status(t *testing.T) {
+	threshold := config.HealthThreshold{Degraded: 5, Down: 50}
+
+	assert.Equal(t, health.StatusUp, status(threshold, 5))
+	assert.Equal(t, health.StatusDegraded, status(threshold, 6))
+	assert.Equal(t, health.StatusDegraded, status(threshold, 50))
+	assert.Equal(t, health.StatusDown, status(threshold, 51))
+}
+
+// setupReady sets up the dependencies of a node, whose transfer and topic watchers lag the given number of seconds
+func setupReady(transferLag, topicLag int64) {
+	latest := int64(1650000000)
+	mocks.MDatabase.On("Ping", mock.Anything).Return(nil)
+	mocks.MHederaNodeClient.On("Ping").Return(nil)
+	mocks.MHederaMirrorClient.On("GetLatestAccountCreditTransaction", bridgeAccount).
+		Return(&transaction.Transaction{ConsensusTimestamp: "1650000000.000000000"}, nil)
+	mocks.MHederaMirrorClient.On("GetLatestMessages", topicID, int64(1)).
+		Return([]message.Message{{ConsensusTimestamp: "1650000000.000000000"}}, nil)
+	mocks.MStatusRepository.On("Get", bridgeAccount.String()).Return((latest-transferLag)*int64(time.Second), nil)
+	mocks.MStatusRepository.On("Get", topicID.String()).Return((latest-topicLag)*int64(time.Second), nil)
+	mocks.MStatusRepository.On("Get", evmWatcher).Return(int64(990), nil)
+
+	head := &types.Header{Number: big.NewInt(1000), Time: 1650000100}
+	nodeA.On("HeaderByNumber", mock.Anything, (*big.Int)(nil)).Return(head, nil)
+	nodeB.On("HeaderByNumber", mock.Anything, (*big.Int)(nil)).Return(head, nil)
+	mocks.MEVMClient.On("Nodes").Return(map[string]client.EVM{"node-a": nodeA, "node-b": nodeB})
+	mocks.MEVMClient.On("HeaderByNumber", mock.Anything, big.NewInt(990)).Return(&types.Header{Number: big.NewInt(990), Time: 1650000100}, nil)
+}
+
+func setup() {
+	mocks.Setup()
+	nodeA = &testClient.MockEVM{}
+	nodeB = &testClient.MockEVM{}
+
+	s = &Service{
+		database:       mocks.MDatabase,
+		mirrorNode:     mocks.MHederaMirrorClient,
+		hederaNode:     mocks.MHederaNodeClient,
+		evmClients:     map[uint64]client.EVM{chainId: mocks.MEVMClient},
+		transferStatus: mocks.MStatusRepository,
+		messageStatus:  mocks.MStatusRepository,
+		bridgeAccount:  bridgeAccount,
+		topicID:        topicID,
+		evmWatchers:    map[uint64]string{chainId: evmWatcher},
+		timeout:        5 * time.Second,
+		latency:        cfg.Latency,
+		watcherLag:     cfg.WatcherLag,
+		evmNodeLag:     cfg.EvmNodeLag,
+		logger:         config.GetLoggerFor("Health Service"),
+	}
+}
@@ -17,16 +17,20 @@
 package lock_event
 
 import (
+	"context"
 	"database/sql"
-	"strconv"
+	"errors"
+	"fmt"
 
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	hederaHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/hedera"
 	"github.com/limechain/hedera-eth-bridge-validator/app/helper/metrics"
 	syncHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/sync"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/failure"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/schedule"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
@@ -41,6 +45,8 @@ type Service struct {
 	scheduleRepository repository.Schedule
 	transferService    service.Transfers
 	scheduledService   service.Scheduled
+	batchService       service.Batch
+	refundService      service.Refund
 	prometheusService  service.Prometheus
 	logger             *log.Entry
 }
@@ -51,6 +57,8 @@ func NewService(
 	scheduleRepository repository.Schedule,
 	scheduled service.Scheduled,
 	transferService service.Transfers,
+	batchService service.Batch,
+	refundService service.Refund,
 	prometheusService service.Prometheus) *Service {
 
 	bridgeAcc, err := hedera.AccountIDFromString(bridgeAccount)
@@ -64,39 +72,47 @@ func NewService(
 		scheduleRepository: scheduleRepository,
 		scheduledService:   scheduled,
 		transferService:    transferService,
+		batchService:       batchService,
+		refundService:      refundService,
 		prometheusService:  prometheusService,
 		logger:             config.GetLoggerFor("Lock Event Service"),
 	}
 }
 
-func (s *Service) ProcessEvent(event payload.Transfer) {
-	s.initSuccessRatePrometheusMetrics(event.TransactionId, event.SourceChainId, event.TargetChainId, event.SourceAsset)
-
-	amount, err := strconv.ParseInt(event.Amount, 10, 64)
+func (s *Service) ProcessEvent(ctx context.Context, event payload.Transfer) error {
+	amount, err := hederaHelper.Amount(event.Amount)
 	if err != nil {
-		s.logger.Errorf("[%s] - Failed to parse event amount [%s]. Error [%s].", event.TransactionId, event.Amount, err)
+		s.logger.Errorf("[%s] - Invalid event amount. Error [%s].", event.TransactionId, err)
+		var rejection *failure.Error
+		if errors.As(err, &rejection) {
+			// The amount does not fit on redelivery either, so the transfer is stored as rejected
+			return s.transferService.RejectTransfer(event, rejection.Code, rejection.Reason)
+		}
+		return err
 	}
 
-	transactionRecord, err := s.transferService.InitiateNewTransfer(event)
+	transactionRecord, err := s.transferService.InitiateNewTransfer(ctx, event)
 	if err != nil {
 		s.logger.Errorf("[%s] - Error occurred while initiating processing. Error: [%s]", event.TransactionId, err)
-		return
+		return err
 	}
 
 	if transactionRecord.Status != status.Initial {
 		s.logger.Debugf("[%s] - Previously added with status [%s]. Skipping further execution.", transactionRecord.TransactionID, transactionRecord.Status)
-		return
+		return nil
 	}
 
-	status := make(chan string)
+	// The callbacks of a failed execution may report more than once, after the wait below has returned
+	status := make(chan string, 2)
 
 	onTokenMintSuccess, onTokenMintFail := s.scheduledTxMinedCallbacks(event.TransactionId, &status, event, schedule.MINT)
 	onExecutionMintSuccess, onExecutionMintFail := s.scheduledTxExecutionCallbacks(event.TransactionId, schedule.MINT, &status, false)
 
 	s.scheduledService.ExecuteScheduledMintTransaction(
+		ctx,
 		event.TransactionId,
 		event.TargetAsset,
-		amount,
+		event.Amount,
 		&status,
 		onExecutionMintSuccess,
 		onExecutionMintFail,
@@ -108,19 +124,40 @@ func (s *Service) ProcessEvent(event payload.Transfer) {
 	s.logger.Debugf("[%s] - Waiting for Mint Transaction Execution.", event.TransactionId)
 statusBlocker:
 	for {
-		switch <-status {
-		case syncHelper.DONE:
-			s.logger.Debugf("[%s] - Proceeding to submit the Scheduled Transfer Transaction.", event.TransactionId)
-			break statusBlocker
-		case syncHelper.FAIL:
-			s.logger.Errorf("[%s] - Failed to await the execution of Scheduled Mint Transaction.", event.TransactionId)
-			return
+		select {
+		case result := <-status:
+			switch result {
+			case syncHelper.DONE:
+				s.logger.Debugf("[%s] - Proceeding to submit the Scheduled Transfer Transaction.", event.TransactionId)
+				break statusBlocker
+			case syncHelper.FAIL:
+				return fmt.Errorf("failed to await the execution of scheduled mint transaction of [%s]", event.TransactionId)
+			}
+		case <-ctx.Done():
+			return ctx.Err()
 		}
 	}
 	accountID, err := hedera.AccountIDFromString(event.Receiver)
 	if err != nil {
 		s.logger.Errorf("[%s] - Failed to parse receiver [%s]. Error: [%s].", event.TransactionId, event.Receiver, err)
-		return
+		return err
+	}
+
+	if s.batchService.Enabled() {
+		err = s.batchService.Add(event.TransactionId, event.TargetAsset, event.Timestamp, accountID,
+			[]transfer.Hedera{
+				{
+					AccountID: accountID,
+					Amount:    amount,
+				},
+			},
+			func() {
+				metrics.ReachTransferStage(constants.UserGetHisTokensStage, event.SourceChainId, event.TargetChainId, event.SourceAsset, event.Timestamp, s.prometheusService)
+			}, nil)
+		if err != nil {
+			s.logger.Errorf("[%s] - Failed to add to batch. Error: [%s].", event.TransactionId, err)
+		}
+		return err
 	}
 
 	transfers := []transfer.Hedera{
@@ -138,6 +175,7 @@ statusBlocker:
 	onTransferSuccess, onTransferFail := s.scheduledTxMinedCallbacks(event.TransactionId, nil, event, schedule.TRANSFER)
 
 	s.scheduledService.ExecuteScheduledTransferTransaction(
+		ctx,
 		event.TransactionId,
 		event.TargetAsset,
 		transfers,
@@ -146,20 +184,7 @@ statusBlocker:
 		onTransferSuccess,
 		onTransferFail,
 	)
-}
-
-func (s Service) initSuccessRatePrometheusMetrics(transactionId string, sourceChainId, targetChainId uint64, asset string) {
-	if !s.prometheusService.GetIsMonitoringEnabled() {
-		return
-	}
-
-	if sourceChainId != constants.HederaNetworkId {
-		if targetChainId != constants.HederaNetworkId {
-			metrics.CreateMajorityReachedIfNotExists(sourceChainId, targetChainId, asset, transactionId, s.prometheusService, s.logger)
-		}
-
-		metrics.CreateUserGetHisTokensIfNotExists(sourceChainId, targetChainId, asset, transactionId, s.prometheusService, s.logger)
-	}
+	return nil
 }
 
 func (s *Service) scheduledTxExecutionCallbacks(id, operation string, blocker *chan string, hasReceiver bool) (onExecutionSuccess func(transactionID string, scheduleID string), onExecutionFail func(transactionID string)) {
@@ -213,30 +238,13 @@ func (s *Service) scheduledTxExecutionCallbacks(id, operation string, blocker *c
 func (s *Service) scheduledTxMinedCallbacks(id string, status *chan string, event payload.Transfer, scheduleType string) (onSuccess, onFail func(transactionID string)) {
 	onSuccess = func(transactionID string) {
 
-		if scheduleType == schedule.TRANSFER && s.prometheusService.GetIsMonitoringEnabled() {
-			metrics.SetUserGetHisTokens(
-				event.SourceChainId,
-				event.TargetChainId,
-				event.SourceAsset,
-				event.TransactionId,
-				s.prometheusService,
-				s.logger,
-			)
+		if scheduleType == schedule.TRANSFER {
+			metrics.ReachTransferStage(constants.UserGetHisTokensStage, event.SourceChainId, event.TargetChainId, event.SourceAsset, event.Timestamp, s.prometheusService)
 		}
 
 		s.logger.Debugf("[%s] - Scheduled [%s] TX execution successful.", id, transactionID)
 
-		err := s.repository.UpdateStatusCompleted(id)
-		if err != nil {
-			s.logger.Errorf("[%s] - Failed to update status completed. Error [%s].", id, err)
-			return
-		}
-		err = s.scheduleRepository.UpdateStatusCompleted(transactionID)
-		if err != nil {
-			s.logger.Errorf("[%s] - Failed to update status completed. Error [%s].", transactionID, err)
-			return
-		}
-
+		err := s.scheduleRepository.UpdateStatusCompleted(transactionID)
 		if err != nil {
 			if status != nil {
 				*status <- syncHelper.FAIL
@@ -244,6 +252,16 @@ func (s *Service) scheduledTxMinedCallbacks(id string, status *chan string, even
 			s.logger.Errorf("[%s] - Failed to update scheduled [%s] status completed. Error [%s].", id, transactionID, err)
 			return
 		}
+
+		// The transfer is completed only once the minted asset is delivered to the receiver
+		if scheduleType == schedule.TRANSFER {
+			err = s.repository.UpdateStatusCompleted(id)
+			if err != nil {
+				s.logger.Errorf("[%s] - Failed to update status completed. Error [%s].", id, err)
+				return
+			}
+		}
+
 		if status != nil {
 			*status <- syncHelper.DONE
 		}
@@ -255,15 +273,21 @@ func (s *Service) scheduledTxMinedCallbacks(id string, status *chan string, even
 			*status <- syncHelper.FAIL
 		}
 		s.logger.Debugf("[%s] - Scheduled TX execution has failed.", id)
-		err := s.scheduleRepository.UpdateStatusFailed(id)
+		if scheduleType == schedule.TRANSFER {
+			// The receiver may not be able to accept the minted asset, in which case it is refunded
+			s.refundService.OnDeliveryFailed(id, transactionID, event.TargetAsset, event.Amount)
+			return
+		}
+
+		err := s.scheduleRepository.UpdateStatusFailed(transactionID)
 		if err != nil {
-			s.logger.Errorf("[%s] - Failed to update schedule status failed. Error [%s].", id, err)
+			s.logger.Errorf("[%s] - Failed to update schedule status failed. Error [%s].", transactionID, err)
 			return
 		}
 
-		err = s.repository.UpdateStatusFailed(transactionID)
+		err = s.repository.UpdateStatusFailed(id)
 		if err != nil {
-			s.logger.Errorf("[%s] - Failed to update transfer status failed. Error [%s].", transactionID, err)
+			s.logger.Errorf("[%s] - Failed to update transfer status failed. Error [%s].", id, err)
 			return
 		}
 	}
@@ -17,14 +17,27 @@
 package lock_event
 
 import (
+	"context"
 	"errors"
+	"math/big"
 	"testing"
 
 	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/transaction"
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	syncHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/sync"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/failure"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/schedule"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
+	"github.com/limechain/hedera-eth-bridge-validator/app/services/refund"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 var (
@@ -42,7 +55,7 @@ var (
 		TargetAsset:   "0.0.222222",
 		NativeAsset:   "0.1283",
 		Receiver:      "0.0.1234",
-		Amount:        "111",
+		Amount:        big.NewInt(111),
 	}
 	s               = &Service{}
 	mockLockEventId = "some-lock-event-id"
@@ -60,6 +73,8 @@ func Test_New(t *testing.T) {
 		mocks.MScheduleRepository,
 		mocks.MScheduledService,
 		mocks.MTransferService,
+		mocks.MBatchService,
+		mocks.MRefundService,
 		mocks.MPrometheusService)
 	assert.Equal(t, s, actualService)
 }
@@ -72,13 +87,164 @@ func Test_ProcessEventFailsOnCreate(t *testing.T) {
 		mocks.MScheduleRepository,
 		mocks.MScheduledService,
 		mocks.MTransferService,
+		mocks.MBatchService,
+		mocks.MRefundService,
 		mocks.MPrometheusService)
 
-	mocks.MTransferService.On("InitiateNewTransfer", lockEvent).Return(nil, errors.New("new-error"))
+	mocks.MTransferService.On("InitiateNewTransfer", mock.Anything, lockEvent).Return(nil, errors.New("new-error"))
 	mocks.MScheduledService.AssertNotCalled(t, "ExecuteScheduledMintTransaction")
 	mocks.MScheduledService.AssertNotCalled(t, "ExecuteScheduledTransferTransaction")
 
-	actualService.ProcessEvent(lockEvent)
+	actualService.ProcessEvent(context.Background(), lockEvent)
+}
+
+func Test_ProcessEventAmountExceedsLimit(t *testing.T) {
+	setup()
+	event := lockEvent
+	event.Amount = new(big.Int).Lsh(big.NewInt(1), 64)
+	mocks.MTransferService.On("RejectTransfer", event, failure.AmountExceedsLimit, mock.Anything).Return(nil)
+
+	err := s.ProcessEvent(context.Background(), event)
+
+	assert.Nil(t, err)
+	mocks.MTransferService.AssertCalled(t, "RejectTransfer", event, failure.AmountExceedsLimit, mock.Anything)
+	mocks.MTransferService.AssertNotCalled(t, "InitiateNewTransfer", mock.Anything, event)
+	mocks.MScheduledService.AssertNumberOfCalls(t, "ExecuteScheduledMintTransaction", 0)
+}
+
+func Test_ProcessEventAmountExceedsLimitRejectFails(t *testing.T) {
+	setup()
+	event := lockEvent
+	event.Amount = new(big.Int).Lsh(big.NewInt(1), 64)
+	mocks.MTransferService.On("RejectTransfer", event, failure.AmountExceedsLimit, mock.Anything).Return(errors.New("some-error"))
+
+	err := s.ProcessEvent(context.Background(), event)
+
+	assert.Error(t, err)
+	mocks.MTransferService.AssertNotCalled(t, "InitiateNewTransfer", mock.Anything, event)
+}
+
+func Test_ScheduledTransferMinedFailCallback(t *testing.T) {
+	setup()
+	mocks.MRefundService.On("OnDeliveryFailed", lockEvent.TransactionId, txId, lockEvent.TargetAsset, lockEvent.Amount).Return()
+
+	_, onFail := s.scheduledTxMinedCallbacks(lockEvent.TransactionId, nil, lockEvent, schedule.TRANSFER)
+	onFail(txId)
+
+	mocks.MRefundService.AssertCalled(t, "OnDeliveryFailed", lockEvent.TransactionId, txId, lockEvent.TargetAsset, lockEvent.Amount)
+	mocks.MTransferRepository.AssertNotCalled(t, "UpdateStatusFailed", lockEvent.TransactionId)
+}
+
+func Test_ScheduledMintMinedFailCallback(t *testing.T) {
+	setup()
+	mocks.MScheduleRepository.On("UpdateStatusFailed", txId).Return(nil)
+	mocks.MTransferRepository.On("UpdateStatusFailed", lockEvent.TransactionId).Return(nil)
+
+	_, onFail := s.scheduledTxMinedCallbacks(lockEvent.TransactionId, nil, lockEvent, schedule.MINT)
+	onFail(txId)
+
+	mocks.MTransferRepository.AssertCalled(t, "UpdateStatusFailed", lockEvent.TransactionId)
+	mocks.MRefundService.AssertNotCalled(t, "OnDeliveryFailed", lockEvent.TransactionId, txId, lockEvent.TargetAsset, lockEvent.Amount)
+}
+
+func Test_ProcessEventCancelledWhileAwaitingMint(t *testing.T) {
+	setup()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	mocks.MTransferService.On("InitiateNewTransfer", mock.Anything, lockEvent).Return(&entity.Transfer{TransactionID: lockEvent.TransactionId, Status: status.Initial}, nil)
+	mocks.MScheduledService.On("ExecuteScheduledMintTransaction", mock.Anything, lockEvent.TransactionId, lockEvent.TargetAsset, lockEvent.Amount).Return()
+
+	err := s.ProcessEvent(ctx, lockEvent)
+
+	assert.Equal(t, context.Canceled, err)
+	mocks.MScheduledService.AssertNotCalled(t, "ExecuteScheduledTransferTransaction", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_ScheduledMintMinedSuccessCallback(t *testing.T) {
+	setup()
+	mocks.MScheduleRepository.On("UpdateStatusCompleted", txId).Return(nil)
+	blocker := make(chan string, 1)
+
+	onSuccess, _ := s.scheduledTxMinedCallbacks(lockEvent.TransactionId, &blocker, lockEvent, schedule.MINT)
+	onSuccess(txId)
+
+	assert.Equal(t, syncHelper.DONE, <-blocker)
+	mocks.MTransferRepository.AssertNotCalled(t, "UpdateStatusCompleted", lockEvent.TransactionId)
+}
+
+func Test_ScheduledMintMinedSuccessCallbackUpdateFails(t *testing.T) {
+	setup()
+	mocks.MScheduleRepository.On("UpdateStatusCompleted", txId).Return(errors.New("some-error"))
+	blocker := make(chan string, 1)
+
+	onSuccess, _ := s.scheduledTxMinedCallbacks(lockEvent.TransactionId, &blocker, lockEvent, schedule.MINT)
+	onSuccess(txId)
+
+	assert.Equal(t, syncHelper.FAIL, <-blocker)
+}
+
+func Test_ScheduledTransferMinedSuccessCallback(t *testing.T) {
+	setup()
+	mocks.MScheduleRepository.On("UpdateStatusCompleted", txId).Return(nil)
+	mocks.MTransferRepository.On("UpdateStatusCompleted", lockEvent.TransactionId).Return(nil)
+
+	onSuccess, _ := s.scheduledTxMinedCallbacks(lockEvent.TransactionId, nil, lockEvent, schedule.TRANSFER)
+	onSuccess(txId)
+
+	mocks.MTransferRepository.AssertCalled(t, "UpdateStatusCompleted", lockEvent.TransactionId)
+}
+
+func Test_MintSucceedsTransferFails_Refunds(t *testing.T) {
+	setup()
+	refundService := refund.NewService(hederaAccount.String(), 0, mocks.MTransferRepository, mocks.MScheduleRepository,
+		mocks.MFeeRepository, mocks.MDistributorService, mocks.MScheduledService, mocks.MHederaMirrorClient, mocks.MQueue)
+	s.refundService = refundService
+	mintTxId := "0.0.123123@123123-111111"
+
+	originator := hedera.AccountID{Account: 1234}
+	// The mocked transfer repository follows the allowed transitions of the transfer
+	stored := &entity.Transfer{
+		TransactionID: lockEvent.TransactionId,
+		SourceChainID: constants.HederaNetworkId,
+		Originator:    originator.String(),
+		Status:        status.Initial,
+	}
+	move := func(to string) func(args mock.Arguments) {
+		return func(args mock.Arguments) {
+			assert.True(t, status.CanTransition(status.KindTransfer, stored.Status, to), "from [%s] to [%s]", stored.Status, to)
+			stored.Status = to
+		}
+	}
+	mocks.MScheduleRepository.On("UpdateStatusCompleted", mintTxId).Return(nil)
+	mocks.MTransferRepository.On("UpdateStatusCompleted", lockEvent.TransactionId).Return(nil).Run(move(status.Completed))
+	mocks.MHederaMirrorClient.On("GetTransaction", txId).Return(&transaction.Response{
+		Transactions: []transaction.Transaction{{Result: "TOKEN_NOT_ASSOCIATED_TO_ACCOUNT", Scheduled: true}},
+	}, nil)
+	mocks.MScheduleRepository.On("Fail", txId, failure.ReceiverNotAssociated, mock.Anything, status.ActorHandler).Return(nil)
+	mocks.MTransferRepository.On("Fail", lockEvent.TransactionId, failure.ReceiverNotAssociated, mock.Anything, status.ActorHandler).Return(nil).Run(move(status.Failed))
+	mocks.MTransferRepository.On("GetByTransactionId", lockEvent.TransactionId).Return(stored, nil)
+	mocks.MDistributorService.On("ValidAmount", big.NewInt(0)).Return(big.NewInt(0))
+	mocks.MScheduledService.On("ExecuteScheduledTransferTransaction", mock.Anything, lockEvent.TransactionId+"-refund", lockEvent.TargetAsset, mock.Anything).Return()
+	// The queued refund is handled the way the refund handler does
+	mocks.MQueue.On("Push", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		message := args.Get(0).(*queue.Message)
+		assert.Equal(t, constants.HederaRefund, message.Topic)
+		p := message.Payload.(*payload.Transfer)
+		assert.Nil(t, refundService.Refund(context.Background(), p.TransactionId, p.SourceAsset, p.Amount))
+	})
+	blocker := make(chan string, 1)
+
+	onMintSuccess, _ := s.scheduledTxMinedCallbacks(lockEvent.TransactionId, &blocker, lockEvent, schedule.MINT)
+	onMintSuccess(mintTxId)
+	_, onTransferFail := s.scheduledTxMinedCallbacks(lockEvent.TransactionId, nil, lockEvent, schedule.TRANSFER)
+	onTransferFail(txId)
+
+	assert.Equal(t, syncHelper.DONE, <-blocker)
+	assert.Equal(t, status.Failed, stored.Status)
+	mocks.MScheduledService.AssertCalled(t, "ExecuteScheduledTransferTransaction", mock.Anything, lockEvent.TransactionId+"-refund", lockEvent.TargetAsset, []transfer.Hedera{
+		{AccountID: originator, Amount: 111},
+		{AccountID: hederaAccount, Amount: -111},
+	})
 }
 
 // TODO: Uncomment when synchronization of scheduled token mint and transfer is ready
@@ -222,6 +388,8 @@ func setup() {
 		scheduleRepository: mocks.MScheduleRepository,
 		scheduledService:   mocks.MScheduledService,
 		transferService:    mocks.MTransferService,
+		batchService:       mocks.MBatchService,
+		refundService:      mocks.MRefundService,
 		prometheusService:  mocks.MPrometheusService,
 		logger:             config.GetLoggerFor("Lock Event Service"),
 	}
@@ -17,9 +17,10 @@
 package messages
 
 import (
+	"context"
 	"encoding/hex"
 	"fmt"
-	"strconv"
+	"math/big"
 	"time"
 
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
@@ -32,9 +33,11 @@ import (
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	big_numbers "github.com/limechain/hedera-eth-bridge-validator/app/helper/big-numbers"
 	ethhelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/evm"
 	auth_message "github.com/limechain/hedera-eth-bridge-validator/app/model/auth-message"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/message"
+	transferModel "github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	log "github.com/sirupsen/logrus"
@@ -50,6 +53,7 @@ type Service struct {
 	ethClients         map[uint64]client.EVM
 	logger             *log.Entry
 	assetsService      service.Assets
+	transferEvents     service.TransferEvents
 	retryAttempts      int
 }
 
@@ -62,6 +66,7 @@ func NewService(
 	ethClients map[uint64]client.EVM,
 	topicID string,
 	assetsService service.Assets,
+	transferEvents service.TransferEvents,
 ) *Service {
 	tID, e := hedera.TopicIDFromString(topicID)
 	if e != nil {
@@ -78,6 +83,7 @@ func NewService(
 		mirrorClient:       mirrorClient,
 		ethClients:         ethClients,
 		assetsService:      assetsService,
+		transferEvents:     transferEvents,
 		retryAttempts:      30,
 	}
 }
@@ -93,19 +99,19 @@ func (ss *Service) SanityCheckFungibleSignature(topicMessage *proto_models.Topic
 	}
 
 	signedAmount := t.Amount
-	if t.NativeChainID == constants.HederaNetworkId {
-		amount, err := strconv.ParseInt(t.Amount, 10, 64)
+	if chargesFee(t) {
+		amount, err := big_numbers.ToBigInt(t.Amount)
 		if err != nil {
 			ss.logger.Errorf("[%s] - Failed to parse transfer amount. Error [%s]", topicMessage.TransferID, err)
 			return false, err
 		}
 
-		feeAmount, err := strconv.ParseInt(t.Fee, 10, 64)
+		feeAmount, err := big_numbers.ToBigInt(t.Fee)
 		if err != nil {
 			ss.logger.Errorf("[%s] - Failed to parse fee amount. Error [%s]", topicMessage.TransferID, err)
 			return false, err
 		}
-		signedAmount = strconv.FormatInt(amount-feeAmount, 10)
+		signedAmount = amount.Sub(amount, feeAmount).String()
 	}
 
 	match :=
@@ -140,14 +146,14 @@ func (ss *Service) SanityCheckNftSignature(topicMessage *proto_models.TopicEthNf
 	return match, nil
 }
 
-func (ss Service) SignFungibleMessage(tm payload.Transfer) ([]byte, error) {
-	authMsgHash, err := auth_message.EncodeFungibleBytesFrom(tm.SourceChainId, tm.TargetChainId, tm.TransactionId, tm.TargetAsset, tm.Receiver, tm.Amount)
+func (ss Service) SignFungibleMessage(ctx context.Context, tm payload.Transfer) ([]byte, error) {
+	authMsg, err := auth_message.EncodeFungibleMessageFrom(tm.SourceChainId, tm.TargetChainId, tm.TransactionId, tm.TargetAsset, tm.Receiver, tm.Amount.String())
 	if err != nil {
 		ss.logger.Errorf("[%s] - Failed to encode the authorisation signature. Error: [%s]", tm.TransactionId, err)
 		return nil, err
 	}
 
-	signatureBytes, err := ss.ethSigners[tm.TargetChainId].Sign(authMsgHash)
+	signatureBytes, err := ss.ethSigners[tm.TargetChainId].Sign(ctx, authMsg)
 	if err != nil {
 		ss.logger.Errorf("[%s] - Failed to sign the authorisation signature. Error: [%s]", tm.TransactionId, err)
 		return nil, err
@@ -160,7 +166,7 @@ func (ss Service) SignFungibleMessage(tm payload.Transfer) ([]byte, error) {
 		TransferID:    tm.TransactionId,
 		Asset:         tm.TargetAsset,
 		Recipient:     tm.Receiver,
-		Amount:        tm.Amount,
+		Amount:        tm.Amount.String(),
 		Signature:     signature,
 	}
 	msg := message.NewFungibleSignature(topicMsg)
@@ -173,14 +179,14 @@ func (ss Service) SignFungibleMessage(tm payload.Transfer) ([]byte, error) {
 	return bytes, nil
 }
 
-func (ss Service) SignNftMessage(tm payload.Transfer) ([]byte, error) {
-	authMsgHash, err := auth_message.EncodeNftBytesFrom(tm.SourceChainId, tm.TargetChainId, tm.TransactionId, tm.TargetAsset, tm.SerialNum, tm.Metadata, tm.Receiver)
+func (ss Service) SignNftMessage(ctx context.Context, tm payload.Transfer) ([]byte, error) {
+	authMsg, err := auth_message.EncodeNftMessageFrom(tm.SourceChainId, tm.TargetChainId, tm.TransactionId, tm.TargetAsset, tm.SerialNum, tm.Metadata, tm.Receiver)
 	if err != nil {
 		ss.logger.Errorf("[%s] - Failed to encode the authorisation signature. Error: [%s]", tm.TransactionId, err)
 		return nil, err
 	}
 
-	signatureBytes, err := ss.ethSigners[tm.TargetChainId].Sign(authMsgHash)
+	signatureBytes, err := ss.ethSigners[tm.TargetChainId].Sign(ctx, authMsg)
 	if err != nil {
 		ss.logger.Errorf("[%s] - Failed to sign the authorisation signature. Error: [%s]", tm.TransactionId, err)
 		return nil, err
@@ -251,9 +257,51 @@ func (ss *Service) ProcessSignature(transferID, signature string, targetChainId
 	}
 
 	ss.logger.Infof("[%s] - Successfully processed Signature Message from [%s]", transferID, address.String())
+	ss.publishSignature(transferID, address.String(), targetChainId)
 	return nil
 }
 
+// publishSignature publishes the stored signature and, if it is the one with which the transfer reached majority, the majority
+func (ss *Service) publishSignature(transferID, signer string, targetChainId uint64) {
+	if !ss.transferEvents.HasSubscribers() {
+		return
+	}
+
+	t, err := ss.transferRepository.GetByTransactionId(transferID)
+	if err != nil || t == nil {
+		ss.logger.Errorf("[%s] - Failed to get transfer for signature event. Error: [%v]", transferID, err)
+		return
+	}
+
+	messages, err := ss.messageRepository.Get(transferID)
+	if err != nil {
+		ss.logger.Errorf("[%s] - Failed to get messages for signature event. Error: [%s]", transferID, err)
+		return
+	}
+
+	event := t.ToEvent(transferModel.EventSignatureAdded)
+	event.Signer = signer
+	event.Signatures = len(messages)
+	ss.transferEvents.Publish(event)
+
+	contractService, ok := ss.contractServices[targetChainId]
+	if !ok {
+		return
+	}
+	reachedMajority, err := contractService.HasValidSignaturesLength(big.NewInt(int64(len(messages))))
+	if err != nil || !reachedMajority {
+		return
+	}
+	reachedMajorityBefore, err := contractService.HasValidSignaturesLength(big.NewInt(int64(len(messages) - 1)))
+	if err != nil || reachedMajorityBefore {
+		return
+	}
+
+	event = t.ToEvent(transferModel.EventMajorityReached)
+	event.Signatures = len(messages)
+	ss.transferEvents.Publish(event)
+}
+
 func (ss *Service) verifySignature(authMsgBytes []byte, signatureBytes []byte, transferID string, targetChainId uint64, authMessageStr string) (common.Address, error) {
 	publicKey, err := crypto.Ecrecover(authMsgBytes, signatureBytes)
 	if err != nil {
@@ -284,13 +332,8 @@ func (ss *Service) awaitTransfer(transferID string) (*entity.Transfer, error) {
 			return nil, err
 		}
 
-		if t != nil {
-			if t.NativeChainID != constants.HederaNetworkId {
-				return t, nil
-			}
-			if t.NativeChainID == constants.HederaNetworkId && t.Fee != "" {
-				return t, nil
-			}
+		if t != nil && (!chargesFee(t) || t.Fee != "") {
+			return t, nil
 		}
 
 		ss.logger.Debugf("[%s] - Transfer not yet added. Querying after 5 seconds", transferID)
@@ -301,3 +344,9 @@ func (ss *Service) awaitTransfer(transferID string) (*entity.Transfer, error) {
 	err := fmt.Errorf("[%s] - Failed to retrieve Transaction Record, dropping transaction", transferID)
 	return nil, err
 }
+
+// chargesFee returns whether validators charge a fee for the transfer, which is only the case
+// for Hedera native assets, transferred from Hedera. Wrapped to wrapped transfers are free of fees
+func chargesFee(t *entity.Transfer) bool {
+	return t.SourceChainID == constants.HederaNetworkId && t.NativeChainID == constants.HederaNetworkId
+}
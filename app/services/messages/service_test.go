@@ -17,8 +17,10 @@
 package messages
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"math/big"
 	"testing"
 	"time"
 
@@ -26,6 +28,7 @@ import (
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/message"
+	transferModel "github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
@@ -96,6 +99,7 @@ func Test_NewService(t *testing.T) {
 		ethClients,
 		"0.0.1",
 		mocks.MAssetsService,
+		mocks.MTransferEventsService,
 	)
 	actualService.retryAttempts = 1
 
@@ -141,6 +145,34 @@ func Test_SanityCheckFungibleSignature_ShouldReturnTrue(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func Test_SanityCheckFungibleSignature_WrappedToWrapped(t *testing.T) {
+	setup()
+
+	message := &proto.TopicEthSignatureMessage{
+		SourceChainId: 5,
+		TargetChainId: targetChainId,
+		TransferID:    "some-wrapped-transfer-id",
+		Asset:         "0xwrapped",
+		Recipient:     topicEthFungibleMessage.Recipient,
+		Amount:        "100",
+	}
+	transfer := &entity.Transfer{
+		TransactionID: message.TransferID,
+		SourceChainID: message.SourceChainId,
+		TargetChainID: message.TargetChainId,
+		NativeChainID: constants.HederaNetworkId,
+		TargetAsset:   message.Asset,
+		Amount:        "100",
+		Receiver:      message.Recipient,
+	}
+
+	mocks.MTransferRepository.On("GetByTransactionId", message.TransferID).Return(transfer, nil)
+
+	ok, err := serviceInstance.SanityCheckFungibleSignature(message)
+	assert.True(t, ok)
+	assert.Nil(t, err)
+}
+
 func Test_SanityCheckNftSignature_ShouldReturnError(t *testing.T) {
 	setup()
 
@@ -176,7 +208,7 @@ func Test_SignFungibleMessage_ShouldReturnError(t *testing.T) {
 
 	tm := payload.Transfer{}
 
-	bytes, err := serviceInstance.SignFungibleMessage(tm)
+	bytes, err := serviceInstance.SignFungibleMessage(context.Background(), tm)
 	assert.Nil(t, bytes)
 	assert.NotNil(t, err)
 
@@ -186,12 +218,12 @@ func Test_SignFungibleMessage_ShouldReturnError(t *testing.T) {
 		TransactionId: topicEthFungibleMessage.TransferID,
 		TargetAsset:   topicEthFungibleMessage.Asset,
 		Receiver:      topicEthFungibleMessage.Recipient,
-		Amount:        topicEthFungibleMessage.Amount,
+		Amount:        big.NewInt(95),
 	}
 
-	mocks.MSignerService.On("Sign", mock.Anything).Return(nil, errors.New("some-error"))
+	mocks.MSignerService.On("Sign", mock.Anything, mock.Anything).Return(nil, errors.New("some-error"))
 
-	bytes, err = serviceInstance.SignFungibleMessage(tm)
+	bytes, err = serviceInstance.SignFungibleMessage(context.Background(), tm)
 	assert.Nil(t, bytes)
 	assert.NotNil(t, err)
 }
@@ -205,12 +237,12 @@ func Test_SignFungibleMessage(t *testing.T) {
 		TransactionId: topicEthFungibleMessage.TransferID,
 		TargetAsset:   topicEthFungibleMessage.Asset,
 		Receiver:      topicEthFungibleMessage.Recipient,
-		Amount:        topicEthFungibleMessage.Amount,
+		Amount:        big.NewInt(95),
 	}
 
-	mocks.MSignerService.On("Sign", mock.Anything).Return([]byte{}, nil)
+	mocks.MSignerService.On("Sign", mock.Anything, mock.Anything).Return([]byte{}, nil)
 
-	bytes, err := serviceInstance.SignFungibleMessage(tm)
+	bytes, err := serviceInstance.SignFungibleMessage(context.Background(), tm)
 	assert.NotNil(t, bytes)
 	assert.Nil(t, err)
 }
@@ -228,9 +260,9 @@ func Test_SignNftMessage_ShouldReturnError(t *testing.T) {
 		IsNft:         true,
 	}
 
-	mocks.MSignerService.On("Sign", mock.Anything).Return(nil, errors.New("some-error"))
+	mocks.MSignerService.On("Sign", mock.Anything, mock.Anything).Return(nil, errors.New("some-error"))
 
-	bytes, err := serviceInstance.SignNftMessage(tm)
+	bytes, err := serviceInstance.SignNftMessage(context.Background(), tm)
 	assert.Nil(t, bytes)
 	assert.NotNil(t, err)
 }
@@ -248,9 +280,9 @@ func Test_SignNftMessage(t *testing.T) {
 		IsNft:         true,
 	}
 
-	mocks.MSignerService.On("Sign", mock.Anything).Return([]byte{}, nil)
+	mocks.MSignerService.On("Sign", mock.Anything, mock.Anything).Return([]byte{}, nil)
 
-	bytes, err := serviceInstance.SignNftMessage(tm)
+	bytes, err := serviceInstance.SignNftMessage(context.Background(), tm)
 	assert.NotNil(t, bytes)
 	assert.Nil(t, err)
 }
@@ -269,6 +301,40 @@ func Test_ProcessSignature(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func Test_publishSignature_MajorityReached(t *testing.T) {
+	setup()
+	transferID := "some-transfer-id"
+	signer := "0x0000000000000000000000000000000000000001"
+
+	mocks.MTransferEventsService.On("HasSubscribers").Return(true)
+	mocks.MTransferRepository.On("GetByTransactionId", transferID).Return(&entity.Transfer{TransactionID: transferID, Receiver: "0x2"}, nil)
+	mocks.MMessageRepository.On("Get", transferID).Return([]entity.Message{{}, {}, {}}, nil)
+	mocks.MBridgeContractService.On("HasValidSignaturesLength", big.NewInt(3)).Return(true, nil)
+	mocks.MBridgeContractService.On("HasValidSignaturesLength", big.NewInt(2)).Return(false, nil)
+	mocks.MTransferEventsService.On("Publish", mock.Anything).Return()
+
+	serviceInstance.publishSignature(transferID, signer, 80001)
+
+	mocks.MTransferEventsService.AssertNumberOfCalls(t, "Publish", 2)
+	signatureEvent := mocks.MTransferEventsService.Calls[1].Arguments.Get(0).(*transferModel.Event)
+	assert.Equal(t, transferModel.EventSignatureAdded, signatureEvent.Type)
+	assert.Equal(t, signer, signatureEvent.Signer)
+	assert.Equal(t, 3, signatureEvent.Signatures)
+	majorityEvent := mocks.MTransferEventsService.Calls[2].Arguments.Get(0).(*transferModel.Event)
+	assert.Equal(t, transferModel.EventMajorityReached, majorityEvent.Type)
+}
+
+func Test_publishSignature_NoSubscribers(t *testing.T) {
+	setup()
+
+	mocks.MTransferEventsService.On("HasSubscribers").Return(false)
+
+	serviceInstance.publishSignature("some-transfer-id", "0x1", 80001)
+
+	mocks.MTransferRepository.AssertNotCalled(t, "GetByTransactionId", mock.Anything)
+	mocks.MTransferEventsService.AssertNotCalled(t, "Publish", mock.Anything)
+}
+
 func setup() {
 	mocks.Setup()
 
@@ -300,6 +366,7 @@ func setup() {
 		ethClients:         ethClients,
 		logger:             config.GetLoggerFor(fmt.Sprintf("Messages Service")),
 		assetsService:      mocks.MAssetsService,
+		transferEvents:     mocks.MTransferEventsService,
 		retryAttempts:      1,
 	}
 }
@@ -325,7 +325,6 @@ func ExecuteWithUpdatingService(t *testing.T, serviceCall func()) {
 		On("Erc721Fee", &bind.CallOpts{}, common.HexToAddress(testConstants.NetworkPolygonWrappedNonFungibleTokenForHedera)).
 		Return(big.NewInt(testConstants.NftFeesForApi[testConstants.PolygonNetworkId][testConstants.NetworkPolygonWrappedNonFungibleTokenForHedera].Fee.IntPart()), nil)
 
-
 	wg := sync.WaitGroup{}
 	wg.Add(1)
 	go func() {
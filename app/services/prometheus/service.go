@@ -17,14 +17,9 @@
 package prometheus
 
 import (
-	"errors"
-	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
-	"github.com/limechain/hedera-eth-bridge-validator/app/helper/metrics"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
-	"github.com/limechain/hedera-eth-bridge-validator/constants"
 	"github.com/prometheus/client_golang/prometheus"
 	log "github.com/sirupsen/logrus"
-	"strconv"
 	"sync"
 )
 
@@ -32,19 +27,23 @@ type Service struct {
 	mu                  sync.RWMutex
 	logger              *log.Entry
 	gauges              map[string]prometheus.Gauge
+	gaugeVecs           map[string]*prometheus.GaugeVec
 	counters            map[string]prometheus.Counter
+	counterVecs         map[string]*prometheus.CounterVec
+	histogramVecs       map[string]*prometheus.HistogramVec
 	isMonitoringEnabled bool
-	assetsService       service.Assets
 }
 
-func NewService(assetsService service.Assets, isMonitoringEnabled bool) *Service {
+func NewService(isMonitoringEnabled bool) *Service {
 
 	return &Service{
 		logger:              config.GetLoggerFor("Prometheus Service"),
 		gauges:              map[string]prometheus.Gauge{},
+		gaugeVecs:           map[string]*prometheus.GaugeVec{},
 		counters:            map[string]prometheus.Counter{},
+		counterVecs:         map[string]*prometheus.CounterVec{},
+		histogramVecs:       map[string]*prometheus.HistogramVec{},
 		isMonitoringEnabled: isMonitoringEnabled,
-		assetsService:       assetsService,
 	}
 }
 
@@ -74,36 +73,29 @@ func (s *Service) CreateGaugeIfNotExists(opts prometheus.GaugeOpts) prometheus.G
 	return gauge
 }
 
-func (s *Service) CreateSuccessRateGaugeIfNotExists(transactionId string, sourceChainId, targetChainId uint64, asset, metricType, metricHelp string) (prometheus.Gauge, error) {
+func (s *Service) CreateGaugeVecIfNotExists(opts prometheus.GaugeOpts, labelNames []string) *prometheus.GaugeVec {
 	if !s.isMonitoringEnabled {
-		return nil, errors.New("monitoring is disabled.")
+		return nil
 	}
 
-	metricName, err := s.ConstructMetricName(
-		sourceChainId,
-		targetChainId,
-		asset,
-		transactionId,
-		metricType,
-	)
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
-	if err != nil {
-		return nil, err
+	if gaugeVec, exist := s.gaugeVecs[opts.Name]; exist {
+		return gaugeVec
 	}
 
-	gauge := s.CreateGaugeIfNotExists(prometheus.GaugeOpts{
-		Name: metricName,
-		Help: metricHelp,
-		ConstLabels: prometheus.Labels{
-			"source_network_id": strconv.FormatUint(sourceChainId, 10),
-			"target_network_id": strconv.FormatUint(targetChainId, 10),
-			"asset":             asset,
-			"transaction_id":    transactionId,
-			"metric_type":       metricType,
-		},
-	})
-
-	return gauge, nil
+	s.logger.Infof("Creating Gauge Vector Metric '%v' ...", opts.Name)
+	gaugeVec := prometheus.NewGaugeVec(opts, labelNames)
+	s.logger.Infof("Gauge Vector Metric '%v' successfully created! Labels: %s", opts.Name, labelNames)
+
+	s.logger.Infof("Registering Gauge Vector Metric '%v' ...", opts.Name)
+	prometheus.MustRegister(gaugeVec)
+	s.logger.Infof("Gauge Vector Metric '%v' successfully registed!", opts.Name)
+
+	s.gaugeVecs[opts.Name] = gaugeVec
+
+	return gaugeVec
 }
 
 func (s *Service) GetGauge(name string) prometheus.Gauge {
@@ -155,14 +147,54 @@ func (s *Service) CreateCounterIfNotExists(opts prometheus.CounterOpts) promethe
 	return counter
 }
 
-func (s *Service) ConstructMetricName(sourceNetworkId, targetNetworkId uint64, asset, transactionId, metricType string) (string, error) {
-	tokenType := constants.Wrapped
-	isNativeAsset := s.assetsService.IsNative(sourceNetworkId, asset)
-	if isNativeAsset {
-		tokenType = constants.Native
+func (s *Service) CreateCounterVecIfNotExists(opts prometheus.CounterOpts, labelNames []string) *prometheus.CounterVec {
+	if !s.isMonitoringEnabled {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if counterVec, exist := s.counterVecs[opts.Name]; exist {
+		return counterVec
+	}
+
+	s.logger.Infof("Creating Counter Vector Metric '%v' ...", opts.Name)
+	counterVec := prometheus.NewCounterVec(opts, labelNames)
+	s.logger.Infof("Counter Vector Metric '%v' successfully created! Labels: %s", opts.Name, labelNames)
+
+	s.logger.Infof("Registering Counter Vector Metric '%v' ...", opts.Name)
+	prometheus.MustRegister(counterVec)
+	s.logger.Infof("Counter Vector Metric '%v' successfully registed!", opts.Name)
+
+	s.counterVecs[opts.Name] = counterVec
+
+	return counterVec
+}
+
+func (s *Service) CreateHistogramVecIfNotExists(opts prometheus.HistogramOpts, labelNames []string) *prometheus.HistogramVec {
+	if !s.isMonitoringEnabled {
+		return nil
 	}
 
-	return metrics.ConstructNameForMetric(sourceNetworkId, targetNetworkId, tokenType, transactionId, metricType)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if histogramVec, exist := s.histogramVecs[opts.Name]; exist {
+		return histogramVec
+	}
+
+	s.logger.Infof("Creating Histogram Vector Metric '%v' ...", opts.Name)
+	histogramVec := prometheus.NewHistogramVec(opts, labelNames)
+	s.logger.Infof("Histogram Vector Metric '%v' successfully created! Labels: %s", opts.Name, labelNames)
+
+	s.logger.Infof("Registering Histogram Vector Metric '%v' ...", opts.Name)
+	prometheus.MustRegister(histogramVec)
+	s.logger.Infof("Histogram Vector Metric '%v' successfully registed!", opts.Name)
+
+	s.histogramVecs[opts.Name] = histogramVec
+
+	return histogramVec
 }
 
 func (s *Service) GetCounter(name string) prometheus.Counter {
@@ -17,39 +17,32 @@
 package prometheus
 
 import (
-	"fmt"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
-	"github.com/limechain/hedera-eth-bridge-validator/constants"
-	testConstants "github.com/limechain/hedera-eth-bridge-validator/test/constants"
-	"github.com/limechain/hedera-eth-bridge-validator/test/helper"
-	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
 
 var (
-	serviceInstance              *Service
-	gauge                        prometheus.Gauge
-	counter                      prometheus.Counter
-	isMonitoringEnabled          = true
-	gaugeOpts                    = prometheus.GaugeOpts{Name: "GaugeName", Help: "GaugeHelp"}
-	gaugeSuffix                  = "gauge_suffix"
-	counterOpts                  = prometheus.CounterOpts{Name: "CounterName", Help: "CounterHelp"}
-	counterSuffix                = "counter_suffix"
-	sourceNetworkId              = constants.HederaNetworkId
-	sourceNetworkName            = testConstants.Networks[constants.HederaNetworkId].Name
-	targetNetworkId              = testConstants.EthereumNetworkId
-	targetNetworkName            = testConstants.Networks[testConstants.EthereumNetworkId].Name
-	assetAddress                 = constants.Hbar
-	transactionId                = "0.0.1234-1234-1234"
-	transactionIdWithUnderscores = "0_0_1234_1234_1234"
+	serviceInstance     *Service
+	gauge               prometheus.Gauge
+	counter             prometheus.Counter
+	isMonitoringEnabled = true
+	gaugeOpts           = prometheus.GaugeOpts{Name: "GaugeName", Help: "GaugeHelp"}
+	gaugeVecOpts        = prometheus.GaugeOpts{Name: "GaugeVecName", Help: "GaugeVecHelp"}
+	gaugeVecLabels      = []string{"label"}
+	counterOpts         = prometheus.CounterOpts{Name: "CounterName", Help: "CounterHelp"}
+	counterVecOpts      = prometheus.CounterOpts{Name: "CounterVecName", Help: "CounterVecHelp"}
+	counterVecLabels    = []string{"label"}
+	counterSuffix       = "counter_suffix"
+	histogramVecOpts    = prometheus.HistogramOpts{Name: "HistogramVecName", Help: "HistogramVecHelp"}
+	histogramVecLabels  = []string{"label"}
 )
 
 func Test_New(t *testing.T) {
 	setup()
 
-	actualService := NewService(mocks.MAssetsService, isMonitoringEnabled)
+	actualService := NewService(isMonitoringEnabled)
 
 	assert.Equal(t, serviceInstance, actualService)
 }
@@ -63,61 +56,61 @@ func Test_CreateGaugeIfNotExists(t *testing.T) {
 	assert.NotNil(t, gauge)
 }
 
-func Test_ConstructMetricName_Native(t *testing.T) {
+func Test_CreateGaugeVecIfNotExists(t *testing.T) {
 	setup()
 
-	mocks.MAssetsService.On("IsNative", sourceNetworkId, assetAddress).Return(true)
+	gaugeVec := serviceInstance.CreateGaugeVecIfNotExists(gaugeVecOpts, gaugeVecLabels)
+	defer prometheus.Unregister(gaugeVec)
 
-	expectedNative := fmt.Sprintf("%v_%v_to_%v_%v_%v", constants.Native, sourceNetworkName, targetNetworkName, transactionIdWithUnderscores, constants.MajorityReachedNameSuffix)
-	actual, err := serviceInstance.ConstructMetricName(sourceNetworkId, targetNetworkId, assetAddress, transactionId, constants.MajorityReachedNameSuffix)
-
-	assert.Equal(t, nil, err)
-	assert.Equal(t, expectedNative, actual)
+	assert.NotNil(t, gaugeVec)
+	assert.Equal(t, gaugeVec, serviceInstance.CreateGaugeVecIfNotExists(gaugeVecOpts, gaugeVecLabels))
 }
 
-func Test_ConstructMetricName_Wrapped(t *testing.T) {
+func Test_CreateGaugeVecIfNotExists_MonitoringDisabled(t *testing.T) {
 	setup()
+	serviceInstance.isMonitoringEnabled = false
 
-	mocks.MAssetsService.On("IsNative", targetNetworkId, assetAddress).Return(false)
-
-	expectedNative := fmt.Sprintf("%v_%v_to_%v_%v_%v", constants.Wrapped, targetNetworkName, sourceNetworkName, transactionIdWithUnderscores, constants.MajorityReachedNameSuffix)
-	actual, err := serviceInstance.ConstructMetricName(targetNetworkId, sourceNetworkId, assetAddress, transactionId, constants.MajorityReachedNameSuffix)
+	gaugeVec := serviceInstance.CreateGaugeVecIfNotExists(gaugeVecOpts, gaugeVecLabels)
 
-	assert.Equal(t, err, nil)
-	assert.Equal(t, expectedNative, actual)
+	assert.Nil(t, gaugeVec)
 }
 
-func Test_ConstructMetricName_ShouldThrow(t *testing.T) {
+func Test_CreateCounterVecIfNotExists(t *testing.T) {
 	setup()
 
-	mocks.MAssetsService.On("IsNative", uint64(10), assetAddress).Return(false)
+	counterVec := serviceInstance.CreateCounterVecIfNotExists(counterVecOpts, counterVecLabels)
+	defer prometheus.Unregister(counterVec)
+
+	assert.NotNil(t, counterVec)
+	assert.Equal(t, counterVec, serviceInstance.CreateCounterVecIfNotExists(counterVecOpts, counterVecLabels))
+}
+
+func Test_CreateCounterVecIfNotExists_MonitoringDisabled(t *testing.T) {
+	setup()
+	serviceInstance.isMonitoringEnabled = false
 
-	_, err := serviceInstance.ConstructMetricName(10, sourceNetworkId, assetAddress, transactionId, constants.MajorityReachedNameSuffix)
+	counterVec := serviceInstance.CreateCounterVecIfNotExists(counterVecOpts, counterVecLabels)
 
-	expectedError := fmt.Sprintf("Network id %v is missing in id to name mapping.", 10)
-	assert.Errorf(t, err, expectedError)
+	assert.Nil(t, counterVec)
 }
 
-func Test_CreateSuccessRateGaugeIfNotExists(t *testing.T) {
+func Test_CreateHistogramVecIfNotExists(t *testing.T) {
 	setup()
 
-	mocks.MAssetsService.On("IsNative", sourceNetworkId, assetAddress).Return(true)
+	histogramVec := serviceInstance.CreateHistogramVecIfNotExists(histogramVecOpts, histogramVecLabels)
+	defer prometheus.Unregister(histogramVec)
 
-	gauge, err := serviceInstance.CreateSuccessRateGaugeIfNotExists(
-		transactionId,
-		sourceNetworkId,
-		targetNetworkId,
-		assetAddress,
-		gaugeSuffix,
-		gaugeOpts.Help)
+	assert.NotNil(t, histogramVec)
+	assert.Equal(t, histogramVec, serviceInstance.CreateHistogramVecIfNotExists(histogramVecOpts, histogramVecLabels))
+}
 
-	fullGaugeName, err2 := serviceInstance.ConstructMetricName(sourceNetworkId, targetNetworkId, assetAddress, transactionId, gaugeSuffix)
+func Test_CreateHistogramVecIfNotExists_MonitoringDisabled(t *testing.T) {
+	setup()
+	serviceInstance.isMonitoringEnabled = false
 
-	defer serviceInstance.DeleteGauge(fullGaugeName)
+	histogramVec := serviceInstance.CreateHistogramVecIfNotExists(histogramVecOpts, histogramVecLabels)
 
-	assert.NotNil(t, gauge)
-	assert.Nil(t, err)
-	assert.Nil(t, err2)
+	assert.Nil(t, histogramVec)
 }
 
 func Test_GetGauge(t *testing.T) {
@@ -172,14 +165,13 @@ func Test_DeleteCounter(t *testing.T) {
 }
 
 func setup() {
-	mocks.Setup()
-	helper.SetupNetworks()
-
 	serviceInstance = &Service{
 		logger:              config.GetLoggerFor("Prometheus Service"),
 		gauges:              map[string]prometheus.Gauge{},
+		gaugeVecs:           map[string]*prometheus.GaugeVec{},
 		counters:            map[string]prometheus.Counter{},
-		assetsService:       mocks.MAssetsService,
+		counterVecs:         map[string]*prometheus.CounterVec{},
+		histogramVecs:       map[string]*prometheus.HistogramVec{},
 		isMonitoringEnabled: isMonitoringEnabled,
 	}
 }
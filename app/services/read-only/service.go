@@ -17,6 +17,8 @@
 package read_only
 
 import (
+	"context"
+	"fmt"
 	"time"
 
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
@@ -25,7 +27,9 @@ import (
 	mirrorNodeTransaction "github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/transaction"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	syncHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/sync"
 	model "github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/failure"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/constants"
@@ -55,6 +59,7 @@ func New(
 }
 
 func (s Service) FindAssetTransfer(
+	ctx context.Context,
 	transferID string,
 	asset string,
 	expectedTransfers []model.Hedera,
@@ -104,7 +109,8 @@ func (s Service) FindAssetTransfer(
 					if isSuccessful {
 						err = s.transferRepository.UpdateStatusCompleted(transferID)
 					} else {
-						err = s.transferRepository.UpdateStatusFailed(transferID)
+						reason := fmt.Sprintf("scheduled transaction [%s] resolved with [%s]", transaction.TransactionID, transaction.Result)
+						err = s.transferRepository.Fail(transferID, failure.FromHederaResult(transaction.Result), reason, status.ActorHandler)
 					}
 					if err != nil {
 						s.logger.Errorf("[%s] - Failed to update status. Error: [%s]", transferID, err)
@@ -119,11 +125,15 @@ func (s Service) FindAssetTransfer(
 		}
 		s.logger.Tracef("[%s] - No asset transfers found.", transferID)
 
-		time.Sleep(s.pollingInterval * time.Second)
+		if !syncHelper.Sleep(ctx, s.pollingInterval*time.Second) {
+			s.logger.Warnf("[%s] - Stopped looking for transfers. Error: [%s]", transferID, ctx.Err())
+			return
+		}
 	}
 }
 
 func (s Service) FindScheduledNftAllowanceApprove(
+	ctx context.Context,
 	t *payload.Transfer,
 	sender hedera.AccountID,
 	save func(transactionID, scheduleID, status string) error) {
@@ -179,11 +189,15 @@ func (s Service) FindScheduledNftAllowanceApprove(
 		}
 
 		s.logger.Tracef("[%s] - No transfers found.", transferID)
-		time.Sleep(s.pollingInterval * time.Second)
+		if !syncHelper.Sleep(ctx, s.pollingInterval*time.Second) {
+			s.logger.Warnf("[%s] - Stopped looking for transfers. Error: [%s]", transferID, ctx.Err())
+			return
+		}
 	}
 }
 
 func (s Service) FindNftTransfer(
+	ctx context.Context,
 	transferID string, tokenID string, serialNum int64, sender string, receiver string,
 	save func(transactionID, scheduleID, status string) error) {
 	for {
@@ -232,11 +246,15 @@ func (s Service) FindNftTransfer(
 			break
 		}
 
-		time.Sleep(s.pollingInterval * time.Second)
+		if !syncHelper.Sleep(ctx, s.pollingInterval*time.Second) {
+			s.logger.Warnf("[%s] - Stopped looking for transfers. Error: [%s]", transferID, ctx.Err())
+			return
+		}
 	}
 }
 
 func (s Service) FindTransfer(
+	ctx context.Context,
 	transferID string,
 	fetch func() (*mirrorNodeTransaction.Response, error),
 	save func(transactionID, scheduleID, status string) error) {
@@ -290,7 +308,10 @@ func (s Service) FindTransfer(
 		}
 
 		s.logger.Tracef("[%s] - No transfers found.", transferID)
-		time.Sleep(s.pollingInterval * time.Second)
+		if !syncHelper.Sleep(ctx, s.pollingInterval*time.Second) {
+			s.logger.Warnf("[%s] - Stopped looking for transfers. Error: [%s]", transferID, ctx.Err())
+			return
+		}
 	}
 }
 
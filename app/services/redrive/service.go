@@ -0,0 +1,280 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package redrive
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	qi "github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	big_numbers "github.com/limechain/hedera-eth-bridge-validator/app/helper/big-numbers"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/timestamp"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/tracing"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/schedule"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
+	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// batchSize is the max number of stuck transfers, re-driven in a single pass
+const batchSize = 100
+
+type Service struct {
+	transferRepository repository.Transfer
+	batchService       service.Batch
+	queue              qi.Queue
+	validator          bool
+	signers            []string
+	threshold          time.Duration
+	maxAttempts        int
+	logger             *log.Entry
+}
+
+// NewService creates the re-drive service. The signers are the addresses of the node,
+// whose signature messages mark the transfer as being processed. Transfers, added to a batch,
+// are re-driven by requeueing them in their batch instead of pushing them to the queue
+func NewService(cfg config.Redrive, validator bool, signers []string, transferRepository repository.Transfer, batchService service.Batch, queue qi.Queue) *Service {
+	return &Service{
+		transferRepository: transferRepository,
+		batchService:       batchService,
+		queue:              queue,
+		validator:          validator,
+		signers:            signers,
+		threshold:          cfg.Threshold * time.Second,
+		maxAttempts:        cfg.MaxAttempts,
+		logger:             config.GetLoggerFor("Redrive Service"),
+	}
+}
+
+func (s *Service) RedriveStuck() {
+	notAfter := time.Now().Add(-s.threshold)
+	transfers, err := s.transferRepository.GetStuck(notAfter, s.maxAttempts, s.signers, batchSize)
+	if err != nil {
+		s.logger.Errorf("Failed to get stuck transfers. Error: [%s]", err)
+		return
+	}
+
+	for _, t := range transfers {
+		batched, err := s.batchService.Batched(t.TransactionID)
+		if err != nil {
+			s.logger.Errorf("[%s] - Failed to re-drive transfer. Error: [%s]", t.TransactionID, err)
+			continue
+		}
+		err = s.redrive(t, batched, notAfter, status.ActorWatcher)
+		if err == service.ErrTransferRedrivenRecently {
+			s.logger.Debugf("[%s] - Already re-driven.", t.TransactionID)
+		} else if err != nil {
+			s.logger.Errorf("[%s] - Failed to re-drive transfer. Error: [%s]", t.TransactionID, err)
+		}
+	}
+}
+
+func (s *Service) Redrive(transferID string) error {
+	t, err := s.transferRepository.GetWithPreloads(transferID)
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to get transfer. Error: [%s]", transferID, err)
+		return err
+	}
+	if t == nil {
+		return service.ErrNotFound
+	}
+
+	if t.Status != status.Initial && t.Status != status.Failed {
+		return service.ErrTransferNotRedrivable
+	}
+	batched, err := s.batchService.Batched(transferID)
+	if err != nil {
+		return err
+	}
+	if s.inFlight(t, batched) {
+		return service.ErrTransferNotRedrivable
+	}
+	// Rejected transfers fail the same way every time they are processed
+	if t.FailureCode.IsRejection() {
+		return service.ErrTransferNotRedrivable
+	}
+
+	return s.redrive(t, batched, time.Now().Add(-s.threshold), status.ActorAdmin)
+}
+
+// redrive marks the transfer as re-driven and pushes it to its handler, or requeues it in its batch if it is batched.
+// The mark succeeds only if the transfer was not re-driven after `notAfter`, so concurrent re-drives of the same
+// transfer push it only once
+func (s *Service) redrive(t *entity.Transfer, batched bool, notAfter time.Time, actor string) error {
+	if batched {
+		return s.requeue(t, notAfter, actor)
+	}
+
+	topic, err := s.topic(t)
+	if err != nil {
+		return err
+	}
+	transfer, err := s.payload(t)
+	if err != nil {
+		return err
+	}
+
+	marked, err := s.transferRepository.MarkRedriven(t.TransactionID, t.Status, notAfter, actor)
+	if err != nil {
+		return err
+	}
+	if !marked {
+		return service.ErrTransferRedrivenRecently
+	}
+
+	// Re-driven transfers start a new trace, as the one in which they were discovered has ended long ago
+	ctx, span := tracing.Start(context.Background(), "redrive transfer", tracing.TransferAttributes(transfer)...)
+	span.SetAttributes(attribute.String("bridge.redrive.actor", actor))
+	defer span.End()
+
+	err = s.queue.Push(&queue.Message{Payload: transfer, Topic: topic, TraceContext: tracing.Inject(ctx)})
+	if err != nil {
+		return err
+	}
+	s.logger.Infof("[%s] - Re-driven to topic [%s].", t.TransactionID, topic)
+	return nil
+}
+
+// requeue marks the batched transfer as re-driven and makes it pending in its batch again. Pushing it to its handler
+// would process it from the start, e.g. minting the already minted amount of the transfer once more
+func (s *Service) requeue(t *entity.Transfer, notAfter time.Time, actor string) error {
+	marked, err := s.transferRepository.MarkRedriven(t.TransactionID, t.Status, notAfter, actor)
+	if err != nil {
+		return err
+	}
+	if !marked {
+		return service.ErrTransferRedrivenRecently
+	}
+
+	err = s.batchService.Requeue(t.TransactionID)
+	if err != nil {
+		return err
+	}
+	s.logger.Infof("[%s] - Re-driven to its batch.", t.TransactionID)
+	return nil
+}
+
+// inFlight returns whether a scheduled transaction or a signature of the node for the transfer is already submitted.
+// The mint of a batched transfer is executed before the transfer is added to its batch, so it is not taken into account
+func (s *Service) inFlight(t *entity.Transfer, batched bool) bool {
+	for _, sc := range t.Schedules {
+		if batched && sc.Operation == schedule.MINT {
+			continue
+		}
+		if sc.Status == status.Submitted || sc.Status == status.Completed {
+			return true
+		}
+	}
+	for _, fee := range t.Fees {
+		if fee.Status == status.Submitted || fee.Status == status.Completed {
+			return true
+		}
+	}
+	for _, message := range t.Messages {
+		for _, signer := range s.signers {
+			if message.Signer == signer {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// topic returns the topic, to which the watcher of the source chain pushes the transfer
+func (s *Service) topic(t *entity.Transfer) (string, error) {
+	nativeHedera := t.NativeChainID == constants.HederaNetworkId
+	toHedera := t.TargetChainID == constants.HederaNetworkId
+
+	if t.SourceChainID == constants.HederaNetworkId {
+		switch {
+		case nativeHedera && t.IsNft:
+			return s.pick(constants.HederaNativeNftTransfer, constants.ReadOnlyHederaNativeNftTransfer), nil
+		case nativeHedera:
+			return s.pick(constants.HederaTransferMessageSubmission, constants.ReadOnlyHederaFeeTransfer), nil
+		case t.IsNft:
+			return s.pick(constants.HederaBurnNftMessageSubmission, constants.ReadOnlyHederaBurnNft), nil
+		default:
+			return s.pick(constants.HederaBurnMessageSubmission, constants.ReadOnlyHederaBurn), nil
+		}
+	}
+
+	switch {
+	case t.IsNft && toHedera && nativeHedera:
+		return s.pick(constants.HederaNftTransfer, constants.ReadOnlyHederaUnlockNftTransfer), nil
+	case t.IsNft && toHedera && t.NativeChainID == t.SourceChainID:
+		return s.pick(constants.HederaMintNftTransfer, constants.ReadOnlyHederaMintNftTransfer), nil
+	case t.IsNft:
+		return "", service.ErrTransferNotRedrivable
+	case toHedera && nativeHedera:
+		return s.pick(constants.HederaFeeTransfer, constants.ReadOnlyHederaTransfer), nil
+	case toHedera:
+		return s.pick(constants.HederaMintHtsTransfer, constants.ReadOnlyHederaMintHtsTransfer), nil
+	default:
+		return s.pick(constants.TopicMessageSubmission, constants.ReadOnlyTransferSave), nil
+	}
+}
+
+func (s *Service) pick(validatorTopic, readOnlyTopic string) string {
+	if s.validator {
+		return validatorTopic
+	}
+	return readOnlyTopic
+}
+
+// payload rebuilds the payload, which the watcher of the source chain pushed for the transfer
+func (s *Service) payload(t *entity.Transfer) (*payload.Transfer, error) {
+	fromHedera := t.SourceChainID == constants.HederaNetworkId
+
+	var transfer *payload.Transfer
+	if t.IsNft {
+		fee := int64(0)
+		if fromHedera && t.NativeChainID == constants.HederaNetworkId {
+			// The fee for the validators is persisted once the transfer is processed, so it is unknown before that
+			parsed, err := strconv.ParseInt(t.Fee, 10, 64)
+			if err != nil {
+				return nil, service.ErrTransferNotRedrivable
+			}
+			fee = parsed
+		}
+		transfer = payload.NewNft(t.TransactionID, t.SourceChainID, t.TargetChainID, t.NativeChainID, t.Receiver, t.SourceAsset, t.TargetAsset, t.NativeAsset, t.SerialNumber, t.Metadata, fee)
+	} else {
+		amount, err := big_numbers.ToBigInt(t.Amount)
+		if err != nil {
+			return nil, service.ErrTransferNotRedrivable
+		}
+		transfer = payload.New(t.TransactionID, t.SourceChainID, t.TargetChainID, t.NativeChainID, t.Receiver, t.SourceAsset, t.TargetAsset, t.NativeAsset, amount)
+	}
+	transfer.Originator = t.Originator
+	transfer.Timestamp = t.Timestamp.Time
+
+	if !s.validator {
+		if fromHedera {
+			transfer.NetworkTimestamp = timestamp.String(t.Timestamp.UnixNano())
+		} else {
+			transfer.NetworkTimestamp = strconv.FormatInt(t.Timestamp.Unix(), 10)
+		}
+	}
+	return transfer, nil
+}
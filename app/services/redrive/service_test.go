@@ -0,0 +1,345 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package redrive
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/failure"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/schedule"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
+	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	s         = &Service{}
+	signer    = "0xsigner"
+	txId      = "0.0.1337-1650000000-000000001"
+	createdAt = time.Unix(1650000000, 1).UTC()
+)
+
+func Test_NewService(t *testing.T) {
+	setup()
+
+	actualService := NewService(config.Redrive{Threshold: 600, MaxAttempts: 3}, true, []string{signer}, mocks.MTransferRepository, mocks.MBatchService, mocks.MQueue)
+
+	assert.Equal(t, 600*time.Second, actualService.threshold)
+	assert.Equal(t, 3, actualService.maxAttempts)
+	assert.True(t, actualService.validator)
+	assert.Equal(t, []string{signer}, actualService.signers)
+}
+
+func Test_RedriveStuck(t *testing.T) {
+	setup()
+
+	stuck := []*entity.Transfer{hederaTransfer(), hederaTransfer()}
+	stuck[1].TransactionID = "0.0.1337-1650000000-000000002"
+	mocks.MTransferRepository.On("GetStuck", mock.Anything, 3, []string{signer}, batchSize).Return(stuck, nil)
+	mocks.MTransferRepository.On("MarkRedriven", stuck[0].TransactionID, status.Initial, mock.Anything, status.ActorWatcher).Return(true, nil)
+	mocks.MTransferRepository.On("MarkRedriven", stuck[1].TransactionID, status.Initial, mock.Anything, status.ActorWatcher).Return(false, nil)
+	mocks.MQueue.On("Push", mock.Anything).Return(nil)
+
+	s.RedriveStuck()
+
+	mocks.MQueue.AssertNumberOfCalls(t, "Push", 1)
+	mocks.MQueue.AssertCalled(t, "Push", &queue.Message{Payload: expectedPayload(), Topic: constants.HederaTransferMessageSubmission})
+}
+
+func Test_RedriveStuck_Batched(t *testing.T) {
+	setup()
+	mocks.MBatchService.ExpectedCalls = nil
+
+	mocks.MTransferRepository.On("GetStuck", mock.Anything, 3, []string{signer}, batchSize).Return([]*entity.Transfer{batchedTransfer()}, nil)
+	mocks.MBatchService.On("Batched", "0xbatched-1").Return(true, nil)
+	mocks.MTransferRepository.On("MarkRedriven", "0xbatched-1", status.Initial, mock.Anything, status.ActorWatcher).Return(true, nil)
+	mocks.MBatchService.On("Requeue", "0xbatched-1").Return(nil)
+
+	s.RedriveStuck()
+
+	mocks.MBatchService.AssertCalled(t, "Requeue", "0xbatched-1")
+	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
+}
+
+func Test_RedriveStuck_GetStuckFails(t *testing.T) {
+	setup()
+
+	mocks.MTransferRepository.On("GetStuck", mock.Anything, 3, []string{signer}, batchSize).Return(nil, errors.New("some-error"))
+
+	s.RedriveStuck()
+
+	mocks.MTransferRepository.AssertNotCalled(t, "MarkRedriven", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
+}
+
+func Test_Redrive(t *testing.T) {
+	setup()
+
+	tr := hederaTransfer()
+	tr.Status = status.Failed
+	mocks.MTransferRepository.On("GetWithPreloads", txId).Return(tr, nil)
+	mocks.MTransferRepository.On("MarkRedriven", txId, status.Failed, mock.Anything, status.ActorAdmin).Return(true, nil)
+	mocks.MQueue.On("Push", mock.Anything).Return(nil)
+
+	err := s.Redrive(txId)
+
+	assert.Nil(t, err)
+	mocks.MQueue.AssertCalled(t, "Push", &queue.Message{Payload: expectedPayload(), Topic: constants.HederaTransferMessageSubmission})
+}
+
+func Test_Redrive_NotFound(t *testing.T) {
+	setup()
+
+	mocks.MTransferRepository.On("GetWithPreloads", txId).Return((*entity.Transfer)(nil), nil)
+
+	err := s.Redrive(txId)
+
+	assert.Equal(t, service.ErrNotFound, err)
+}
+
+func Test_Redrive_TerminalStatus(t *testing.T) {
+	setup()
+
+	tr := hederaTransfer()
+	tr.Status = status.Completed
+	mocks.MTransferRepository.On("GetWithPreloads", txId).Return(tr, nil)
+
+	err := s.Redrive(txId)
+
+	assert.Equal(t, service.ErrTransferNotRedrivable, err)
+	mocks.MTransferRepository.AssertNotCalled(t, "MarkRedriven", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Redrive_InFlight(t *testing.T) {
+	setup()
+
+	signed := hederaTransfer()
+	signed.Messages = []entity.Message{{Signer: signer}}
+	scheduled := hederaTransfer()
+	scheduled.TransactionID = "0xscheduled"
+	scheduled.Schedules = []entity.Schedule{{Status: status.Submitted}}
+	mocks.MTransferRepository.On("GetWithPreloads", txId).Return(signed, nil)
+	mocks.MTransferRepository.On("GetWithPreloads", "0xscheduled").Return(scheduled, nil)
+
+	assert.Equal(t, service.ErrTransferNotRedrivable, s.Redrive(txId))
+	assert.Equal(t, service.ErrTransferNotRedrivable, s.Redrive("0xscheduled"))
+	mocks.MTransferRepository.AssertNotCalled(t, "MarkRedriven", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Redrive_BatchedWithCompletedMint(t *testing.T) {
+	setup()
+	mocks.MBatchService.ExpectedCalls = nil
+
+	tr := batchedTransfer()
+	tr.Status = status.Failed
+	tr.Schedules = []entity.Schedule{
+		{Operation: schedule.MINT, Status: status.Completed},
+		{Operation: schedule.TRANSFER, Status: status.Failed, HasReceiver: true},
+	}
+	mocks.MTransferRepository.On("GetWithPreloads", "0xbatched-1").Return(tr, nil)
+	mocks.MBatchService.On("Batched", "0xbatched-1").Return(true, nil)
+	mocks.MTransferRepository.On("MarkRedriven", "0xbatched-1", status.Failed, mock.Anything, status.ActorAdmin).Return(true, nil)
+	mocks.MBatchService.On("Requeue", "0xbatched-1").Return(nil)
+
+	err := s.Redrive("0xbatched-1")
+
+	assert.Nil(t, err)
+	mocks.MBatchService.AssertCalled(t, "Requeue", "0xbatched-1")
+	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
+}
+
+func Test_Redrive_BatchedInFlight(t *testing.T) {
+	setup()
+	mocks.MBatchService.ExpectedCalls = nil
+
+	tr := batchedTransfer()
+	tr.Schedules = []entity.Schedule{
+		{Operation: schedule.MINT, Status: status.Completed},
+		{Operation: schedule.TRANSFER, Status: status.Submitted, HasReceiver: true},
+	}
+	mocks.MTransferRepository.On("GetWithPreloads", "0xbatched-1").Return(tr, nil)
+	mocks.MBatchService.On("Batched", "0xbatched-1").Return(true, nil)
+
+	err := s.Redrive("0xbatched-1")
+
+	assert.Equal(t, service.ErrTransferNotRedrivable, err)
+	mocks.MTransferRepository.AssertNotCalled(t, "MarkRedriven", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Redrive_Rejected(t *testing.T) {
+	setup()
+
+	tr := hederaTransfer()
+	tr.Status = status.Failed
+	tr.FailureCode = failure.Blacklisted
+	mocks.MTransferRepository.On("GetWithPreloads", txId).Return(tr, nil)
+
+	err := s.Redrive(txId)
+
+	assert.Equal(t, service.ErrTransferNotRedrivable, err)
+	mocks.MTransferRepository.AssertNotCalled(t, "MarkRedriven", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Redrive_RedrivenRecently(t *testing.T) {
+	setup()
+
+	mocks.MTransferRepository.On("GetWithPreloads", txId).Return(hederaTransfer(), nil)
+	mocks.MTransferRepository.On("MarkRedriven", txId, status.Initial, mock.Anything, status.ActorAdmin).Return(false, nil)
+
+	err := s.Redrive(txId)
+
+	assert.Equal(t, service.ErrTransferRedrivenRecently, err)
+	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
+}
+
+func Test_Redrive_NativeNftWithoutFee(t *testing.T) {
+	setup()
+
+	tr := hederaTransfer()
+	tr.IsNft = true
+	mocks.MTransferRepository.On("GetWithPreloads", txId).Return(tr, nil)
+
+	err := s.Redrive(txId)
+
+	assert.Equal(t, service.ErrTransferNotRedrivable, err)
+	mocks.MTransferRepository.AssertNotCalled(t, "MarkRedriven", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Topic(t *testing.T) {
+	setup()
+
+	evm := uint64(80001)
+	otherEvm := uint64(3)
+	cases := []struct {
+		source, target, native uint64
+		isNft                  bool
+		validatorTopic         string
+		readOnlyTopic          string
+	}{
+		{constants.HederaNetworkId, evm, constants.HederaNetworkId, true, constants.HederaNativeNftTransfer, constants.ReadOnlyHederaNativeNftTransfer},
+		{constants.HederaNetworkId, evm, constants.HederaNetworkId, false, constants.HederaTransferMessageSubmission, constants.ReadOnlyHederaFeeTransfer},
+		{constants.HederaNetworkId, evm, evm, true, constants.HederaBurnNftMessageSubmission, constants.ReadOnlyHederaBurnNft},
+		{constants.HederaNetworkId, evm, evm, false, constants.HederaBurnMessageSubmission, constants.ReadOnlyHederaBurn},
+		{evm, constants.HederaNetworkId, constants.HederaNetworkId, true, constants.HederaNftTransfer, constants.ReadOnlyHederaUnlockNftTransfer},
+		{evm, constants.HederaNetworkId, evm, true, constants.HederaMintNftTransfer, constants.ReadOnlyHederaMintNftTransfer},
+		{evm, constants.HederaNetworkId, constants.HederaNetworkId, false, constants.HederaFeeTransfer, constants.ReadOnlyHederaTransfer},
+		{evm, constants.HederaNetworkId, otherEvm, false, constants.HederaMintHtsTransfer, constants.ReadOnlyHederaMintHtsTransfer},
+		{evm, otherEvm, evm, false, constants.TopicMessageSubmission, constants.ReadOnlyTransferSave},
+	}
+
+	for _, c := range cases {
+		tr := &entity.Transfer{SourceChainID: c.source, TargetChainID: c.target, NativeChainID: c.native, IsNft: c.isNft}
+
+		s.validator = true
+		topic, err := s.topic(tr)
+		assert.Nil(t, err)
+		assert.Equal(t, c.validatorTopic, topic)
+
+		s.validator = false
+		topic, err = s.topic(tr)
+		assert.Nil(t, err)
+		assert.Equal(t, c.readOnlyTopic, topic)
+	}
+
+	_, err := s.topic(&entity.Transfer{SourceChainID: evm, TargetChainID: otherEvm, NativeChainID: evm, IsNft: true})
+	assert.Equal(t, service.ErrTransferNotRedrivable, err)
+}
+
+func Test_Payload_ReadOnly(t *testing.T) {
+	setup()
+	s.validator = false
+
+	fromHedera, err := s.payload(hederaTransfer())
+	assert.Nil(t, err)
+	assert.Equal(t, "1650000000.000000001", fromHedera.NetworkTimestamp)
+
+	fromEvm := hederaTransfer()
+	fromEvm.SourceChainID = 80001
+	fromEvm.Timestamp = entity.NanoTime{Time: time.Unix(1650000000, 0).UTC()}
+	actual, err := s.payload(fromEvm)
+	assert.Nil(t, err)
+	assert.Equal(t, "1650000000", actual.NetworkTimestamp)
+}
+
+func hederaTransfer() *entity.Transfer {
+	return &entity.Transfer{
+		TransactionID: txId,
+		SourceChainID: constants.HederaNetworkId,
+		TargetChainID: 80001,
+		NativeChainID: constants.HederaNetworkId,
+		SourceAsset:   constants.Hbar,
+		TargetAsset:   "0xwrapped",
+		NativeAsset:   constants.Hbar,
+		Receiver:      "0xreceiver",
+		Amount:        "100",
+		Status:        status.Initial,
+		Timestamp:     entity.NanoTime{Time: createdAt},
+		Originator:    "0.0.1337",
+	}
+}
+
+// batchedTransfer returns a transfer of a wrapped asset to Hedera, minted and added to a batch
+func batchedTransfer() *entity.Transfer {
+	return &entity.Transfer{
+		TransactionID: "0xbatched-1",
+		SourceChainID: 80001,
+		TargetChainID: constants.HederaNetworkId,
+		NativeChainID: 80001,
+		SourceAsset:   "0xnative",
+		TargetAsset:   "0.0.22222",
+		NativeAsset:   "0xnative",
+		Receiver:      "0.0.1337",
+		Amount:        "100",
+		Status:        status.Initial,
+		Timestamp:     entity.NanoTime{Time: createdAt},
+		Originator:    "0xoriginator",
+	}
+}
+
+func expectedPayload() *payload.Transfer {
+	p := payload.New(txId, constants.HederaNetworkId, 80001, constants.HederaNetworkId, "0xreceiver", constants.Hbar, "0xwrapped", constants.Hbar, big.NewInt(100))
+	p.Originator = "0.0.1337"
+	p.Timestamp = createdAt
+	return p
+}
+
+func setup() {
+	mocks.Setup()
+
+	mocks.MBatchService.On("Batched", mock.Anything).Return(false, nil)
+
+	s = &Service{
+		transferRepository: mocks.MTransferRepository,
+		batchService:       mocks.MBatchService,
+		queue:              mocks.MQueue,
+		validator:          true,
+		signers:            []string{signer},
+		threshold:          600 * time.Second,
+		maxAttempts:        3,
+		logger:             config.GetLoggerFor("Redrive Service"),
+	}
+}
@@ -0,0 +1,370 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package refund
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/gookit/event"
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	qi "github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	util "github.com/limechain/hedera-eth-bridge-validator/app/helper/fee"
+	hederaHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/hedera"
+	bridge_config_event "github.com/limechain/hedera-eth-bridge-validator/app/model/bridge-config-event"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/failure"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/schedule"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
+	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
+	"github.com/limechain/hedera-eth-bridge-validator/app/services/fee/distributor"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	log "github.com/sirupsen/logrus"
+)
+
+type Service struct {
+	bridgeAccount      hedera.AccountID
+	feePercentage      int64
+	transferRepository repository.Transfer
+	scheduleRepository repository.Schedule
+	feeRepository      repository.Fee
+	distributorService service.Distributor
+	scheduledService   service.Scheduled
+	mirrorNode         client.MirrorNode
+	queue              qi.Queue
+	logger             *log.Entry
+}
+
+func NewService(
+	bridgeAccount string,
+	feePercentage int64,
+	transferRepository repository.Transfer,
+	scheduleRepository repository.Schedule,
+	feeRepository repository.Fee,
+	distributor service.Distributor,
+	scheduled service.Scheduled,
+	mirrorNode client.MirrorNode,
+	queue qi.Queue) *Service {
+
+	bridgeAcc, err := hedera.AccountIDFromString(bridgeAccount)
+	if err != nil {
+		log.Fatalf("Invalid bridge account: [%s].", bridgeAccount)
+	}
+	if feePercentage < constants.FeeMinPercentage || feePercentage > constants.FeeMaxPercentage {
+		log.Fatalf("Invalid refund fee percentage: [%d]", feePercentage)
+	}
+
+	instance := &Service{
+		bridgeAccount:      bridgeAcc,
+		feePercentage:      feePercentage,
+		transferRepository: transferRepository,
+		scheduleRepository: scheduleRepository,
+		feeRepository:      feeRepository,
+		distributorService: distributor,
+		scheduledService:   scheduled,
+		mirrorNode:         mirrorNode,
+		queue:              queue,
+		logger:             config.GetLoggerFor("Refund Service"),
+	}
+	event.On(constants.EventBridgeConfigUpdate, event.ListenerFunc(func(e event.Event) error {
+		return bridgeCfgUpdateEventHandler(e, instance)
+	}), constants.ServiceEventPriority)
+
+	return instance
+}
+
+// refundID returns the ID of the refund of the given transfer. It is used as memo of the refund scheduled transactions,
+// so that they differ from the scheduled transactions of the transfer itself
+func refundID(transferID string) string {
+	return fmt.Sprintf("%s-refund", transferID)
+}
+
+func (s *Service) OnDeliveryFailed(transferID, transactionID, asset string, amount *big.Int) {
+	code, reason := s.failure(transactionID)
+
+	err := s.scheduleRepository.Fail(transactionID, code, reason, status.ActorHandler)
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to update schedule status failed. Error [%s].", transactionID, err)
+	}
+
+	err = s.transferRepository.Fail(transferID, code, reason, status.ActorHandler)
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to update status failed. Error [%s].", transferID, err)
+		return
+	}
+
+	if !code.IsRefundable() {
+		return
+	}
+	s.logger.Infof("[%s] - Receiver cannot accept the transfer with [%s].", transferID, code)
+	// The refund is handled from the queue, so that it is attempted again if it fails
+	err = s.queue.Push(&queue.Message{
+		Payload: &payload.Transfer{
+			TransactionId: transferID,
+			SourceAsset:   asset,
+			Amount:        amount,
+		},
+		Topic: constants.HederaRefund,
+	})
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to push refund. Error [%s].", transferID, err)
+	}
+}
+
+func (s *Service) Refund(ctx context.Context, transferID, asset string, amount *big.Int) error {
+	t, err := s.transferRepository.GetByTransactionId(transferID)
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to get transfer. Error [%s].", transferID, err)
+		return err
+	}
+	if t == nil {
+		s.logger.Errorf("[%s] - Transfer not found.", transferID)
+		return service.ErrNotFound
+	}
+	if t.Status != status.Failed {
+		s.logger.Warnf("[%s] - Skipping refund of transfer with status [%s].", transferID, t.Status)
+		return nil
+	}
+
+	originator, err := s.originatorAccount(t)
+	if errors.Is(err, service.ErrOriginatorWithoutHederaAccount) {
+		// The transfer is left failed, as attempting the refund again would not succeed either
+		s.logger.Warnf("[%s] - Rejecting refund, as originator [%s] has no Hedera account.", transferID, t.Originator)
+		return nil
+	}
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to resolve the Hedera account of originator [%s]. Error [%s].", transferID, t.Originator, err)
+		return err
+	}
+
+	total, err := hederaHelper.Amount(amount)
+	if err != nil {
+		s.logger.Errorf("[%s] - Invalid refund amount. Error [%s].", transferID, err)
+		return err
+	}
+
+	fee, transfers, err := s.prepareTransfers(total, originator)
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to prepare refund transfers. Error [%s].", transferID, err)
+		return err
+	}
+
+	splitTransfers := distributor.SplitAccountAmounts(transfers,
+		transfer.Hedera{
+			AccountID: s.bridgeAccount,
+			Amount:    -total,
+		})
+
+	s.logger.Infof("[%s] - Refunding [%d] of [%s] to [%s] with fee [%d].", transferID, total-fee, asset, originator, fee)
+	for _, splitTransfer := range splitTransfers {
+		feeAmount, hasOriginator := util.TotalFeeFromTransfers(splitTransfer, originator)
+		onExecutionSuccess, onExecutionFail := s.scheduledTxExecutionCallbacks(transferID, feeAmount, hasOriginator)
+		onSuccess, onFail := s.scheduledTxMinedCallbacks(transferID, feeAmount, hasOriginator)
+
+		s.scheduledService.ExecuteScheduledTransferTransaction(ctx, refundID(transferID), asset, splitTransfer, onExecutionSuccess, onExecutionFail, onSuccess, onFail)
+	}
+	return nil
+}
+
+// failure returns the code and the reason, for which the scheduled transaction has failed, as found on the mirror node
+func (s *Service) failure(transactionID string) (failure.Code, string) {
+	response, err := s.mirrorNode.GetTransaction(transactionID)
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to get scheduled transaction result. Error [%s].", transactionID, err)
+		return failure.ScheduledTransactionFailed, fmt.Sprintf("scheduled transaction [%s] failed", transactionID)
+	}
+
+	for _, tx := range response.Transactions {
+		if tx.Scheduled {
+			return failure.FromHederaResult(tx.Result), fmt.Sprintf("scheduled transaction [%s] resolved with [%s]", transactionID, tx.Result)
+		}
+	}
+	return failure.ScheduledTransactionFailed, fmt.Sprintf("scheduled transaction [%s] failed", transactionID)
+}
+
+// prepareTransfers returns the valid fee and the positive transfers to the members and the originator of the given total
+func (s *Service) prepareTransfers(total int64, originator hedera.AccountID) (fee int64, transfers []transfer.Hedera, err error) {
+	validFee := new(big.Int).Mul(big.NewInt(total), big.NewInt(s.feePercentage))
+	validFee = s.distributorService.ValidAmount(validFee.Quo(validFee, big.NewInt(constants.FeeMaxPercentage)))
+
+	fee, err = hederaHelper.Amount(validFee)
+	if err != nil {
+		return 0, nil, err
+	}
+	if fee > 0 {
+		transfers, err = s.distributorService.CalculateMemberDistribution(fee)
+		if err != nil {
+			return 0, nil, err
+		}
+	}
+
+	transfers = append(transfers,
+		transfer.Hedera{
+			AccountID: originator,
+			Amount:    total - fee,
+		})
+
+	return fee, transfers, nil
+}
+
+// originatorAccount returns the Hedera account of the originator of the transfer.
+// Originators on EVM chains are refunded only to an existing Hedera account with their EVM address, as found on the mirror node
+func (s *Service) originatorAccount(t *entity.Transfer) (hedera.AccountID, error) {
+	if t.Originator == "" {
+		return hedera.AccountID{}, errors.New("unknown originator")
+	}
+	if t.SourceChainID == constants.HederaNetworkId {
+		return hedera.AccountIDFromString(t.Originator)
+	}
+
+	response, err := s.mirrorNode.GetAccount(strings.TrimPrefix(t.Originator, "0x"))
+	if errors.Is(err, client.ErrNotFound) {
+		return hedera.AccountID{}, service.ErrOriginatorWithoutHederaAccount
+	}
+	if err != nil {
+		return hedera.AccountID{}, err
+	}
+	if response == nil || response.Account == "" {
+		return hedera.AccountID{}, service.ErrOriginatorWithoutHederaAccount
+	}
+	return hedera.AccountIDFromString(response.Account)
+}
+
+func (s *Service) scheduledTxExecutionCallbacks(transferID, feeAmount string, hasOriginator bool) (onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail func(transactionID string)) {
+	onExecutionSuccess = func(transactionID, scheduleID string) {
+		s.logger.Debugf("[%s] - Updating db status of refund to Submitted with TransactionID [%s].", transferID, transactionID)
+		err := s.scheduleRepository.Create(&entity.Schedule{
+			ScheduleID:    scheduleID,
+			Operation:     schedule.REFUND,
+			TransactionID: transactionID,
+			HasReceiver:   hasOriginator,
+			Status:        status.Submitted,
+			TransferID: sql.NullString{
+				String: transferID,
+				Valid:  true,
+			},
+		})
+		if err != nil {
+			s.logger.Errorf(
+				"[%s] - Failed to update submitted refund status with TransactionID [%s], ScheduleID [%s]. Error [%s].",
+				transferID, transactionID, scheduleID, err)
+			return
+		}
+
+		if feeAmount == "0" {
+			return
+		}
+		err = s.feeRepository.Create(&entity.Fee{
+			TransactionID: transactionID,
+			ScheduleID:    scheduleID,
+			Amount:        feeAmount,
+			Status:        status.Submitted,
+			TransferID: sql.NullString{
+				String: transferID,
+				Valid:  true,
+			},
+		})
+		if err != nil {
+			s.logger.Errorf("[%s] - Failed to create refund Fee Record [%s]. Error [%s].", transactionID, transferID, err)
+		}
+	}
+
+	onExecutionFail = func(transactionID string) {
+		err := s.scheduleRepository.Create(&entity.Schedule{
+			TransactionID: transactionID,
+			Operation:     schedule.REFUND,
+			Status:        status.Failed,
+			HasReceiver:   hasOriginator,
+			TransferID: sql.NullString{
+				String: transferID,
+				Valid:  true,
+			},
+		})
+		if err != nil {
+			s.logger.Errorf("[%s] - Failed to update refund status failed. Error [%s].", transferID, err)
+		}
+	}
+
+	return onExecutionSuccess, onExecutionFail
+}
+
+func (s *Service) scheduledTxMinedCallbacks(transferID, feeAmount string, hasOriginator bool) (onSuccess, onFail func(transactionID string)) {
+	onSuccess = func(transactionID string) {
+		s.logger.Debugf("[%s] - Scheduled refund TX [%s] execution successful.", transferID, transactionID)
+
+		err := s.scheduleRepository.UpdateStatusCompleted(transactionID)
+		if err != nil {
+			s.logger.Errorf("[%s] - Failed to update refund status completed. Error [%s].", transactionID, err)
+		}
+
+		if feeAmount != "0" {
+			err = s.feeRepository.UpdateStatusCompleted(transactionID)
+			if err != nil {
+				s.logger.Errorf("[%s] Fee - Failed to update status completed. Error [%s].", transactionID, err)
+			}
+		}
+
+		if !hasOriginator {
+			return
+		}
+		err = s.transferRepository.UpdateStatus(transferID, status.Refunded, status.ActorHandler, fmt.Sprintf("refunded to the originator in [%s]", transactionID))
+		if err != nil {
+			s.logger.Errorf("[%s] - Failed to update status refunded. Error [%s].", transferID, err)
+		}
+	}
+
+	onFail = func(transactionID string) {
+		s.logger.Errorf("[%s] - Scheduled refund TX [%s] execution has failed.", transferID, transactionID)
+
+		err := s.scheduleRepository.UpdateStatusFailed(transactionID)
+		if err != nil {
+			s.logger.Errorf("[%s] - Failed to update refund status failed. Error [%s].", transactionID, err)
+		}
+
+		if feeAmount != "0" {
+			err = s.feeRepository.UpdateStatusFailed(transactionID)
+			if err != nil {
+				s.logger.Errorf("[%s] Fee - Failed to update status failed. Error [%s].", transactionID, err)
+			}
+		}
+	}
+
+	return onSuccess, onFail
+}
+
+func bridgeCfgUpdateEventHandler(e event.Event, instance *Service) error {
+	params, ok := e.Get(constants.BridgeConfigUpdateEventParamsKey).(*bridge_config_event.Params)
+	if !ok {
+		errMsg := fmt.Sprintf("failed to cast params from event [%s]", constants.EventBridgeConfigUpdate)
+		log.Errorf(errMsg)
+		return errors.New(errMsg)
+	}
+
+	instance.feePercentage = params.Bridge.Hedera.RefundFeePercentage
+
+	return nil
+}
@@ -0,0 +1,327 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package refund
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/account"
+	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/transaction"
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/failure"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/schedule"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
+	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	s             = &Service{}
+	bridgeAccount = hedera.AccountID{Account: 222222}
+	memberAccount = hedera.AccountID{Account: 333333}
+	originator    = hedera.AccountID{Account: 1337}
+	token         = "0.0.22222"
+	transferId    = "0.0.1337-1650000000-000000001"
+	txId          = "0.0.123123-123123-321321"
+	scheduleId    = "0.0.666666"
+	evmOriginator = "7a3f3d3c9b6a2fbd7ad21e7e2a4a3c0b6d8b1f00"
+)
+
+func Test_New(t *testing.T) {
+	setup()
+
+	actualService := NewService(bridgeAccount.String(), 5000, mocks.MTransferRepository, mocks.MScheduleRepository,
+		mocks.MFeeRepository, mocks.MDistributorService, mocks.MScheduledService, mocks.MHederaMirrorClient, mocks.MQueue)
+
+	assert.Equal(t, bridgeAccount, actualService.bridgeAccount)
+	assert.Equal(t, int64(5000), actualService.feePercentage)
+}
+
+func Test_Refund(t *testing.T) {
+	setup()
+
+	mocks.MTransferRepository.On("GetByTransactionId", transferId).Return(failedTransfer(), nil)
+	mocks.MDistributorService.On("ValidAmount", big.NewInt(0)).Return(big.NewInt(0))
+	mocks.MScheduledService.On("ExecuteScheduledTransferTransaction", mock.Anything, transferId+"-refund", token, mock.Anything).Return()
+
+	s.Refund(context.Background(), transferId, token, big.NewInt(100))
+
+	mocks.MScheduledService.AssertCalled(t, "ExecuteScheduledTransferTransaction", mock.Anything, transferId+"-refund", token, []transfer.Hedera{
+		{AccountID: originator, Amount: 100},
+		{AccountID: bridgeAccount, Amount: -100},
+	})
+	mocks.MDistributorService.AssertNotCalled(t, "CalculateMemberDistribution", mock.Anything)
+}
+
+func Test_Refund_WithFee(t *testing.T) {
+	setup()
+	s.feePercentage = 10000
+
+	mocks.MTransferRepository.On("GetByTransactionId", transferId).Return(failedTransfer(), nil)
+	mocks.MDistributorService.On("ValidAmount", big.NewInt(10)).Return(big.NewInt(10))
+	mocks.MDistributorService.On("CalculateMemberDistribution", int64(10)).Return([]transfer.Hedera{{AccountID: memberAccount, Amount: 10}}, nil)
+	mocks.MScheduledService.On("ExecuteScheduledTransferTransaction", mock.Anything, transferId+"-refund", token, mock.Anything).Return()
+
+	s.Refund(context.Background(), transferId, token, big.NewInt(100))
+
+	mocks.MScheduledService.AssertCalled(t, "ExecuteScheduledTransferTransaction", mock.Anything, transferId+"-refund", token, []transfer.Hedera{
+		{AccountID: memberAccount, Amount: 10},
+		{AccountID: originator, Amount: 90},
+		{AccountID: bridgeAccount, Amount: -100},
+	})
+}
+
+func Test_Refund_NotFailed(t *testing.T) {
+	setup()
+
+	refunded := failedTransfer()
+	refunded.Status = status.Refunded
+	mocks.MTransferRepository.On("GetByTransactionId", transferId).Return(refunded, nil)
+
+	s.Refund(context.Background(), transferId, token, big.NewInt(100))
+
+	mocks.MScheduledService.AssertNotCalled(t, "ExecuteScheduledTransferTransaction", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Refund_AmountExceedsLimit(t *testing.T) {
+	setup()
+
+	mocks.MTransferRepository.On("GetByTransactionId", transferId).Return(failedTransfer(), nil)
+
+	s.Refund(context.Background(), transferId, token, new(big.Int).Lsh(big.NewInt(1), 64))
+
+	mocks.MScheduledService.AssertNotCalled(t, "ExecuteScheduledTransferTransaction", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Refund_GetTransferFails(t *testing.T) {
+	setup()
+
+	mocks.MTransferRepository.On("GetByTransactionId", transferId).Return(nil, errors.New("some-error"))
+
+	s.Refund(context.Background(), transferId, token, big.NewInt(100))
+
+	mocks.MScheduledService.AssertNotCalled(t, "ExecuteScheduledTransferTransaction", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Refund_EvmOriginator(t *testing.T) {
+	setup()
+
+	mocks.MTransferRepository.On("GetByTransactionId", transferId).Return(evmFailedTransfer(), nil)
+	mocks.MHederaMirrorClient.On("GetAccount", evmOriginator).Return(&account.AccountsResponse{Account: originator.String()}, nil)
+	mocks.MDistributorService.On("ValidAmount", big.NewInt(0)).Return(big.NewInt(0))
+	mocks.MScheduledService.On("ExecuteScheduledTransferTransaction", mock.Anything, transferId+"-refund", token, mock.Anything).Return()
+
+	err := s.Refund(context.Background(), transferId, token, big.NewInt(100))
+
+	assert.Nil(t, err)
+	mocks.MScheduledService.AssertCalled(t, "ExecuteScheduledTransferTransaction", mock.Anything, transferId+"-refund", token, []transfer.Hedera{
+		{AccountID: originator, Amount: 100},
+		{AccountID: bridgeAccount, Amount: -100},
+	})
+}
+
+func Test_Refund_EvmOriginatorWithoutHederaAccount(t *testing.T) {
+	setup()
+
+	mocks.MTransferRepository.On("GetByTransactionId", transferId).Return(evmFailedTransfer(), nil)
+	mocks.MHederaMirrorClient.On("GetAccount", evmOriginator).Return((*account.AccountsResponse)(nil), fmt.Errorf("%w: account", client.ErrNotFound))
+
+	err := s.Refund(context.Background(), transferId, token, big.NewInt(100))
+
+	assert.Nil(t, err)
+	mocks.MScheduledService.AssertNotCalled(t, "ExecuteScheduledTransferTransaction", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mocks.MTransferRepository.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Refund_EvmOriginatorMirrorNodeFails(t *testing.T) {
+	setup()
+
+	mocks.MTransferRepository.On("GetByTransactionId", transferId).Return(evmFailedTransfer(), nil)
+	mocks.MHederaMirrorClient.On("GetAccount", evmOriginator).Return((*account.AccountsResponse)(nil), errors.New("some-error"))
+
+	err := s.Refund(context.Background(), transferId, token, big.NewInt(100))
+
+	assert.EqualError(t, err, "some-error")
+	mocks.MScheduledService.AssertNotCalled(t, "ExecuteScheduledTransferTransaction", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_OnDeliveryFailed_Refunds(t *testing.T) {
+	setup()
+
+	reason := "scheduled transaction [" + txId + "] resolved with [TOKEN_NOT_ASSOCIATED_TO_ACCOUNT]"
+	mocks.MHederaMirrorClient.On("GetTransaction", txId).Return(scheduledResponse("TOKEN_NOT_ASSOCIATED_TO_ACCOUNT"), nil)
+	mocks.MScheduleRepository.On("Fail", txId, failure.ReceiverNotAssociated, reason, status.ActorHandler).Return(nil)
+	mocks.MTransferRepository.On("Fail", transferId, failure.ReceiverNotAssociated, reason, status.ActorHandler).Return(nil)
+	mocks.MQueue.On("Push", mock.Anything).Return(nil)
+
+	s.OnDeliveryFailed(transferId, txId, token, big.NewInt(100))
+
+	mocks.MTransferRepository.AssertCalled(t, "Fail", transferId, failure.ReceiverNotAssociated, reason, status.ActorHandler)
+	mocks.MQueue.AssertCalled(t, "Push", &queue.Message{
+		Payload: &payload.Transfer{TransactionId: transferId, SourceAsset: token, Amount: big.NewInt(100)},
+		Topic:   constants.HederaRefund,
+	})
+}
+
+func Test_OnDeliveryFailed_FailNotRefunded(t *testing.T) {
+	setup()
+
+	mocks.MHederaMirrorClient.On("GetTransaction", txId).Return(scheduledResponse("TOKEN_NOT_ASSOCIATED_TO_ACCOUNT"), nil)
+	mocks.MScheduleRepository.On("Fail", txId, failure.ReceiverNotAssociated, mock.Anything, status.ActorHandler).Return(nil)
+	mocks.MTransferRepository.On("Fail", transferId, failure.ReceiverNotAssociated, mock.Anything, status.ActorHandler).Return(errors.New("some-error"))
+
+	s.OnDeliveryFailed(transferId, txId, token, big.NewInt(100))
+
+	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
+}
+
+func Test_OnDeliveryFailed_NotRefundable(t *testing.T) {
+	setup()
+
+	mocks.MHederaMirrorClient.On("GetTransaction", txId).Return(scheduledResponse("INSUFFICIENT_TOKEN_BALANCE"), nil)
+	mocks.MScheduleRepository.On("Fail", txId, failure.InsufficientBridgeBalance, mock.Anything, status.ActorHandler).Return(nil)
+	mocks.MTransferRepository.On("Fail", transferId, failure.InsufficientBridgeBalance, mock.Anything, status.ActorHandler).Return(nil)
+
+	s.OnDeliveryFailed(transferId, txId, token, big.NewInt(100))
+
+	mocks.MQueue.AssertNotCalled(t, "Push", mock.Anything)
+	mocks.MScheduledService.AssertNotCalled(t, "ExecuteScheduledTransferTransaction", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_OnDeliveryFailed_MirrorNodeFails(t *testing.T) {
+	setup()
+
+	mocks.MHederaMirrorClient.On("GetTransaction", txId).Return(&transaction.Response{}, errors.New("some-error"))
+	mocks.MScheduleRepository.On("Fail", txId, failure.ScheduledTransactionFailed, mock.Anything, status.ActorHandler).Return(nil)
+	mocks.MTransferRepository.On("Fail", transferId, failure.ScheduledTransactionFailed, mock.Anything, status.ActorHandler).Return(nil)
+
+	s.OnDeliveryFailed(transferId, txId, token, big.NewInt(100))
+
+	mocks.MTransferRepository.AssertCalled(t, "Fail", transferId, failure.ScheduledTransactionFailed, mock.Anything, status.ActorHandler)
+	mocks.MScheduledService.AssertNotCalled(t, "ExecuteScheduledTransferTransaction", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_ScheduledTxExecutionSuccessCallback(t *testing.T) {
+	setup()
+
+	mocks.MScheduleRepository.On("Create", &entity.Schedule{
+		ScheduleID:    scheduleId,
+		Operation:     schedule.REFUND,
+		TransactionID: txId,
+		HasReceiver:   true,
+		Status:        status.Submitted,
+		TransferID:    sql.NullString{String: transferId, Valid: true},
+	}).Return(nil)
+	mocks.MFeeRepository.On("Create", &entity.Fee{
+		TransactionID: txId,
+		ScheduleID:    scheduleId,
+		Amount:        "10",
+		Status:        status.Submitted,
+		TransferID:    sql.NullString{String: transferId, Valid: true},
+	}).Return(nil)
+
+	onExecutionSuccess, _ := s.scheduledTxExecutionCallbacks(transferId, "10", true)
+	onExecutionSuccess(txId, scheduleId)
+
+	mocks.MScheduleRepository.AssertNumberOfCalls(t, "Create", 1)
+	mocks.MFeeRepository.AssertNumberOfCalls(t, "Create", 1)
+}
+
+func Test_ScheduledTxMinedSuccessCallback(t *testing.T) {
+	setup()
+
+	mocks.MScheduleRepository.On("UpdateStatusCompleted", txId).Return(nil)
+	mocks.MTransferRepository.On("UpdateStatus", transferId, status.Refunded, status.ActorHandler, mock.Anything).Return(nil)
+
+	onSuccess, _ := s.scheduledTxMinedCallbacks(transferId, "0", true)
+	onSuccess(txId)
+
+	mocks.MTransferRepository.AssertCalled(t, "UpdateStatus", transferId, status.Refunded, status.ActorHandler, mock.Anything)
+	mocks.MFeeRepository.AssertNotCalled(t, "UpdateStatusCompleted", txId)
+}
+
+func Test_ScheduledTxMinedFailCallback(t *testing.T) {
+	setup()
+
+	mocks.MScheduleRepository.On("UpdateStatusFailed", txId).Return(nil)
+	mocks.MFeeRepository.On("UpdateStatusFailed", txId).Return(nil)
+
+	_, onFail := s.scheduledTxMinedCallbacks(transferId, "10", true)
+	onFail(txId)
+
+	mocks.MFeeRepository.AssertCalled(t, "UpdateStatusFailed", txId)
+	mocks.MTransferRepository.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func failedTransfer() *entity.Transfer {
+	return &entity.Transfer{
+		TransactionID: transferId,
+		SourceChainID: constants.HederaNetworkId,
+		TargetChainID: 80001,
+		SourceAsset:   token,
+		Amount:        "100",
+		Status:        status.Failed,
+		FailureCode:   failure.InvalidMemo,
+		Originator:    originator.String(),
+	}
+}
+
+func evmFailedTransfer() *entity.Transfer {
+	t := failedTransfer()
+	t.SourceChainID = 80001
+	t.TargetChainID = constants.HederaNetworkId
+	t.Originator = "0x" + evmOriginator
+	return t
+}
+
+func scheduledResponse(result string) *transaction.Response {
+	return &transaction.Response{
+		Transactions: []transaction.Transaction{
+			{Result: "SUCCESS"},
+			{Result: result, Scheduled: true},
+		},
+	}
+}
+
+func setup() {
+	mocks.Setup()
+
+	s = &Service{
+		bridgeAccount:      bridgeAccount,
+		transferRepository: mocks.MTransferRepository,
+		scheduleRepository: mocks.MScheduleRepository,
+		feeRepository:      mocks.MFeeRepository,
+		distributorService: mocks.MDistributorService,
+		scheduledService:   mocks.MScheduledService,
+		mirrorNode:         mocks.MHederaMirrorClient,
+		queue:              mocks.MQueue,
+		logger:             config.GetLoggerFor("Refund Service"),
+	}
+}
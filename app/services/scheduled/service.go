@@ -1,7 +1,9 @@
 package scheduled
 
 import (
+	"context"
 	"fmt"
+	"math/big"
 	"strings"
 
 	"github.com/hashgraph/hedera-sdk-go/v2"
@@ -40,10 +42,11 @@ func New(
 
 // ExecuteScheduledTransferTransaction submits a scheduled transaction and executes provided functions when necessary
 func (s *Service) ExecuteScheduledTransferTransaction(
+	ctx context.Context,
 	id, nativeAsset string,
 	transfers []transfer.Hedera,
 	onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string)) {
-	transactionResponse, err := s.executeScheduledTransfersTransaction(id, nativeAsset, transfers)
+	transactionResponse, err := s.executeScheduledTransfersTransaction(ctx, id, nativeAsset, transfers)
 	if err != nil {
 		if transactionResponse != nil {
 			onExecutionFail(hederahelper.ToMirrorNodeTransactionID(transactionResponse.TransactionID.String()))
@@ -53,7 +56,7 @@ func (s *Service) ExecuteScheduledTransferTransaction(
 		}
 		return
 	}
-	err = s.createOrSignScheduledTransaction(transactionResponse, id, onExecutionSuccess, onExecutionFail, onSuccess, onFail)
+	err = s.createOrSignScheduledTransaction(ctx, transactionResponse, id, onExecutionSuccess, onExecutionFail, onSuccess, onFail)
 	if err != nil {
 		s.logger.Errorf("[%s] - Failed to create/sign scheduled transfer transaction. Error [%s].", id, err)
 		return
@@ -62,9 +65,10 @@ func (s *Service) ExecuteScheduledTransferTransaction(
 
 // ExecuteScheduledNftTransferTransaction submits a scheduled nft transaction and executes provided functions when necessary
 func (s *Service) ExecuteScheduledNftTransferTransaction(
+	ctx context.Context,
 	id string, nftID hedera.NftID, sender hedera.AccountID, receiving hedera.AccountID, approved bool,
 	onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string)) {
-	transactionResponse, err := s.hederaNodeClient.SubmitScheduledNftTransferTransaction(nftID, s.payerAccount, sender, receiving, id, approved)
+	transactionResponse, err := s.hederaNodeClient.SubmitScheduledNftTransferTransaction(ctx, nftID, s.payerAccount, sender, receiving, id, approved)
 	if err != nil {
 		if transactionResponse != nil {
 			onExecutionFail(hederahelper.ToMirrorNodeTransactionID(transactionResponse.TransactionID.String()))
@@ -74,7 +78,7 @@ func (s *Service) ExecuteScheduledNftTransferTransaction(
 		}
 		return
 	}
-	err = s.createOrSignScheduledTransaction(transactionResponse, id, onExecutionSuccess, onExecutionFail, onSuccess, onFail)
+	err = s.createOrSignScheduledTransaction(ctx, transactionResponse, id, onExecutionSuccess, onExecutionFail, onSuccess, onFail)
 	if err != nil {
 		s.logger.Errorf("[%s] - Failed to create/sign scheduled transfer transaction. Error [%s].", id, err)
 		return
@@ -82,9 +86,10 @@ func (s *Service) ExecuteScheduledNftTransferTransaction(
 }
 
 func (s *Service) ExecuteScheduledNftAllowTransaction(
+	ctx context.Context,
 	id string, nftID hedera.NftID, owner hedera.AccountID, spender hedera.AccountID,
 	onExecutionSuccess func(txId, scheduleId string), onExecutionFail, onSuccess, onFail func(txId string)) {
-	tx, err := s.hederaNodeClient.SubmitScheduledNftApproveTransaction(s.payerAccount, id, nftID, owner, spender)
+	tx, err := s.hederaNodeClient.SubmitScheduledNftApproveTransaction(ctx, s.payerAccount, id, nftID, owner, spender)
 	if err != nil {
 		s.logger.Errorf("[%s] - Failed to submit scheduled nft approve transaction. Error [%s].", id, err)
 		if tx != nil {
@@ -92,21 +97,65 @@ func (s *Service) ExecuteScheduledNftAllowTransaction(
 		}
 		return
 	}
-	err = s.createOrSignScheduledTransaction(tx, id, onExecutionSuccess, onExecutionFail, onSuccess, onFail)
+	err = s.createOrSignScheduledTransaction(ctx, tx, id, onExecutionSuccess, onExecutionFail, onSuccess, onFail)
 	if err != nil {
 		s.logger.Errorf("[%s] - Failed to create/sign scheduled nft approve transaction. Error [%s].", id, err)
 		return
 	}
 }
 
-func (s *Service) executeScheduledTransfersTransaction(id, nativeAsset string, transfers []transfer.Hedera) (*hedera.TransactionResponse, error) {
+// ExecuteScheduledNftMintTransaction submits a scheduled mint transaction of a single NFT and executes provided functions when necessary
+func (s *Service) ExecuteScheduledNftMintTransaction(
+	ctx context.Context,
+	id string, tokenID hedera.TokenID, metadata []byte,
+	onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string)) {
+	transactionResponse, err := s.hederaNodeClient.SubmitScheduledNftMintTransaction(ctx, tokenID, metadata, s.payerAccount, id)
+	if err != nil {
+		if transactionResponse != nil {
+			onExecutionFail(hederahelper.ToMirrorNodeTransactionID(transactionResponse.TransactionID.String()))
+			s.logger.Errorf("[%s] - Failed to submit scheduled nft mint transaction at Node Account [%s]. Error [%s].", id, transactionResponse.NodeID.String(), err)
+		} else {
+			s.logger.Errorf("[%s] - Failed to submit scheduled nft mint transaction. Error [%s].", id, err)
+		}
+		return
+	}
+	err = s.createOrSignScheduledTransaction(ctx, transactionResponse, id, onExecutionSuccess, onExecutionFail, onSuccess, onFail)
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to create/sign scheduled nft mint transaction. Error [%s].", id, err)
+		return
+	}
+}
+
+// ExecuteScheduledNftBurnTransaction submits a scheduled burn transaction of a single NFT and executes provided functions when necessary
+func (s *Service) ExecuteScheduledNftBurnTransaction(
+	ctx context.Context,
+	id string, nftID hedera.NftID,
+	onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string)) {
+	transactionResponse, err := s.hederaNodeClient.SubmitScheduledNftBurnTransaction(ctx, nftID, s.payerAccount, id)
+	if err != nil {
+		if transactionResponse != nil {
+			onExecutionFail(hederahelper.ToMirrorNodeTransactionID(transactionResponse.TransactionID.String()))
+			s.logger.Errorf("[%s] - Failed to submit scheduled nft burn transaction at Node Account [%s]. Error [%s].", id, transactionResponse.NodeID.String(), err)
+		} else {
+			s.logger.Errorf("[%s] - Failed to submit scheduled nft burn transaction. Error [%s].", id, err)
+		}
+		return
+	}
+	err = s.createOrSignScheduledTransaction(ctx, transactionResponse, id, onExecutionSuccess, onExecutionFail, onSuccess, onFail)
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to create/sign scheduled nft burn transaction. Error [%s].", id, err)
+		return
+	}
+}
+
+func (s *Service) executeScheduledTransfersTransaction(ctx context.Context, id, nativeAsset string, transfers []transfer.Hedera) (*hedera.TransactionResponse, error) {
 	var tokenID hedera.TokenID
 	var transactionResponse *hedera.TransactionResponse
 	var err error
 
 	if nativeAsset == constants.Hbar {
 		transactionResponse, err = s.hederaNodeClient.
-			SubmitScheduledHbarTransferTransaction(transfers, s.payerAccount, id)
+			SubmitScheduledHbarTransferTransaction(ctx, transfers, s.payerAccount, id)
 	} else {
 		tokenID, err = hedera.TokenIDFromString(nativeAsset)
 		if err != nil {
@@ -114,13 +163,13 @@ func (s *Service) executeScheduledTransfersTransaction(id, nativeAsset string, t
 			return nil, err
 		}
 		transactionResponse, err = s.hederaNodeClient.
-			SubmitScheduledTokenTransferTransaction(tokenID, transfers, s.payerAccount, id)
+			SubmitScheduledTokenTransferTransaction(ctx, tokenID, transfers, s.payerAccount, id)
 	}
 	return transactionResponse, err
 }
 
-func (s *Service) ExecuteScheduledMintTransaction(id, asset string, amount int64, status *chan string, onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string)) {
-	transactionResponse, err := s.executeScheduledTokenMintTransaction(id, asset, amount)
+func (s *Service) ExecuteScheduledMintTransaction(ctx context.Context, id, asset string, amount *big.Int, status *chan string, onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string)) {
+	transactionResponse, err := s.executeScheduledTokenMintTransaction(ctx, id, asset, amount)
 	if err != nil {
 		if transactionResponse != nil {
 			onExecutionFail(hederahelper.ToMirrorNodeTransactionID(transactionResponse.TransactionID.String()))
@@ -132,7 +181,7 @@ func (s *Service) ExecuteScheduledMintTransaction(id, asset string, amount int64
 		return
 	}
 
-	err = s.createOrSignScheduledTransaction(transactionResponse, id, onExecutionSuccess, onExecutionFail, onSuccess, onFail)
+	err = s.createOrSignScheduledTransaction(ctx, transactionResponse, id, onExecutionSuccess, onExecutionFail, onSuccess, onFail)
 	if err != nil {
 		s.logger.Errorf("[%s] - Failed to create/sign scheduled mint transaction. Error [%s].", id, err)
 		*status <- sync.FAIL
@@ -140,8 +189,8 @@ func (s *Service) ExecuteScheduledMintTransaction(id, asset string, amount int64
 	}
 }
 
-func (s *Service) ExecuteScheduledBurnTransaction(id, asset string, amount int64, status *chan string, onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string)) {
-	transactionResponse, err := s.executeScheduledTokenBurnTransaction(id, asset, amount)
+func (s *Service) ExecuteScheduledBurnTransaction(ctx context.Context, id, asset string, amount *big.Int, status *chan string, onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string)) {
+	transactionResponse, err := s.executeScheduledTokenBurnTransaction(ctx, id, asset, amount)
 	if err != nil {
 		if transactionResponse != nil {
 			onExecutionFail(hederahelper.ToMirrorNodeTransactionID(transactionResponse.TransactionID.String()))
@@ -153,7 +202,7 @@ func (s *Service) ExecuteScheduledBurnTransaction(id, asset string, amount int64
 		return
 	}
 
-	err = s.createOrSignScheduledTransaction(transactionResponse, id, onExecutionSuccess, onExecutionFail, onSuccess, onFail)
+	err = s.createOrSignScheduledTransaction(ctx, transactionResponse, id, onExecutionSuccess, onExecutionFail, onSuccess, onFail)
 	if err != nil {
 		s.logger.Errorf("[%s] - Failed to create/sign scheduled burn transaction. Error [%s].", id, err)
 		*status <- sync.FAIL
@@ -161,7 +210,7 @@ func (s *Service) ExecuteScheduledBurnTransaction(id, asset string, amount int64
 	}
 }
 
-func (s *Service) executeScheduledTokenMintTransaction(id, asset string, amount int64) (*hedera.TransactionResponse, error) {
+func (s *Service) executeScheduledTokenMintTransaction(ctx context.Context, id, asset string, amount *big.Int) (*hedera.TransactionResponse, error) {
 	var tokenID hedera.TokenID
 	var transactionResponse *hedera.TransactionResponse
 	var err error
@@ -172,13 +221,19 @@ func (s *Service) executeScheduledTokenMintTransaction(id, asset string, amount
 		return nil, err
 	}
 
+	hederaAmount, err := hederahelper.Amount(amount)
+	if err != nil {
+		s.logger.Errorf("[%s] - Invalid amount [%s]. Error [%s].", id, amount, err)
+		return nil, err
+	}
+
 	transactionResponse, err = s.hederaNodeClient.
-		SubmitScheduledTokenMintTransaction(tokenID, amount, s.payerAccount, id)
+		SubmitScheduledTokenMintTransaction(ctx, tokenID, hederaAmount, s.payerAccount, id)
 
 	return transactionResponse, err
 }
 
-func (s *Service) executeScheduledTokenBurnTransaction(id string, asset string, amount int64) (*hedera.TransactionResponse, error) {
+func (s *Service) executeScheduledTokenBurnTransaction(ctx context.Context, id string, asset string, amount *big.Int) (*hedera.TransactionResponse, error) {
 	var tokenID hedera.TokenID
 	var transactionResponse *hedera.TransactionResponse
 	var err error
@@ -189,13 +244,19 @@ func (s *Service) executeScheduledTokenBurnTransaction(id string, asset string,
 		return nil, err
 	}
 
+	hederaAmount, err := hederahelper.Amount(amount)
+	if err != nil {
+		s.logger.Errorf("[%s] - Invalid amount [%s]. Error [%s].", id, amount, err)
+		return nil, err
+	}
+
 	transactionResponse, err = s.hederaNodeClient.
-		SubmitScheduledTokenBurnTransaction(tokenID, amount, s.payerAccount, id)
+		SubmitScheduledTokenBurnTransaction(ctx, tokenID, hederaAmount, s.payerAccount, id)
 
 	return transactionResponse, err
 }
 
-func (s *Service) createOrSignScheduledTransaction(transactionResponse *hedera.TransactionResponse, id string, onExecutionSuccess func(transactionID string, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string)) error {
+func (s *Service) createOrSignScheduledTransaction(ctx context.Context, transactionResponse *hedera.TransactionResponse, id string, onExecutionSuccess func(transactionID string, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string)) error {
 	scheduledTxID := hederahelper.ToMirrorNodeTransactionID(transactionResponse.TransactionID.String())
 	s.logger.Infof("[%s] - Successfully submitted scheduled transaction [%s].",
 		id,
@@ -210,7 +271,7 @@ func (s *Service) createOrSignScheduledTransaction(transactionResponse *hedera.T
 
 	switch txReceipt.Status {
 	case hedera.StatusIdenticalScheduleAlreadyCreated:
-		s.handleScheduleSign(id, *txReceipt.ScheduleID)
+		s.handleScheduleSign(ctx, id, *txReceipt.ScheduleID)
 	case hedera.StatusSuccess:
 	default:
 		txID := hederahelper.ToMirrorNodeTransactionID(transactionResponse.TransactionID.String())
@@ -227,17 +288,18 @@ func (s *Service) createOrSignScheduledTransaction(transactionResponse *hedera.T
 		onSuccess(transactionID)
 	}
 
-	onMinedFail := func() {
+	onMinedFail := func(result string) {
+		s.logger.Errorf("[%s] - TX [%s] - Scheduled Transaction mined with [%s].", id, transactionID, result)
 		onFail(transactionID)
 	}
 
-	go s.mirrorNodeClient.WaitForScheduledTransaction(transactionID, onMinedSuccess, onMinedFail)
+	go s.mirrorNodeClient.WaitForScheduledTransaction(ctx, transactionID, onMinedSuccess, onMinedFail)
 	return nil
 }
 
-func (s *Service) handleScheduleSign(id string, scheduleID hedera.ScheduleID) {
+func (s *Service) handleScheduleSign(ctx context.Context, id string, scheduleID hedera.ScheduleID) {
 	s.logger.Debugf("[%s] - Scheduled transaction already created - Executing Scheduled Sign for [%s].", id, scheduleID)
-	txResponse, err := s.hederaNodeClient.SubmitScheduleSign(scheduleID)
+	txResponse, err := s.hederaNodeClient.SubmitScheduleSign(ctx, scheduleID)
 
 	if err != nil {
 		if txResponse != nil {
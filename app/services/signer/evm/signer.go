@@ -17,9 +17,12 @@
 package evm
 
 import (
+	"context"
 	"crypto/ecdsa"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/keystore"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
 	log "github.com/sirupsen/logrus"
 	"math/big"
 )
@@ -36,8 +39,22 @@ func NewEVMSigner(privateKey string) *Signer {
 	return &Signer{privateKey: pk}
 }
 
-func (s *Signer) Sign(msg []byte) ([]byte, error) {
-	signature, err := crypto.Sign(msg, s.privateKey)
+// NewEVMSignerFromKeystore creates a signer with the key decrypted from the go-ethereum V3 keystore JSON
+func NewEVMSignerFromKeystore(cfg config.Keystore) *Signer {
+	password, err := keystore.Password(cfg.PasswordEnv, cfg.PasswordFile)
+	if err != nil {
+		log.Fatalf("Failed to read password of EVM Keystore [%s]. Error: [%s]", cfg.Path, err)
+	}
+
+	pk, err := keystore.LoadEVMKey(cfg.Path, password)
+	if err != nil {
+		log.Fatalf("Failed to load EVM Keystore [%s]. Error: [%s]", cfg.Path, err)
+	}
+	return &Signer{privateKey: pk}
+}
+
+func (s *Signer) Sign(_ context.Context, msg []byte) ([]byte, error) {
+	signature, err := crypto.Sign(crypto.Keccak256(msg), s.privateKey)
 	if err != nil {
 		return nil, err
 	}
@@ -17,12 +17,16 @@
 package evm
 
 import (
+	"context"
 	"crypto/ecdsa"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/keystore"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/stretchr/testify/assert"
 	"math/big"
+	"path/filepath"
 	"testing"
 )
 
@@ -34,12 +38,17 @@ func mockSigner() (service.Signer, *ecdsa.PrivateKey) {
 }
 
 func Test_Sign(t *testing.T) {
-	s, _ := mockSigner()
+	s, pk := mockSigner()
 
 	msg := []byte("12345678123456781234567812345678")
-	res, err := s.Sign(msg)
+	res, err := s.Sign(context.Background(), msg)
 	assert.Empty(t, err)
 	assert.NotEmpty(t, res)
+
+	res[64] -= 27
+	publicKey, err := crypto.SigToPub(crypto.Keccak256(msg), res)
+	assert.Nil(t, err)
+	assert.Equal(t, crypto.PubkeyToAddress(pk.PublicKey), crypto.PubkeyToAddress(*publicKey))
 }
 
 func TestSigner_NewKeyTransactor(t *testing.T) {
@@ -56,3 +65,14 @@ func Test_Address(t *testing.T) {
 	addr := crypto.PubkeyToAddress(pk.PublicKey).String()
 	assert.Equal(t, s.Address(), addr)
 }
+
+func Test_NewEVMSignerFromKeystore(t *testing.T) {
+	pk, _ := crypto.GenerateKey()
+	path := filepath.Join(t.TempDir(), "keystore.json")
+	_ = keystore.StoreEVMKey(path, "password", pk)
+	t.Setenv("EVM_KEYSTORE_PASSWORD", "password")
+
+	s := NewEVMSignerFromKeystore(config.Keystore{Path: path, PasswordEnv: "EVM_KEYSTORE_PASSWORD"})
+
+	assert.Equal(t, crypto.PubkeyToAddress(pk.PublicKey).String(), s.Address())
+}
@@ -0,0 +1,152 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package remote
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+)
+
+// JSON-RPC methods supported by the remote signer
+const (
+	ethAccounts = "eth_accounts"
+)
+
+// signPath is the Web3Signer endpoint signing the Keccak-256 hash of the given data. Unlike `eth_sign`,
+// it does not apply the EIP-191 prefix, which would break transaction signatures
+const signPath = "/api/v1/eth1/sign/%s"
+
+type signRequest struct {
+	Data string `json:"data"`
+}
+
+type Request struct {
+	JsonRpc string        `json:"jsonrpc"`
+	Method  string        `json:"method"`
+	Params  []interface{} `json:"params"`
+	Id      uint64        `json:"id"`
+}
+
+type Response struct {
+	JsonRpc string          `json:"jsonrpc"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *Error          `json:"error,omitempty"`
+	Id      uint64          `json:"id"`
+}
+
+type Error struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("remote signer error [%d]: %s", e.Code, e.Message)
+}
+
+type rpcClient struct {
+	url        string
+	httpClient *http.Client
+	id         uint64
+}
+
+func newRpcClient(url string, httpClient *http.Client) *rpcClient {
+	return &rpcClient{
+		url:        url,
+		httpClient: httpClient,
+	}
+}
+
+// call executes the JSON-RPC method and decodes its result into the given value
+func (c *rpcClient) call(ctx context.Context, method string, result interface{}, params ...interface{}) error {
+	if params == nil {
+		params = []interface{}{}
+	}
+
+	body, err := json.Marshal(Request{
+		JsonRpc: "2.0",
+		Method:  method,
+		Params:  params,
+		Id:      atomic.AddUint64(&c.id, 1),
+	})
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	httpResponse, err := c.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer httpResponse.Body.Close()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return fmt.Errorf("remote signer responded with status [%d]", httpResponse.StatusCode)
+	}
+
+	response := &Response{}
+	err = json.NewDecoder(httpResponse.Body).Decode(response)
+	if err != nil {
+		return err
+	}
+	if response.Error != nil {
+		return response.Error
+	}
+
+	return json.Unmarshal(response.Result, result)
+}
+
+// sign requests a signature of the hash of the hex encoded data from the signing endpoint, returning the hex encoded signature
+func (c *rpcClient) sign(ctx context.Context, address, data string) (string, error) {
+	body, err := json.Marshal(signRequest{Data: data})
+	if err != nil {
+		return "", err
+	}
+
+	url := strings.TrimSuffix(c.url, "/") + fmt.Sprintf(signPath, address)
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	httpResponse, err := c.httpClient.Do(request)
+	if err != nil {
+		return "", err
+	}
+	defer httpResponse.Body.Close()
+
+	result, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return "", err
+	}
+	if httpResponse.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("remote signer responded with status [%d]: %s", httpResponse.StatusCode, strings.TrimSpace(string(result)))
+	}
+
+	return strings.TrimSpace(string(result)), nil
+}
@@ -0,0 +1,244 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package remote
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+)
+
+// ErrSignatureMismatch is returned when the signature returned by the remote signer does not recover to the signer address.
+// This is the case when the remote signer prefixes the message or signs it as it is, instead of its hash
+var ErrSignatureMismatch = errors.New("signature does not match the remote signer address")
+
+// Signer signs messages through a remote Web3Signer compatible signer, so that the private key
+// never resides on the validator host. Web3Signer signs only the Keccak-256 hash of the given data,
+// so the signer sends the messages, whose hashes are to be signed, and never the digests themselves
+type Signer struct {
+	client  *rpcClient
+	address common.Address
+	timeout time.Duration
+	logger  *log.Entry
+}
+
+func NewRemoteSigner(cfg config.RemoteSigner) *Signer {
+	tlsConfig, err := NewTLSConfig(cfg.CaFile, cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		log.Fatalf("Failed to load TLS configuration of remote signer [%s]. Error: [%s]", cfg.Url, err)
+	}
+
+	httpClient := &http.Client{
+		Transport: &http.Transport{TLSClientConfig: tlsConfig},
+	}
+
+	s, err := newSigner(cfg.Url, cfg.Address, httpClient, cfg.Timeout*time.Second)
+	if err != nil {
+		log.Fatalf("Failed to initialise remote signer [%s]. Error: [%s]", cfg.Url, err)
+	}
+	return s
+}
+
+// newSigner creates a signer and verifies that the remote signer manages the given address.
+// Every request to the remote signer is bound by the given timeout
+func newSigner(url, address string, httpClient *http.Client, timeout time.Duration) (*Signer, error) {
+	if !common.IsHexAddress(address) {
+		return nil, fmt.Errorf("invalid address [%s]", address)
+	}
+
+	s := &Signer{
+		client:  newRpcClient(url, httpClient),
+		address: common.HexToAddress(address),
+		timeout: timeout,
+		logger:  config.GetLoggerFor(fmt.Sprintf("Remote Signer [%s]", address)),
+	}
+
+	var accounts []string
+	err := s.call(context.Background(), ethAccounts, &accounts)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, account := range accounts {
+		if common.IsHexAddress(account) && common.HexToAddress(account) == s.address {
+			return s, nil
+		}
+	}
+	return nil, fmt.Errorf("address [%s] is not managed by the remote signer", address)
+}
+
+// NewTLSConfig loads the CA used to verify the remote signer and the client certificate presented to it.
+// Empty paths are skipped, falling back to the system CAs and no client certificate respectively
+func NewTLSConfig(caFile, certFile, keyFile string) (*tls.Config, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if caFile != "" {
+		ca, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, err
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, fmt.Errorf("no certificates found in [%s]", caFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if certFile != "" || keyFile != "" {
+		certificate, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	return tlsConfig, nil
+}
+
+// Sign signs the Keccak-256 hash of the message, returning a signature with a recovery id of 27 or 28
+func (s *Signer) Sign(ctx context.Context, msg []byte) ([]byte, error) {
+	signature, err := s.signMessage(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	// note: https://github.com/ethereum/go-ethereum/issues/19751
+	signature[64] += 27
+
+	return signature, nil
+}
+
+func (s *Signer) NewKeyTransactor(chainId *big.Int) (*bind.TransactOpts, error) {
+	if chainId == nil {
+		return nil, bind.ErrNoChainID
+	}
+
+	txSigner := types.LatestSignerForChainID(chainId)
+	opts := &bind.TransactOpts{
+		From:    s.address,
+		Context: context.Background(),
+	}
+	// The signing request is bound by the context the caller sets on the transactor
+	opts.Signer = func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+		if address != s.address {
+			return nil, bind.ErrNotAuthorized
+		}
+
+		message, err := signingMessage(txSigner, tx)
+		if err != nil {
+			return nil, err
+		}
+		signature, err := s.signMessage(opts.Context, message)
+		if err != nil {
+			return nil, err
+		}
+		return tx.WithSignature(txSigner, signature)
+	}
+	return opts, nil
+}
+
+func (s *Signer) Address() string {
+	return s.address.String()
+}
+
+// signMessage requests a signature of the Keccak-256 hash of the message, returning it with a recovery id of 0 or 1.
+// The signature is verified to recover to the signer address
+func (s *Signer) signMessage(ctx context.Context, message []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	result, err := s.client.sign(ctx, s.address.String(), hexutil.Encode(message))
+	if err != nil {
+		s.logger.Errorf("Failed to sign message [%s]. Error: [%s]", hexutil.Encode(message), err)
+		return nil, err
+	}
+
+	signature, err := hexutil.Decode(result)
+	if err != nil {
+		return nil, err
+	}
+	if len(signature) != crypto.SignatureLength {
+		return nil, fmt.Errorf("invalid signature length [%d]", len(signature))
+	}
+	if signature[64] >= 27 {
+		signature[64] -= 27
+	}
+
+	publicKey, err := crypto.SigToPub(crypto.Keccak256(message), signature)
+	if err != nil {
+		return nil, err
+	}
+	if crypto.PubkeyToAddress(*publicKey) != s.address {
+		return nil, ErrSignatureMismatch
+	}
+
+	return signature, nil
+}
+
+// signingMessage returns the RLP encoded fields of the transaction, whose Keccak-256 hash is signed by the given signer
+func signingMessage(txSigner types.Signer, tx *types.Transaction) ([]byte, error) {
+	chainId := txSigner.ChainID()
+
+	var fields []interface{}
+	switch tx.Type() {
+	case types.LegacyTxType:
+		fields = []interface{}{tx.Nonce(), tx.GasPrice(), tx.Gas(), tx.To(), tx.Value(), tx.Data(), chainId, uint(0), uint(0)}
+	case types.AccessListTxType:
+		fields = []interface{}{chainId, tx.Nonce(), tx.GasPrice(), tx.Gas(), tx.To(), tx.Value(), tx.Data(), tx.AccessList()}
+	case types.DynamicFeeTxType:
+		fields = []interface{}{chainId, tx.Nonce(), tx.GasTipCap(), tx.GasFeeCap(), tx.Gas(), tx.To(), tx.Value(), tx.Data(), tx.AccessList()}
+	default:
+		return nil, fmt.Errorf("unsupported transaction type [%d]", tx.Type())
+	}
+
+	message, err := rlp.EncodeToBytes(fields)
+	if err != nil {
+		return nil, err
+	}
+	if tx.Type() != types.LegacyTxType {
+		message = append([]byte{tx.Type()}, message...)
+	}
+
+	// The message must hash to what the transaction signer recovers the sender from
+	if crypto.Keccak256Hash(message) != txSigner.Hash(tx) {
+		return nil, fmt.Errorf("unsupported signing scheme of transaction type [%d]", tx.Type())
+	}
+	return message, nil
+}
+
+// call executes the JSON-RPC method, giving up once the context is done or the configured timeout elapses
+func (s *Signer) call(ctx context.Context, method string, result interface{}, params ...interface{}) error {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	return s.client.call(ctx, method, result, params...)
+}
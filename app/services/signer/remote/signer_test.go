@@ -0,0 +1,337 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package remote
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	auth_message "github.com/limechain/hedera-eth-bridge-validator/app/model/auth-message"
+	remote_signer "github.com/limechain/hedera-eth-bridge-validator/test/remote-signer"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	message = []byte("message")
+	timeout = 5 * time.Second
+)
+
+func setup(t *testing.T) (*Signer, *ecdsa.PrivateKey, *httptest.Server) {
+	key, _ := crypto.GenerateKey()
+	server := httptest.NewTLSServer(remote_signer.NewStub(key))
+	t.Cleanup(server.Close)
+
+	s, err := newSigner(server.URL, crypto.PubkeyToAddress(key.PublicKey).String(), server.Client(), timeout)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s, key, server
+}
+
+func Test_NewSigner_UnknownAddress(t *testing.T) {
+	_, _, server := setup(t)
+	other, _ := crypto.GenerateKey()
+
+	s, err := newSigner(server.URL, crypto.PubkeyToAddress(other.PublicKey).String(), server.Client(), timeout)
+
+	assert.Nil(t, s)
+	assert.Error(t, err)
+}
+
+func Test_NewSigner_InvalidAddress(t *testing.T) {
+	_, _, server := setup(t)
+
+	s, err := newSigner(server.URL, "invalid", server.Client(), timeout)
+
+	assert.Nil(t, s)
+	assert.Error(t, err)
+}
+
+func Test_Sign(t *testing.T) {
+	s, key, _ := setup(t)
+
+	signature, err := s.Sign(context.Background(), message)
+	assert.Nil(t, err)
+
+	expected, _ := crypto.Sign(crypto.Keccak256(message), key)
+	expected[64] += 27
+	assert.Equal(t, expected, signature)
+}
+
+func Test_Sign_AuthorisationMessage(t *testing.T) {
+	s, key, _ := setup(t)
+	authMsg, _ := auth_message.EncodeFungibleMessageFrom(1, 2, "0.0.123-123321-123321", "0x0000000000000000000000000000000000000001", "0x0000000000000000000000000000000000000002", "100")
+	authMsgHash, _ := auth_message.EncodeFungibleBytesFrom(1, 2, "0.0.123-123321-123321", "0x0000000000000000000000000000000000000001", "0x0000000000000000000000000000000000000002", "100")
+
+	signature, err := s.Sign(context.Background(), authMsg)
+	assert.Nil(t, err)
+
+	signature[64] -= 27
+	publicKey, err := crypto.SigToPub(authMsgHash, signature)
+	assert.Nil(t, err)
+	assert.Equal(t, crypto.PubkeyToAddress(key.PublicKey), crypto.PubkeyToAddress(*publicKey))
+}
+
+func Test_Sign_SignatureMismatch(t *testing.T) {
+	s, _, _ := setup(t)
+	other, _ := crypto.GenerateKey()
+	server := httptest.NewTLSServer(mismatchingSigner(other))
+	defer server.Close()
+	s.client = newRpcClient(server.URL, server.Client())
+
+	signature, err := s.Sign(context.Background(), message)
+
+	assert.Nil(t, signature)
+	assert.ErrorIs(t, err, ErrSignatureMismatch)
+}
+
+func Test_Sign_PrefixingSigner(t *testing.T) {
+	s, key, _ := setup(t)
+	server := httptest.NewTLSServer(prefixingSigner(key))
+	defer server.Close()
+	s.client = newRpcClient(server.URL, server.Client())
+
+	signature, err := s.Sign(context.Background(), message)
+
+	assert.Nil(t, signature)
+	assert.ErrorIs(t, err, ErrSignatureMismatch)
+}
+
+func Test_Sign_StubEthSignPrefixes(t *testing.T) {
+	s, key, _ := setup(t)
+
+	var result string
+	err := s.call(context.Background(), "eth_sign", &result, s.Address(), hexutil.Encode(message))
+	assert.Nil(t, err)
+
+	expected, _ := crypto.Sign(accounts.TextHash(message), key)
+	expected[64] += 27
+	assert.Equal(t, hexutil.Encode(expected), result)
+
+	signature, err := s.Sign(context.Background(), message)
+	assert.Nil(t, err)
+	assert.NotEqual(t, hexutil.Encode(signature), result)
+}
+
+func Test_Sign_Timeout(t *testing.T) {
+	s, _, _ := setup(t)
+	unblock := make(chan struct{})
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-unblock
+	}))
+	defer server.Close()
+	defer close(unblock)
+	s.client = newRpcClient(server.URL, server.Client())
+	s.timeout = 10 * time.Millisecond
+
+	signature, err := s.Sign(context.Background(), message)
+
+	assert.Nil(t, signature)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func Test_Sign_Cancelled(t *testing.T) {
+	s, _, _ := setup(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	signature, err := s.Sign(ctx, message)
+
+	assert.Nil(t, signature)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func Test_NewKeyTransactor(t *testing.T) {
+	s, key, _ := setup(t)
+	chainId := big.NewInt(80001)
+
+	opts, err := s.NewKeyTransactor(chainId)
+	assert.Nil(t, err)
+	assert.Equal(t, crypto.PubkeyToAddress(key.PublicKey), opts.From)
+
+	tx := types.NewTransaction(1, common.Address{}, big.NewInt(1), 21000, big.NewInt(1), nil)
+	signedTx, err := opts.Signer(opts.From, tx)
+	assert.Nil(t, err)
+
+	sender, err := types.Sender(types.LatestSignerForChainID(chainId), signedTx)
+	assert.Nil(t, err)
+	assert.Equal(t, opts.From, sender)
+}
+
+func Test_NewKeyTransactor_TypedTransactions(t *testing.T) {
+	s, _, _ := setup(t)
+	chainId := big.NewInt(80001)
+	to := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	accessList := types.AccessList{{Address: to, StorageKeys: []common.Hash{{1}}}}
+
+	opts, _ := s.NewKeyTransactor(chainId)
+	for _, tx := range []*types.Transaction{
+		types.NewTx(&types.AccessListTx{ChainID: chainId, Nonce: 1, GasPrice: big.NewInt(1), Gas: 21000, To: &to, Value: big.NewInt(1), AccessList: accessList}),
+		types.NewTx(&types.DynamicFeeTx{ChainID: chainId, Nonce: 1, GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(2), Gas: 21000, To: &to, Value: big.NewInt(1), Data: []byte{1}}),
+		types.NewTx(&types.DynamicFeeTx{ChainID: chainId, Nonce: 2, GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(2), Gas: 100000, Data: []byte{1, 2}, AccessList: accessList}),
+	} {
+		signedTx, err := opts.Signer(opts.From, tx)
+		assert.Nil(t, err)
+
+		sender, err := types.Sender(types.LatestSignerForChainID(chainId), signedTx)
+		assert.Nil(t, err)
+		assert.Equal(t, opts.From, sender)
+	}
+}
+
+func Test_NewKeyTransactor_NotAuthorized(t *testing.T) {
+	s, _, _ := setup(t)
+
+	opts, _ := s.NewKeyTransactor(big.NewInt(80001))
+	tx := types.NewTransaction(1, common.Address{}, big.NewInt(1), 21000, big.NewInt(1), nil)
+	signedTx, err := opts.Signer(common.Address{}, tx)
+
+	assert.Nil(t, signedTx)
+	assert.Error(t, err)
+}
+
+func Test_Address(t *testing.T) {
+	s, key, _ := setup(t)
+
+	assert.Equal(t, crypto.PubkeyToAddress(key.PublicKey).String(), s.Address())
+}
+
+func Test_MutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	caFile, certFile, keyFile := generateCertificates(t, dir)
+	key, _ := crypto.GenerateKey()
+
+	clientCa, _ := os.ReadFile(caFile)
+	pool := x509.NewCertPool()
+	pool.AppendCertsFromPEM(clientCa)
+	server := httptest.NewUnstartedServer(remote_signer.NewStub(key))
+	server.TLS = &tls.Config{ClientCAs: pool, ClientAuth: tls.RequireAndVerifyClientCert}
+	serverCertificate, _ := tls.LoadX509KeyPair(certFile, keyFile)
+	server.TLS.Certificates = []tls.Certificate{serverCertificate}
+	server.StartTLS()
+	defer server.Close()
+
+	tlsConfig, err := NewTLSConfig(caFile, certFile, keyFile)
+	assert.Nil(t, err)
+	address := crypto.PubkeyToAddress(key.PublicKey).String()
+
+	s, err := newSigner(server.URL, address, &http.Client{Transport: &http.Transport{TLSClientConfig: tlsConfig}}, timeout)
+	assert.Nil(t, err)
+	assert.NotNil(t, s)
+
+	withoutCertificate, _ := NewTLSConfig(caFile, "", "")
+	s, err = newSigner(server.URL, address, &http.Client{Transport: &http.Transport{TLSClientConfig: withoutCertificate}}, timeout)
+	assert.Nil(t, s)
+	assert.Error(t, err)
+}
+
+func Test_NewTLSConfig_MissingFiles(t *testing.T) {
+	_, err := NewTLSConfig("missing-ca.pem", "", "")
+	assert.Error(t, err)
+
+	_, err = NewTLSConfig("", "missing-cert.pem", "missing-key.pem")
+	assert.Error(t, err)
+}
+
+// mismatchingSigner signs every message with the given key, regardless of the requested account
+func mismatchingSigner(key *ecdsa.PrivateKey) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		signature, _ := crypto.Sign(crypto.Keccak256(message), key)
+		_, _ = w.Write([]byte(hexutil.Encode(signature)))
+	}
+}
+
+// prefixingSigner signs every message with the EIP-191 prefix applied, as `eth_sign` does
+func prefixingSigner(key *ecdsa.PrivateKey) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		request := &signRequest{}
+		_ = json.NewDecoder(r.Body).Decode(request)
+		data, _ := hexutil.Decode(request.Data)
+		signature, _ := crypto.Sign(accounts.TextHash(data), key)
+		_, _ = w.Write([]byte(hexutil.Encode(signature)))
+	}
+}
+
+// generateCertificates creates a CA and a certificate signed by it, used by both the server and the client
+func generateCertificates(t *testing.T, dir string) (caFile, certFile, keyFile string) {
+	caKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDer, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	certTemplate := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		DNSNames:     []string{"localhost"},
+	}
+	certDer, err := x509.CreateCertificate(rand.Reader, certTemplate, caTemplate, &certKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, _ := x509.MarshalECPrivateKey(certKey)
+
+	caFile = filepath.Join(dir, "ca.pem")
+	certFile = filepath.Join(dir, "cert.pem")
+	keyFile = filepath.Join(dir, "key.pem")
+	writePem(t, caFile, "CERTIFICATE", caDer)
+	writePem(t, certFile, "CERTIFICATE", certDer)
+	writePem(t, keyFile, "EC PRIVATE KEY", keyDer)
+	return caFile, certFile, keyFile
+}
+
+func writePem(t *testing.T, file, blockType string, bytes []byte) {
+	err := os.WriteFile(file, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: bytes}), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,274 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transfer_events
+
+import (
+	"context"
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	model "github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/failure"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
+	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+)
+
+// transferRepository publishes an event for each created transfer and each change of transfer status
+type transferRepository struct {
+	repository.Transfer
+	events service.TransferEvents
+	logger *log.Entry
+}
+
+// NewTransferRepository decorates the given repository, publishing the changes of transfers to the given events
+func NewTransferRepository(transfers repository.Transfer, events service.TransferEvents) repository.Transfer {
+	return &transferRepository{
+		Transfer: transfers,
+		events:   events,
+		logger:   config.GetLoggerFor("Transfer Events Repository"),
+	}
+}
+
+func (r *transferRepository) Create(ctx context.Context, ct *payload.Transfer) (*entity.Transfer, error) {
+	t, err := r.Transfer.Create(ctx, ct)
+	if err == nil && r.events.HasSubscribers() {
+		r.events.Publish(t.ToEvent(model.EventTransferCreated))
+	}
+
+	return t, err
+}
+
+func (r *transferRepository) Reject(ct *payload.Transfer, code failure.Code, reason string) (*entity.Transfer, error) {
+	t, err := r.Transfer.Reject(ct, code, reason)
+	if err == nil && t != nil && r.events.HasSubscribers() {
+		r.events.Publish(t.ToEvent(model.EventTransferCreated))
+	}
+
+	return t, err
+}
+
+func (r *transferRepository) UpdateStatus(txId, s, actor, reason string) error {
+	previous := r.statusOf(txId)
+	err := r.Transfer.UpdateStatus(txId, s, actor, reason)
+	if err == nil {
+		r.publishStatusChanged(txId, previous)
+	}
+
+	return err
+}
+
+func (r *transferRepository) UpdateStatusCompleted(txId string) error {
+	previous := r.statusOf(txId)
+	err := r.Transfer.UpdateStatusCompleted(txId)
+	if err == nil {
+		r.publishStatusChanged(txId, previous)
+	}
+
+	return err
+}
+
+func (r *transferRepository) UpdateStatusFailed(txId string) error {
+	previous := r.statusOf(txId)
+	err := r.Transfer.UpdateStatusFailed(txId)
+	if err == nil {
+		r.publishStatusChanged(txId, previous)
+	}
+
+	return err
+}
+
+func (r *transferRepository) Fail(txId string, code failure.Code, reason, actor string) error {
+	previous := r.statusOf(txId)
+	err := r.Transfer.Fail(txId, code, reason, actor)
+	if err == nil {
+		r.publishStatusChanged(txId, previous)
+	}
+
+	return err
+}
+
+func (r *transferRepository) UpdateStatusReorged(sourceChainId uint64, after time.Time) ([]string, error) {
+	ids, err := r.Transfer.UpdateStatusReorged(sourceChainId, after)
+	if err == nil {
+		for _, id := range ids {
+			r.publishStatusChanged(id, "")
+		}
+	}
+
+	return ids, err
+}
+
+func (r *transferRepository) UpdateStatusClaimReorged(targetChainId, fromBlock uint64) ([]string, error) {
+	ids, err := r.Transfer.UpdateStatusClaimReorged(targetChainId, fromBlock)
+	for _, id := range ids {
+		r.publishStatusChanged(id, status.Claimed)
+	}
+
+	return ids, err
+}
+
+func (r *transferRepository) UpdateStatusReincluded(ct *payload.Transfer) (*entity.Transfer, error) {
+	previous := r.statusOf(ct.TransactionId)
+	t, err := r.Transfer.UpdateStatusReincluded(ct)
+	if err == nil && t.Status != previous && r.events.HasSubscribers() {
+		r.events.Publish(t.ToEvent(model.EventStatusChanged))
+	}
+
+	return t, err
+}
+
+func (r *transferRepository) UpdateStatusClaimed(txId, claimTxHash string, claimBlockNumber uint64, claimTimestamp int64, claimer string) error {
+	previous := r.statusOf(txId)
+	err := r.Transfer.UpdateStatusClaimed(txId, claimTxHash, claimBlockNumber, claimTimestamp, claimer)
+	if err == nil {
+		r.publishStatusChanged(txId, previous)
+	}
+
+	return err
+}
+
+func (r *transferRepository) MarkRedriven(txId, fromStatus string, notAfter time.Time, actor string) (bool, error) {
+	marked, err := r.Transfer.MarkRedriven(txId, fromStatus, notAfter, actor)
+	if err == nil && marked && fromStatus != status.Initial {
+		r.publishStatusChanged(txId, fromStatus)
+	}
+
+	return marked, err
+}
+
+// statusOf returns the status of the transfer before it is moved, read only if there is someone to receive the event
+func (r *transferRepository) statusOf(txId string) string {
+	if !r.events.HasSubscribers() {
+		return ""
+	}
+
+	t, err := r.Transfer.GetByTransactionId(txId)
+	if err != nil || t == nil {
+		return ""
+	}
+	return t.Status
+}
+
+// publishStatusChanged reads the updated transfer only if there is someone to receive the event.
+// Nothing is published if the transfer was already in the status it got moved to
+func (r *transferRepository) publishStatusChanged(txId, previous string) {
+	if !r.events.HasSubscribers() {
+		return
+	}
+
+	t, err := r.Transfer.GetByTransactionId(txId)
+	if err != nil || t == nil {
+		r.logger.Errorf("[%s] - Failed to get transfer for status changed event. Error: [%v]", txId, err)
+		return
+	}
+	if t.Status == previous {
+		return
+	}
+
+	r.events.Publish(t.ToEvent(model.EventStatusChanged))
+}
+
+// scheduleRepository publishes an event for each completed or failed scheduled transaction of a transfer.
+// Batched scheduled transactions publish an event for each of their transfers
+type scheduleRepository struct {
+	repository.Schedule
+	transferRepository repository.Transfer
+	events             service.TransferEvents
+	logger             *log.Entry
+}
+
+// NewScheduleRepository decorates the given repository, publishing the outcome of scheduled transactions to the given events
+func NewScheduleRepository(schedules repository.Schedule, transfers repository.Transfer, events service.TransferEvents) repository.Schedule {
+	return &scheduleRepository{
+		Schedule:           schedules,
+		transferRepository: transfers,
+		events:             events,
+		logger:             config.GetLoggerFor("Schedule Events Repository"),
+	}
+}
+
+func (r *scheduleRepository) UpdateStatus(txId, s, actor, reason string) error {
+	err := r.Schedule.UpdateStatus(txId, s, actor, reason)
+	if err == nil && s == status.Completed {
+		r.publish(model.EventScheduledTransactionCompleted, txId)
+	} else if err == nil && s == status.Failed {
+		r.publish(model.EventScheduledTransactionFailed, txId)
+	}
+
+	return err
+}
+
+func (r *scheduleRepository) UpdateStatusCompleted(txId string) error {
+	err := r.Schedule.UpdateStatusCompleted(txId)
+	if err == nil {
+		r.publish(model.EventScheduledTransactionCompleted, txId)
+	}
+
+	return err
+}
+
+func (r *scheduleRepository) UpdateStatusFailed(txId string) error {
+	err := r.Schedule.UpdateStatusFailed(txId)
+	if err == nil {
+		r.publish(model.EventScheduledTransactionFailed, txId)
+	}
+
+	return err
+}
+
+func (r *scheduleRepository) Fail(txId string, code failure.Code, reason, actor string) error {
+	err := r.Schedule.Fail(txId, code, reason, actor)
+	if err == nil {
+		r.publish(model.EventScheduledTransactionFailed, txId)
+	}
+
+	return err
+}
+
+func (r *scheduleRepository) publish(eventType, txId string) {
+	if !r.events.HasSubscribers() {
+		return
+	}
+
+	// Batched scheduled transactions have a record per included transfer, each of which gets its own event
+	schedules, err := r.Schedule.GetAllByTransactionID(txId)
+	if err != nil {
+		r.logger.Errorf("[%s] - Failed to get scheduled transaction for its event. Error: [%s]", txId, err)
+		return
+	}
+
+	for _, s := range schedules {
+		if !s.TransferID.Valid {
+			// Scheduled transactions, which are not part of a transfer (ex. fee distributions of batches)
+			continue
+		}
+
+		t, err := r.transferRepository.GetByTransactionId(s.TransferID.String)
+		if err != nil || t == nil {
+			r.logger.Errorf("[%s] - Failed to get transfer for scheduled transaction event. Error: [%v]", s.TransferID.String, err)
+			continue
+		}
+
+		event := t.ToEvent(eventType)
+		event.ScheduledTransactionId = txId
+		r.events.Publish(event)
+	}
+}
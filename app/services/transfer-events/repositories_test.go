@@ -0,0 +1,340 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transfer_events
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	model "github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/failure"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
+	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	scheduledTxId  = "0.0.2-1650000001-000000001"
+	entityTransfer = &entity.Transfer{
+		TransactionID: transferId,
+		Receiver:      receiver,
+		Originator:    originator,
+		Status:        status.Completed,
+	}
+)
+
+func Test_TransferRepository_Create(t *testing.T) {
+	mocks.Setup()
+	ct := &payload.Transfer{TransactionId: transferId}
+	mocks.MTransferRepository.On("Create", mock.Anything, ct).Return(entityTransfer, nil)
+	mocks.MTransferEventsService.On("HasSubscribers").Return(true)
+	mocks.MTransferEventsService.On("Publish", mock.Anything).Return()
+
+	actual, err := NewTransferRepository(mocks.MTransferRepository, mocks.MTransferEventsService).Create(context.Background(), ct)
+
+	assert.Nil(t, err)
+	assert.Equal(t, entityTransfer, actual)
+	published := mocks.MTransferEventsService.Calls[1].Arguments.Get(0).(*model.Event)
+	assert.Equal(t, model.EventTransferCreated, published.Type)
+	assert.Equal(t, receiver, published.Receiver)
+	assert.Equal(t, originator, published.Originator)
+}
+
+func Test_TransferRepository_Reject(t *testing.T) {
+	mocks.Setup()
+	ct := &payload.Transfer{TransactionId: transferId}
+	rejected := &entity.Transfer{TransactionID: transferId, Status: status.Failed, FailureCode: failure.Blacklisted}
+	mocks.MTransferRepository.On("Reject", ct, failure.Blacklisted, "blacklisted").Return(rejected, nil)
+	mocks.MTransferEventsService.On("HasSubscribers").Return(true)
+	mocks.MTransferEventsService.On("Publish", mock.Anything).Return()
+
+	actual, err := NewTransferRepository(mocks.MTransferRepository, mocks.MTransferEventsService).Reject(ct, failure.Blacklisted, "blacklisted")
+
+	assert.Nil(t, err)
+	assert.Equal(t, rejected, actual)
+	published := mocks.MTransferEventsService.Calls[1].Arguments.Get(0).(*model.Event)
+	assert.Equal(t, model.EventTransferCreated, published.Type)
+	assert.Equal(t, status.Failed, published.Status)
+}
+
+func Test_TransferRepository_Reject_AlreadyRecorded(t *testing.T) {
+	mocks.Setup()
+	ct := &payload.Transfer{TransactionId: transferId}
+	mocks.MTransferRepository.On("Reject", ct, failure.Blacklisted, "blacklisted").Return((*entity.Transfer)(nil), nil)
+
+	actual, err := NewTransferRepository(mocks.MTransferRepository, mocks.MTransferEventsService).Reject(ct, failure.Blacklisted, "blacklisted")
+
+	assert.Nil(t, err)
+	assert.Nil(t, actual)
+	mocks.MTransferEventsService.AssertNotCalled(t, "Publish", mock.Anything)
+}
+
+func Test_TransferRepository_UpdateStatusCompleted(t *testing.T) {
+	mocks.Setup()
+	mocks.MTransferRepository.On("UpdateStatusCompleted", transferId).Return(nil)
+	mocks.MTransferRepository.On("GetByTransactionId", transferId).Return(initialTransfer(), nil).Once()
+	mocks.MTransferRepository.On("GetByTransactionId", transferId).Return(entityTransfer, nil)
+	mocks.MTransferEventsService.On("HasSubscribers").Return(true)
+	mocks.MTransferEventsService.On("Publish", mock.Anything).Return()
+
+	err := NewTransferRepository(mocks.MTransferRepository, mocks.MTransferEventsService).UpdateStatusCompleted(transferId)
+
+	assert.Nil(t, err)
+	mocks.MTransferEventsService.AssertNumberOfCalls(t, "Publish", 1)
+	published := publishedEvent()
+	assert.Equal(t, model.EventStatusChanged, published.Type)
+	assert.Equal(t, status.Completed, published.Status)
+}
+
+func Test_TransferRepository_UpdateStatusCompleted_AlreadyCompleted(t *testing.T) {
+	mocks.Setup()
+	mocks.MTransferRepository.On("UpdateStatusCompleted", transferId).Return(nil)
+	mocks.MTransferRepository.On("GetByTransactionId", transferId).Return(entityTransfer, nil)
+	mocks.MTransferEventsService.On("HasSubscribers").Return(true)
+
+	err := NewTransferRepository(mocks.MTransferRepository, mocks.MTransferEventsService).UpdateStatusCompleted(transferId)
+
+	assert.Nil(t, err)
+	mocks.MTransferEventsService.AssertNotCalled(t, "Publish", mock.Anything)
+}
+
+func Test_TransferRepository_UpdateStatus(t *testing.T) {
+	mocks.Setup()
+	mocks.MTransferRepository.On("UpdateStatus", transferId, status.Failed, status.ActorAdmin, "manual").Return(nil)
+	mocks.MTransferRepository.On("GetByTransactionId", transferId).Return(initialTransfer(), nil).Once()
+	mocks.MTransferRepository.On("GetByTransactionId", transferId).Return(entityTransfer, nil)
+	mocks.MTransferEventsService.On("HasSubscribers").Return(true)
+	mocks.MTransferEventsService.On("Publish", mock.Anything).Return()
+
+	err := NewTransferRepository(mocks.MTransferRepository, mocks.MTransferEventsService).UpdateStatus(transferId, status.Failed, status.ActorAdmin, "manual")
+
+	assert.Nil(t, err)
+	mocks.MTransferEventsService.AssertNumberOfCalls(t, "Publish", 1)
+}
+
+func Test_TransferRepository_UpdateStatusReincluded(t *testing.T) {
+	mocks.Setup()
+	ct := &payload.Transfer{TransactionId: transferId}
+	reincluded := &entity.Transfer{TransactionID: transferId, Status: status.Initial}
+	mocks.MTransferRepository.On("GetByTransactionId", transferId).Return(&entity.Transfer{TransactionID: transferId, Status: status.Reorged}, nil)
+	mocks.MTransferRepository.On("UpdateStatusReincluded", ct).Return(reincluded, nil)
+	mocks.MTransferEventsService.On("HasSubscribers").Return(true)
+	mocks.MTransferEventsService.On("Publish", mock.Anything).Return()
+
+	actual, err := NewTransferRepository(mocks.MTransferRepository, mocks.MTransferEventsService).UpdateStatusReincluded(ct)
+
+	assert.Nil(t, err)
+	assert.Equal(t, reincluded, actual)
+	published := publishedEvent()
+	assert.Equal(t, model.EventStatusChanged, published.Type)
+	assert.Equal(t, status.Initial, published.Status)
+}
+
+func Test_TransferRepository_UpdateStatusReorged(t *testing.T) {
+	mocks.Setup()
+	after := time.Unix(1650000000, 0)
+	mocks.MTransferRepository.On("UpdateStatusReorged", uint64(1), after).Return([]string{transferId}, nil)
+	mocks.MTransferRepository.On("GetByTransactionId", transferId).Return(entityTransfer, nil)
+	mocks.MTransferEventsService.On("HasSubscribers").Return(true)
+	mocks.MTransferEventsService.On("Publish", mock.Anything).Return()
+
+	ids, err := NewTransferRepository(mocks.MTransferRepository, mocks.MTransferEventsService).UpdateStatusReorged(1, after)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{transferId}, ids)
+	mocks.MTransferEventsService.AssertNumberOfCalls(t, "Publish", 1)
+}
+
+func Test_TransferRepository_UpdateStatusClaimReorged(t *testing.T) {
+	mocks.Setup()
+	mocks.MTransferRepository.On("UpdateStatusClaimReorged", uint64(1), uint64(100)).Return([]string{transferId}, nil)
+	mocks.MTransferRepository.On("GetByTransactionId", transferId).Return(&entity.Transfer{TransactionID: transferId, Status: status.Completed}, nil)
+	mocks.MTransferEventsService.On("HasSubscribers").Return(true)
+	mocks.MTransferEventsService.On("Publish", mock.Anything).Return()
+
+	ids, err := NewTransferRepository(mocks.MTransferRepository, mocks.MTransferEventsService).UpdateStatusClaimReorged(1, 100)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{transferId}, ids)
+	mocks.MTransferEventsService.AssertNumberOfCalls(t, "Publish", 1)
+	assert.Equal(t, status.Completed, publishedEvent().Status)
+}
+
+func Test_TransferRepository_UpdateStatusFailed_Err(t *testing.T) {
+	mocks.Setup()
+	mocks.MTransferRepository.On("UpdateStatusFailed", transferId).Return(errors.New("some-error"))
+	mocks.MTransferEventsService.On("HasSubscribers").Return(false)
+
+	err := NewTransferRepository(mocks.MTransferRepository, mocks.MTransferEventsService).UpdateStatusFailed(transferId)
+
+	assert.NotNil(t, err)
+	mocks.MTransferEventsService.AssertNotCalled(t, "Publish", mock.Anything)
+}
+
+func Test_TransferRepository_MarkRedriven(t *testing.T) {
+	mocks.Setup()
+	notAfter := time.Unix(1650000000, 0)
+	mocks.MTransferRepository.On("MarkRedriven", transferId, status.Failed, notAfter, status.ActorAdmin).Return(true, nil)
+	mocks.MTransferRepository.On("GetByTransactionId", transferId).Return(entityTransfer, nil)
+	mocks.MTransferEventsService.On("HasSubscribers").Return(true)
+	mocks.MTransferEventsService.On("Publish", mock.Anything).Return()
+
+	marked, err := NewTransferRepository(mocks.MTransferRepository, mocks.MTransferEventsService).MarkRedriven(transferId, status.Failed, notAfter, status.ActorAdmin)
+
+	assert.Nil(t, err)
+	assert.True(t, marked)
+	mocks.MTransferEventsService.AssertNumberOfCalls(t, "Publish", 1)
+}
+
+func Test_TransferRepository_MarkRedriven_FromInitial(t *testing.T) {
+	mocks.Setup()
+	notAfter := time.Unix(1650000000, 0)
+	mocks.MTransferRepository.On("MarkRedriven", transferId, status.Initial, notAfter, status.ActorWatcher).Return(true, nil)
+
+	marked, err := NewTransferRepository(mocks.MTransferRepository, mocks.MTransferEventsService).MarkRedriven(transferId, status.Initial, notAfter, status.ActorWatcher)
+
+	assert.Nil(t, err)
+	assert.True(t, marked)
+	mocks.MTransferEventsService.AssertNotCalled(t, "Publish", mock.Anything)
+}
+
+func Test_TransferRepository_NoSubscribers(t *testing.T) {
+	mocks.Setup()
+	mocks.MTransferRepository.On("UpdateStatusCompleted", transferId).Return(nil)
+	mocks.MTransferEventsService.On("HasSubscribers").Return(false)
+
+	err := NewTransferRepository(mocks.MTransferRepository, mocks.MTransferEventsService).UpdateStatusCompleted(transferId)
+
+	assert.Nil(t, err)
+	mocks.MTransferRepository.AssertNotCalled(t, "GetByTransactionId", transferId)
+	mocks.MTransferEventsService.AssertNotCalled(t, "Publish", mock.Anything)
+}
+
+// initialTransfer returns the transfer before it is moved from INITIAL
+func initialTransfer() *entity.Transfer {
+	return &entity.Transfer{TransactionID: transferId, Status: status.Initial}
+}
+
+// publishedEvent returns the event of the last call to Publish
+func publishedEvent() *model.Event {
+	var published *model.Event
+	for _, call := range mocks.MTransferEventsService.Calls {
+		if call.Method == "Publish" {
+			published = call.Arguments.Get(0).(*model.Event)
+		}
+	}
+	return published
+}
+
+func Test_ScheduleRepository_UpdateStatusCompleted(t *testing.T) {
+	mocks.Setup()
+	mocks.MScheduleRepository.On("UpdateStatusCompleted", scheduledTxId).Return(nil)
+	mocks.MScheduleRepository.On("GetAllByTransactionID", scheduledTxId).Return([]*entity.Schedule{{
+		TransactionID: scheduledTxId,
+		TransferID:    sql.NullString{String: transferId, Valid: true},
+	}}, nil)
+	mocks.MTransferRepository.On("GetByTransactionId", transferId).Return(entityTransfer, nil)
+	mocks.MTransferEventsService.On("HasSubscribers").Return(true)
+	mocks.MTransferEventsService.On("Publish", mock.Anything).Return()
+
+	err := NewScheduleRepository(mocks.MScheduleRepository, mocks.MTransferRepository, mocks.MTransferEventsService).UpdateStatusCompleted(scheduledTxId)
+
+	assert.Nil(t, err)
+	published := mocks.MTransferEventsService.Calls[1].Arguments.Get(0).(*model.Event)
+	assert.Equal(t, model.EventScheduledTransactionCompleted, published.Type)
+	assert.Equal(t, scheduledTxId, published.ScheduledTransactionId)
+}
+
+func Test_ScheduleRepository_UpdateStatus(t *testing.T) {
+	mocks.Setup()
+	mocks.MScheduleRepository.On("UpdateStatus", scheduledTxId, status.Failed, status.ActorRecovery, "recovered").Return(nil)
+	mocks.MScheduleRepository.On("GetAllByTransactionID", scheduledTxId).Return([]*entity.Schedule{{
+		TransactionID: scheduledTxId,
+		TransferID:    sql.NullString{String: transferId, Valid: true},
+	}}, nil)
+	mocks.MTransferRepository.On("GetByTransactionId", transferId).Return(entityTransfer, nil)
+	mocks.MTransferEventsService.On("HasSubscribers").Return(true)
+	mocks.MTransferEventsService.On("Publish", mock.Anything).Return()
+
+	err := NewScheduleRepository(mocks.MScheduleRepository, mocks.MTransferRepository, mocks.MTransferEventsService).UpdateStatus(scheduledTxId, status.Failed, status.ActorRecovery, "recovered")
+
+	assert.Nil(t, err)
+	published := mocks.MTransferEventsService.Calls[1].Arguments.Get(0).(*model.Event)
+	assert.Equal(t, model.EventScheduledTransactionFailed, published.Type)
+}
+
+func Test_ScheduleRepository_Fail(t *testing.T) {
+	mocks.Setup()
+	mocks.MScheduleRepository.On("Fail", scheduledTxId, failure.ReceiverNotAssociated, "not associated", status.ActorRecovery).Return(nil)
+	mocks.MScheduleRepository.On("GetAllByTransactionID", scheduledTxId).Return([]*entity.Schedule{{TransactionID: scheduledTxId, TransferID: sql.NullString{String: transferId, Valid: true}}}, nil)
+	mocks.MTransferRepository.On("GetByTransactionId", transferId).Return(entityTransfer, nil)
+	mocks.MTransferEventsService.On("HasSubscribers").Return(true)
+	mocks.MTransferEventsService.On("Publish", mock.Anything).Return()
+
+	err := NewScheduleRepository(mocks.MScheduleRepository, mocks.MTransferRepository, mocks.MTransferEventsService).Fail(scheduledTxId, failure.ReceiverNotAssociated, "not associated", status.ActorRecovery)
+
+	assert.Nil(t, err)
+	published := mocks.MTransferEventsService.Calls[1].Arguments.Get(0).(*model.Event)
+	assert.Equal(t, model.EventScheduledTransactionFailed, published.Type)
+}
+
+func Test_ScheduleRepository_UpdateStatusCompleted_Batch(t *testing.T) {
+	mocks.Setup()
+	otherTransferId := "0.0.123456-1650000000-000000002"
+	mocks.MScheduleRepository.On("UpdateStatusCompleted", scheduledTxId).Return(nil)
+	mocks.MScheduleRepository.On("GetAllByTransactionID", scheduledTxId).Return([]*entity.Schedule{
+		{TransactionID: scheduledTxId, TransferID: sql.NullString{String: transferId, Valid: true}},
+		{TransactionID: scheduledTxId, TransferID: sql.NullString{String: otherTransferId, Valid: true}},
+	}, nil)
+	mocks.MTransferRepository.On("GetByTransactionId", transferId).Return(&entity.Transfer{TransactionID: transferId}, nil)
+	mocks.MTransferRepository.On("GetByTransactionId", otherTransferId).Return(&entity.Transfer{TransactionID: otherTransferId}, nil)
+	mocks.MTransferEventsService.On("HasSubscribers").Return(true)
+	mocks.MTransferEventsService.On("Publish", mock.Anything).Return()
+
+	err := NewScheduleRepository(mocks.MScheduleRepository, mocks.MTransferRepository, mocks.MTransferEventsService).UpdateStatusCompleted(scheduledTxId)
+
+	assert.Nil(t, err)
+	mocks.MTransferEventsService.AssertNumberOfCalls(t, "Publish", 2)
+	var published []string
+	for _, call := range mocks.MTransferEventsService.Calls {
+		if call.Method == "Publish" {
+			event := call.Arguments.Get(0).(*model.Event)
+			assert.Equal(t, model.EventScheduledTransactionCompleted, event.Type)
+			assert.Equal(t, scheduledTxId, event.ScheduledTransactionId)
+			published = append(published, event.TransferId)
+		}
+	}
+	assert.Equal(t, []string{transferId, otherTransferId}, published)
+}
+
+func Test_ScheduleRepository_UpdateStatusFailed_WithoutTransfer(t *testing.T) {
+	mocks.Setup()
+	mocks.MScheduleRepository.On("UpdateStatusFailed", scheduledTxId).Return(nil)
+	mocks.MScheduleRepository.On("GetAllByTransactionID", scheduledTxId).Return([]*entity.Schedule{{TransactionID: scheduledTxId}}, nil)
+	mocks.MTransferEventsService.On("HasSubscribers").Return(true)
+
+	err := NewScheduleRepository(mocks.MScheduleRepository, mocks.MTransferRepository, mocks.MTransferEventsService).UpdateStatusFailed(scheduledTxId)
+
+	assert.Nil(t, err)
+	mocks.MTransferEventsService.AssertNotCalled(t, "Publish", mock.Anything)
+}
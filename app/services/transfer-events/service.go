@@ -0,0 +1,168 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transfer_events
+
+import (
+	"sync"
+
+	model "github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+)
+
+// subscriberBufferSize is the number of events buffered per subscriber. Events to slower subscribers are dropped,
+// unless they subscribed with SubscribeLossless
+const subscriberBufferSize = 64
+
+type subscriber struct {
+	subscription model.Subscription
+	events       chan *model.Event
+	lossless     bool
+	// pending, ready and done are used by lossless subscribers only
+	mutex   sync.Mutex
+	pending []*model.Event
+	ready   chan struct{}
+	done    chan struct{}
+}
+
+// push queues the event of a lossless subscriber without blocking the publisher
+func (sub *subscriber) push(event *model.Event) {
+	sub.mutex.Lock()
+	sub.pending = append(sub.pending, event)
+	sub.mutex.Unlock()
+
+	select {
+	case sub.ready <- struct{}{}:
+	default:
+	}
+}
+
+// forward sends the queued events of a lossless subscriber in order, until it unsubscribes
+func (sub *subscriber) forward() {
+	defer close(sub.events)
+	for {
+		sub.mutex.Lock()
+		pending := sub.pending
+		sub.pending = nil
+		sub.mutex.Unlock()
+
+		if len(pending) == 0 {
+			select {
+			case <-sub.ready:
+				continue
+			case <-sub.done:
+				return
+			}
+		}
+
+		for _, event := range pending {
+			select {
+			case sub.events <- event:
+			case <-sub.done:
+				return
+			}
+		}
+	}
+}
+
+// Service is an in-memory hub of transfer events. Each validator streams only the events it observed itself
+type Service struct {
+	mutex       sync.RWMutex
+	subscribers map[*subscriber]struct{}
+	logger      *log.Entry
+}
+
+func NewService() *Service {
+	return &Service{
+		subscribers: make(map[*subscriber]struct{}),
+		logger:      config.GetLoggerFor("Transfer Events Service"),
+	}
+}
+
+func (s *Service) Publish(event *model.Event) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	for sub := range s.subscribers {
+		if !sub.subscription.Matches(event) {
+			continue
+		}
+
+		if sub.lossless {
+			sub.push(event)
+			continue
+		}
+
+		select {
+		case sub.events <- event:
+		default:
+			s.logger.Warnf("[%s] - Dropped [%s] event for slow subscriber.", event.TransferId, event.Type)
+		}
+	}
+}
+
+func (s *Service) Subscribe(subscription model.Subscription) (<-chan *model.Event, func()) {
+	sub := &subscriber{
+		subscription: subscription,
+		events:       make(chan *model.Event, subscriberBufferSize),
+	}
+
+	return sub.events, s.add(sub)
+}
+
+func (s *Service) SubscribeLossless(subscription model.Subscription) (<-chan *model.Event, func()) {
+	sub := &subscriber{
+		subscription: subscription,
+		events:       make(chan *model.Event),
+		lossless:     true,
+		ready:        make(chan struct{}, 1),
+		done:         make(chan struct{}),
+	}
+	go sub.forward()
+
+	return sub.events, s.add(sub)
+}
+
+// add registers the subscriber and returns the function cancelling its subscription
+func (s *Service) add(sub *subscriber) func() {
+	s.mutex.Lock()
+	s.subscribers[sub] = struct{}{}
+	s.mutex.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			s.mutex.Lock()
+			delete(s.subscribers, sub)
+			s.mutex.Unlock()
+
+			if sub.lossless {
+				// the events channel is closed by forward
+				close(sub.done)
+				return
+			}
+			close(sub.events)
+		})
+	}
+}
+
+func (s *Service) HasSubscribers() bool {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return len(s.subscribers) > 0
+}
@@ -0,0 +1,105 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transfer_events
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	model "github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	transferId = "0.0.123-1650000000-000000001"
+	receiver   = "0x000000000000000000000000000000000000AbCd"
+	originator = "0.0.123"
+	event      = &model.Event{
+		Type:       model.EventTransferCreated,
+		TransferId: transferId,
+		Receiver:   receiver,
+		Originator: originator,
+	}
+)
+
+func Test_PublishToMatchingSubscribers(t *testing.T) {
+	s := NewService()
+	byId, _ := s.Subscribe(model.Subscription{TransferIds: []string{transferId}})
+	byReceiver, _ := s.Subscribe(model.Subscription{Receiver: "0x000000000000000000000000000000000000abcd"})
+	byOriginator, _ := s.Subscribe(model.Subscription{Originator: originator})
+	all, _ := s.Subscribe(model.Subscription{All: true})
+	other, _ := s.Subscribe(model.Subscription{TransferIds: []string{"other"}})
+
+	s.Publish(event)
+
+	assert.Equal(t, event, <-byId)
+	assert.Equal(t, event, <-byReceiver)
+	assert.Equal(t, event, <-byOriginator)
+	assert.Equal(t, event, <-all)
+	assert.Len(t, other, 0)
+}
+
+func Test_Unsubscribe(t *testing.T) {
+	s := NewService()
+	events, unsubscribe := s.Subscribe(model.Subscription{TransferIds: []string{transferId}})
+	assert.True(t, s.HasSubscribers())
+
+	unsubscribe()
+	unsubscribe()
+	s.Publish(event)
+
+	_, ok := <-events
+	assert.False(t, ok)
+	assert.False(t, s.HasSubscribers())
+}
+
+func Test_PublishDropsEventsOfSlowSubscribers(t *testing.T) {
+	s := NewService()
+	events, _ := s.Subscribe(model.Subscription{TransferIds: []string{transferId}})
+
+	for i := 0; i <= subscriberBufferSize; i++ {
+		s.Publish(event)
+	}
+
+	assert.Len(t, events, subscriberBufferSize)
+}
+
+func Test_PublishQueuesEventsOfLosslessSubscribers(t *testing.T) {
+	s := NewService()
+	events, unsubscribe := s.SubscribeLossless(model.Subscription{All: true})
+
+	total := 10 * subscriberBufferSize
+	for i := 0; i < total; i++ {
+		s.Publish(&model.Event{Type: model.EventTransferCreated, TransferId: strconv.Itoa(i)})
+	}
+
+	for i := 0; i < total; i++ {
+		select {
+		case e := <-events:
+			assert.Equal(t, strconv.Itoa(i), e.TransferId)
+		case <-time.After(time.Second):
+			t.Fatalf("event [%d] was not received", i)
+		}
+	}
+
+	unsubscribe()
+	unsubscribe()
+	_, ok := <-events
+	assert.False(t, ok)
+	assert.False(t, s.HasSubscribers())
+}
@@ -17,10 +17,12 @@
 package transfers
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
 	"math/big"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -36,8 +38,11 @@ import (
 	"github.com/limechain/hedera-eth-bridge-validator/app/helper/memo"
 	"github.com/limechain/hedera-eth-bridge-validator/app/helper/metrics"
 	syncHelper "github.com/limechain/hedera-eth-bridge-validator/app/helper/sync"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/timestamp"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/tracing"
 	model "github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/failure"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/schedule"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
@@ -138,7 +143,7 @@ func (ts *Service) SanityCheckTransfer(tx mirrorNodeTransaction.Transaction) mod
 }
 
 // InitiateNewTransfer Stores the incoming transfer message into the Database aware of already processed transfers
-func (ts *Service) InitiateNewTransfer(tm payload.Transfer) (*entity.Transfer, error) {
+func (ts *Service) InitiateNewTransfer(ctx context.Context, tm payload.Transfer) (*entity.Transfer, error) {
 	dbTransaction, err := ts.transferRepository.GetByTransactionId(tm.TransactionId)
 	if err != nil {
 		ts.logger.Errorf("[%s] - Failed to get db record. Error [%s]", tm.TransactionId, err)
@@ -151,7 +156,7 @@ func (ts *Service) InitiateNewTransfer(tm payload.Transfer) (*entity.Transfer, e
 	}
 
 	ts.logger.Debugf("[%s] - Adding new Transaction Record", tm.TransactionId)
-	tx, err := ts.transferRepository.Create(&tm)
+	tx, err := ts.transferRepository.Create(ctx, &tm)
 	if err != nil {
 		ts.logger.Errorf("[%s] - Failed to create a transaction record. Error [%s].", tm.TransactionId, err)
 		return nil, err
@@ -159,6 +164,15 @@ func (ts *Service) InitiateNewTransfer(tm payload.Transfer) (*entity.Transfer, e
 	return tx, nil
 }
 
+// RejectTransfer Stores the incoming transfer message into the Database as failed with the given code
+func (ts *Service) RejectTransfer(tm payload.Transfer, code failure.Code, reason string) error {
+	_, err := ts.transferRepository.Reject(&tm, code, reason)
+	if err != nil {
+		ts.logger.Errorf("[%s] - Failed to create a rejected transaction record. Error [%s].", tm.TransactionId, err)
+	}
+	return err
+}
+
 func (ts *Service) authMessageSubmissionCallbacks(txId string) (onSuccess, onRevert func()) {
 	onSuccess = func() {
 		ts.logger.Debugf("Authorisation Signature TX successfully executed for TX [%s]", txId)
@@ -170,35 +184,31 @@ func (ts *Service) authMessageSubmissionCallbacks(txId string) (onSuccess, onRev
 	return onSuccess, onRevert
 }
 
-func (ts *Service) ProcessNativeTransfer(tm payload.Transfer) error {
-	intAmount, err := strconv.ParseInt(tm.Amount, 10, 64)
+func (ts *Service) ProcessNativeTransfer(ctx context.Context, tm payload.Transfer) error {
+	fee, remainder := ts.feeService.CalculateFee(tm.NativeAsset, tm.Amount)
+	validFee := ts.distributor.ValidAmount(fee)
+	remainder = new(big.Int).Add(remainder, new(big.Int).Sub(fee, validFee))
+
+	hederaFee, err := hederaHelper.Amount(validFee)
 	if err != nil {
-		ts.logger.Errorf("[%s] - Failed to parse amount. Error: [%s]", tm.TransactionId, err)
+		ts.logger.Errorf("[%s] - Invalid fee. Error: [%s]", tm.TransactionId, err)
 		return err
 	}
 
-	fee, remainder := ts.feeService.CalculateFee(tm.NativeAsset, intAmount)
-	validFee := ts.distributor.ValidAmount(fee)
-	if validFee != fee {
-		remainder += fee - validFee
-	}
-
-	go ts.processFeeTransfer(validFee, tm.SourceChainId, tm.TargetChainId, tm.TransactionId, tm.NativeAsset)
+	go ts.processFeeTransfer(tracing.Detach(ctx), hederaFee, tm.SourceChainId, tm.TargetChainId, tm.TransactionId, tm.NativeAsset)
 
-	wrappedAmount := strconv.FormatInt(remainder, 10)
-
-	tm.Amount = wrappedAmount
-	signatureMessage, err := ts.messageService.SignFungibleMessage(tm)
+	tm.Amount = remainder
+	signatureMessage, err := ts.messageService.SignFungibleMessage(ctx, tm)
 	if err != nil {
 		return err
 	}
 
-	return ts.submitTopicMessageAndWaitForTransaction(tm.TransactionId, signatureMessage)
+	return ts.submitTopicMessageAndWaitForTransaction(ctx, tm.TransactionId, signatureMessage)
 }
 
-func (ts *Service) ProcessNativeNftTransfer(tm payload.Transfer) error {
+func (ts *Service) ProcessNativeNftTransfer(ctx context.Context, tm payload.Transfer) error {
 	ts.logger.Infof("[%s] - Sending NFT to bridge account.", tm.TransactionId)
-	status, wg, err := ts.transferNftToBridgeAccount(tm)
+	status, wg, err := ts.transferNftToBridgeAccount(ctx, tm)
 	if err != nil {
 		return err
 	}
@@ -211,18 +221,18 @@ func (ts *Service) ProcessNativeNftTransfer(tm payload.Transfer) error {
 		return errors.New("failed-scheduled-nft-transfer")
 	}
 
-	feePerValidator := ts.distributor.ValidAmount(tm.Fee)
-	go ts.processFeeTransfer(feePerValidator, tm.SourceChainId, tm.TargetChainId, tm.TransactionId, constants.Hbar)
+	feePerValidator := ts.distributor.ValidAmount(big.NewInt(tm.Fee)).Int64()
+	go ts.processFeeTransfer(tracing.Detach(ctx), feePerValidator, tm.SourceChainId, tm.TargetChainId, tm.TransactionId, constants.Hbar)
 
-	signatureMessage, err := ts.messageService.SignNftMessage(tm)
+	signatureMessage, err := ts.messageService.SignNftMessage(ctx, tm)
 	if err != nil {
 		return err
 	}
 
-	return ts.submitTopicMessageAndWaitForTransaction(tm.TransactionId, signatureMessage)
+	return ts.submitTopicMessageAndWaitForTransaction(ctx, tm.TransactionId, signatureMessage)
 }
 
-func (ts *Service) transferNftToBridgeAccount(tm payload.Transfer) (status *string, wg *sync.WaitGroup, err error) {
+func (ts *Service) transferNftToBridgeAccount(ctx context.Context, tm payload.Transfer) (status *string, wg *sync.WaitGroup, err error) {
 	status = new(string)
 	wg = new(sync.WaitGroup)
 	wg.Add(1)
@@ -246,16 +256,11 @@ func (ts *Service) transferNftToBridgeAccount(tm payload.Transfer) (status *stri
 		return nil, nil, err
 	}
 
-	ts.scheduledService.ExecuteScheduledNftTransferTransaction(tm.TransactionId, nftID, sender, ts.bridgeAccountID, true, onExecutionSuccess, onExecutionFail, onSuccess, onFail)
+	ts.scheduledService.ExecuteScheduledNftTransferTransaction(ctx, tm.TransactionId, nftID, sender, ts.bridgeAccountID, true, onExecutionSuccess, onExecutionFail, onSuccess, onFail)
 	return status, wg, err
 }
 
-func (ts *Service) ProcessWrappedTransfer(tm payload.Transfer) error {
-	amount, err := big_numbers.ToBigInt(tm.Amount)
-	if err != nil {
-		return err
-	}
-
+func (ts *Service) ProcessWrappedTransfer(ctx context.Context, tm payload.Transfer) error {
 	sourceAssetInfo, exists := ts.assetsService.FungibleAssetInfo(tm.SourceChainId, tm.SourceAsset)
 	if !exists {
 		return fmt.Errorf("Failed to retrieve fungible asset info of [%s].", tm.SourceAsset)
@@ -267,15 +272,15 @@ func (ts *Service) ProcessWrappedTransfer(tm payload.Transfer) error {
 	}
 
 	// Convert the amount to the initial, so that the correct amount is being burned.
-	targetAmount := decimal.TargetAmount(targetAssetInfo.Decimals, sourceAssetInfo.Decimals, amount)
+	targetAmount := decimal.TargetAmount(targetAssetInfo.Decimals, sourceAssetInfo.Decimals, tm.Amount)
 	if targetAmount.Cmp(big.NewInt(0)) == 0 {
-		return fmt.Errorf("Insufficient amount provided: Amount [%s] and Target Amount [%s].", amount, targetAmount)
+		return fmt.Errorf("Insufficient amount provided: Amount [%s] and Target Amount [%s].", tm.Amount, targetAmount)
 	}
 
 	status := make(chan string)
 	onExecutionBurnSuccess, onExecutionBurnFail := ts.scheduledBurnTxExecutionCallbacks(tm.TransactionId, &status)
 	onTokenBurnSuccess, onTokenBurnFail := ts.scheduledBurnTxMinedCallbacks(&status)
-	ts.scheduledService.ExecuteScheduledBurnTransaction(tm.TransactionId, tm.SourceAsset, targetAmount.Int64(), &status, onExecutionBurnSuccess, onExecutionBurnFail, onTokenBurnSuccess, onTokenBurnFail)
+	ts.scheduledService.ExecuteScheduledBurnTransaction(ctx, tm.TransactionId, tm.SourceAsset, targetAmount, &status, onExecutionBurnSuccess, onExecutionBurnFail, onTokenBurnSuccess, onTokenBurnFail)
 
 statusBlocker:
 	for {
@@ -289,12 +294,48 @@ statusBlocker:
 		}
 	}
 
-	signatureMessage, err := ts.messageService.SignFungibleMessage(tm)
+	signatureMessage, err := ts.messageService.SignFungibleMessage(ctx, tm)
+	if err != nil {
+		return err
+	}
+
+	return ts.submitTopicMessageAndWaitForTransaction(ctx, tm.TransactionId, signatureMessage)
+}
+
+func (ts *Service) ProcessWrappedNftTransfer(ctx context.Context, tm payload.Transfer, wrappedSerialNum int64) error {
+	token, err := hedera.TokenIDFromString(tm.SourceAsset)
+	if err != nil {
+		ts.logger.Errorf("[%s] - Failed to parse token [%s]. Error [%s].", tm.TransactionId, tm.SourceAsset, err)
+		return err
+	}
+
+	nftID := hedera.NftID{
+		TokenID:      token,
+		SerialNumber: wrappedSerialNum,
+	}
+
+	ts.logger.Infof("[%s] - Burning wrapped NFT [%s].", tm.TransactionId, nftID)
+	status := new(string)
+	wg := new(sync.WaitGroup)
+	wg.Add(1)
+	onExecutionSuccess, onExecutionFail := hederaHelper.ScheduledNftTxExecutionCallbacks(ts.transferRepository, ts.scheduleRepository, ts.logger, tm.TransactionId, false, status, schedule.BURN, wg)
+	onSuccess, onFail := hederaHelper.ScheduledNftTxMinedCallbacks(ts.transferRepository, ts.scheduleRepository, ts.logger, tm.TransactionId, status, wg)
+	ts.scheduledService.ExecuteScheduledNftBurnTransaction(ctx, tm.TransactionId, nftID, onExecutionSuccess, onExecutionFail, onSuccess, onFail)
+
+	wg.Wait()
+	if *status == syncHelper.DONE {
+		ts.logger.Debugf("[%s] - Proceeding to sign and submit unlock permission messages.", tm.TransactionId)
+	} else {
+		ts.logger.Errorf("[%s] - Scheduled Transaction for NFT burn failed.", tm.TransactionId)
+		return errors.New("failed-scheduled-nft-burn")
+	}
+
+	signatureMessage, err := ts.messageService.SignNftMessage(ctx, tm)
 	if err != nil {
 		return err
 	}
 
-	return ts.submitTopicMessageAndWaitForTransaction(tm.TransactionId, signatureMessage)
+	return ts.submitTopicMessageAndWaitForTransaction(ctx, tm.TransactionId, signatureMessage)
 }
 
 // TransferData returns from the database the given transfer, its signatures and
@@ -314,7 +355,9 @@ func (ts *Service) TransferData(txId string) (interface{}, error) {
 		return service.TransferData{}, service.ErrBadRequestTransferTargetNetworkNoSignaturesRequired
 	}
 
-	if t != nil && t.NativeChainID == constants.HederaNetworkId && t.Fee == "" {
+	// Fees are charged by the validators only for transfers of Hedera native assets, originating from Hedera
+	chargesFee := t.SourceChainID == constants.HederaNetworkId && t.NativeChainID == constants.HederaNetworkId
+	if chargesFee && t.Fee == "" {
 		return service.TransferData{}, service.ErrNotFound
 	}
 
@@ -327,6 +370,9 @@ func (ts *Service) TransferData(txId string) (interface{}, error) {
 		SourceAsset:   t.SourceAsset,
 		NativeAsset:   t.NativeAsset,
 		TargetAsset:   t.TargetAsset,
+		Status:        t.Status,
+		Claim:         t.Claim(),
+		Failure:       t.Failure(),
 	}
 
 	var signatures []string
@@ -347,19 +393,19 @@ func (ts *Service) TransferData(txId string) (interface{}, error) {
 
 	if !t.IsNft {
 		signedAmount := t.Amount
-		if t.NativeChainID == constants.HederaNetworkId {
-			amount, err := strconv.ParseInt(t.Amount, 10, 64)
+		if chargesFee {
+			amount, err := big_numbers.ToBigInt(t.Amount)
 			if err != nil {
 				ts.logger.Errorf("[%s] - Failed to parse transfer amount. Error [%s]", t.TransactionID, err)
 				return nil, err
 			}
 
-			feeAmount, err := strconv.ParseInt(t.Fee, 10, 64)
+			feeAmount, err := big_numbers.ToBigInt(t.Fee)
 			if err != nil {
 				ts.logger.Errorf("[%s] - Failed to parse fee amount. Error [%s]", t.TransactionID, err)
 				return nil, err
 			}
-			signedAmount = strconv.FormatInt(amount-feeAmount, 10)
+			signedAmount = amount.Sub(amount, feeAmount).String()
 		}
 		return service.FungibleTransferData{
 			TransferData: transferData,
@@ -374,6 +420,120 @@ func (ts *Service) TransferData(txId string) (interface{}, error) {
 	}, nil
 }
 
+func (ts *Service) Timeline(txId string) (*model.Timeline, error) {
+	t, err := ts.transferRepository.GetWithPreloads(txId)
+	if err != nil {
+		ts.logger.Errorf("[%s] - Failed to query Transfer with preloads. Error: [%s].", txId, err)
+		return nil, err
+	}
+
+	if t == nil {
+		return nil, service.ErrNotFound
+	}
+
+	steps := []*model.TimelineStep{
+		{
+			Type:          model.StepSourceTransaction,
+			Timestamp:     t.Timestamp.Time,
+			TransactionId: t.TransactionID,
+		},
+	}
+
+	for _, m := range t.Messages {
+		steps = append(steps, &model.TimelineStep{
+			Type:      model.StepSignature,
+			Timestamp: timestamp.FromNanos(m.TransactionTimestamp),
+			Signer:    m.Signer,
+			Signature: m.Signature,
+		})
+	}
+
+	for _, s := range t.Schedules {
+		validStart, err := hederaHelper.TimestampFromTxId(s.TransactionID)
+		if err != nil {
+			ts.logger.Errorf("[%s] - Failed to parse scheduled transaction [%s] timestamp. Error: [%s]", txId, s.TransactionID, err)
+			return nil, err
+		}
+		steps = append(steps, &model.TimelineStep{
+			Type:          model.StepScheduledTransaction,
+			Timestamp:     timestamp.FromNanos(validStart),
+			TransactionId: s.TransactionID,
+			Operation:     s.Operation,
+			ScheduleId:    s.ScheduleID,
+			Status:        s.Status,
+			Failure:       s.Failure(),
+		})
+	}
+
+	for _, f := range t.Fees {
+		validStart, err := hederaHelper.TimestampFromTxId(f.TransactionID)
+		if err != nil {
+			ts.logger.Errorf("[%s] - Failed to parse fee transaction [%s] timestamp. Error: [%s]", txId, f.TransactionID, err)
+			return nil, err
+		}
+		steps = append(steps, &model.TimelineStep{
+			Type:          model.StepFee,
+			Timestamp:     timestamp.FromNanos(validStart),
+			TransactionId: f.TransactionID,
+			ScheduleId:    f.ScheduleID,
+			Status:        f.Status,
+			Amount:        f.Amount,
+		})
+	}
+
+	if claim := t.Claim(); claim != nil {
+		steps = append(steps, &model.TimelineStep{
+			Type:          model.StepClaim,
+			Timestamp:     claim.Timestamp,
+			TransactionId: claim.TxHash,
+			BlockNumber:   claim.BlockNumber,
+			Claimer:       claim.Claimer,
+		})
+	}
+
+	// The source transaction is always first, the rest are ordered by the time they occurred
+	sort.SliceStable(steps[1:], func(i, j int) bool {
+		return steps[i+1].Timestamp.Before(steps[j+1].Timestamp)
+	})
+	for i := 1; i < len(steps); i++ {
+		steps[i].ElapsedMs = steps[i].Timestamp.Sub(steps[i-1].Timestamp).Milliseconds()
+	}
+
+	return &model.Timeline{
+		TransactionId: t.TransactionID,
+		Status:        t.Status,
+		Steps:         steps,
+		ElapsedMs:     steps[len(steps)-1].Timestamp.Sub(steps[0].Timestamp).Milliseconds(),
+	}, nil
+}
+
+func (ts *Service) StatusHistory(txId string) ([]*model.StatusChange, error) {
+	history, err := ts.transferRepository.GetStatusHistory(txId)
+	if err != nil {
+		ts.logger.Errorf("[%s] - Failed to query status history. Error: [%s].", txId, err)
+		return nil, err
+	}
+
+	if len(history) == 0 {
+		// Transfers, created before the history was recorded, have no entries
+		t, err := ts.transferRepository.GetByTransactionId(txId)
+		if err != nil {
+			ts.logger.Errorf("[%s] - Failed to query Transfer. Error: [%s].", txId, err)
+			return nil, err
+		}
+		if t == nil {
+			return nil, service.ErrNotFound
+		}
+	}
+
+	changes := make([]*model.StatusChange, len(history))
+	for i, h := range history {
+		changes[i] = h.ToDto()
+	}
+
+	return changes, nil
+}
+
 func (ts *Service) Paged(req *model.PagedRequest) (*model.Paged, error) {
 	items, count, err := ts.transferRepository.Paged(req)
 	if err != nil {
@@ -386,14 +546,25 @@ func (ts *Service) Paged(req *model.PagedRequest) (*model.Paged, error) {
 		res = append(res, t.ToDto())
 	}
 
-	return &model.Paged{
+	var nextCursor string
+	if req.IsCursorBased() && len(items) > 0 && uint64(len(items)) == req.PageSize {
+		last := items[len(items)-1]
+		nextCursor = model.EncodeCursor(last.Timestamp.UnixNano(), last.TransactionID)
+	}
+
+	paged := &model.Paged{
 		Items:      res,
-		TotalCount: count,
-	}, nil
+		NextCursor: nextCursor,
+	}
+	if req.CountsTotal() {
+		paged.TotalCount = &count
+	}
+	return paged, nil
 }
 
-func (ts *Service) submitTopicMessageAndWaitForTransaction(transferID string, signatureMessageBytes []byte) error {
+func (ts *Service) submitTopicMessageAndWaitForTransaction(ctx context.Context, transferID string, signatureMessageBytes []byte) error {
 	messageTxId, err := ts.hederaNode.SubmitTopicConsensusMessage(
+		ctx,
 		ts.topicID,
 		signatureMessageBytes)
 	if err != nil {
@@ -404,11 +575,11 @@ func (ts *Service) submitTopicMessageAndWaitForTransaction(transferID string, si
 	// Attach update callbacks on Signature HCS Message
 	ts.logger.Infof("[%s] - Submitted signature on Topic [%s]", transferID, ts.topicID)
 	onSuccessfulAuthMessage, onFailedAuthMessage := ts.authMessageSubmissionCallbacks(transferID)
-	ts.mirrorNode.WaitForTransaction(hederaHelper.ToMirrorNodeTransactionID(messageTxId.String()), onSuccessfulAuthMessage, onFailedAuthMessage)
+	ts.mirrorNode.WaitForTransaction(ctx, hederaHelper.ToMirrorNodeTransactionID(messageTxId.String()), onSuccessfulAuthMessage, onFailedAuthMessage)
 	return nil
 }
 
-func (ts *Service) processFeeTransfer(totalFee int64, sourceChainId, targetChainId uint64, transferID string, nativeAsset string) {
+func (ts *Service) processFeeTransfer(ctx context.Context, totalFee int64, sourceChainId, targetChainId uint64, transferID string, nativeAsset string) {
 
 	transfers, err := ts.distributor.CalculateMemberDistribution(totalFee)
 	if err != nil {
@@ -440,7 +611,7 @@ func (ts *Service) processFeeTransfer(totalFee int64, sourceChainId, targetChain
 		onExecutionSuccess, onExecutionFail := ts.scheduledFeeTxExecutionCallbacks(transferID, strconv.FormatInt(fee, 10))
 		onSuccess, onFail := ts.scheduledFeeTxMinedCallbacks(feeOutParams, splitTransfer)
 
-		ts.scheduledService.ExecuteScheduledTransferTransaction(transferID, nativeAsset, splitTransfer, onExecutionSuccess, onExecutionFail, onSuccess, onFail)
+		ts.scheduledService.ExecuteScheduledTransferTransaction(ctx, transferID, nativeAsset, splitTransfer, onExecutionSuccess, onExecutionFail, onSuccess, onFail)
 	}
 
 	if ts.prometheusService.GetIsMonitoringEnabled() {
@@ -460,7 +631,7 @@ func (ts *Service) onMinedFeeTransactionsSetMetrics(sourceChainId, targetChainId
 		return
 	}
 
-	metrics.SetFeeTransferred(sourceChainId, targetChainId, nativeAsset, transferID, ts.prometheusService, ts.logger)
+	metrics.ReachStoredTransferStage(constants.FeeTransferredStage, transferID, ts.transferRepository, ts.prometheusService, ts.logger)
 }
 
 func (ts *Service) scheduledBurnTxExecutionCallbacks(transferID string, blocker *chan string) (onExecutionSuccess func(transactionID string, scheduleID string), onExecutionFail func(transactionID string)) {
@@ -655,7 +826,3 @@ func (ts *Service) scheduledFeeTxMinedCallbacks(feeOutParams *hederaHelper.FeeOu
 	}
 	return onSuccess, onFail
 }
-
-func (ts *Service) UpdateTransferStatusCompleted(transferID string) error {
-	return ts.transferRepository.UpdateStatusCompleted(transferID)
-}
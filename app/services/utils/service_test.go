@@ -195,7 +195,7 @@ func Test_Retry_HappyPath(t *testing.T) {
 
 	expectedValue := 1
 
-	res, err := service.Retry(func(ctx context.Context) retry.Result {
+	res, err := service.Retry(context.Background(), func(ctx context.Context) retry.Result {
 		select {
 		case <-time.After(1 * time.Second): // Simulate work
 		case <-ctx.Done():
@@ -220,7 +220,7 @@ func Test_Retry_SuccessAfterRetry(t *testing.T) {
 	expectedValue := 1
 	currentRun := 0
 
-	res, err := service.Retry(func(ctx context.Context) retry.Result {
+	res, err := service.Retry(context.Background(), func(ctx context.Context) retry.Result {
 		currentRun++
 
 		waitTime := 10 * time.Second
@@ -252,7 +252,7 @@ func Test_Retry_Timeout(t *testing.T) {
 
 	expectedValue := 1
 
-	res, err := service.Retry(func(ctx context.Context) retry.Result {
+	res, err := service.Retry(context.Background(), func(ctx context.Context) retry.Result {
 		waitTime := 10 * time.Second
 
 		select {
@@ -278,7 +278,7 @@ func Test_Retry_ReturnError(t *testing.T) {
 	setup()
 
 	currentRun := 0
-	res, err := service.Retry(func(ctx context.Context) retry.Result {
+	res, err := service.Retry(context.Background(), func(ctx context.Context) retry.Result {
 		currentRun++
 		return retry.Result{
 			Value: nil,
@@ -291,3 +291,23 @@ func Test_Retry_ReturnError(t *testing.T) {
 	require.Equal(t, err.Error(), "some error")
 	require.Equal(t, currentRun, 1)
 }
+
+func Test_Retry_ContextCancelled(t *testing.T) {
+	setup()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	currentRun := 0
+	res, err := service.Retry(ctx, func(ctx context.Context) retry.Result {
+		currentRun++
+		cancel()
+		<-ctx.Done()
+		return retry.Result{
+			Value: nil,
+			Error: ctx.Err(),
+		}
+	}, 3)
+
+	require.Nil(t, res)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, currentRun, 1)
+}
@@ -0,0 +1,355 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/client"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	transferModel "github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+	webhookModel "github.com/limechain/hedera-eth-bridge-validator/app/model/webhook"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// dueBatchSize is the maximum number of deliveries attempted concurrently
+	dueBatchSize = 50
+	// maxBackoff caps the exponential backoff between the attempts of a delivery
+	maxBackoff = time.Hour
+)
+
+type Service struct {
+	endpoints       []config.Webhook
+	maxAttempts     int
+	initialBackoff  time.Duration
+	timeout         time.Duration
+	pollingInterval time.Duration
+	repository      repository.WebhookDelivery
+	transferEvents  service.TransferEvents
+	httpClient      client.HttpClient
+	notify          chan struct{}
+	once            sync.Once
+	dispatching     sync.WaitGroup
+	logger          *log.Entry
+}
+
+func NewService(cfg config.Webhooks, repository repository.WebhookDelivery, transferEvents service.TransferEvents, httpClient client.HttpClient) *Service {
+	return &Service{
+		endpoints:       cfg.Endpoints,
+		maxAttempts:     cfg.MaxAttempts,
+		initialBackoff:  cfg.InitialBackoff * time.Second,
+		timeout:         cfg.Timeout * time.Second,
+		pollingInterval: cfg.PollingInterval * time.Second,
+		repository:      repository,
+		transferEvents:  transferEvents,
+		httpClient:      httpClient,
+		notify:          make(chan struct{}, 1),
+		logger:          config.GetLoggerFor("Webhooks Service"),
+	}
+}
+
+func (s *Service) Start(ctx context.Context) {
+	if len(s.endpoints) == 0 {
+		return
+	}
+
+	s.once.Do(func() {
+		// Deliveries are persisted from the events, so none of them may be dropped
+		events, _ := s.transferEvents.SubscribeLossless(transferModel.Subscription{All: true})
+		go s.listen(events)
+		s.dispatching.Add(1)
+		go s.dispatch(ctx)
+		s.logger.Infof("Delivering transfer events to [%d] webhook endpoints.", len(s.endpoints))
+	})
+}
+
+func (s *Service) Drain() error {
+	s.dispatching.Wait()
+	return nil
+}
+
+func (s *Service) Delivery(id uint64) (*webhookModel.Delivery, error) {
+	delivery, err := s.repository.Get(id)
+	if err != nil {
+		s.logger.Errorf("[%d] - Failed to get webhook delivery. Error: [%s]", id, err)
+		return nil, err
+	}
+	if delivery == nil {
+		return nil, service.ErrNotFound
+	}
+
+	return delivery.ToDto(), nil
+}
+
+func (s *Service) Replay(id uint64) (*webhookModel.Delivery, error) {
+	original, err := s.repository.Get(id)
+	if err != nil {
+		s.logger.Errorf("[%d] - Failed to get webhook delivery for replay. Error: [%s]", id, err)
+		return nil, err
+	}
+	if original == nil {
+		return nil, service.ErrNotFound
+	}
+	if _, ok := s.endpoint(original.Url); !ok {
+		return nil, service.ErrWebhookNotConfigured
+	}
+
+	replay := &entity.WebhookDelivery{
+		Url:           original.Url,
+		Event:         original.Event,
+		TransferID:    original.TransferID,
+		Payload:       original.Payload,
+		Status:        webhookModel.StatusPending,
+		NextAttemptAt: time.Now().UnixNano(),
+		ReplayOf:      original.ID,
+	}
+	err = s.repository.Create(replay)
+	if err != nil {
+		s.logger.Errorf("[%d] - Failed to create webhook delivery replay. Error: [%s]", id, err)
+		return nil, err
+	}
+
+	s.logger.Infof("[%d] - Replaying webhook delivery of [%s] to [%s] as [%d].", id, replay.Event, replay.Url, replay.ID)
+	s.wake()
+	return replay.ToDto(), nil
+}
+
+// Sign returns the value of the signature header for the given payload, sent at the given unix timestamp
+func Sign(secret, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// eventName returns the webhook event for the given transfer event or an empty string if no webhook is fired for it
+func eventName(event *transferModel.Event) string {
+	switch event.Type {
+	case transferModel.EventTransferCreated,
+		transferModel.EventMajorityReached,
+		transferModel.EventScheduledTransactionCompleted,
+		transferModel.EventScheduledTransactionFailed:
+		return event.Type
+	case transferModel.EventStatusChanged:
+		switch event.Status {
+		case status.Completed:
+			return webhookModel.EventTransferCompleted
+		case status.Failed:
+			return webhookModel.EventTransferFailed
+		case status.Claimed:
+			return webhookModel.EventTransferClaimed
+		case status.Refunded:
+			return webhookModel.EventTransferRefunded
+		}
+	}
+	return ""
+}
+
+func (s *Service) listen(events <-chan *transferModel.Event) {
+	for event := range events {
+		s.enqueue(event)
+	}
+}
+
+// enqueue logs a pending delivery of the event for each endpoint subscribed for it
+func (s *Service) enqueue(event *transferModel.Event) {
+	name := eventName(event)
+	if name == "" {
+		return
+	}
+
+	payload, err := json.Marshal(&webhookModel.Payload{
+		Event:     name,
+		Timestamp: event.Timestamp,
+		Data:      event,
+	})
+	if err != nil {
+		s.logger.Errorf("[%s] - Failed to encode [%s] webhook payload. Error: [%s]", event.TransferId, name, err)
+		return
+	}
+
+	created := false
+	for _, endpoint := range s.endpoints {
+		if !endpoint.Accepts(name) {
+			continue
+		}
+
+		err = s.repository.Create(&entity.WebhookDelivery{
+			Url:           endpoint.Url,
+			Event:         name,
+			TransferID:    event.TransferId,
+			Payload:       payload,
+			Status:        webhookModel.StatusPending,
+			NextAttemptAt: time.Now().UnixNano(),
+		})
+		if err != nil {
+			s.logger.Errorf("[%s] - Failed to create [%s] webhook delivery to [%s]. Error: [%s]", event.TransferId, name, endpoint.Url, err)
+			continue
+		}
+		created = true
+	}
+
+	if created {
+		s.wake()
+	}
+}
+
+func (s *Service) wake() {
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
+
+// dispatch attempts the due deliveries until the context is cancelled. The attempts in flight are not
+// cancelled along with it, so that their outcome is stored
+func (s *Service) dispatch(ctx context.Context) {
+	defer s.dispatching.Done()
+
+	// Deliveries are attempted concurrently, so the lease has to cover a single attempt only
+	lease := 2 * s.timeout
+	for ctx.Err() == nil {
+		deliveries, err := s.repository.Due(dueBatchSize, lease)
+		if err != nil {
+			s.logger.Errorf("Failed to get due webhook deliveries. Error: [%s]", err)
+			select {
+			case <-ctx.Done():
+			case <-time.After(s.pollingInterval):
+			}
+			continue
+		}
+
+		var wg sync.WaitGroup
+		for _, d := range deliveries {
+			wg.Add(1)
+			go func(d *entity.WebhookDelivery) {
+				defer wg.Done()
+				s.deliver(d)
+			}(d)
+		}
+		wg.Wait()
+
+		if len(deliveries) == dueBatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-s.notify:
+		case <-time.After(s.pollingInterval):
+		}
+	}
+	s.logger.Infof("Stopped dispatching webhook deliveries.")
+}
+
+// deliver attempts the delivery and stores its outcome, scheduling a retry with exponential backoff on failure
+func (s *Service) deliver(delivery *entity.WebhookDelivery) {
+	delivery.Attempts++
+	responseCode, err := s.post(delivery)
+	delivery.ResponseCode = responseCode
+
+	now := time.Now()
+	if err == nil {
+		delivery.Status = webhookModel.StatusDelivered
+		delivery.LastError = ""
+		delivery.DeliveredAt = now.UnixNano()
+	} else {
+		delivery.LastError = err.Error()
+		if delivery.Attempts >= s.maxAttempts {
+			delivery.Status = webhookModel.StatusFailed
+		} else {
+			backoff := s.backoff(delivery.Attempts)
+			delivery.NextAttemptAt = now.Add(backoff).UnixNano()
+			s.logger.Warnf("[%d] - Webhook delivery of [%s] to [%s] failed. Retrying in [%s]. Error: [%s]", delivery.ID, delivery.Event, delivery.Url, backoff, err)
+		}
+	}
+
+	err = s.repository.Update(delivery)
+	if err != nil {
+		s.logger.Errorf("[%d] - Failed to update webhook delivery. Error: [%s]", delivery.ID, err)
+	}
+}
+
+func (s *Service) post(delivery *entity.WebhookDelivery) (int, error) {
+	endpoint, ok := s.endpoint(delivery.Url)
+	if !ok {
+		return 0, service.ErrWebhookNotConfigured
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.Url, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(webhookModel.HeaderEvent, delivery.Event)
+	req.Header.Set(webhookModel.HeaderDelivery, strconv.FormatUint(delivery.ID, 10))
+	req.Header.Set(webhookModel.HeaderTimestamp, timestamp)
+	req.Header.Set(webhookModel.HeaderSignature, Sign(endpoint.Secret, timestamp, delivery.Payload))
+
+	res, err := s.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
+		return res.StatusCode, fmt.Errorf("unexpected response status [%d]", res.StatusCode)
+	}
+	return res.StatusCode, nil
+}
+
+// backoff returns the time before the next attempt, doubled after each failed attempt
+func (s *Service) backoff(attempts int) time.Duration {
+	backoff := s.initialBackoff
+	for i := 1; i < attempts && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxBackoff {
+		return maxBackoff
+	}
+	return backoff
+}
+
+func (s *Service) endpoint(url string) (config.Webhook, bool) {
+	for _, endpoint := range s.endpoints {
+		if endpoint.Url == url {
+			return endpoint, true
+		}
+	}
+	return config.Webhook{}, false
+}
@@ -0,0 +1,349 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	transferModel "github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+	webhookModel "github.com/limechain/hedera-eth-bridge-validator/app/model/webhook"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
+	transfer_events "github.com/limechain/hedera-eth-bridge-validator/app/services/transfer-events"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	"github.com/limechain/hedera-eth-bridge-validator/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	s          = &Service{}
+	url        = "https://integrator/hooks"
+	claimedUrl = "https://integrator/claimed"
+	secret     = "secret"
+	deliveryId = uint64(7)
+	transferId = "0.0.123456-1650000000-000000001"
+	payload    = []byte(`{"event":"TRANSFER_CLAIMED"}`)
+	endpoints  = []config.Webhook{
+		{Url: url, Secret: secret},
+		{Url: claimedUrl, Events: []string{webhookModel.EventTransferClaimed}, Secret: secret},
+	}
+)
+
+func Test_New(t *testing.T) {
+	setup()
+
+	actual := NewService(config.Webhooks{
+		Endpoints:       endpoints,
+		MaxAttempts:     3,
+		InitialBackoff:  5,
+		Timeout:         10,
+		PollingInterval: 1,
+	}, mocks.MWebhookDeliveryRepository, mocks.MTransferEventsService, mocks.MHTTPClient)
+
+	assert.Equal(t, endpoints, actual.endpoints)
+	assert.Equal(t, 3, actual.maxAttempts)
+	assert.Equal(t, 5*time.Second, actual.initialBackoff)
+	assert.Equal(t, 10*time.Second, actual.timeout)
+	assert.Equal(t, time.Second, actual.pollingInterval)
+}
+
+func Test_StartWithoutEndpoints(t *testing.T) {
+	setup()
+	s.endpoints = nil
+
+	s.Start(context.Background())
+
+	mocks.MTransferEventsService.AssertNotCalled(t, "SubscribeLossless", mock.Anything)
+}
+
+func Test_ListenDoesNotDropEvents(t *testing.T) {
+	setup()
+	hub := transfer_events.NewService()
+	events, unsubscribe := hub.SubscribeLossless(transferModel.Subscription{All: true})
+	defer unsubscribe()
+
+	total := 200
+	created := make(chan struct{}, total)
+	mocks.MWebhookDeliveryRepository.On("Create", mock.Anything).Run(func(_ mock.Arguments) {
+		// slower than the publisher, so that events pile up beyond the buffer of a regular subscriber
+		time.Sleep(time.Millisecond)
+		created <- struct{}{}
+	}).Return(nil)
+	go s.listen(events)
+
+	for i := 0; i < total; i++ {
+		hub.Publish(&transferModel.Event{Type: transferModel.EventTransferCreated, TransferId: transferId})
+	}
+
+	for i := 0; i < total; i++ {
+		select {
+		case <-created:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("only [%d] out of [%d] deliveries were created", i, total)
+		}
+	}
+}
+
+func Test_Sign(t *testing.T) {
+	assert.Equal(t, "sha256=2eefa4c8f502f63ee6ce865997cc4d3f68eae6c1c1346ad133ecf7333e7476b9", Sign(secret, "1650000000", payload))
+}
+
+func Test_EventName(t *testing.T) {
+	assert.Equal(t, webhookModel.EventTransferCreated, eventName(&transferModel.Event{Type: transferModel.EventTransferCreated}))
+	assert.Equal(t, webhookModel.EventMajorityReached, eventName(&transferModel.Event{Type: transferModel.EventMajorityReached}))
+	assert.Equal(t, webhookModel.EventScheduledTransactionCompleted, eventName(&transferModel.Event{Type: transferModel.EventScheduledTransactionCompleted}))
+	assert.Equal(t, webhookModel.EventTransferCompleted, eventName(&transferModel.Event{Type: transferModel.EventStatusChanged, Status: status.Completed}))
+	assert.Equal(t, webhookModel.EventTransferFailed, eventName(&transferModel.Event{Type: transferModel.EventStatusChanged, Status: status.Failed}))
+	assert.Equal(t, webhookModel.EventTransferClaimed, eventName(&transferModel.Event{Type: transferModel.EventStatusChanged, Status: status.Claimed}))
+	assert.Equal(t, webhookModel.EventTransferRefunded, eventName(&transferModel.Event{Type: transferModel.EventStatusChanged, Status: status.Refunded}))
+	assert.Empty(t, eventName(&transferModel.Event{Type: transferModel.EventStatusChanged, Status: status.Reorged}))
+	assert.Empty(t, eventName(&transferModel.Event{Type: transferModel.EventSignatureAdded}))
+}
+
+func Test_Enqueue(t *testing.T) {
+	setup()
+	event := &transferModel.Event{Type: transferModel.EventStatusChanged, TransferId: transferId, Status: status.Claimed}
+	mocks.MWebhookDeliveryRepository.On("Create", mock.Anything).Return(nil)
+
+	s.enqueue(event)
+
+	mocks.MWebhookDeliveryRepository.AssertNumberOfCalls(t, "Create", 2)
+	created := mocks.MWebhookDeliveryRepository.Calls[1].Arguments.Get(0).(*entity.WebhookDelivery)
+	assert.Equal(t, claimedUrl, created.Url)
+	assert.Equal(t, webhookModel.EventTransferClaimed, created.Event)
+	assert.Equal(t, transferId, created.TransferID)
+	assert.Equal(t, webhookModel.StatusPending, created.Status)
+
+	sent := &webhookModel.Payload{}
+	assert.Nil(t, json.Unmarshal(created.Payload, sent))
+	assert.Equal(t, webhookModel.EventTransferClaimed, sent.Event)
+	assert.Equal(t, transferId, sent.Data.TransferId)
+}
+
+func Test_EnqueueFiltersEvents(t *testing.T) {
+	setup()
+	event := &transferModel.Event{Type: transferModel.EventTransferCreated, TransferId: transferId}
+	mocks.MWebhookDeliveryRepository.On("Create", mock.Anything).Return(nil)
+
+	s.enqueue(event)
+
+	mocks.MWebhookDeliveryRepository.AssertNumberOfCalls(t, "Create", 1)
+}
+
+func Test_EnqueueIgnoredEvent(t *testing.T) {
+	setup()
+
+	s.enqueue(&transferModel.Event{Type: transferModel.EventSignatureAdded, TransferId: transferId})
+
+	mocks.MWebhookDeliveryRepository.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func Test_Deliver(t *testing.T) {
+	setup()
+	delivery := pendingDelivery()
+	mocks.MHTTPClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+		timestamp := req.Header.Get(webhookModel.HeaderTimestamp)
+		return req.Method == http.MethodPost &&
+			req.URL.String() == url &&
+			req.Header.Get(webhookModel.HeaderEvent) == webhookModel.EventTransferClaimed &&
+			req.Header.Get(webhookModel.HeaderDelivery) == "7" &&
+			req.Header.Get(webhookModel.HeaderSignature) == Sign(secret, timestamp, payload)
+	})).Return(&http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil)
+	mocks.MWebhookDeliveryRepository.On("Update", delivery).Return(nil)
+
+	s.deliver(delivery)
+
+	assert.Equal(t, webhookModel.StatusDelivered, delivery.Status)
+	assert.Equal(t, 1, delivery.Attempts)
+	assert.Equal(t, http.StatusOK, delivery.ResponseCode)
+	assert.NotZero(t, delivery.DeliveredAt)
+	mocks.MWebhookDeliveryRepository.AssertCalled(t, "Update", delivery)
+}
+
+func Test_DeliverRetriesWithBackoff(t *testing.T) {
+	setup()
+	delivery := pendingDelivery()
+	mocks.MHTTPClient.On("Do", mock.Anything).Return(&http.Response{StatusCode: http.StatusInternalServerError, Body: http.NoBody}, nil)
+	mocks.MWebhookDeliveryRepository.On("Update", delivery).Return(nil)
+
+	before := time.Now()
+	s.deliver(delivery)
+
+	assert.Equal(t, webhookModel.StatusPending, delivery.Status)
+	assert.Equal(t, http.StatusInternalServerError, delivery.ResponseCode)
+	assert.NotEmpty(t, delivery.LastError)
+	assert.GreaterOrEqual(t, delivery.NextAttemptAt, before.Add(s.initialBackoff).UnixNano())
+}
+
+func Test_DeliverFailsAfterMaxAttempts(t *testing.T) {
+	setup()
+	delivery := pendingDelivery()
+	delivery.Attempts = s.maxAttempts - 1
+	mocks.MHTTPClient.On("Do", mock.Anything).Return((*http.Response)(nil), errors.New("connection refused"))
+	mocks.MWebhookDeliveryRepository.On("Update", delivery).Return(nil)
+
+	s.deliver(delivery)
+
+	assert.Equal(t, webhookModel.StatusFailed, delivery.Status)
+	assert.Equal(t, s.maxAttempts, delivery.Attempts)
+	assert.Equal(t, "connection refused", delivery.LastError)
+}
+
+func Test_DrainWaitsForDeliveriesInFlight(t *testing.T) {
+	setup()
+	delivery := pendingDelivery()
+	attempting := make(chan struct{})
+	respond := make(chan struct{})
+	mocks.MWebhookDeliveryRepository.On("Due", dueBatchSize, 2*s.timeout).Return([]*entity.WebhookDelivery{delivery}, nil).Once()
+	mocks.MWebhookDeliveryRepository.On("Due", dueBatchSize, 2*s.timeout).Return([]*entity.WebhookDelivery{}, nil)
+	mocks.MHTTPClient.On("Do", mock.Anything).Run(func(_ mock.Arguments) {
+		close(attempting)
+		<-respond
+	}).Return(&http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil)
+	mocks.MWebhookDeliveryRepository.On("Update", delivery).Return(nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.dispatching.Add(1)
+	go s.dispatch(ctx)
+	<-attempting
+	cancel()
+
+	drained := make(chan error)
+	go func() {
+		drained <- s.Drain()
+	}()
+	select {
+	case <-drained:
+		t.Fatal("drained before the delivery in flight finished")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(respond)
+	select {
+	case err := <-drained:
+		assert.Nil(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("did not drain after the delivery in flight finished")
+	}
+	assert.Equal(t, webhookModel.StatusDelivered, delivery.Status)
+	mocks.MWebhookDeliveryRepository.AssertCalled(t, "Update", delivery)
+	mocks.MWebhookDeliveryRepository.AssertNumberOfCalls(t, "Due", 1)
+}
+
+func Test_Backoff(t *testing.T) {
+	setup()
+
+	assert.Equal(t, 5*time.Second, s.backoff(1))
+	assert.Equal(t, 10*time.Second, s.backoff(2))
+	assert.Equal(t, 40*time.Second, s.backoff(4))
+	assert.Equal(t, maxBackoff, s.backoff(20))
+}
+
+func Test_Delivery(t *testing.T) {
+	setup()
+	mocks.MWebhookDeliveryRepository.On("Get", deliveryId).Return(pendingDelivery(), nil)
+
+	actual, err := s.Delivery(deliveryId)
+
+	assert.Nil(t, err)
+	assert.Equal(t, pendingDelivery().ToDto(), actual)
+}
+
+func Test_Delivery_NotFound(t *testing.T) {
+	setup()
+	mocks.MWebhookDeliveryRepository.On("Get", deliveryId).Return((*entity.WebhookDelivery)(nil), nil)
+
+	actual, err := s.Delivery(deliveryId)
+
+	assert.Equal(t, service.ErrNotFound, err)
+	assert.Nil(t, actual)
+}
+
+func Test_Replay(t *testing.T) {
+	setup()
+	original := pendingDelivery()
+	original.Status = webhookModel.StatusFailed
+	mocks.MWebhookDeliveryRepository.On("Get", deliveryId).Return(original, nil)
+	mocks.MWebhookDeliveryRepository.On("Create", mock.MatchedBy(func(d *entity.WebhookDelivery) bool {
+		return d.ReplayOf == deliveryId && d.Url == url && d.Status == webhookModel.StatusPending && string(d.Payload) == string(payload)
+	})).Return(nil)
+
+	actual, err := s.Replay(deliveryId)
+
+	assert.Nil(t, err)
+	assert.Equal(t, deliveryId, actual.ReplayOf)
+	assert.Equal(t, webhookModel.StatusPending, actual.Status)
+	assert.Len(t, s.notify, 1)
+}
+
+func Test_Replay_NotFound(t *testing.T) {
+	setup()
+	mocks.MWebhookDeliveryRepository.On("Get", deliveryId).Return((*entity.WebhookDelivery)(nil), nil)
+
+	actual, err := s.Replay(deliveryId)
+
+	assert.Equal(t, service.ErrNotFound, err)
+	assert.Nil(t, actual)
+}
+
+func Test_Replay_NotConfigured(t *testing.T) {
+	setup()
+	original := pendingDelivery()
+	original.Url = "https://removed/hooks"
+	mocks.MWebhookDeliveryRepository.On("Get", deliveryId).Return(original, nil)
+
+	actual, err := s.Replay(deliveryId)
+
+	assert.Equal(t, service.ErrWebhookNotConfigured, err)
+	assert.Nil(t, actual)
+	mocks.MWebhookDeliveryRepository.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func pendingDelivery() *entity.WebhookDelivery {
+	return &entity.WebhookDelivery{
+		ID:         deliveryId,
+		Url:        url,
+		Event:      webhookModel.EventTransferClaimed,
+		TransferID: transferId,
+		Payload:    payload,
+		Status:     webhookModel.StatusPending,
+	}
+}
+
+func setup() {
+	mocks.Setup()
+
+	s = &Service{
+		endpoints:       endpoints,
+		maxAttempts:     5,
+		initialBackoff:  5 * time.Second,
+		timeout:         10 * time.Second,
+		pollingInterval: time.Second,
+		repository:      mocks.MWebhookDeliveryRepository,
+		transferEvents:  mocks.MTransferEventsService,
+		httpClient:      mocks.MHTTPClient,
+		notify:          make(chan struct{}, 1),
+		logger:          config.GetLoggerFor("Webhooks Service"),
+	}
+}
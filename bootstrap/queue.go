@@ -0,0 +1,43 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bootstrap
+
+import (
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/core/queue"
+	qi "github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+)
+
+// PrepareQueue instantiates the queue used between watchers and handlers
+func PrepareQueue(cfg config.Queue, repository repository.Queue) qi.Queue {
+	if !cfg.Persistent {
+		return queue.NewQueue()
+	}
+
+	log.Infoln("Using persistent queue.")
+	return queue.NewPersistentQueue(
+		repository,
+		cfg.PollingInterval*time.Second,
+		cfg.VisibilityTimeout*time.Second,
+		cfg.MaxAttempts,
+		cfg.BatchSize,
+		config.GetLoggerFor("Persistent Queue"))
+}
@@ -19,11 +19,18 @@ package bootstrap
 import (
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/database"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/audit"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/batch"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/block"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/fee"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/message"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/queue"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/schedule"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/status"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/transfer"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/webhook"
+	transfer_events "github.com/limechain/hedera-eth-bridge-validator/app/services/transfer-events"
 )
 
 // Repositories struct holding the referenced repositories
@@ -34,17 +41,29 @@ type Repositories struct {
 	Message        repository.Message
 	Fee            repository.Fee
 	Schedule       repository.Schedule
+	Queue          repository.Queue
+	Block          repository.Block
+	Batch          repository.Batch
+	Webhook        repository.WebhookDelivery
+	AuditLog       repository.AuditLog
 }
 
-// PrepareRepositories initialises connection to the Database and instantiates the repositories
-func PrepareRepositories(db database.Database) *Repositories {
+// PrepareRepositories initialises connection to the Database and instantiates the repositories.
+// Changes of transfers and their scheduled transactions are published to the given transfer events
+func PrepareRepositories(db database.Database, transferEvents service.TransferEvents) *Repositories {
 	connection := db.Connection()
+	transferRepository := transfer_events.NewTransferRepository(transfer.NewRepository(connection), transferEvents)
 	return &Repositories{
 		TransferStatus: status.NewRepositoryForStatus(connection, status.Transfer),
 		MessageStatus:  status.NewRepositoryForStatus(connection, status.Message),
-		Transfer:       transfer.NewRepository(connection),
+		Transfer:       transferRepository,
 		Message:        message.NewRepository(connection),
 		Fee:            fee.NewRepository(connection),
-		Schedule:       schedule.NewRepository(connection),
+		Schedule:       transfer_events.NewScheduleRepository(schedule.NewRepository(connection), transferRepository, transferEvents),
+		Queue:          queue.NewRepository(connection),
+		Block:          block.NewRepository(connection),
+		Batch:          batch.NewRepository(connection),
+		Webhook:        webhook.NewRepository(connection),
+		AuditLog:       audit.NewRepository(connection),
 	}
 }
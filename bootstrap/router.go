@@ -18,6 +18,7 @@ package bootstrap
 
 import (
 	apirouter "github.com/limechain/hedera-eth-bridge-validator/app/router"
+	"github.com/limechain/hedera-eth-bridge-validator/app/router/admin"
 	"github.com/limechain/hedera-eth-bridge-validator/app/router/assets"
 	burn_event "github.com/limechain/hedera-eth-bridge-validator/app/router/burn-event"
 	config_bridge "github.com/limechain/hedera-eth-bridge-validator/app/router/config-bridge"
@@ -25,7 +26,6 @@ import (
 	"github.com/limechain/hedera-eth-bridge-validator/app/router/healthcheck"
 	min_amounts "github.com/limechain/hedera-eth-bridge-validator/app/router/min-amounts"
 	"github.com/limechain/hedera-eth-bridge-validator/app/router/transfer"
-	"github.com/limechain/hedera-eth-bridge-validator/app/router/transfer-reset"
 	"github.com/limechain/hedera-eth-bridge-validator/app/router/utils"
 	"github.com/limechain/hedera-eth-bridge-validator/app/router/validator-version"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
@@ -36,8 +36,8 @@ import (
 
 func InitializeAPIRouter(services *Services, bridgeConfig *parser.Bridge, nodeConfig config.Node) *apirouter.APIRouter {
 	apiRouter := apirouter.NewAPIRouter()
-	apiRouter.AddV1Router(healthcheck.Route, healthcheck.NewRouter())
-	apiRouter.AddV1Router(transfer.Route, transfer.NewRouter(services.transfers))
+	apiRouter.AddV1Router(healthcheck.Route, healthcheck.NewRouter(services.Health))
+	apiRouter.AddV1Router(transfer.Route, transfer.NewRouter(services.transfers, services.TransferEvents))
 	apiRouter.AddV1Router(burn_event.Route, burn_event.NewRouter(services.BurnEvents))
 	apiRouter.AddV1Router(constants.PrometheusMetricsEndpoint, promhttp.Handler())
 	apiRouter.AddV1Router(config_bridge.Route, config_bridge.NewRouter(bridgeConfig))
@@ -45,7 +45,7 @@ func InitializeAPIRouter(services *Services, bridgeConfig *parser.Bridge, nodeCo
 	apiRouter.AddV1Router(assets.Route, assets.NewRouter(bridgeConfig, services.Assets, services.Pricing))
 	apiRouter.AddV1Router(utils.Route, utils.NewRouter(services.Utils))
 	apiRouter.AddV1Router(fees.Route, fees.NewRouter(services.Pricing))
-	apiRouter.AddV1Router(transfer_reset.Route, transfer_reset.NewRouter(services.transfers, services.Prometheus, nodeConfig))
 	apiRouter.AddV1Router(validator_version.Route, validator_version.NewRouter())
+	apiRouter.AddV1Router(admin.Route, admin.NewRouter(services.Admin, services.Webhooks, nodeConfig.Admin))
 	return apiRouter
 }
@@ -22,24 +22,32 @@ import (
 
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/app/core/server"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
 	burn_message "github.com/limechain/hedera-eth-bridge-validator/app/process/handler/burn-message"
 	fee_message "github.com/limechain/hedera-eth-bridge-validator/app/process/handler/fee-message"
 	fee_transfer "github.com/limechain/hedera-eth-bridge-validator/app/process/handler/fee-transfer"
 	mh "github.com/limechain/hedera-eth-bridge-validator/app/process/handler/message"
 	message_submission "github.com/limechain/hedera-eth-bridge-validator/app/process/handler/message-submission"
 	mint_hts "github.com/limechain/hedera-eth-bridge-validator/app/process/handler/mint-hts"
+	nbmh "github.com/limechain/hedera-eth-bridge-validator/app/process/handler/nft/burn-message"
 	nfmh "github.com/limechain/hedera-eth-bridge-validator/app/process/handler/nft/fee-message"
+	nmh "github.com/limechain/hedera-eth-bridge-validator/app/process/handler/nft/mint"
 	nth "github.com/limechain/hedera-eth-bridge-validator/app/process/handler/nft/transfer"
 	rbh "github.com/limechain/hedera-eth-bridge-validator/app/process/handler/read-only/burn"
 	rfh "github.com/limechain/hedera-eth-bridge-validator/app/process/handler/read-only/fee"
 	rfth "github.com/limechain/hedera-eth-bridge-validator/app/process/handler/read-only/fee-transfer"
 	rmth "github.com/limechain/hedera-eth-bridge-validator/app/process/handler/read-only/mint-hts"
+	rnbh "github.com/limechain/hedera-eth-bridge-validator/app/process/handler/read-only/nft/burn"
 	rnfmh "github.com/limechain/hedera-eth-bridge-validator/app/process/handler/read-only/nft/fee"
+	rnmh "github.com/limechain/hedera-eth-bridge-validator/app/process/handler/read-only/nft/mint"
 	rnth "github.com/limechain/hedera-eth-bridge-validator/app/process/handler/read-only/nft/transfer"
 	rthh "github.com/limechain/hedera-eth-bridge-validator/app/process/handler/read-only/transfer"
+	"github.com/limechain/hedera-eth-bridge-validator/app/process/handler/refund"
+	"github.com/limechain/hedera-eth-bridge-validator/app/process/watcher/batch"
 	bridge_config "github.com/limechain/hedera-eth-bridge-validator/app/process/watcher/bridge-config"
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/watcher/evm"
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/watcher/price"
+	"github.com/limechain/hedera-eth-bridge-validator/app/process/watcher/redrive"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/config/parser"
 	"github.com/limechain/hedera-eth-bridge-validator/constants"
@@ -68,6 +76,9 @@ func InitializeServerPairs(server *server.Server, services *Services, repositori
 	// Hedera Native unlock Nft Handlers
 	registerHederaNativeUnlockNftHandlers(server, services, repositories, configuration)
 
+	// EVM Native Nft handlers
+	registerEvmNativeNFTHandlers(server, services, repositories, clients, configuration)
+
 	// Assets Watcher
 	registerAssetsWatcher(server, services, configuration, clients)
 
@@ -79,6 +90,12 @@ func InitializeServerPairs(server *server.Server, services *Services, repositori
 
 	// Bridge Config Watcher
 	registerBridgeConfigWatcher(server, services, parsedBridge.UseLocalConfig, bridgeCfgTopicId, parsedBridge.PollingInterval)
+
+	// Redrive Watcher
+	registerRedriveWatcher(server, services, configuration)
+
+	// Batch Watcher
+	registerBatchWatcher(server, services, configuration)
 }
 
 func registerBridgeConfigWatcher(s *server.Server, services *Services, useLocalConfig bool, bridgeCfgTopicId hedera.TopicID, pollingInterval time.Duration) {
@@ -89,8 +106,23 @@ func registerBridgeConfigWatcher(s *server.Server, services *Services, useLocalC
 	}
 }
 
+func registerRedriveWatcher(server *server.Server, services *Services, configuration *config.Config) {
+	if configuration.Node.Redrive.Enabled {
+		server.AddWatcher(redrive.NewWatcher(services.Redrive, configuration.Node.Redrive.Interval))
+	} else {
+		log.Infoln("Redrive is disabled. Stuck transfers will not be re-driven.")
+	}
+}
+
+func registerBatchWatcher(server *server.Server, services *Services, configuration *config.Config) {
+	if configuration.Node.Batching.Enabled {
+		server.AddWatcher(batch.NewWatcher(services.Batch))
+	}
+}
+
 func registerTransferWatcher(server *server.Server, services *Services, repositories *Repositories, clients *Clients, configuration *config.Config) {
-	server.AddWatcher(createTransferWatcher(
+	// Controlled by the ID of its status
+	server.AddControlledWatcher(configuration.Bridge.Hedera.BridgeAccount, createTransferWatcher(
 		configuration,
 		services.transfers,
 		services.Assets,
@@ -102,12 +134,13 @@ func registerTransferWatcher(server *server.Server, services *Services, reposito
 }
 
 func registerValidationServerPairs(server *server.Server, services *Services, repositories *Repositories, clients *Clients, configuration *config.Config) {
-	// Watcher - ConsensusTopic
-	server.AddWatcher(
+	// Watcher - ConsensusTopic, controlled by the ID of its status
+	server.AddControlledWatcher(configuration.Bridge.TopicId,
 		createConsensusTopicWatcher(
 			configuration,
 			clients.MirrorNode,
-			repositories.MessageStatus))
+			repositories.MessageStatus,
+			services.Prometheus))
 
 	// Handler - TopicMessageValidation
 	server.AddHandler(constants.TopicMessageValidation, mh.NewHandler(
@@ -116,8 +149,7 @@ func registerValidationServerPairs(server *server.Server, services *Services, re
 		repositories.Message,
 		services.ContractServices,
 		services.Messages,
-		services.Prometheus,
-		services.Assets))
+		services.Prometheus))
 }
 
 func registerTransferMessageHandlers(server *server.Server, services *Services, repositories *Repositories, clients *Clients, configuration *config.Config) {
@@ -142,21 +174,23 @@ func registerTransferMessageHandlers(server *server.Server, services *Services,
 
 	// HederaTransferMessageSubmission
 	server.AddHandler(constants.HederaTransferMessageSubmission, fee_message.NewHandler(services.transfers))
+
+	// HederaRefund
+	server.AddHandler(constants.HederaRefund, refund.NewHandler(services.Refund))
 }
 
 func registerEvmClients(server *server.Server, services *Services, repositories *Repositories, clients *Clients, configuration *config.Config) {
 	for _, evmClient := range clients.EvmClients {
 		chain := evmClient.GetChainID()
 		contractService := services.ContractServices[chain]
-		// Given that addresses between different
-		// EVM networks might be the same, a concatenation between
-		// <chain-id>-<contract-address> removes possible duplication.
-		dbIdentifier := fmt.Sprintf("%d-%s", chain, contractService.Address().String())
+		dbIdentifier := evmWatcherID(chain, contractService)
 		blacklisted := configuration.Bridge.BlacklistedAccounts
 
-		server.AddWatcher(
+		server.AddControlledWatcher(dbIdentifier,
 			evm.NewWatcher(
 				repositories.TransferStatus,
+				repositories.Block,
+				repositories.Transfer,
 				contractService,
 				services.Prometheus,
 				services.Pricing,
@@ -168,10 +202,18 @@ func registerEvmClients(server *server.Server, services *Services, repositories
 				configuration.Node.Clients.EvmPool[chain].PollingInterval,
 				configuration.Node.Clients.EvmPool[chain].MaxLogsBlocks,
 				blacklisted,
+				configuration.Node.Clients.EvmPool[chain].WsUrl != "",
 			))
 	}
 }
 
+// evmWatcherID returns the ID of the Status of the EVM watcher for the router contract.
+// Given that addresses between different EVM networks might be the same,
+// a concatenation between <chain-id>-<contract-address> removes possible duplication.
+func evmWatcherID(chain uint64, contractService service.Contracts) string {
+	return fmt.Sprintf("%d-%s", chain, contractService.Address().String())
+}
+
 func registerAssetsWatcher(server *server.Server, services *Services, configuration *config.Config, clients *Clients) {
 	server.AddWatcher(createAssetsWatcher(
 		clients.MirrorNode,
@@ -233,6 +275,39 @@ func registerHederaNativeNFTHandlers(server *server.Server, services *Services,
 		services.ReadOnly))
 }
 
+func registerEvmNativeNFTHandlers(server *server.Server, services *Services, repositories *Repositories, clients *Clients, configuration *config.Config) {
+	// HederaMintNftTransfer
+	server.AddHandler(constants.HederaMintNftTransfer, nmh.NewHandler(
+		configuration.Bridge.Hedera.BridgeAccount,
+		repositories.Transfer,
+		repositories.Schedule,
+		services.transfers,
+		services.Scheduled,
+		clients.MirrorNode))
+
+	// ReadOnlyHederaMintNftTransfer
+	server.AddHandler(constants.ReadOnlyHederaMintNftTransfer, rnmh.NewHandler(
+		configuration.Bridge.Hedera.BridgeAccount,
+		configuration.Bridge.Hedera.PayerAccount,
+		repositories.Transfer,
+		repositories.Schedule,
+		clients.MirrorNode,
+		services.ReadOnly,
+		services.transfers))
+
+	// HederaBurnNftMessageSubmission
+	server.AddHandler(constants.HederaBurnNftMessageSubmission, nbmh.NewHandler(repositories.Transfer, services.transfers))
+
+	// ReadOnlyHederaBurnNft
+	server.AddHandler(constants.ReadOnlyHederaBurnNft, rnbh.NewHandler(
+		configuration.Bridge.Hedera.BridgeAccount,
+		clients.MirrorNode,
+		repositories.Schedule,
+		repositories.Transfer,
+		services.transfers,
+		services.ReadOnly))
+}
+
 func registerReadOnlyHandlers(server *server.Server, services *Services, repositories *Repositories, clients *Clients, configuration *config.Config) {
 	// ReadOnlyHederaTransfer
 	server.AddHandler(constants.ReadOnlyHederaTransfer, rfth.NewHandler(
@@ -245,6 +320,7 @@ func registerReadOnlyHandlers(server *server.Server, services *Services, reposit
 		services.Fees,
 		services.transfers,
 		services.ReadOnly,
+		services.Batch,
 		services.Prometheus))
 
 	// ReadOnlyHederaFeeTransfer
@@ -278,6 +354,7 @@ func registerReadOnlyHandlers(server *server.Server, services *Services, reposit
 		clients.MirrorNode,
 		services.transfers,
 		services.ReadOnly,
+		services.Batch,
 		services.Prometheus))
 
 	//ReadOnlyTransferSave
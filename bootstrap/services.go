@@ -19,25 +19,35 @@ package bootstrap
 import (
 	"fmt"
 	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/domain/database"
+	qi "github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
+	"github.com/limechain/hedera-eth-bridge-validator/app/services/admin"
 	"github.com/limechain/hedera-eth-bridge-validator/app/services/assets"
+	"github.com/limechain/hedera-eth-bridge-validator/app/services/batch"
 	bridge_config "github.com/limechain/hedera-eth-bridge-validator/app/services/bridge-config"
 	burn_event "github.com/limechain/hedera-eth-bridge-validator/app/services/burn-event"
 	"github.com/limechain/hedera-eth-bridge-validator/app/services/contracts"
 	"github.com/limechain/hedera-eth-bridge-validator/app/services/fee/calculator"
 	"github.com/limechain/hedera-eth-bridge-validator/app/services/fee/distributor"
+	"github.com/limechain/hedera-eth-bridge-validator/app/services/health"
 	lock_event "github.com/limechain/hedera-eth-bridge-validator/app/services/lock-event"
 	"github.com/limechain/hedera-eth-bridge-validator/app/services/messages"
 	"github.com/limechain/hedera-eth-bridge-validator/app/services/pricing"
 	prometheusServices "github.com/limechain/hedera-eth-bridge-validator/app/services/prometheus"
 	read_only "github.com/limechain/hedera-eth-bridge-validator/app/services/read-only"
+	"github.com/limechain/hedera-eth-bridge-validator/app/services/redrive"
+	"github.com/limechain/hedera-eth-bridge-validator/app/services/refund"
 	"github.com/limechain/hedera-eth-bridge-validator/app/services/scheduled"
 	"github.com/limechain/hedera-eth-bridge-validator/app/services/signer/evm"
+	"github.com/limechain/hedera-eth-bridge-validator/app/services/signer/remote"
 	"github.com/limechain/hedera-eth-bridge-validator/app/services/transfers"
 	utilsSvc "github.com/limechain/hedera-eth-bridge-validator/app/services/utils"
+	"github.com/limechain/hedera-eth-bridge-validator/app/services/webhooks"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	"github.com/limechain/hedera-eth-bridge-validator/config/parser"
 	"github.com/limechain/hedera-eth-bridge-validator/constants"
+	"net/http"
 )
 
 type Services struct {
@@ -50,16 +60,23 @@ type Services struct {
 	Fees             service.Fee
 	Distributor      service.Distributor
 	Scheduled        service.Scheduled
+	Batch            service.Batch
+	Refund           service.Refund
+	TransferEvents   service.TransferEvents
 	ReadOnly         service.ReadOnly
 	Prometheus       service.Prometheus
 	Pricing          service.Pricing
 	Assets           service.Assets
 	Utils            service.Utils
 	BridgeConfig     service.BridgeConfig
+	Webhooks         service.Webhooks
+	Admin            service.Admin
+	Redrive          service.Redrive
+	Health           service.Health
 }
 
 // PrepareServices instantiates all the necessary services with their required context and parameters
-func PrepareServices(c *config.Config, parsedBridge *parser.Bridge, clients *Clients, repositories Repositories, parsedBridgeConfigTopicId hedera.TopicID) *Services {
+func PrepareServices(c *config.Config, parsedBridge *parser.Bridge, clients *Clients, repositories Repositories, transferEvents service.TransferEvents, queue qi.Queue, parsedBridgeConfigTopicId hedera.TopicID) *Services {
 
 	bridgeCfgService := bridge_config.NewService(c, parsedBridge, clients.MirrorNode)
 	if !parsedBridge.UseLocalConfig {
@@ -86,7 +103,7 @@ func PrepareServices(c *config.Config, parsedBridge *parser.Bridge, clients *Cli
 
 	for _, client := range clients.EvmClients {
 		chainId := client.GetChainID()
-		evmSigners[chainId] = evm.NewEVMSigner(client.GetPrivateKey())
+		evmSigners[chainId] = prepareSigner(c.Node.Clients.EvmPool[chainId].Signer, client.GetPrivateKey())
 		evmConfig, ok := c.Bridge.EVMs[chainId]
 		if ok && evmConfig.RouterContractAddress != "" {
 			contractServices[chainId] = contracts.NewService(client, evmConfig.RouterContractAddress, clients.RouterClients[chainId])
@@ -97,7 +114,7 @@ func PrepareServices(c *config.Config, parsedBridge *parser.Bridge, clients *Cli
 	distributor := distributor.New(c.Bridge.Hedera.Members)
 	scheduled := scheduled.New(c.Bridge.Hedera.PayerAccount, clients.HederaNode, clients.MirrorNode)
 
-	prometheus := prometheusServices.NewService(assetsService, c.Node.Monitoring.Enable)
+	prometheus := prometheusServices.NewService(c.Node.Monitoring.Enable)
 	messages := messages.NewService(
 		evmSigners,
 		contractServices,
@@ -106,7 +123,8 @@ func PrepareServices(c *config.Config, parsedBridge *parser.Bridge, clients *Cli
 		clients.MirrorNode,
 		clients.EvmClients,
 		c.Bridge.TopicId,
-		assetsService)
+		assetsService,
+		transferEvents)
 
 	transfers := transfers.NewService(
 		clients.HederaNode,
@@ -124,6 +142,33 @@ func PrepareServices(c *config.Config, parsedBridge *parser.Bridge, clients *Cli
 		prometheus,
 		assetsService)
 
+	// Batches are executed once all EVM watchers have processed the blocks of their windows
+	evmWatchers := make([]string, 0, len(clients.EvmClients))
+	for chain := range clients.EvmClients {
+		evmWatchers = append(evmWatchers, evmWatcherID(chain, contractServices[chain]))
+	}
+	batchService := batch.NewService(
+		c.Bridge.Hedera.BridgeAccount,
+		c.Node.Batching,
+		evmWatchers,
+		repositories.Batch,
+		repositories.Block,
+		repositories.Transfer,
+		repositories.Schedule,
+		repositories.Fee,
+		scheduled)
+
+	refundService := refund.NewService(
+		c.Bridge.Hedera.BridgeAccount,
+		c.Bridge.Hedera.RefundFeePercentage,
+		repositories.Transfer,
+		repositories.Schedule,
+		repositories.Fee,
+		distributor,
+		scheduled,
+		clients.MirrorNode,
+		queue)
+
 	burnEvent := burn_event.NewService(
 		c.Bridge.Hedera.BridgeAccount,
 		repositories.Transfer,
@@ -133,6 +178,8 @@ func PrepareServices(c *config.Config, parsedBridge *parser.Bridge, clients *Cli
 		scheduled,
 		fees,
 		transfers,
+		batchService,
+		refundService,
 		prometheus)
 
 	lockEvent := lock_event.NewService(
@@ -141,6 +188,8 @@ func PrepareServices(c *config.Config, parsedBridge *parser.Bridge, clients *Cli
 		repositories.Schedule,
 		scheduled,
 		transfers,
+		batchService,
+		refundService,
 		prometheus)
 
 	readOnly := read_only.New(clients.MirrorNode, repositories.Transfer, c.Node.Clients.MirrorNode.PollingInterval)
@@ -155,6 +204,8 @@ func PrepareServices(c *config.Config, parsedBridge *parser.Bridge, clients *Cli
 
 	utilsService := utilsSvc.New(clients.EvmClients, burnEvent)
 
+	webhooksService := webhooks.NewService(c.Node.Webhooks, repositories.Webhook, transferEvents, new(http.Client))
+
 	return &Services{
 		Signers:          evmSigners,
 		ContractServices: contractServices,
@@ -165,11 +216,74 @@ func PrepareServices(c *config.Config, parsedBridge *parser.Bridge, clients *Cli
 		Fees:             fees,
 		Distributor:      distributor,
 		Scheduled:        scheduled,
+		Batch:            batchService,
+		Refund:           refundService,
+		TransferEvents:   transferEvents,
 		ReadOnly:         readOnly,
 		Prometheus:       prometheus,
 		Pricing:          pricingService,
 		Assets:           assetsService,
 		Utils:            utilsService,
 		BridgeConfig:     bridgeCfgService,
+		Webhooks:         webhooksService,
+	}
+}
+
+// PrepareRedriveService instantiates the re-drive service, pushing the stuck transfers to the queue of the node
+func PrepareRedriveService(services *Services, repositories *Repositories, c *config.Config, queue qi.Queue) {
+	var signers []string
+	if c.Node.Validator {
+		seen := make(map[string]bool)
+		for _, signer := range services.Signers {
+			if !seen[signer.Address()] {
+				seen[signer.Address()] = true
+				signers = append(signers, signer.Address())
+			}
+		}
+	}
+
+	services.Redrive = redrive.NewService(c.Node.Redrive, c.Node.Validator, signers, repositories.Transfer, services.Batch, queue)
+}
+
+// PrepareAdminService instantiates the admin service, once the watchers it controls are registered
+func PrepareAdminService(services *Services, repositories *Repositories, watchers service.WatcherControl) {
+	services.Admin = admin.NewService(
+		repositories.Transfer,
+		repositories.TransferStatus,
+		repositories.AuditLog,
+		services.ContractServices,
+		services.Prometheus,
+		services.Redrive,
+		watchers)
+}
+
+// PrepareHealthService instantiates the health service, checking the dependencies and the watchers of the node
+func PrepareHealthService(services *Services, repositories *Repositories, clients *Clients, c *config.Config, db database.Database) {
+	evmWatchers := make(map[uint64]string, len(clients.EvmClients))
+	for chain := range clients.EvmClients {
+		evmWatchers[chain] = evmWatcherID(chain, services.ContractServices[chain])
+	}
+
+	services.Health = health.NewService(
+		c.Node.Health,
+		db,
+		clients.MirrorNode,
+		clients.HederaNode,
+		clients.EvmClients,
+		repositories.TransferStatus,
+		repositories.MessageStatus,
+		c.Bridge.Hedera.BridgeAccount,
+		c.Bridge.TopicId,
+		evmWatchers)
+}
+
+// prepareSigner instantiates the signer configured for the EVM chain, falling back to the local one
+func prepareSigner(cfg config.Signer, privateKey string) service.Signer {
+	if cfg.Type == config.RemoteSignerType {
+		return remote.NewRemoteSigner(cfg.Remote)
+	}
+	if cfg.Keystore.Path != "" {
+		return evm.NewEVMSignerFromKeystore(cfg.Keystore)
 	}
+	return evm.NewEVMSigner(privateKey)
 }
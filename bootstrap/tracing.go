@@ -0,0 +1,40 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bootstrap
+
+import (
+	"context"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/tracing"
+	"github.com/limechain/hedera-eth-bridge-validator/config"
+	log "github.com/sirupsen/logrus"
+)
+
+// PrepareTracing sets up the export of the traces of the node. Returns the shutdown hook, exporting the remaining spans
+func PrepareTracing(cfg config.Tracing) func() error {
+	shutdown, err := tracing.Setup(cfg)
+	if err != nil {
+		log.Fatalf("Failed to set up tracing. Error: [%s]", err)
+	}
+	if cfg.Enabled {
+		log.Infof("Exporting traces to [%s].", cfg.Endpoint)
+	}
+
+	return func() error {
+		return shutdown(context.Background())
+	}
+}
@@ -63,6 +63,7 @@ func createTransferWatcher(
 func createConsensusTopicWatcher(configuration *config.Config,
 	client client.MirrorNode,
 	repository repository.Status,
+	prometheusService service.Prometheus,
 ) *cmw.Watcher {
 	topic := configuration.Bridge.TopicId
 	log.Debugf("Added Topic Watcher for topic [%s]\n", topic)
@@ -70,7 +71,8 @@ func createConsensusTopicWatcher(configuration *config.Config,
 		topic,
 		repository,
 		configuration.Node.Clients.MirrorNode.PollingInterval,
-		configuration.Node.Clients.Hedera.StartTimestamp)
+		configuration.Node.Clients.Hedera.StartTimestamp,
+		prometheusService)
 }
 
 func createAssetsWatcher(
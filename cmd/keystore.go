@@ -0,0 +1,169 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"crypto/ecdsa"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/helper/keystore"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	keystoreCommand = "keystore"
+
+	evmKeyType    = "evm"
+	hederaKeyType = "hedera"
+
+	ed25519Algorithm = "ed25519"
+	ecdsaAlgorithm   = "ecdsa"
+)
+
+const keystoreUsage = `Usage: keystore <create|convert> [flags]
+
+  create   generates a new key and stores it encrypted
+  convert  encrypts an existing plain text private key
+
+Flags:`
+
+// runKeystore creates and converts encrypted keystores, so that plain text private keys can be removed from node.yml.
+// EVM keys are stored as go-ethereum V3 keystore JSON and Hedera keys as password-protected PKCS#8 PEM files
+func runKeystore(args []string) {
+	flags := flag.NewFlagSet(keystoreCommand, flag.ExitOnError)
+	keyType := flags.String("type", evmKeyType, "The type of the key: evm or hedera")
+	algorithm := flags.String("algorithm", ed25519Algorithm, "The algorithm of newly created Hedera keys: ed25519 or ecdsa")
+	out := flags.String("out", "", "The path of the keystore file to create")
+	passwordEnv := flags.String("password-env", "", "The environment variable holding the keystore password")
+	passwordFile := flags.String("password-file", "", "The file holding the keystore password")
+	privateKeyEnv := flags.String("private-key-env", "", "The environment variable holding the plain text private key to convert")
+	privateKeyFile := flags.String("private-key-file", "", "The file holding the plain text private key to convert")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), keystoreUsage)
+		flags.PrintDefaults()
+	}
+
+	if len(args) == 0 {
+		flags.Usage()
+		os.Exit(2)
+	}
+	action := args[0]
+	_ = flags.Parse(args[1:])
+
+	if *out == "" {
+		log.Fatalf("keystore: --out is required")
+	}
+	password, err := keystore.Password(*passwordEnv, *passwordFile)
+	if err != nil {
+		log.Fatalf("keystore: failed to read password. Error: [%s]", err)
+	}
+	if password == "" {
+		log.Fatalf("keystore: password must not be empty")
+	}
+
+	var privateKey string
+	switch action {
+	case "create":
+	case "convert":
+		privateKey, err = readPrivateKey(*privateKeyEnv, *privateKeyFile)
+		if err != nil {
+			log.Fatalf("keystore: failed to read private key. Error: [%s]", err)
+		}
+	default:
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	switch *keyType {
+	case evmKeyType:
+		storeEVMKey(*out, password, privateKey)
+	case hederaKeyType:
+		storeHederaKey(*out, password, privateKey, *algorithm)
+	default:
+		log.Fatalf("keystore: unsupported key type [%s]", *keyType)
+	}
+}
+
+// storeEVMKey encrypts the given private key, or a newly generated one if empty
+func storeEVMKey(out, password, privateKey string) {
+	var key *ecdsa.PrivateKey
+	var err error
+	if privateKey == "" {
+		key, err = crypto.GenerateKey()
+	} else {
+		key, err = crypto.HexToECDSA(strings.TrimPrefix(privateKey, "0x"))
+	}
+	if err != nil {
+		log.Fatalf("keystore: invalid EVM private key. Error: [%s]", err)
+	}
+
+	err = keystore.StoreEVMKey(out, password, key)
+	if err != nil {
+		log.Fatalf("keystore: failed to store EVM key. Error: [%s]", err)
+	}
+	fmt.Printf("Stored EVM key of address [%s] in [%s]\n", crypto.PubkeyToAddress(key.PublicKey), out)
+}
+
+// storeHederaKey encrypts the given private key, or a newly generated one of the given algorithm if empty
+func storeHederaKey(out, password, privateKey, algorithm string) {
+	var key hedera.PrivateKey
+	var err error
+	switch {
+	case privateKey != "":
+		key, err = hedera.PrivateKeyFromString(privateKey)
+	case algorithm == ed25519Algorithm:
+		key, err = hedera.PrivateKeyGenerateEd25519()
+	case algorithm == ecdsaAlgorithm:
+		key, err = hedera.PrivateKeyGenerateEcdsa()
+	default:
+		err = fmt.Errorf("unsupported algorithm [%s]", algorithm)
+	}
+	if err != nil {
+		log.Fatalf("keystore: invalid Hedera private key. Error: [%s]", err)
+	}
+
+	err = keystore.StoreHederaKey(out, password, key)
+	if err != nil {
+		log.Fatalf("keystore: failed to store Hedera key. Error: [%s]", err)
+	}
+	fmt.Printf("Stored Hedera key with public key [%s] in [%s]\n", key.PublicKey(), out)
+}
+
+func readPrivateKey(privateKeyEnv, privateKeyFile string) (string, error) {
+	if privateKeyEnv != "" {
+		privateKey, ok := os.LookupEnv(privateKeyEnv)
+		if !ok || privateKey == "" {
+			return "", fmt.Errorf("environment variable [%s] is not set", privateKeyEnv)
+		}
+		return strings.TrimSpace(privateKey), nil
+	}
+
+	if privateKeyFile != "" {
+		content, err := os.ReadFile(privateKeyFile)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(string(content)), nil
+	}
+
+	return "", fmt.Errorf("--private-key-env or --private-key-file is required")
+}
@@ -17,6 +17,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/app/core/server"
@@ -24,13 +25,22 @@ import (
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/repository"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence"
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/recovery"
+	transfer_events "github.com/limechain/hedera-eth-bridge-validator/app/services/transfer-events"
 	"github.com/limechain/hedera-eth-bridge-validator/bootstrap"
 	"github.com/limechain/hedera-eth-bridge-validator/config"
 	log "github.com/sirupsen/logrus"
 	_ "net/http/pprof"
+	"os"
+	"os/signal"
+	"syscall"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == keystoreCommand {
+		runKeystore(os.Args[2:])
+		return
+	}
+
 	// Config
 	configuration, parsedBridge, err := config.LoadConfig()
 	if err != nil {
@@ -39,19 +49,20 @@ func main() {
 
 	config.InitLogger(configuration.Node.LogLevel, configuration.Node.LogFormat)
 
+	// Tracing
+	shutdownTracing := bootstrap.PrepareTracing(configuration.Node.Tracing)
+
 	// Prepare Clients
 	clients := bootstrap.PrepareClients(configuration.Node.Clients, configuration.Bridge.EVMs, parsedBridge.Networks)
 
-	// Prepare Node
-	server := server.NewServer()
-
 	var services *bootstrap.Services = nil
 	conn := persistence.NewPgConnector(configuration.Node.Database)
 	db := persistence.NewDatabase(conn)
 	db.Migrate()
 
-	// Prepare repositories
-	repositories := bootstrap.PrepareRepositories(db)
+	// Prepare repositories, publishing the changes of transfers to the subscribers of transfer events
+	transferEvents := transfer_events.NewService()
+	repositories := bootstrap.PrepareRepositories(db, transferEvents)
 
 	// Prepare Services
 	var parsedBridgeConfigTopicId hedera.TopicID
@@ -62,15 +73,39 @@ func main() {
 			panic(fmt.Sprintf("failed to parse bridge config topic id [%s]. Err: [%s]", parsedBridgeConfigTopicId, err))
 		}
 	}
-	services = bootstrap.PrepareServices(configuration, parsedBridge, clients, *repositories, parsedBridgeConfigTopicId)
+	q := bootstrap.PrepareQueue(configuration.Node.Queue, repositories.Queue)
+	services = bootstrap.PrepareServices(configuration, parsedBridge, clients, *repositories, transferEvents, q, parsedBridgeConfigTopicId)
+
+	// Prepare Node
+	bootstrap.PrepareRedriveService(services, repositories, configuration, q)
+	server := server.NewServer(
+		q,
+		configuration.Node.Workers,
+		services.Prometheus)
 	bootstrap.InitializeServerPairs(server, services, repositories, clients, configuration, parsedBridge, parsedBridgeConfigTopicId)
+	bootstrap.PrepareAdminService(services, repositories, server)
+	bootstrap.PrepareHealthService(services, repositories, clients, configuration, db)
 
 	apiRouter := bootstrap.InitializeAPIRouter(services, parsedBridge, configuration.Node)
 
 	executeRecovery(repositories.Fee, repositories.Schedule, clients.MirrorNode)
 
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, syscall.SIGINT)
+	defer stop()
+
+	// Deliver transfer events to the configured webhook endpoints
+	services.Webhooks.Start(ctx)
+
+	// Let the webhook deliveries in flight store their outcome before the database is closed
+	server.AddShutdownHook(services.Webhooks.Drain)
+	// Stop the queue and close the database once the in-flight handlers finish
+	server.AddShutdownHook(q.Close)
+	server.AddShutdownHook(db.Close)
+	// Export the spans, recorded until the shutdown
+	server.AddShutdownHook(shutdownTracing)
+
 	// Start
-	server.Run(apiRouter.Router, fmt.Sprintf(":%s", configuration.Node.Port))
+	server.Run(ctx, apiRouter.Router, fmt.Sprintf(":%s", configuration.Node.Port))
 }
 
 func executeRecovery(feeRepository repository.Fee, scheduleRepository repository.Schedule, client client.MirrorNode) {
@@ -52,13 +52,14 @@ func (b *Bridge) Update(from *Bridge) {
 }
 
 type BridgeHedera struct {
-	BridgeAccount   string
-	PayerAccount    string
-	Members         []string
-	Tokens          map[string]HederaToken
-	FeePercentages  map[string]int64
-	NftConstantFees map[string]int64
-	NftDynamicFees  map[string]decimal.Decimal
+	BridgeAccount       string
+	PayerAccount        string
+	Members             []string
+	Tokens              map[string]HederaToken
+	FeePercentages      map[string]int64
+	NftConstantFees     map[string]int64
+	NftDynamicFees      map[string]decimal.Decimal
+	RefundFeePercentage int64
 }
 
 type HederaToken struct {
@@ -116,10 +117,11 @@ func NewBridge(bridge parser.Bridge) *Bridge {
 
 		if networkId == constants.HederaNetworkId { // Hedera
 			config.Hedera = &BridgeHedera{
-				BridgeAccount: networkInfo.BridgeAccount,
-				PayerAccount:  networkInfo.PayerAccount,
-				Members:       networkInfo.Members,
-				Tokens:        make(map[string]HederaToken),
+				BridgeAccount:       networkInfo.BridgeAccount,
+				PayerAccount:        networkInfo.PayerAccount,
+				Members:             networkInfo.Members,
+				Tokens:              make(map[string]HederaToken),
+				RefundFeePercentage: networkInfo.RefundFeePercentage,
 			}
 
 			for name, tokenInfo := range networkInfo.Tokens.Nft {
@@ -134,13 +136,19 @@ func NewBridge(bridge parser.Bridge) *Bridge {
 				RouterContractAddress: networkInfo.RouterContractAddress,
 				Tokens:                make(map[string]Token),
 			}
-			// Currently, only EVM Fungible native tokens are supported
 			for name, tokenInfo := range networkInfo.Tokens.Fungible {
 				config.EVMs[networkId].Tokens[name] = Token{
 					Networks:         tokenInfo.Networks,
 					ReleaseTimestamp: tokenInfo.ReleaseTimestamp,
 				}
 			}
+			// EVM native NFTs are bridged only to Hedera, as wrapped HTS NFTs
+			for name, tokenInfo := range networkInfo.Tokens.Nft {
+				config.EVMs[networkId].Tokens[name] = Token{
+					Networks:         tokenInfo.Networks,
+					ReleaseTimestamp: tokenInfo.ReleaseTimestamp,
+				}
+			}
 		}
 
 		for tokenAddress, tokenInfo := range networkInfo.Tokens.Fungible {
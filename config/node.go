@@ -25,14 +25,21 @@ import (
 )
 
 type Node struct {
-	Database           Database
-	Clients            Clients
-	LogLevel           string
-	LogFormat          string
-	Port               string
-	Validator          bool
-	Monitoring         Monitoring
-	GaugeResetPassword string
+	Database   Database
+	Clients    Clients
+	LogLevel   string
+	LogFormat  string
+	Port       string
+	Validator  bool
+	Monitoring Monitoring
+	Queue      Queue
+	Workers    Workers
+	Batching   Batching
+	Webhooks   Webhooks
+	Admin      Admin
+	Redrive    Redrive
+	Health     Health
+	Tracing    Tracing
 }
 
 type Database struct {
@@ -54,19 +61,110 @@ type Clients struct {
 type Evm struct {
 	BlockConfirmations uint64        `yaml:"block_confirmations"`
 	NodeUrl            string        `yaml:"node_url"`
+	WsUrl              string        `yaml:"ws_url"`
 	PrivateKey         string        `yaml:"private_key"`
 	StartBlock         int64         `yaml:"start_block"`
 	PollingInterval    time.Duration `yaml:"polling_interval"`
 	MaxLogsBlocks      int64         `yaml:"max_logs_blocks"`
+	Finality           string        `yaml:"finality"`
 }
 
 type EvmPool struct {
 	BlockConfirmations uint64
 	NodeUrls           []string
+	WsUrl              string
 	PrivateKey         string
 	StartBlock         int64
 	PollingInterval    time.Duration
 	MaxLogsBlocks      int64
+	Finality           string
+	Signer             Signer
+}
+
+const (
+	// SafeFinality treats blocks up to the `safe` block of the node as final
+	SafeFinality = "safe"
+	// FinalizedFinality treats blocks up to the `finalized` block of the node as final
+	FinalizedFinality = "finalized"
+)
+
+// Signer //
+
+const (
+	// LocalSignerType signs with the private key of the EVM client
+	LocalSignerType = "local"
+	// RemoteSignerType signs through a remote Web3Signer compatible JSON-RPC signer
+	RemoteSignerType = "remote"
+)
+
+type Signer struct {
+	Type     string
+	Keystore Keystore
+	Remote   RemoteSigner
+}
+
+type RemoteSigner struct {
+	Url      string
+	Address  string
+	CaFile   string
+	CertFile string
+	KeyFile  string
+	Timeout  time.Duration
+}
+
+const (
+	// in seconds
+	defaultRemoteSignerTimeout = 10
+)
+
+func (s *Signer) DefaultOrConfig(cfg *parser.Signer) *Signer {
+	s.Type = LocalSignerType
+	if cfg.Type != "" {
+		s.Type = cfg.Type
+	}
+
+	s.Keystore = *new(Keystore).DefaultOrConfig(&cfg.Keystore)
+	s.Remote = RemoteSigner(cfg.Remote)
+	if s.Remote.Timeout == 0 {
+		s.Remote.Timeout = defaultRemoteSignerTimeout
+	}
+
+	switch s.Type {
+	case LocalSignerType:
+	case RemoteSignerType:
+		if s.Remote.Url == "" {
+			log.Fatalf("node configuration: Remote Signer URL is required")
+		}
+		if s.Remote.Address == "" {
+			log.Fatalf("node configuration: Remote Signer Address is required")
+		}
+	default:
+		log.Fatalf("node configuration: unsupported Signer type [%s]", s.Type)
+	}
+
+	return s
+}
+
+// Keystore //
+
+// Keystore points to an encrypted key file, used instead of a plain text private key.
+// The password is read from the PasswordEnv environment variable or from the PasswordFile
+type Keystore struct {
+	Path         string
+	PasswordEnv  string
+	PasswordFile string
+}
+
+func (k *Keystore) DefaultOrConfig(cfg *parser.Keystore) *Keystore {
+	*k = Keystore(*cfg)
+	if k.Path != "" && k.PasswordEnv == "" && k.PasswordFile == "" {
+		log.Fatalf("node configuration: Keystore [%s] requires a password env or a password file", k.Path)
+	}
+	if k.PasswordEnv != "" && k.PasswordFile != "" {
+		log.Fatalf("node configuration: Keystore [%s] accepts either a password env or a password file", k.Path)
+	}
+
+	return k
 }
 
 type Hedera struct {
@@ -80,6 +178,7 @@ type Hedera struct {
 type Operator struct {
 	AccountId  string
 	PrivateKey string
+	Keystore   Keystore
 }
 
 const (
@@ -91,8 +190,9 @@ func (h *Hedera) DefaultOrConfig(cfg *parser.Hedera) *Hedera {
 	if h.Operator.AccountId = cfg.Operator.AccountId; h.Operator.AccountId == "" {
 		log.Fatalf("node configuration: Hedera Operator Account ID is required")
 	}
-	if h.Operator.PrivateKey = cfg.Operator.PrivateKey; h.Operator.PrivateKey == "" {
-		log.Fatalf("node configuration: Hedera Operator Private Key is required")
+	h.Operator.Keystore = *new(Keystore).DefaultOrConfig(&cfg.Operator.Keystore)
+	if h.Operator.PrivateKey = cfg.Operator.PrivateKey; h.Operator.PrivateKey == "" && h.Operator.Keystore.Path == "" {
+		log.Fatalf("node configuration: Hedera Operator Private Key or Keystore is required")
 	}
 
 	h.Rpc = parseRpc(cfg.Rpc)
@@ -218,6 +318,401 @@ type Monitoring struct {
 	DashboardPolling time.Duration
 }
 
+// Queue //
+
+type Queue struct {
+	Persistent        bool
+	PollingInterval   time.Duration
+	VisibilityTimeout time.Duration
+	MaxAttempts       int
+	BatchSize         int
+}
+
+const (
+	// in seconds
+	defaultQueuePollingInterval   = 1
+	defaultQueueVisibilityTimeout = 300
+	defaultQueueMaxAttempts       = 5
+	defaultQueueBatchSize         = 50
+)
+
+func (q *Queue) DefaultOrConfig(cfg *parser.Queue) *Queue {
+	q.Persistent = cfg.Persistent
+	q.PollingInterval = defaultQueuePollingInterval
+	q.VisibilityTimeout = defaultQueueVisibilityTimeout
+	q.MaxAttempts = defaultQueueMaxAttempts
+	q.BatchSize = defaultQueueBatchSize
+
+	if cfg.PollingInterval != 0 {
+		q.PollingInterval = cfg.PollingInterval
+	}
+	if cfg.VisibilityTimeout != 0 {
+		q.VisibilityTimeout = cfg.VisibilityTimeout
+	}
+	if cfg.MaxAttempts != 0 {
+		q.MaxAttempts = cfg.MaxAttempts
+	}
+	if cfg.BatchSize != 0 {
+		q.BatchSize = cfg.BatchSize
+	}
+	return q
+}
+
+// Workers //
+
+type Workers struct {
+	DefaultConcurrency int
+	QueueSize          int
+	Concurrency        map[string]int
+	ShutdownTimeout    time.Duration
+}
+
+const (
+	defaultWorkersConcurrency     = 16
+	defaultWorkersQueueSize       = 100
+	defaultWorkersShutdownTimeout = 30 // in seconds
+)
+
+func (w *Workers) DefaultOrConfig(cfg *parser.Workers) *Workers {
+	w.DefaultConcurrency = defaultWorkersConcurrency
+	w.QueueSize = defaultWorkersQueueSize
+	w.Concurrency = make(map[string]int)
+	w.ShutdownTimeout = defaultWorkersShutdownTimeout
+
+	if cfg.DefaultConcurrency != 0 {
+		w.DefaultConcurrency = cfg.DefaultConcurrency
+	}
+	if cfg.QueueSize != 0 {
+		w.QueueSize = cfg.QueueSize
+	}
+	if cfg.ShutdownTimeout != 0 {
+		w.ShutdownTimeout = cfg.ShutdownTimeout
+	}
+	for topic, concurrency := range cfg.Concurrency {
+		w.Concurrency[topic] = concurrency
+	}
+	return w
+}
+
+// ConcurrencyFor returns the number of workers for the given handler topic
+func (w Workers) ConcurrencyFor(topic string) int {
+	if concurrency, ok := w.Concurrency[topic]; ok && concurrency > 0 {
+		return concurrency
+	}
+	return w.DefaultConcurrency
+}
+
+// Batching //
+
+// Batching aggregates the scheduled transfers of the same token, whose events occurred in the same Window,
+// into a single scheduled transaction. The batch is executed Delay after the later of the end of the Window and
+// its last added transfer, giving all validators time to pick up the events. Window and Delay must be the same for all validators
+type Batching struct {
+	Enabled bool
+	Window  time.Duration
+	Delay   time.Duration
+}
+
+const (
+	// in seconds
+	defaultBatchingWindow = 30
+	defaultBatchingDelay  = 60
+)
+
+func (b *Batching) DefaultOrConfig(cfg *parser.Batching) *Batching {
+	b.Enabled = cfg.Enabled
+	b.Window = defaultBatchingWindow
+	b.Delay = defaultBatchingDelay
+
+	if cfg.Window != 0 {
+		b.Window = cfg.Window
+	}
+	if cfg.Delay != 0 {
+		b.Delay = cfg.Delay
+	}
+	return b
+}
+
+// Webhooks //
+
+// Webhooks are the endpoints of integrators, notified about the lifecycle events of transfers.
+// Payloads are signed with the Secret of the endpoint. An endpoint without Events receives all events
+type Webhooks struct {
+	Endpoints       []Webhook
+	MaxAttempts     int
+	InitialBackoff  time.Duration
+	Timeout         time.Duration
+	PollingInterval time.Duration
+}
+
+type Webhook struct {
+	Url    string
+	Events []string
+	Secret string
+}
+
+const (
+	defaultWebhooksMaxAttempts = 5
+	// in seconds
+	defaultWebhooksInitialBackoff  = 5
+	defaultWebhooksTimeout         = 10
+	defaultWebhooksPollingInterval = 1
+)
+
+func (w *Webhooks) DefaultOrConfig(cfg *parser.Webhooks) *Webhooks {
+	w.MaxAttempts = defaultWebhooksMaxAttempts
+	w.InitialBackoff = defaultWebhooksInitialBackoff
+	w.Timeout = defaultWebhooksTimeout
+	w.PollingInterval = defaultWebhooksPollingInterval
+
+	if cfg.MaxAttempts != 0 {
+		w.MaxAttempts = cfg.MaxAttempts
+	}
+	if cfg.InitialBackoff != 0 {
+		w.InitialBackoff = cfg.InitialBackoff
+	}
+	if cfg.Timeout != 0 {
+		w.Timeout = cfg.Timeout
+	}
+	if cfg.PollingInterval != 0 {
+		w.PollingInterval = cfg.PollingInterval
+	}
+
+	for _, endpoint := range cfg.Endpoints {
+		if endpoint.Url == "" {
+			log.Fatalf("node configuration: Webhook URL is required")
+		}
+		if endpoint.Secret == "" {
+			log.Fatalf("node configuration: Webhook [%s] requires a secret", endpoint.Url)
+		}
+		w.Endpoints = append(w.Endpoints, Webhook(endpoint))
+	}
+	return w
+}
+
+// Accepts returns whether the endpoint is subscribed for the given event
+func (w Webhook) Accepts(event string) bool {
+	if len(w.Events) == 0 {
+		return true
+	}
+	for _, e := range w.Events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// Admin //
+
+// Admin holds the credentials of the admin API. Requests are authenticated either with one of the bearer Tokens
+// or are signed with the secret of one of the Keys. Each credential grants access to the operations of its Scopes
+type Admin struct {
+	Tokens       []AdminToken
+	Keys         []AdminKey
+	MaxClockSkew time.Duration
+}
+
+type AdminToken struct {
+	Name   string
+	Token  string
+	Scopes []string
+}
+
+type AdminKey struct {
+	Id     string
+	Secret string
+	Scopes []string
+}
+
+const (
+	// in seconds
+	defaultAdminMaxClockSkew = 300
+)
+
+func (a *Admin) DefaultOrConfig(cfg *parser.Admin) *Admin {
+	a.MaxClockSkew = defaultAdminMaxClockSkew
+	if cfg.MaxClockSkew != 0 {
+		a.MaxClockSkew = cfg.MaxClockSkew
+	}
+
+	names := make(map[string]bool)
+	for _, token := range cfg.Tokens {
+		if token.Name == "" || token.Token == "" {
+			log.Fatalf("node configuration: Admin Token requires a name and a token")
+		}
+		if names[token.Name] {
+			log.Fatalf("node configuration: duplicate Admin Token [%s]", token.Name)
+		}
+		names[token.Name] = true
+		a.Tokens = append(a.Tokens, AdminToken(token))
+	}
+
+	ids := make(map[string]bool)
+	for _, key := range cfg.Keys {
+		if key.Id == "" || key.Secret == "" {
+			log.Fatalf("node configuration: Admin Key requires an id and a secret")
+		}
+		if ids[key.Id] {
+			log.Fatalf("node configuration: duplicate Admin Key [%s]", key.Id)
+		}
+		ids[key.Id] = true
+		a.Keys = append(a.Keys, AdminKey(key))
+	}
+	return a
+}
+
+// Redrive //
+
+// Redrive periodically pushes the transfers, stuck in INITIAL for longer than Threshold, back to their handlers.
+// A transfer is re-driven at most MaxAttempts times and at most once per Threshold
+type Redrive struct {
+	Enabled     bool
+	Interval    time.Duration
+	Threshold   time.Duration
+	MaxAttempts int
+}
+
+const (
+	defaultRedriveMaxAttempts = 3
+	// in seconds
+	defaultRedriveInterval  = 60
+	defaultRedriveThreshold = 600
+)
+
+func (r *Redrive) DefaultOrConfig(cfg *parser.Redrive) *Redrive {
+	r.Enabled = cfg.Enabled
+	r.Interval = defaultRedriveInterval
+	r.Threshold = defaultRedriveThreshold
+	r.MaxAttempts = defaultRedriveMaxAttempts
+
+	if cfg.Interval != 0 {
+		r.Interval = cfg.Interval
+	}
+	if cfg.Threshold != 0 {
+		r.Threshold = cfg.Threshold
+	}
+	if cfg.MaxAttempts != 0 {
+		r.MaxAttempts = cfg.MaxAttempts
+	}
+	return r
+}
+
+// Health //
+
+// Health holds the thresholds of the checks of the readiness endpoint. A component, exceeding the Degraded
+// threshold of its check, is DEGRADED and one, exceeding the Down threshold or the Timeout of the check, is DOWN
+type Health struct {
+	Timeout    time.Duration
+	Latency    HealthThreshold // in milliseconds
+	WatcherLag HealthThreshold // in seconds, between the last processed and the latest event of the watcher
+	EvmNodeLag HealthThreshold // in blocks, between the head of an EVM node and the highest head of its network
+}
+
+type HealthThreshold struct {
+	Degraded int64
+	Down     int64
+}
+
+const (
+	// in seconds
+	defaultHealthTimeout            = 5
+	defaultHealthWatcherLagDegraded = 1800
+	defaultHealthWatcherLagDown     = 7200
+	// in milliseconds
+	defaultHealthLatencyDegraded = 1000
+	defaultHealthLatencyDown     = 3000
+	// in blocks
+	defaultHealthEvmNodeLagDegraded = 5
+	defaultHealthEvmNodeLagDown     = 50
+)
+
+func (h *Health) DefaultOrConfig(cfg *parser.Health) *Health {
+	h.Timeout = defaultHealthTimeout
+	if cfg.Timeout != 0 {
+		h.Timeout = cfg.Timeout
+	}
+	h.Latency = *new(HealthThreshold).defaultOrConfig("latency", &cfg.Latency, defaultHealthLatencyDegraded, defaultHealthLatencyDown)
+	h.WatcherLag = *new(HealthThreshold).defaultOrConfig("watcher_lag", &cfg.WatcherLag, defaultHealthWatcherLagDegraded, defaultHealthWatcherLagDown)
+	h.EvmNodeLag = *new(HealthThreshold).defaultOrConfig("evm_node_lag", &cfg.EvmNodeLag, defaultHealthEvmNodeLagDegraded, defaultHealthEvmNodeLagDown)
+	return h
+}
+
+func (t *HealthThreshold) defaultOrConfig(name string, cfg *parser.HealthThreshold, degraded, down int64) *HealthThreshold {
+	t.Degraded = degraded
+	t.Down = down
+
+	if cfg.Degraded != 0 {
+		t.Degraded = cfg.Degraded
+	}
+	if cfg.Down != 0 {
+		t.Down = cfg.Down
+	}
+	if t.Degraded > t.Down {
+		log.Fatalf("node configuration: Health [%s] degraded threshold [%d] exceeds its down threshold [%d]", name, t.Degraded, t.Down)
+	}
+	return t
+}
+
+// Tracing //
+
+// Samplers of the traces. A sampler decides only on the traces, started by the node,
+// while the spans of a sampled trace are always recorded
+const (
+	AlwaysOnSampler  = "always_on"
+	AlwaysOffSampler = "always_off"
+	RatioSampler     = "ratio"
+)
+
+// Tracing holds the configuration of the OpenTelemetry traces, exported over OTLP/gRPC to Endpoint
+type Tracing struct {
+	Enabled      bool
+	Endpoint     string
+	Insecure     bool
+	ServiceName  string
+	Sampler      string
+	SamplerRatio float64 // the ratio of the sampled traces, used by the ratio sampler
+}
+
+const (
+	defaultTracingEndpoint     = "localhost:4317"
+	defaultTracingServiceName  = "hedera-eth-bridge-validator"
+	defaultTracingSampler      = AlwaysOnSampler
+	defaultTracingSamplerRatio = 1
+)
+
+func (t *Tracing) DefaultOrConfig(cfg *parser.Tracing) *Tracing {
+	t.Enabled = cfg.Enabled
+	t.Insecure = cfg.Insecure
+	t.Endpoint = defaultTracingEndpoint
+	t.ServiceName = defaultTracingServiceName
+	t.Sampler = defaultTracingSampler
+	t.SamplerRatio = defaultTracingSamplerRatio
+
+	if cfg.Endpoint != "" {
+		t.Endpoint = cfg.Endpoint
+	}
+	if cfg.ServiceName != "" {
+		t.ServiceName = cfg.ServiceName
+	}
+	if cfg.Sampler != "" {
+		t.Sampler = cfg.Sampler
+	}
+	if cfg.SamplerRatio != 0 {
+		t.SamplerRatio = cfg.SamplerRatio
+	}
+
+	switch t.Sampler {
+	case AlwaysOnSampler, AlwaysOffSampler, RatioSampler:
+	default:
+		log.Fatalf("node configuration: Tracing sampler should be one of [%s], [%s] or [%s], got [%s]", AlwaysOnSampler, AlwaysOffSampler, RatioSampler, t.Sampler)
+	}
+	if t.SamplerRatio < 0 || t.SamplerRatio > 1 {
+		log.Fatalf("node configuration: Tracing sampler ratio should be between 0 and 1, got [%v]", t.SamplerRatio)
+	}
+	return t
+}
+
 type Recovery struct {
 	StartTimestamp int64
 	StartBlock     int64
@@ -246,11 +741,28 @@ func New(node parser.Node) Node {
 			Enable:           node.Monitoring.Enable,
 			DashboardPolling: node.Monitoring.DashboardPolling,
 		},
-		GaugeResetPassword: node.GaugeResetPassword,
+		Queue:    *new(Queue).DefaultOrConfig(&node.Queue),
+		Workers:  *new(Workers).DefaultOrConfig(&node.Workers),
+		Batching: *new(Batching).DefaultOrConfig(&node.Batching),
+		Webhooks: *new(Webhooks).DefaultOrConfig(&node.Webhooks),
+		Admin:    *new(Admin).DefaultOrConfig(&node.Admin),
+		Redrive:  *new(Redrive).DefaultOrConfig(&node.Redrive),
+		Health:   *new(Health).DefaultOrConfig(&node.Health),
+		Tracing:  *new(Tracing).DefaultOrConfig(&node.Tracing),
 	}
 
 	for key, value := range node.Clients.EvmPool {
-		config.Clients.EvmPool[key] = EvmPool(value)
+		config.Clients.EvmPool[key] = EvmPool{
+			BlockConfirmations: value.BlockConfirmations,
+			NodeUrls:           value.NodeUrls,
+			WsUrl:              value.WsUrl,
+			PrivateKey:         value.PrivateKey,
+			StartBlock:         value.StartBlock,
+			PollingInterval:    value.PollingInterval,
+			MaxLogsBlocks:      value.MaxLogsBlocks,
+			Finality:           value.Finality,
+			Signer:             *new(Signer).DefaultOrConfig(&value.Signer),
+		}
 	}
 
 	return config
@@ -85,6 +85,12 @@ func Test_New(t *testing.T) {
 					StartBlock:         0,
 					PollingInterval:    0,
 					MaxLogsBlocks:      0,
+					Signer: Signer{
+						Type: LocalSignerType,
+						Remote: RemoteSigner{
+							Timeout: defaultRemoteSignerTimeout,
+						},
+					},
 				},
 			},
 			Hedera: Hedera{
@@ -119,6 +125,51 @@ func Test_New(t *testing.T) {
 			Enable:           false,
 			DashboardPolling: 0,
 		},
+		Queue: Queue{
+			Persistent:        false,
+			PollingInterval:   defaultQueuePollingInterval,
+			VisibilityTimeout: defaultQueueVisibilityTimeout,
+			MaxAttempts:       defaultQueueMaxAttempts,
+			BatchSize:         defaultQueueBatchSize,
+		},
+		Workers: Workers{
+			DefaultConcurrency: defaultWorkersConcurrency,
+			QueueSize:          defaultWorkersQueueSize,
+			Concurrency:        map[string]int{},
+			ShutdownTimeout:    defaultWorkersShutdownTimeout,
+		},
+		Batching: Batching{
+			Enabled: false,
+			Window:  defaultBatchingWindow,
+			Delay:   defaultBatchingDelay,
+		},
+		Webhooks: Webhooks{
+			MaxAttempts:     defaultWebhooksMaxAttempts,
+			InitialBackoff:  defaultWebhooksInitialBackoff,
+			Timeout:         defaultWebhooksTimeout,
+			PollingInterval: defaultWebhooksPollingInterval,
+		},
+		Admin: Admin{
+			MaxClockSkew: defaultAdminMaxClockSkew,
+		},
+		Redrive: Redrive{
+			Enabled:     false,
+			Interval:    defaultRedriveInterval,
+			Threshold:   defaultRedriveThreshold,
+			MaxAttempts: defaultRedriveMaxAttempts,
+		},
+		Health: Health{
+			Timeout:    defaultHealthTimeout,
+			Latency:    HealthThreshold{Degraded: defaultHealthLatencyDegraded, Down: defaultHealthLatencyDown},
+			WatcherLag: HealthThreshold{Degraded: defaultHealthWatcherLagDegraded, Down: defaultHealthWatcherLagDown},
+			EvmNodeLag: HealthThreshold{Degraded: defaultHealthEvmNodeLagDegraded, Down: defaultHealthEvmNodeLagDown},
+		},
+		Tracing: Tracing{
+			Endpoint:     defaultTracingEndpoint,
+			ServiceName:  defaultTracingServiceName,
+			Sampler:      defaultTracingSampler,
+			SamplerRatio: defaultTracingSamplerRatio,
+		},
 	}
 
 	actual := New(in)
@@ -222,3 +273,242 @@ func Test_RetryPolicy_DefaultOrConfig_WithPassedMaxJitter(t *testing.T) {
 
 	assert.Equal(t, expected, actual)
 }
+
+func Test_Queue_DefaultOrConfig(t *testing.T) {
+	expected := Queue{
+		Persistent:        true,
+		PollingInterval:   defaultQueuePollingInterval,
+		VisibilityTimeout: 60,
+		MaxAttempts:       defaultQueueMaxAttempts,
+		BatchSize:         defaultQueueBatchSize,
+	}
+
+	actual := Queue{}
+	actual.DefaultOrConfig(&parser.Queue{
+		Persistent:        true,
+		VisibilityTimeout: 60,
+	})
+
+	assert.Equal(t, expected, actual)
+}
+
+func Test_Workers_DefaultOrConfig(t *testing.T) {
+	expected := Workers{
+		DefaultConcurrency: defaultWorkersConcurrency,
+		QueueSize:          defaultWorkersQueueSize,
+		Concurrency: map[string]int{
+			"TOPIC_MSG_VALIDATION": 32,
+		},
+		ShutdownTimeout: 10,
+	}
+
+	actual := Workers{}
+	actual.DefaultOrConfig(&parser.Workers{
+		Concurrency: map[string]int{
+			"TOPIC_MSG_VALIDATION": 32,
+		},
+		ShutdownTimeout: 10,
+	})
+
+	assert.Equal(t, expected, actual)
+}
+
+func Test_Workers_ConcurrencyFor(t *testing.T) {
+	workers := Workers{
+		DefaultConcurrency: 16,
+		Concurrency: map[string]int{
+			"HEDERA_MINT_HTS_TRANSFER": 4,
+		},
+	}
+
+	assert.Equal(t, 4, workers.ConcurrencyFor("HEDERA_MINT_HTS_TRANSFER"))
+	assert.Equal(t, 16, workers.ConcurrencyFor("TOPIC_MSG_VALIDATION"))
+}
+
+func Test_Batching_DefaultOrConfig(t *testing.T) {
+	expected := Batching{
+		Enabled: true,
+		Window:  10,
+		Delay:   defaultBatchingDelay,
+	}
+
+	actual := Batching{}
+	actual.DefaultOrConfig(&parser.Batching{
+		Enabled: true,
+		Window:  10,
+	})
+
+	assert.Equal(t, expected, actual)
+}
+
+func Test_Webhooks_DefaultOrConfig(t *testing.T) {
+	expected := Webhooks{
+		Endpoints: []Webhook{
+			{Url: "https://integrator/hooks", Events: []string{"TRANSFER_CLAIMED"}, Secret: "secret"},
+		},
+		MaxAttempts:     3,
+		InitialBackoff:  defaultWebhooksInitialBackoff,
+		Timeout:         defaultWebhooksTimeout,
+		PollingInterval: defaultWebhooksPollingInterval,
+	}
+
+	actual := Webhooks{}
+	actual.DefaultOrConfig(&parser.Webhooks{
+		Endpoints: []parser.Webhook{
+			{Url: "https://integrator/hooks", Events: []string{"TRANSFER_CLAIMED"}, Secret: "secret"},
+		},
+		MaxAttempts: 3,
+	})
+
+	assert.Equal(t, expected, actual)
+}
+
+func Test_Webhook_Accepts(t *testing.T) {
+	all := Webhook{Url: "https://integrator/hooks"}
+	filtered := Webhook{Url: "https://integrator/hooks", Events: []string{"TRANSFER_CLAIMED"}}
+
+	assert.True(t, all.Accepts("TRANSFER_CREATED"))
+	assert.True(t, filtered.Accepts("TRANSFER_CLAIMED"))
+	assert.False(t, filtered.Accepts("TRANSFER_CREATED"))
+}
+
+func Test_Admin_DefaultOrConfig(t *testing.T) {
+	expected := Admin{
+		Tokens: []AdminToken{
+			{Name: "operator", Token: "token", Scopes: []string{"transfers:write"}},
+		},
+		Keys: []AdminKey{
+			{Id: "automation", Secret: "secret", Scopes: []string{"*"}},
+		},
+		MaxClockSkew: 60,
+	}
+
+	actual := Admin{}
+	actual.DefaultOrConfig(&parser.Admin{
+		Tokens: []parser.AdminToken{
+			{Name: "operator", Token: "token", Scopes: []string{"transfers:write"}},
+		},
+		Keys: []parser.AdminKey{
+			{Id: "automation", Secret: "secret", Scopes: []string{"*"}},
+		},
+		MaxClockSkew: 60,
+	})
+
+	assert.Equal(t, expected, actual)
+}
+
+func Test_Signer_DefaultOrConfig(t *testing.T) {
+	expected := Signer{
+		Type: RemoteSignerType,
+		Remote: RemoteSigner{
+			Url:      "https://signer:9000",
+			Address:  "0x0000000000000000000000000000000000000001",
+			CaFile:   "ca.pem",
+			CertFile: "client.pem",
+			KeyFile:  "client.key",
+			Timeout:  defaultRemoteSignerTimeout,
+		},
+	}
+
+	actual := Signer{}
+	actual.DefaultOrConfig(&parser.Signer{
+		Type: RemoteSignerType,
+		Remote: parser.RemoteSigner{
+			Url:      "https://signer:9000",
+			Address:  "0x0000000000000000000000000000000000000001",
+			CaFile:   "ca.pem",
+			CertFile: "client.pem",
+			KeyFile:  "client.key",
+		},
+	})
+
+	assert.Equal(t, expected, actual)
+}
+
+func Test_Keystore_DefaultOrConfig(t *testing.T) {
+	expected := Keystore{
+		Path:        "keystore.json",
+		PasswordEnv: "VALIDATOR_KEYSTORE_PASSWORD",
+	}
+
+	actual := Keystore{}
+	actual.DefaultOrConfig(&parser.Keystore{
+		Path:        "keystore.json",
+		PasswordEnv: "VALIDATOR_KEYSTORE_PASSWORD",
+	})
+
+	assert.Equal(t, expected, actual)
+}
+
+func Test_Hedera_DefaultOrConfig_Keystore(t *testing.T) {
+	actual := Hedera{}
+	actual.DefaultOrConfig(&parser.Hedera{
+		Operator: parser.Operator{
+			AccountId: "0.0.1",
+			Keystore: parser.Keystore{
+				Path:         "operator.pem",
+				PasswordFile: "password.txt",
+			},
+		},
+	})
+
+	assert.Equal(t, "", actual.Operator.PrivateKey)
+	assert.Equal(t, Keystore{Path: "operator.pem", PasswordFile: "password.txt"}, actual.Operator.Keystore)
+}
+
+func Test_Redrive_DefaultOrConfig(t *testing.T) {
+	expected := Redrive{
+		Enabled:     true,
+		Interval:    defaultRedriveInterval,
+		Threshold:   300,
+		MaxAttempts: defaultRedriveMaxAttempts,
+	}
+
+	actual := Redrive{}
+	actual.DefaultOrConfig(&parser.Redrive{
+		Enabled:   true,
+		Threshold: 300,
+	})
+
+	assert.Equal(t, expected, actual)
+}
+
+func Test_Health_DefaultOrConfig(t *testing.T) {
+	expected := Health{
+		Timeout:    10,
+		Latency:    HealthThreshold{Degraded: 500, Down: defaultHealthLatencyDown},
+		WatcherLag: HealthThreshold{Degraded: defaultHealthWatcherLagDegraded, Down: defaultHealthWatcherLagDown},
+		EvmNodeLag: HealthThreshold{Degraded: 10, Down: 100},
+	}
+
+	actual := Health{}
+	actual.DefaultOrConfig(&parser.Health{
+		Timeout:    10,
+		Latency:    parser.HealthThreshold{Degraded: 500},
+		EvmNodeLag: parser.HealthThreshold{Degraded: 10, Down: 100},
+	})
+
+	assert.Equal(t, expected, actual)
+}
+
+func Test_Tracing_DefaultOrConfig(t *testing.T) {
+	expected := Tracing{
+		Enabled:      true,
+		Endpoint:     "collector:4317",
+		Insecure:     true,
+		ServiceName:  defaultTracingServiceName,
+		Sampler:      RatioSampler,
+		SamplerRatio: 0.25,
+	}
+
+	actual := Tracing{}
+	actual.DefaultOrConfig(&parser.Tracing{
+		Enabled:      true,
+		Endpoint:     "collector:4317",
+		Insecure:     true,
+		Sampler:      RatioSampler,
+		SamplerRatio: 0.25,
+	})
+
+	assert.Equal(t, expected, actual)
+}
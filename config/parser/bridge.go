@@ -50,6 +50,7 @@ type Network struct {
 	PayerAccount          string   `yaml:"payer_account,omitempty" json:"payerAccount,omitempty"`
 	RouterContractAddress string   `yaml:"router_contract_address,omitempty" json:"routerContractAddress,omitempty"`
 	Members               []string `yaml:"members,omitempty" json:"members,omitempty"`
+	RefundFeePercentage   int64    `yaml:"refund_fee_percentage,omitempty" json:"refundFeePercentage,omitempty"` // Represents the fee, which validators take for refunding undeliverable transfers. Applies only for Hedera
 	Tokens                Tokens   `yaml:"tokens,omitempty" json:"tokens,omitempty"`
 }
 
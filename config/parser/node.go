@@ -30,7 +30,14 @@ type Node struct {
 	Validator           bool       `yaml:"validator"`
 	Monitoring          Monitoring `yaml:"monitoring"`
 	BridgeConfigTopicId Monitoring `yaml:"bridge_config_topic_id"`
-	GaugeResetPassword  string     `yaml:"gauge_reset_pass"`
+	Queue               Queue      `yaml:"queue"`
+	Workers             Workers    `yaml:"workers"`
+	Batching            Batching   `yaml:"batching"`
+	Webhooks            Webhooks   `yaml:"webhooks"`
+	Admin               Admin      `yaml:"admin"`
+	Redrive             Redrive    `yaml:"redrive"`
+	Health              Health     `yaml:"health"`
+	Tracing             Tracing    `yaml:"tracing"`
 }
 
 type Database struct {
@@ -54,19 +61,45 @@ type Clients struct {
 type Evm struct {
 	BlockConfirmations uint64        `yaml:"block_confirmations"`
 	NodeUrl            string        `yaml:"node_url"`
+	WsUrl              string        `yaml:"ws_url"`
 	PrivateKey         string        `yaml:"private_key"`
 	StartBlock         int64         `yaml:"start_block"`
 	PollingInterval    time.Duration `yaml:"polling_interval"`
 	MaxLogsBlocks      int64         `yaml:"max_logs_blocks"`
+	Finality           string        `yaml:"finality"`
 }
 
 type EvmPool struct {
 	BlockConfirmations uint64        `yaml:"block_confirmations"`
 	NodeUrls           []string      `yaml:"node_url"`
+	WsUrl              string        `yaml:"ws_url"`
 	PrivateKey         string        `yaml:"private_key"`
 	StartBlock         int64         `yaml:"start_block"`
 	PollingInterval    time.Duration `yaml:"polling_interval"`
 	MaxLogsBlocks      int64         `yaml:"max_logs_blocks"`
+	Finality           string        `yaml:"finality"`
+	Signer             Signer        `yaml:"signer"`
+}
+
+type Signer struct {
+	Type     string       `yaml:"type"`
+	Keystore Keystore     `yaml:"keystore"`
+	Remote   RemoteSigner `yaml:"remote"`
+}
+
+type Keystore struct {
+	Path         string `yaml:"path"`
+	PasswordEnv  string `yaml:"password_env"`
+	PasswordFile string `yaml:"password_file"`
+}
+
+type RemoteSigner struct {
+	Url      string        `yaml:"url"`
+	Address  string        `yaml:"address"`
+	CaFile   string        `yaml:"ca_file"`
+	CertFile string        `yaml:"cert_file"`
+	KeyFile  string        `yaml:"key_file"`
+	Timeout  time.Duration `yaml:"timeout"`
 }
 
 // Hedera //
@@ -80,8 +113,9 @@ type Hedera struct {
 }
 
 type Operator struct {
-	AccountId  string `yaml:"account_id"`
-	PrivateKey string `yaml:"private_key"`
+	AccountId  string   `yaml:"account_id"`
+	PrivateKey string   `yaml:"private_key"`
+	Keystore   Keystore `yaml:"keystore"`
 }
 
 // MirrorNode //
@@ -120,3 +154,84 @@ type Monitoring struct {
 	Enable           bool          `yaml:"enable"`
 	DashboardPolling time.Duration `yaml:"dashboard_polling"`
 }
+
+type Queue struct {
+	Persistent        bool          `yaml:"persistent"`
+	PollingInterval   time.Duration `yaml:"polling_interval"`
+	VisibilityTimeout time.Duration `yaml:"visibility_timeout"`
+	MaxAttempts       int           `yaml:"max_attempts"`
+	BatchSize         int           `yaml:"batch_size"`
+}
+
+type Batching struct {
+	Enabled bool          `yaml:"enabled"`
+	Window  time.Duration `yaml:"window"`
+	Delay   time.Duration `yaml:"delay"`
+}
+
+type Webhooks struct {
+	Endpoints       []Webhook     `yaml:"endpoints"`
+	MaxAttempts     int           `yaml:"max_attempts"`
+	InitialBackoff  time.Duration `yaml:"initial_backoff"`
+	Timeout         time.Duration `yaml:"timeout"`
+	PollingInterval time.Duration `yaml:"polling_interval"`
+}
+
+type Webhook struct {
+	Url    string   `yaml:"url"`
+	Events []string `yaml:"events"`
+	Secret string   `yaml:"secret"`
+}
+
+type Admin struct {
+	Tokens       []AdminToken  `yaml:"tokens"`
+	Keys         []AdminKey    `yaml:"keys"`
+	MaxClockSkew time.Duration `yaml:"max_clock_skew"`
+}
+
+type AdminToken struct {
+	Name   string   `yaml:"name"`
+	Token  string   `yaml:"token"`
+	Scopes []string `yaml:"scopes"`
+}
+
+type AdminKey struct {
+	Id     string   `yaml:"id"`
+	Secret string   `yaml:"secret"`
+	Scopes []string `yaml:"scopes"`
+}
+
+type Redrive struct {
+	Enabled     bool          `yaml:"enabled"`
+	Interval    time.Duration `yaml:"interval"`
+	Threshold   time.Duration `yaml:"threshold"`
+	MaxAttempts int           `yaml:"max_attempts"`
+}
+
+type Health struct {
+	Timeout    time.Duration   `yaml:"timeout"`
+	Latency    HealthThreshold `yaml:"latency"`
+	WatcherLag HealthThreshold `yaml:"watcher_lag"`
+	EvmNodeLag HealthThreshold `yaml:"evm_node_lag"`
+}
+
+type HealthThreshold struct {
+	Degraded int64 `yaml:"degraded"`
+	Down     int64 `yaml:"down"`
+}
+
+type Tracing struct {
+	Enabled      bool    `yaml:"enabled"`
+	Endpoint     string  `yaml:"endpoint"`
+	Insecure     bool    `yaml:"insecure"`
+	ServiceName  string  `yaml:"service_name"`
+	Sampler      string  `yaml:"sampler"`
+	SamplerRatio float64 `yaml:"sampler_ratio"`
+}
+
+type Workers struct {
+	DefaultConcurrency int            `yaml:"default_concurrency"`
+	QueueSize          int            `yaml:"queue_size"`
+	Concurrency        map[string]int `yaml:"concurrency"`
+	ShutdownTimeout    time.Duration  `yaml:"shutdown_timeout"`
+}
@@ -31,23 +31,28 @@ var (
 const (
 	HederaFeeTransfer               = "HEDERA_FEE_TRANSFER"            // WEVM -> NH
 	HederaTransferMessageSubmission = "HEDERA_TRANSFER_MSG_SUBMISSION" // NH -> WEVM
-	HederaBurnMessageSubmission     = "BURN_TOPIC_MSG_SUBMISSION"      // WH -> NEVM
-	HederaMintHtsTransfer           = "HEDERA_MINT_HTS_TRANSFER"       // NEVM -> WH
+	HederaBurnMessageSubmission     = "BURN_TOPIC_MSG_SUBMISSION"      // WH -> NEVM || WH -> WEVM
+	HederaMintHtsTransfer           = "HEDERA_MINT_HTS_TRANSFER"       // NEVM -> WH || WEVM -> WH
 	HederaNativeNftTransfer         = "HEDERA_NATIVE_NFT_TRANSFER"     // NH NFT -> WEVM
 	HederaNftTransfer               = "HEDERA_NFT_TRANSFER"            // WEVM NFT -> NH
+	HederaMintNftTransfer           = "HEDERA_MINT_NFT_TRANSFER"       // NEVM NFT -> WH
+	HederaBurnNftMessageSubmission  = "HEDERA_BURN_NFT_MSG_SUBMISSION" // WH NFT -> NEVM
 	TopicMessageSubmission          = "TOPIC_MSG_SUBMISSION"           // WEVM -> WEVM
 	TopicMessageValidation          = "TOPIC_MSG_VALIDATION"           // Messages coming from HCS Topic submission
+	HederaRefund                    = "HEDERA_REFUND"                  // Rejected H -> EVM and undeliverable EVM -> H, refunded to the originator
 )
 
 // Read-only handler topics
 const (
 	ReadOnlyHederaFeeTransfer       = "READ_ONLY_HEDERA_FEE_TRANSFER"        // NH -> WEVM
 	ReadOnlyHederaTransfer          = "READ_ONLY_HEDERA_NATIVE_TRANSFER"     // WEVM -> NH
-	ReadOnlyHederaBurn              = "READ_ONLY_HEDERA_BURN"                // WH -> NEVM
-	ReadOnlyHederaMintHtsTransfer   = "READ_ONLY_HEDERA_MINT_HTS_TRANSFER"   // NEVM -> WH
+	ReadOnlyHederaBurn              = "READ_ONLY_HEDERA_BURN"                // WH -> NEVM || WH -> WEVM
+	ReadOnlyHederaMintHtsTransfer   = "READ_ONLY_HEDERA_MINT_HTS_TRANSFER"   // NEVM -> WH || WEVM -> WH
 	ReadOnlyTransferSave            = "READ_ONLY_SAVE_TRANSFER"              // WEVM -> WEVM
 	ReadOnlyHederaNativeNftTransfer = "READ_ONLY_HEDERA_NFT_TRANSFER"        // NH NFT -> WEVM
 	ReadOnlyHederaUnlockNftTransfer = "READ_ONLY_HEDERA_UNLOCK_NFT_TRANSFER" // WEVM NFT -> NH
+	ReadOnlyHederaMintNftTransfer   = "READ_ONLY_HEDERA_MINT_NFT_TRANSFER"   // NEVM NFT -> WH
+	ReadOnlyHederaBurnNft           = "READ_ONLY_HEDERA_BURN_NFT"            // WH NFT -> NEVM
 )
 
 const InvalidNodeAccount = "INVALID_NODE_ACCOUNT"
@@ -54,14 +54,57 @@ const (
 
 	PrometheusMetricsEndpoint = "/metrics"
 
-	// Success Rate Metrics //
+	// Transfer Metrics //
 
-	MajorityReachedNameSuffix  = "majority_reached"
-	MajorityReachedHelp        = "Majority reached for hedera transaction."
-	FeeTransferredNameSuffix   = "fee_transferred"
-	FeeTransferredHelp         = "Fee transferred to the bridge account."
-	UserGetHisTokensNameSuffix = "user_get_his_tokens"
-	UserGetHisTokensHelp       = "The user get his tokens after bridging."
+	TransferStagesExpectedCounterName = "transfer_stages_expected_total"
+	TransferStagesExpectedCounterHelp = "Number of transfers, picked up by the watchers, which are expected to reach the stage."
+	TransferStagesReachedCounterName  = "transfer_stages_reached_total"
+	TransferStagesReachedCounterHelp  = "Number of transfers, which reached the stage."
+	TransferStageLatencyHistogramName = "transfer_stage_latency_seconds"
+	TransferStageLatencyHistogramHelp = "Time between the source transaction of a transfer and the transfer reaching the stage."
+	SourceChainIdMetricLabelKey       = "source_chain_id"
+	TargetChainIdMetricLabelKey       = "target_chain_id"
+	TransferAssetMetricLabelKey       = "asset"
+	StageMetricLabelKey               = "stage"
+	MajorityReachedStage              = "majority_reached"
+	FeeTransferredStage               = "fee_transferred"
+	UserGetHisTokensStage             = "user_get_his_tokens"
+
+	// Queue Metrics //
+
+	QueueDepthGaugeName       = "queue_depth"
+	QueueDepthGaugeHelp       = "Number of messages waiting for a free handler worker."
+	HandlersInFlightGaugeName = "handlers_in_flight"
+	HandlersInFlightGaugeHelp = "Number of messages currently being handled."
+	TopicMetricLabelKey       = "topic"
+
+	// EVM Metrics //
+
+	EvmReorgsCounterName  = "evm_chain_reorganisations"
+	EvmReorgsCounterHelp  = "Number of chain reorganisations detected by the EVM watcher."
+	ChainIdMetricLabelKey = "chain_id"
+
+	// Watcher Metrics //
+
+	WatcherLastProcessedBlockGaugeName     = "watcher_last_processed_block"
+	WatcherLastProcessedBlockGaugeHelp     = "Last block processed by the EVM watcher."
+	WatcherChainHeadBlockGaugeName         = "watcher_chain_head_block"
+	WatcherChainHeadBlockGaugeHelp         = "Latest confirmed block of the chain of the EVM watcher."
+	WatcherLagBlocksGaugeName              = "watcher_lag_blocks"
+	WatcherLagBlocksGaugeHelp              = "Number of confirmed blocks not yet processed by the EVM watcher."
+	WatcherLastProcessedTimestampGaugeName = "watcher_last_processed_timestamp_seconds"
+	WatcherLastProcessedTimestampGaugeHelp = "Consensus timestamp of the last event or time of the last block processed by the watcher."
+	WatcherChainHeadTimestampGaugeName     = "watcher_chain_head_timestamp_seconds"
+	WatcherChainHeadTimestampGaugeHelp     = "Time of the latest successful poll of the mirror node by the Hedera watcher."
+	WatcherLagSecondsGaugeName             = "watcher_lag_seconds"
+	WatcherLagSecondsGaugeHelp             = "Time since the chain time, up to which the watcher has processed all events."
+	WatcherPollItemsHistogramName          = "watcher_poll_items"
+	WatcherPollItemsHistogramHelp          = "Number of transactions, messages or logs processed per poll of the watcher."
+	WatcherPollErrorsCounterName           = "watcher_poll_errors_total"
+	WatcherPollErrorsCounterHelp           = "Number of failed polls of the watcher."
+	WatcherPollDurationHistogramName       = "watcher_poll_duration_seconds"
+	WatcherPollDurationHistogramHelp       = "Duration of the polls of the watcher."
+	WatcherMetricLabelKey                  = "watcher"
 )
 
 var (
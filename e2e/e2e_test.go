@@ -28,7 +28,6 @@ import (
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 
-	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 
 	evmSetup "github.com/limechain/hedera-eth-bridge-validator/e2e/setup/evm"
 
@@ -71,7 +70,7 @@ func Test_HBAR(t *testing.T) {
 		t.Fatalf("Expecting Token [%s] is not supported. - Error: [%s]", constants.Hbar, err)
 	}
 
-	mintAmount, fee := expected.ReceiverAndFeeAmounts(setupEnv.Clients.FeeCalculator, setupEnv.Clients.Distributor, constants.Hbar, amount)
+	mintAmount, fee := expected.ReceiverAndFeeAmounts(setupEnv.Clients.FeeCalculator, setupEnv.Clients.Distributor, constants.Hbar, big.NewInt(amount))
 
 	// Step 1 - Verify the transfer of Hbars to the Bridge Account
 	transactionResponse, wrappedBalanceBefore := verify.TransferToBridgeAccount(t, setupEnv.Clients.Hedera, setupEnv.BridgeAccount, targetAsset, evm, memo, receiver, amount)
@@ -80,7 +79,7 @@ func Test_HBAR(t *testing.T) {
 	receivedSignatures := verify.TopicMessagesWithStartTime(t, setupEnv.Clients.Hedera, setupEnv.TopicID, setupEnv.Scenario.ExpectedValidatorsCount, hederahelper.FromHederaTransactionID(transactionResponse.TransactionID).String(), now.UnixNano())
 
 	// Step 3 - Validate fee scheduled transaction
-	expectedTransfers := expected.MirrorNodeExpectedTransfersForHederaTransfer(setupEnv.Members, setupEnv.BridgeAccount, constants.Hbar, fee)
+	expectedTransfers := expected.MirrorNodeExpectedTransfersForHederaTransfer(setupEnv.Members, setupEnv.BridgeAccount, constants.Hbar, fee.Int64())
 	scheduledTxID, scheduleID := verify.MembersScheduledTxs(t, setupEnv.Clients.Hedera, setupEnv.Clients.MirrorNode, setupEnv.Members, constants.Hbar, expectedTransfers, now)
 
 	// Step 4 - Verify Transfer retrieved from Validator API
@@ -91,7 +90,7 @@ func Test_HBAR(t *testing.T) {
 		evm,
 		hederahelper.FromHederaTransactionID(transactionResponse.TransactionID).String(),
 		constants.Hbar,
-		fmt.Sprint(mintAmount),
+		mintAmount.String(),
 		targetAsset,
 	)
 
@@ -115,7 +114,7 @@ func Test_HBAR(t *testing.T) {
 	submit.WaitForTransaction(t, evm, txHash)
 
 	// Step 7 - Validate Token balances
-	verify.WrappedAssetBalance(t, evm, targetAsset, big.NewInt(mintAmount), wrappedBalanceBefore, receiver)
+	verify.WrappedAssetBalance(t, evm, targetAsset, mintAmount, wrappedBalanceBefore, receiver)
 
 	// Step 8 - Prepare Comparable Expected Transfer Record
 	expectedTxRecord := expected.FungibleTransferRecord(
@@ -127,7 +126,7 @@ func Test_HBAR(t *testing.T) {
 		targetAsset,
 		constants.Hbar,
 		strconv.FormatInt(amount, 10),
-		strconv.FormatInt(fee, 10),
+		fee.String(),
 		receiver.String(),
 		status.Completed,
 		setupEnv.Clients.Hedera.GetOperatorAccountID().String(),
@@ -148,7 +147,7 @@ func Test_HBAR(t *testing.T) {
 		expectedTxRecord.TransactionID,
 		expectedTxRecord.TargetAsset,
 		expectedTxRecord.Receiver,
-		strconv.FormatInt(mintAmount, 10),
+		mintAmount.String(),
 	)
 
 	if err != nil {
@@ -175,7 +174,7 @@ func Test_E2E_Token_Transfer(t *testing.T) {
 	chainId := setupEnv.Scenario.FirstEvmChainId
 	evm := setupEnv.Clients.EVM[chainId]
 	memo := fmt.Sprintf("%d-%s", chainId, evm.Receiver.String())
-	mintAmount, fee := expected.ReceiverAndFeeAmounts(setupEnv.Clients.FeeCalculator, setupEnv.Clients.Distributor, setupEnv.TokenID.String(), amount)
+	mintAmount, fee := expected.ReceiverAndFeeAmounts(setupEnv.Clients.FeeCalculator, setupEnv.Clients.Distributor, setupEnv.TokenID.String(), big.NewInt(amount))
 
 	targetAsset, err := evmSetup.NativeToWrappedAsset(setupEnv.AssetMappings, constants.HederaNetworkId, chainId, setupEnv.TokenID.String())
 	if err != nil {
@@ -189,7 +188,7 @@ func Test_E2E_Token_Transfer(t *testing.T) {
 	receivedSignatures := verify.TopicMessagesWithStartTime(t, setupEnv.Clients.Hedera, setupEnv.TopicID, setupEnv.Scenario.ExpectedValidatorsCount, hederahelper.FromHederaTransactionID(transactionResponse.TransactionID).String(), now.UnixNano())
 
 	// Step 3 - Validate fee scheduled transaction
-	expectedTransfers := expected.MirrorNodeExpectedTransfersForHederaTransfer(setupEnv.Members, setupEnv.BridgeAccount, setupEnv.TokenID.String(), fee)
+	expectedTransfers := expected.MirrorNodeExpectedTransfersForHederaTransfer(setupEnv.Members, setupEnv.BridgeAccount, setupEnv.TokenID.String(), fee.Int64())
 	scheduledTxID, scheduleID := verify.MembersScheduledTxs(t, setupEnv.Clients.Hedera, setupEnv.Clients.MirrorNode, setupEnv.Members, setupEnv.TokenID.String(), expectedTransfers, now)
 
 	// Step 4 - Verify Transfer retrieved from Validator API
@@ -200,7 +199,7 @@ func Test_E2E_Token_Transfer(t *testing.T) {
 		evm,
 		hederahelper.FromHederaTransactionID(transactionResponse.TransactionID).String(),
 		setupEnv.TokenID.String(),
-		fmt.Sprint(mintAmount),
+		mintAmount.String(),
 		targetAsset,
 	)
 
@@ -224,7 +223,7 @@ func Test_E2E_Token_Transfer(t *testing.T) {
 	submit.WaitForTransaction(t, evm, txHash)
 
 	// Step 7 - Validate Token balances
-	verify.WrappedAssetBalance(t, evm, targetAsset, big.NewInt(mintAmount), wrappedBalanceBefore, evm.Receiver)
+	verify.WrappedAssetBalance(t, evm, targetAsset, mintAmount, wrappedBalanceBefore, evm.Receiver)
 
 	// Step 8 - Verify Database records
 	expectedTxRecord := expected.FungibleTransferRecord(
@@ -236,7 +235,7 @@ func Test_E2E_Token_Transfer(t *testing.T) {
 		targetAsset,
 		setupEnv.TokenID.String(),
 		strconv.FormatInt(amount, 10),
-		strconv.FormatInt(fee, 10),
+		fee.String(),
 		evm.Receiver.String(),
 		status.Completed,
 		setupEnv.Clients.Hedera.GetOperatorAccountID().String(),
@@ -256,7 +255,7 @@ func Test_E2E_Token_Transfer(t *testing.T) {
 		expectedTxRecord.TransactionID,
 		expectedTxRecord.TargetAsset,
 		expectedTxRecord.Receiver,
-		strconv.FormatInt(mintAmount, 10),
+		mintAmount.String(),
 	)
 
 	if err != nil {
@@ -290,7 +289,7 @@ func Test_EVM_Hedera_HBAR(t *testing.T) {
 	}
 
 	// Step 1 - Calculate Expected Receive And Fee Amounts
-	expectedReceiveAmount, fee := expected.ReceiverAndFeeAmounts(setupEnv.Clients.FeeCalculator, setupEnv.Clients.Distributor, constants.Hbar, amount)
+	expectedReceiveAmount, fee := expected.ReceiverAndFeeAmounts(setupEnv.Clients.FeeCalculator, setupEnv.Clients.Distributor, constants.Hbar, big.NewInt(amount))
 
 	// Step 2 - Submit burn transaction to the bridge contract
 	burnTxReceipt, expectedRouterBurn := submit.BurnEthTransaction(t, setupEnv.AssetMappings, evm, constants.Hbar, constants.HederaNetworkId, chainId, setupEnv.Clients.Hedera.GetOperatorAccountID().ToBytes(), amount)
@@ -306,14 +305,14 @@ func Test_EVM_Hedera_HBAR(t *testing.T) {
 	expectedId := verify.BurnEvent(t, burnTxReceipt, expectedRouterBurn)
 
 	// Step 4 - Validate that a scheduled transaction was submitted
-	expectedTransfers := expected.MirrorNodeExpectedTransfersForBurnEvent(setupEnv.Members, setupEnv.Clients.Hedera, setupEnv.BridgeAccount, constants.Hbar, expectedReceiveAmount, fee)
+	expectedTransfers := expected.MirrorNodeExpectedTransfersForBurnEvent(setupEnv.Members, setupEnv.Clients.Hedera, setupEnv.BridgeAccount, constants.Hbar, expectedReceiveAmount.Int64(), fee.Int64())
 	transactionID, scheduleID := verify.SubmittedScheduledTx(t, setupEnv.Clients.Hedera, setupEnv.Clients.MirrorNode, setupEnv.Members, constants.Hbar, expectedTransfers, now)
 
 	// Step 5 - Validate Event Transaction ID retrieved from Validator API
 	verify.EventTransactionIDFromValidatorAPI(t, setupEnv.Clients.ValidatorClient, expectedId, transactionID)
 
 	// Step 6 - Validate that the balance of the receiver account (hedera) was changed with the correct amount
-	verify.ReceiverAccountBalance(t, setupEnv.Clients.Hedera, uint64(expectedReceiveAmount), accountBalanceBefore, constants.Hbar, setupEnv.TokenID)
+	verify.ReceiverAccountBalance(t, setupEnv.Clients.Hedera, expectedReceiveAmount.Uint64(), accountBalanceBefore, constants.Hbar, setupEnv.TokenID)
 
 	// Step 7 - Prepare Expected Database Records
 	expectedBurnEventRecord := expected.FungibleTransferRecord(
@@ -325,7 +324,7 @@ func Test_EVM_Hedera_HBAR(t *testing.T) {
 		constants.Hbar,
 		constants.Hbar,
 		strconv.FormatInt(amount, 10),
-		strconv.FormatInt(fee, 10),
+		fee.String(),
 		setupEnv.Clients.Hedera.GetOperatorAccountID().String(),
 		status.Completed,
 		evm.Signer.Address(),
@@ -361,7 +360,7 @@ func Test_EVM_Hedera_Token(t *testing.T) {
 	}
 
 	// Step 1 - Calculate Expected Receive Amount
-	expectedReceiveAmount, fee := expected.ReceiverAndFeeAmounts(setupEnv.Clients.FeeCalculator, setupEnv.Clients.Distributor, setupEnv.TokenID.String(), amount)
+	expectedReceiveAmount, fee := expected.ReceiverAndFeeAmounts(setupEnv.Clients.FeeCalculator, setupEnv.Clients.Distributor, setupEnv.TokenID.String(), big.NewInt(amount))
 
 	// Step 2 - Submit burn transaction to the bridge contract
 	burnTxReceipt, expectedRouterBurn := submit.BurnEthTransaction(t, setupEnv.AssetMappings, evm, setupEnv.TokenID.String(), constants.HederaNetworkId, chainId, setupEnv.Clients.Hedera.GetOperatorAccountID().ToBytes(), amount)
@@ -377,14 +376,14 @@ func Test_EVM_Hedera_Token(t *testing.T) {
 	expectedId := verify.BurnEvent(t, burnTxReceipt, expectedRouterBurn)
 
 	// Step 4 - Validate that a scheduled transaction was submitted
-	expectedTransfers := expected.MirrorNodeExpectedTransfersForBurnEvent(setupEnv.Members, setupEnv.Clients.Hedera, setupEnv.BridgeAccount, setupEnv.TokenID.String(), expectedReceiveAmount, fee)
+	expectedTransfers := expected.MirrorNodeExpectedTransfersForBurnEvent(setupEnv.Members, setupEnv.Clients.Hedera, setupEnv.BridgeAccount, setupEnv.TokenID.String(), expectedReceiveAmount.Int64(), fee.Int64())
 	transactionID, scheduleID := verify.SubmittedScheduledTx(t, setupEnv.Clients.Hedera, setupEnv.Clients.MirrorNode, setupEnv.Members, setupEnv.TokenID.String(), expectedTransfers, now)
 
 	// Step 5 - Validate Event Transaction ID retrieved from Validator API
 	verify.EventTransactionIDFromValidatorAPI(t, setupEnv.Clients.ValidatorClient, expectedId, transactionID)
 
 	// Step 6 - Validate that the balance of the receiver account (hedera) was changed with the correct amount
-	verify.ReceiverAccountBalance(t, setupEnv.Clients.Hedera, uint64(expectedReceiveAmount), accountBalanceBefore, setupEnv.TokenID.String(), setupEnv.TokenID)
+	verify.ReceiverAccountBalance(t, setupEnv.Clients.Hedera, expectedReceiveAmount.Uint64(), accountBalanceBefore, setupEnv.TokenID.String(), setupEnv.TokenID)
 
 	// Step 7 - Prepare Expected Database Records
 	expectedBurnEventRecord := expected.FungibleTransferRecord(
@@ -396,7 +395,7 @@ func Test_EVM_Hedera_Token(t *testing.T) {
 		setupEnv.TokenID.String(),
 		setupEnv.TokenID.String(),
 		strconv.FormatInt(amount, 10),
-		strconv.FormatInt(fee, 10),
+		fee.String(),
 		setupEnv.Clients.Hedera.GetOperatorAccountID().String(),
 		status.Completed,
 		evm.Signer.Address(),
@@ -870,7 +869,7 @@ func Test_Hedera_Native_EVM_NFT_Transfer(t *testing.T) {
 
 	transferFee := setupEnv.NftConstantFees[nftToken]
 
-	validatorsFee := setupEnv.Clients.Distributor.ValidAmount(transferFee)
+	validatorsFee := setupEnv.Clients.Distributor.ValidAmount(big.NewInt(transferFee))
 
 	// Step 1 - Get Token Metadata
 	nftData, err := setupEnv.Clients.MirrorNode.GetNft(nftToken, serialNumber)
@@ -920,7 +919,7 @@ func Test_Hedera_Native_EVM_NFT_Transfer(t *testing.T) {
 	receivedSignatures := verify.TopicMessagesWithStartTime(t, setupEnv.Clients.Hedera, setupEnv.TopicID, setupEnv.Scenario.ExpectedValidatorsCount, hederahelper.FromHederaTransactionID(feeResponse.TransactionID).String(), signaturesStartTime)
 
 	// Step 6 - Validate members fee scheduled transaction
-	expectedTransfers := expected.MirrorNodeExpectedTransfersForHederaTransfer(setupEnv.Members, setupEnv.BridgeAccount, constants.Hbar, validatorsFee)
+	expectedTransfers := expected.MirrorNodeExpectedTransfersForHederaTransfer(setupEnv.Members, setupEnv.BridgeAccount, constants.Hbar, validatorsFee.Int64())
 	scheduledTxID, scheduleID := verify.MembersScheduledTxs(t, setupEnv.Clients.Hedera, setupEnv.Clients.MirrorNode, setupEnv.Members, constants.Hbar, expectedTransfers, now)
 
 	// Step 7 - Verify Non-Fungible Transfer retrieved from Validator API
@@ -967,7 +966,7 @@ func Test_Hedera_Native_EVM_NFT_Transfer(t *testing.T) {
 		NativeAsset:   nftToken,
 		Receiver:      receiver.String(),
 		Amount:        "",
-		Fee:           strconv.FormatInt(validatorsFee, 10),
+		Fee:           validatorsFee.String(),
 		Status:        status.Completed,
 		SerialNumber:  serialNumber,
 		Metadata:      string(decodedMetadata),
@@ -2,17 +2,17 @@ package expected
 
 import (
 	"database/sql"
-	"strconv"
+	"math/big"
 
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/status"
 )
 
-func FeeRecord(transactionID, scheduleID string, amount int64, transferID string) *entity.Fee {
+func FeeRecord(transactionID, scheduleID string, amount *big.Int, transferID string) *entity.Fee {
 	fee := &entity.Fee{
 		TransactionID: transactionID,
 		ScheduleID:    scheduleID,
-		Amount:        strconv.FormatInt(amount, 10),
+		Amount:        amount.String(),
 		Status:        status.Completed,
 	}
 
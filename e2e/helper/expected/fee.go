@@ -24,11 +24,11 @@ import (
 	"testing"
 )
 
-func ReceiverAndFeeAmounts(feeCalc service.Fee, distributor service.Distributor, token string, amount int64) (receiverAmount, fee int64) {
+func ReceiverAndFeeAmounts(feeCalc service.Fee, distributor service.Distributor, token string, amount *big.Int) (receiverAmount, fee *big.Int) {
 	fee, remainder := feeCalc.CalculateFee(token, amount)
 	validFee := distributor.ValidAmount(fee)
-	if validFee != fee {
-		remainder += fee - validFee
+	if validFee.Cmp(fee) != 0 {
+		remainder = new(big.Int).Add(remainder, new(big.Int).Sub(fee, validFee))
 	}
 
 	return remainder, validFee
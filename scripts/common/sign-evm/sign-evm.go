@@ -14,6 +14,7 @@ go run ./scripts/common/sign-evm/sign-evm.go \
 */
 
 import (
+	"context"
 	"encoding/hex"
 	"flag"
 	"fmt"
@@ -100,7 +101,7 @@ func main() {
 	}
 
 	for _, s := range signers {
-		signature, err := s.Sign(authMsgHash)
+		signature, err := s.Sign(context.Background(), authMsgHash)
 		if err != nil {
 			panic(err)
 		}
@@ -254,7 +254,7 @@ func (m *migrator) evmFields(transfer *tempTransfer) error {
 	uT := time.Unix(int64(block.Time()), 0)
 	transfer.Timestamp = entity.NanoTime{Time: uT.UTC()}
 
-	tx, err := c.RetryTransactionByHash(common.HexToHash(txHash))
+	tx, err := c.RetryTransactionByHash(context.Background(), common.HexToHash(txHash))
 	if err != nil {
 		return err
 	}
@@ -91,6 +91,39 @@ func (m *MockBridgeContract) ParseBurnERC721Log(log types.Log) (*router.RouterBu
 	return args.Get(0).(*router.RouterBurnERC721), args.Get(1).(error)
 }
 
+func (m *MockBridgeContract) ParseLockERC721Log(log types.Log) (*router.RouterLockERC721, error) {
+	args := m.Called(log)
+	if args[0] == nil {
+		return nil, args.Get(1).(error)
+	}
+	if args[1] == nil {
+		return args.Get(0).(*router.RouterLockERC721), nil
+	}
+	return args.Get(0).(*router.RouterLockERC721), args.Get(1).(error)
+}
+
+func (m *MockBridgeContract) ParseUnlockERC721Log(log types.Log) (*router.RouterUnlockERC721, error) {
+	args := m.Called(log)
+	if args[0] == nil {
+		return nil, args.Get(1).(error)
+	}
+	if args[1] == nil {
+		return args.Get(0).(*router.RouterUnlockERC721), nil
+	}
+	return args.Get(0).(*router.RouterUnlockERC721), args.Get(1).(error)
+}
+
+func (m *MockBridgeContract) ParseMintERC721Log(log types.Log) (*router.RouterMintERC721, error) {
+	args := m.Called(log)
+	if args[0] == nil {
+		return nil, args.Get(1).(error)
+	}
+	if args[1] == nil {
+		return args.Get(0).(*router.RouterMintERC721), nil
+	}
+	return args.Get(0).(*router.RouterMintERC721), args.Get(1).(error)
+}
+
 func (m *MockBridgeContract) IsMember(address string) bool {
 	panic("implement me")
 }
@@ -66,6 +66,21 @@ func (m *MockDiamondRouter) ParseBurnERC721(log types.Log) (*router.RouterBurnER
 	return args.Get(0).(*router.RouterBurnERC721), args.Error(1)
 }
 
+func (m *MockDiamondRouter) ParseLockERC721(log types.Log) (*router.RouterLockERC721, error) {
+	args := m.Called(log)
+	return args.Get(0).(*router.RouterLockERC721), args.Error(1)
+}
+
+func (m *MockDiamondRouter) ParseUnlockERC721(log types.Log) (*router.RouterUnlockERC721, error) {
+	args := m.Called(log)
+	return args.Get(0).(*router.RouterUnlockERC721), args.Error(1)
+}
+
+func (m *MockDiamondRouter) ParseMintERC721(log types.Log) (*router.RouterMintERC721, error) {
+	args := m.Called(log)
+	return args.Get(0).(*router.RouterMintERC721), args.Error(1)
+}
+
 func (m *MockDiamondRouter) WatchBurn(opts *bind.WatchOpts, sink chan<- *router.RouterBurn) (event.Subscription, error) {
 	args := m.Called(opts, sink)
 	return args.Get(0).(event.Subscription), args.Error(1)
@@ -31,6 +31,11 @@ type MockEVM struct {
 	mock.Mock
 }
 
+func (m *MockEVM) Nodes() map[string]client.EVM {
+	args := m.Called()
+	return args.Get(0).(map[string]client.EVM)
+}
+
 func (m *MockEVM) SetChainID(chainId uint64) {
 	m.Called(chainId)
 }
@@ -60,8 +65,8 @@ func (m *MockEVM) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]typ
 	return args.Get(0).([]types.Log), args.Get(1).(error)
 }
 
-func (m *MockEVM) RetryBlockNumber() (uint64, error) {
-	args := m.Called()
+func (m *MockEVM) RetryBlockNumber(ctx context.Context) (uint64, error) {
+	args := m.Called(ctx)
 
 	if args.Get(1) == nil {
 		return args.Get(0).(uint64), nil
@@ -70,8 +75,8 @@ func (m *MockEVM) RetryBlockNumber() (uint64, error) {
 	return args.Get(0).(uint64), args.Get(1).(error)
 }
 
-func (m *MockEVM) RetryFilterLogs(q ethereum.FilterQuery) ([]types.Log, error) {
-	args := m.Called(q)
+func (m *MockEVM) RetryFilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	args := m.Called(ctx, q)
 
 	if args.Get(1) == nil {
 		return args.Get(0).([]types.Log), nil
@@ -132,6 +137,19 @@ func (m *MockEVM) GetPrivateKey() string {
 	return args.Get(0).(string)
 }
 
+func (m *MockEVM) RetryConfirmedBlockNumber(ctx context.Context) (uint64, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(uint64), args.Error(1)
+}
+
+func (m *MockEVM) RetryHeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	args := m.Called(ctx, number)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*types.Header), args.Error(1)
+}
+
 func (m *MockEVM) BlockConfirmations() uint64 {
 	args := m.Called()
 
@@ -188,6 +206,14 @@ func (m *MockEVM) SubscribeFilterLogs(ctx context.Context, query ethereum.Filter
 	return args.Get(0).(ethereum.Subscription), args.Error(1)
 }
 
+func (m *MockEVM) SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error) {
+	args := m.Called(ctx, ch)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(ethereum.Subscription), args.Error(1)
+}
+
 func (m *MockEVM) WaitForTransactionReceipt(hash common.Hash) (txReceipt *types.Receipt, err error) {
 	args := m.Called(hash)
 	if err, ok := args.Get(1).(error); ok {
@@ -196,7 +222,7 @@ func (m *MockEVM) WaitForTransactionReceipt(hash common.Hash) (txReceipt *types.
 	return args.Get(0).(*types.Receipt), nil
 }
 
-func (m *MockEVM) RetryTransactionByHash(hash common.Hash) (*types.Transaction, error) {
-	args := m.Called(hash)
+func (m *MockEVM) RetryTransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, error) {
+	args := m.Called(ctx, hash)
 	return args.Get(0).(*types.Transaction), nil
 }
@@ -17,6 +17,7 @@
 package client
 
 import (
+	"context"
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/account"
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/message"
@@ -107,6 +108,14 @@ func (m *MockHederaMirror) GetAccountCreditTransactionsBetween(accountId hedera.
 	return args.Get(0).([]transaction.Transaction), args.Get(1).(error)
 }
 
+func (m *MockHederaMirror) GetLatestAccountCreditTransaction(accountId hedera.AccountID) (*transaction.Transaction, error) {
+	args := m.Called(accountId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*transaction.Transaction), args.Error(1)
+}
+
 func (m *MockHederaMirror) GetMessagesForTopicBetween(topicId hedera.TopicID, from, to int64) ([]message.Message, error) {
 	args := m.Called(topicId, from, to)
 
@@ -153,8 +162,8 @@ func (m *MockHederaMirror) GetLatestMessages(topicId hedera.TopicID, limit int64
 	return args.Get(0).([]message.Message), args.Get(1).(error)
 }
 
-func (m *MockHederaMirror) WaitForTransaction(txId string, onSuccess, onFailure func()) {
-	m.Called(txId, onSuccess, onFailure)
+func (m *MockHederaMirror) WaitForTransaction(ctx context.Context, txId string, onSuccess, onFailure func()) {
+	m.Called(ctx, txId, onSuccess, onFailure)
 }
 
 func (m *MockHederaMirror) GetAccountCreditTransactionsAfterTimestamp(accountId hedera.AccountID, milestoneTimestamp int64) (*transaction.Response, error) {
@@ -222,8 +231,8 @@ func (m *MockHederaMirror) GetTransaction(transactionID string) (*transaction.Re
 	return args.Get(0).(*transaction.Response), args.Get(1).(error)
 }
 
-func (m *MockHederaMirror) WaitForScheduledTransaction(txId string, onSuccess, onFailure func()) {
-	m.Called(txId /*, onSuccess, onFailure*/)
+func (m *MockHederaMirror) WaitForScheduledTransaction(ctx context.Context, txId string, onSuccess func(), onFailure func(result string)) {
+	m.Called(ctx, txId /*, onSuccess, onFailure*/)
 }
 
 func (m *MockHederaMirror) GetHBARUsdPrice() (price decimal.Decimal, err error) {
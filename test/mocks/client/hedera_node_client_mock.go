@@ -17,6 +17,7 @@
 package client
 
 import (
+	"context"
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 	"github.com/stretchr/testify/mock"
@@ -26,8 +27,8 @@ type MockHederaNode struct {
 	mock.Mock
 }
 
-func (m *MockHederaNode) SubmitScheduledNftTransferTransaction(nftID hedera.NftID, payerAccount hedera.AccountID, sender hedera.AccountID, receiving hedera.AccountID, memo string, approved bool) (*hedera.TransactionResponse, error) {
-	args := m.Called(nftID, payerAccount, sender, receiving, memo, approved)
+func (m *MockHederaNode) SubmitScheduledNftTransferTransaction(ctx context.Context, nftID hedera.NftID, payerAccount hedera.AccountID, sender hedera.AccountID, receiving hedera.AccountID, memo string, approved bool) (*hedera.TransactionResponse, error) {
+	args := m.Called(ctx, nftID, payerAccount, sender, receiving, memo, approved)
 	if args.Get(1) == nil {
 		return args.Get(0).(*hedera.TransactionResponse), nil
 	}
@@ -40,8 +41,8 @@ func (m *MockHederaNode) GetClient() *hedera.Client {
 	return args.Get(0).(*hedera.Client)
 }
 
-func (m *MockHederaNode) SubmitTopicConsensusMessage(topicId hedera.TopicID, message []byte) (*hedera.TransactionID, error) {
-	args := m.Called(topicId, message)
+func (m *MockHederaNode) SubmitTopicConsensusMessage(ctx context.Context, topicId hedera.TopicID, message []byte) (*hedera.TransactionID, error) {
+	args := m.Called(ctx, topicId, message)
 
 	if args.Get(1) == nil {
 		return args.Get(0).(*hedera.TransactionID), nil
@@ -50,11 +51,12 @@ func (m *MockHederaNode) SubmitTopicConsensusMessage(topicId hedera.TopicID, mes
 }
 
 func (m *MockHederaNode) SubmitScheduledTokenTransferTransaction(
+	ctx context.Context,
 	tokenID hedera.TokenID,
 	transfers []transfer.Hedera,
 	payerAccountID hedera.AccountID,
 	memo string) (*hedera.TransactionResponse, error) {
-	args := m.Called(tokenID, transfers, payerAccountID, memo)
+	args := m.Called(ctx, tokenID, transfers, payerAccountID, memo)
 
 	if args.Get(1) == nil {
 		return args.Get(0).(*hedera.TransactionResponse), nil
@@ -64,10 +66,11 @@ func (m *MockHederaNode) SubmitScheduledTokenTransferTransaction(
 }
 
 func (m *MockHederaNode) SubmitScheduledHbarTransferTransaction(
+	ctx context.Context,
 	transfers []transfer.Hedera,
 	payerAccountID hedera.AccountID,
 	memo string) (*hedera.TransactionResponse, error) {
-	args := m.Called(transfers, payerAccountID, memo)
+	args := m.Called(ctx, transfers, payerAccountID, memo)
 
 	if args.Get(1) == nil {
 		return args.Get(0).(*hedera.TransactionResponse), nil
@@ -76,24 +79,40 @@ func (m *MockHederaNode) SubmitScheduledHbarTransferTransaction(
 	return args.Get(0).(*hedera.TransactionResponse), args.Get(1).(error)
 }
 
-func (m *MockHederaNode) SubmitScheduleSign(scheduleID hedera.ScheduleID) (*hedera.TransactionResponse, error) {
-	args := m.Called(scheduleID)
+func (m *MockHederaNode) SubmitScheduleSign(ctx context.Context, scheduleID hedera.ScheduleID) (*hedera.TransactionResponse, error) {
+	args := m.Called(ctx, scheduleID)
 	if args.Get(1) == nil {
 		return args.Get(0).(*hedera.TransactionResponse), nil
 	}
 	return args.Get(0).(*hedera.TransactionResponse), args.Get(1).(error)
 }
 
-func (m *MockHederaNode) SubmitScheduledTokenMintTransaction(tokenID hedera.TokenID, amount int64, payerAccountID hedera.AccountID, memo string) (*hedera.TransactionResponse, error) {
-	args := m.Called(tokenID, amount, payerAccountID, memo)
+func (m *MockHederaNode) SubmitScheduledTokenMintTransaction(ctx context.Context, tokenID hedera.TokenID, amount int64, payerAccountID hedera.AccountID, memo string) (*hedera.TransactionResponse, error) {
+	args := m.Called(ctx, tokenID, amount, payerAccountID, memo)
 	if args.Get(1) == nil {
 		return args.Get(0).(*hedera.TransactionResponse), nil
 	}
 	return args.Get(0).(*hedera.TransactionResponse), args.Get(1).(error)
 }
 
-func (m *MockHederaNode) SubmitScheduledTokenBurnTransaction(id hedera.TokenID, amount int64, account hedera.AccountID, memo string) (*hedera.TransactionResponse, error) {
-	args := m.Called(id, amount, account, memo)
+func (m *MockHederaNode) SubmitScheduledTokenBurnTransaction(ctx context.Context, id hedera.TokenID, amount int64, account hedera.AccountID, memo string) (*hedera.TransactionResponse, error) {
+	args := m.Called(ctx, id, amount, account, memo)
+	if args.Get(1) == nil {
+		return args.Get(0).(*hedera.TransactionResponse), nil
+	}
+	return args.Get(0).(*hedera.TransactionResponse), args.Get(1).(error)
+}
+
+func (m *MockHederaNode) SubmitScheduledNftMintTransaction(ctx context.Context, tokenID hedera.TokenID, metadata []byte, payerAccountID hedera.AccountID, memo string) (*hedera.TransactionResponse, error) {
+	args := m.Called(ctx, tokenID, metadata, payerAccountID, memo)
+	if args.Get(1) == nil {
+		return args.Get(0).(*hedera.TransactionResponse), nil
+	}
+	return args.Get(0).(*hedera.TransactionResponse), args.Get(1).(error)
+}
+
+func (m *MockHederaNode) SubmitScheduledNftBurnTransaction(ctx context.Context, nftID hedera.NftID, payerAccountID hedera.AccountID, memo string) (*hedera.TransactionResponse, error) {
+	args := m.Called(ctx, nftID, payerAccountID, memo)
 	if args.Get(1) == nil {
 		return args.Get(0).(*hedera.TransactionResponse), nil
 	}
@@ -108,8 +127,13 @@ func (m *MockHederaNode) TransactionReceiptQuery(transactionID hedera.Transactio
 	return args.Get(0).(hedera.TransactionReceipt), args.Get(1).(error)
 }
 
-func (m *MockHederaNode) SubmitScheduledNftApproveTransaction(payer hedera.AccountID, memo string, nftId hedera.NftID, owner, spender hedera.AccountID) (*hedera.TransactionResponse, error) {
-	args := m.Called(payer, memo, nftId, owner, spender)
+func (m *MockHederaNode) Ping() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+func (m *MockHederaNode) SubmitScheduledNftApproveTransaction(ctx context.Context, payer hedera.AccountID, memo string, nftId hedera.NftID, owner, spender hedera.AccountID) (*hedera.TransactionResponse, error) {
+	args := m.Called(ctx, payer, memo, nftId, owner, spender)
 	if args.Get(1) == nil {
 		return args.Get(0).(*hedera.TransactionResponse), nil
 	}
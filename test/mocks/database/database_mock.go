@@ -17,6 +17,8 @@
 package database
 
 import (
+	"context"
+
 	"github.com/stretchr/testify/mock"
 	"gorm.io/gorm"
 )
@@ -33,3 +35,16 @@ func (m *MockDatabase) Connection() *gorm.DB {
 func (m *MockDatabase) Migrate() {
 	return
 }
+
+func (m *MockDatabase) Ping(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+func (m *MockDatabase) Close() error {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
@@ -16,12 +16,20 @@
 
 package handlers
 
-import "github.com/stretchr/testify/mock"
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
 
 type MockHandler struct {
 	mock.Mock
 }
 
-func (m *MockHandler) Handle(payload interface{}) {
-	m.Called(payload)
+func (m *MockHandler) Handle(ctx context.Context, payload interface{}) error {
+	args := m.Called(ctx, payload)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
 }
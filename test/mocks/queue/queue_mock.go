@@ -30,6 +30,12 @@ func (m *MockQueue) Channel() chan *queue.Message {
 	return args.Get(0).(chan *queue.Message)
 }
 
-func (m *MockQueue) Push(message *queue.Message) {
-	m.Called(message)
+func (m *MockQueue) Push(message *queue.Message) error {
+	args := m.Called(message)
+	return args.Error(0)
+}
+
+func (m *MockQueue) Close() error {
+	args := m.Called()
+	return args.Error(0)
 }
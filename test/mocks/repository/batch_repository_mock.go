@@ -0,0 +1,76 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repository
+
+import (
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockBatchRepository struct {
+	mock.Mock
+}
+
+func (m *MockBatchRepository) Save(item *entity.BatchItem) error {
+	args := m.Called(item)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (m *MockBatchRepository) GetDue(at time.Time) ([]*entity.BatchItem, error) {
+	args := m.Called(at)
+	if args.Get(1) == nil {
+		return args.Get(0).([]*entity.BatchItem), nil
+	}
+	return nil, args.Get(1).(error)
+}
+
+func (m *MockBatchRepository) CountUnbatched(token string, from, to time.Time) (int64, error) {
+	args := m.Called(token, from, to)
+	if args.Get(1) == nil {
+		return args.Get(0).(int64), nil
+	}
+	return 0, args.Get(1).(error)
+}
+
+func (m *MockBatchRepository) TakePending(batchID string) ([]*entity.BatchItem, error) {
+	args := m.Called(batchID)
+	if args.Get(1) == nil {
+		return args.Get(0).([]*entity.BatchItem), nil
+	}
+	return nil, args.Get(1).(error)
+}
+
+func (m *MockBatchRepository) Get(transferID string) (*entity.BatchItem, error) {
+	args := m.Called(transferID)
+	if args.Get(1) == nil {
+		return args.Get(0).(*entity.BatchItem), nil
+	}
+	return nil, args.Get(1).(error)
+}
+
+func (m *MockBatchRepository) Requeue(transferID string) error {
+	args := m.Called(transferID)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
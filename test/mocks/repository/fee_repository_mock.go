@@ -55,6 +55,14 @@ func (mfr *MockFeeRepository) UpdateStatusCompleted(id string) error {
 	return args.Get(0).(error)
 }
 
+func (mfr *MockFeeRepository) UpdateStatus(txId, status, actor, reason string) error {
+	args := mfr.Called(txId, status, actor, reason)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
 func (mfr *MockFeeRepository) UpdateStatusFailed(id string) error {
 	args := mfr.Called(id)
 	if args.Get(0) == nil {
@@ -0,0 +1,68 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repository
+
+import (
+	"time"
+
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockQueueRepository struct {
+	mock.Mock
+}
+
+func (m *MockQueueRepository) Create(message *entity.QueueMessage) error {
+	args := m.Called(message)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (m *MockQueueRepository) Claim(limit int, visibilityTimeout time.Duration) ([]*entity.QueueMessage, error) {
+	args := m.Called(limit, visibilityTimeout)
+	if args.Get(1) == nil {
+		return args.Get(0).([]*entity.QueueMessage), nil
+	}
+	return nil, args.Get(1).(error)
+}
+
+func (m *MockQueueRepository) Acknowledge(topic string, id uint64) error {
+	args := m.Called(topic, id)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (m *MockQueueRepository) Release(id uint64, visibleAt time.Time) error {
+	args := m.Called(id, visibleAt)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (m *MockQueueRepository) MoveToDeadLetter(id uint64, reason string) error {
+	args := m.Called(id, reason)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
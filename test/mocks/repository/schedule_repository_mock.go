@@ -18,6 +18,7 @@ package repository
 
 import (
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/failure"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -40,7 +41,19 @@ func (m *MockScheduleRepository) GetAllSubmittedIds() ([]*entity.Schedule, error
 }
 
 func (m *MockScheduleRepository) Get(txId string) (*entity.Schedule, error) {
-	panic("implement me")
+	args := m.Called(txId)
+	if args.Get(1) == nil {
+		return args.Get(0).(*entity.Schedule), nil
+	}
+	return nil, args.Get(1).(error)
+}
+
+func (m *MockScheduleRepository) GetAllByTransactionID(txId string) ([]*entity.Schedule, error) {
+	args := m.Called(txId)
+	if args.Get(1) == nil {
+		return args.Get(0).([]*entity.Schedule), nil
+	}
+	return nil, args.Get(1).(error)
 }
 
 func (m *MockScheduleRepository) Create(entity *entity.Schedule) error {
@@ -59,6 +72,14 @@ func (m *MockScheduleRepository) UpdateStatusCompleted(txId string) error {
 	return args.Get(0).(error)
 }
 
+func (m *MockScheduleRepository) UpdateStatus(txId, status, actor, reason string) error {
+	args := m.Called(txId, status, actor, reason)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
 func (m *MockScheduleRepository) UpdateStatusFailed(txId string) error {
 	args := m.Called(txId)
 	if args.Get(0) == nil {
@@ -67,6 +88,14 @@ func (m *MockScheduleRepository) UpdateStatusFailed(txId string) error {
 	return args.Get(0).(error)
 }
 
+func (m *MockScheduleRepository) Fail(txId string, code failure.Code, reason, actor string) error {
+	args := m.Called(txId, code, reason, actor)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
 func (m *MockScheduleRepository) GetReceiverTransferByTransactionID(id string) (*entity.Schedule, error) {
 	args := m.Called(id)
 	if args.Get(1) == nil {
@@ -17,8 +17,12 @@
 package repository
 
 import (
+	"context"
+	"time"
+
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/failure"
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
 	"github.com/stretchr/testify/mock"
 )
@@ -35,6 +39,38 @@ func (m *MockTransferRepository) UpdateStatusFailed(txId string) error {
 	return args.Get(0).(error)
 }
 
+func (m *MockTransferRepository) UpdateStatusReorged(sourceChainId uint64, after time.Time) ([]string, error) {
+	args := m.Called(sourceChainId, after)
+	if args.Get(1) == nil {
+		return args.Get(0).([]string), nil
+	}
+	return nil, args.Get(1).(error)
+}
+
+func (m *MockTransferRepository) UpdateStatusClaimReorged(targetChainId, fromBlock uint64) ([]string, error) {
+	args := m.Called(targetChainId, fromBlock)
+	if args.Get(1) == nil {
+		return args.Get(0).([]string), nil
+	}
+	return nil, args.Get(1).(error)
+}
+
+func (m *MockTransferRepository) UpdateStatusReincluded(ct *payload.Transfer) (*entity.Transfer, error) {
+	args := m.Called(ct)
+	if args.Get(1) == nil {
+		return args.Get(0).(*entity.Transfer), nil
+	}
+	return nil, args.Get(1).(error)
+}
+
+func (m *MockTransferRepository) UpdateStatusClaimed(txId, claimTxHash string, claimBlockNumber uint64, claimTimestamp int64, claimer string) error {
+	args := m.Called(txId, claimTxHash, claimBlockNumber, claimTimestamp, claimer)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
 func (m *MockTransferRepository) GetByTransactionId(txId string) (*entity.Transfer, error) {
 	args := m.Called(txId)
 	if args.Get(1) == nil {
@@ -59,14 +95,47 @@ func (m *MockTransferRepository) GetWithPreloads(txId string) (*entity.Transfer,
 	return nil, args.Get(1).(error)
 }
 
-func (m *MockTransferRepository) Create(ct *payload.Transfer) (*entity.Transfer, error) {
-	args := m.Called(ct)
+func (m *MockTransferRepository) GetByWrappedSerialNumber(wrappedAsset string, serialNumber int64) (*entity.Transfer, error) {
+	args := m.Called(wrappedAsset, serialNumber)
 	if args.Get(1) == nil {
 		return args.Get(0).(*entity.Transfer), nil
 	}
 	return nil, args.Get(1).(error)
 }
 
+func (m *MockTransferRepository) UpdateWrappedSerialNumber(txId string, serialNumber int64) error {
+	args := m.Called(txId, serialNumber)
+	if args.Get(0) == nil {
+		return nil
+	}
+
+	return args.Get(0).(error)
+}
+
+func (m *MockTransferRepository) Create(ctx context.Context, ct *payload.Transfer) (*entity.Transfer, error) {
+	args := m.Called(ctx, ct)
+	if args.Get(1) == nil {
+		return args.Get(0).(*entity.Transfer), nil
+	}
+	return nil, args.Get(1).(error)
+}
+
+func (m *MockTransferRepository) Reject(ct *payload.Transfer, code failure.Code, reason string) (*entity.Transfer, error) {
+	args := m.Called(ct, code, reason)
+	if args.Get(1) == nil {
+		return args.Get(0).(*entity.Transfer), nil
+	}
+	return nil, args.Get(1).(error)
+}
+
+func (m *MockTransferRepository) Fail(txId string, code failure.Code, reason, actor string) error {
+	args := m.Called(txId, code, reason, actor)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
 func (m *MockTransferRepository) UpdateFee(txId, fee string) error {
 	args := m.Called(txId, fee)
 	if args.Get(0) == nil {
@@ -87,3 +156,35 @@ func (m *MockTransferRepository) UpdateStatusCompleted(txId string) error {
 func (m *MockTransferRepository) Paged(req *transfer.PagedRequest) ([]*entity.Transfer, int64, error) {
 	panic("implement me")
 }
+
+func (m *MockTransferRepository) GetStuck(before time.Time, maxRedrives int, signers []string, limit int) ([]*entity.Transfer, error) {
+	args := m.Called(before, maxRedrives, signers, limit)
+	if args.Get(1) == nil {
+		return args.Get(0).([]*entity.Transfer), nil
+	}
+	return nil, args.Get(1).(error)
+}
+
+func (m *MockTransferRepository) MarkRedriven(txId, fromStatus string, notAfter time.Time, actor string) (bool, error) {
+	args := m.Called(txId, fromStatus, notAfter, actor)
+	if args.Get(1) == nil {
+		return args.Bool(0), nil
+	}
+	return false, args.Get(1).(error)
+}
+
+func (m *MockTransferRepository) UpdateStatus(txId, status, actor, reason string) error {
+	args := m.Called(txId, status, actor, reason)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (m *MockTransferRepository) GetStatusHistory(txId string) ([]*entity.StatusHistory, error) {
+	args := m.Called(txId)
+	if args.Get(1) == nil {
+		return args.Get(0).([]*entity.StatusHistory), nil
+	}
+	return nil, args.Get(1).(error)
+}
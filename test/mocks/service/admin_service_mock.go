@@ -0,0 +1,90 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/admin"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockAdminService struct {
+	mock.Mock
+}
+
+func (m *MockAdminService) UpdateTransferStatus(transferID, status, reason string) error {
+	args := m.Called(transferID, status, reason)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (m *MockAdminService) RedriveTransfer(transferID string) error {
+	args := m.Called(transferID)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (m *MockAdminService) Watchers() ([]*admin.Watcher, error) {
+	args := m.Called()
+	if args.Get(1) == nil {
+		return args.Get(0).([]*admin.Watcher), nil
+	}
+	return nil, args.Get(1).(error)
+}
+
+func (m *MockAdminService) PauseWatcher(id string) error {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (m *MockAdminService) ResumeWatcher(id string) error {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (m *MockAdminService) RewindWatcher(id string, to int64) error {
+	args := m.Called(id, to)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (m *MockAdminService) ReloadMembers() {
+	m.Called()
+}
+
+func (m *MockAdminService) Audit(entry *admin.AuditEntry) {
+	m.Called(entry)
+}
+
+func (m *MockAdminService) AuditLog(limit int) ([]*admin.AuditEntry, error) {
+	args := m.Called(limit)
+	if args.Get(1) == nil {
+		return args.Get(0).([]*admin.AuditEntry), nil
+	}
+	return nil, args.Get(1).(error)
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"time"
+
+	"github.com/hashgraph/hedera-sdk-go/v2"
+	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockBatchService struct {
+	mock.Mock
+}
+
+func (m *MockBatchService) Enabled() bool {
+	args := m.Called()
+	return args.Bool(0)
+}
+
+func (m *MockBatchService) ID(token string, timestamp time.Time) string {
+	args := m.Called(token, timestamp)
+	return args.String(0)
+}
+
+func (m *MockBatchService) Add(transferID, token string, timestamp time.Time, receiver hedera.AccountID, transfers []transfer.Hedera, onSuccess, onFail func()) error {
+	args := m.Called(transferID, token, timestamp, receiver, transfers)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (m *MockBatchService) ExecuteDue() {
+	m.Called()
+}
+
+func (m *MockBatchService) Batched(transferID string) (bool, error) {
+	args := m.Called(transferID)
+	if args.Get(1) == nil {
+		return args.Bool(0), nil
+	}
+	return false, args.Get(1).(error)
+}
+
+func (m *MockBatchService) Requeue(transferID string) error {
+	args := m.Called(transferID)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
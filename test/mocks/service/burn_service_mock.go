@@ -17,6 +17,7 @@
 package service
 
 import (
+	"context"
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
 	"github.com/stretchr/testify/mock"
 )
@@ -36,6 +37,10 @@ func (m *MockBurnService) TransactionID(id string) (string, error) {
 	return args[0].(string), args[1].(error)
 }
 
-func (m *MockBurnService) ProcessEvent(event payload.Transfer) {
-	m.Called(event)
+func (m *MockBurnService) ProcessEvent(ctx context.Context, event payload.Transfer) error {
+	args := m.Called(ctx, event)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
 }
@@ -17,6 +17,8 @@
 package service
 
 import (
+	"math/big"
+
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/transaction"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 	"github.com/stretchr/testify/mock"
@@ -42,7 +44,7 @@ func (mds *MockDistrubutorService) CalculateMemberDistribution(validFee int64) (
 	return nil, args.Get(1).(error)
 }
 
-func (mds *MockDistrubutorService) ValidAmount(amount int64) int64 {
+func (mds *MockDistrubutorService) ValidAmount(amount *big.Int) *big.Int {
 	args := mds.Called(amount)
-	return args.Get(0).(int64)
+	return args.Get(0).(*big.Int)
 }
@@ -16,13 +16,17 @@
 
 package service
 
-import "github.com/stretchr/testify/mock"
+import (
+	"math/big"
+
+	"github.com/stretchr/testify/mock"
+)
 
 type MockFeeService struct {
 	mock.Mock
 }
 
-func (mfs *MockFeeService) CalculateFee(token string, amount int64) (fee, remainder int64) {
+func (mfs *MockFeeService) CalculateFee(token string, amount *big.Int) (fee, remainder *big.Int) {
 	args := mfs.Called(token, amount)
-	return args.Get(0).(int64), args.Get(1).(int64)
+	return args.Get(0).(*big.Int), args.Get(1).(*big.Int)
 }
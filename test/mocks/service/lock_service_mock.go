@@ -17,6 +17,7 @@
 package service
 
 import (
+	"context"
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
 	"github.com/stretchr/testify/mock"
 )
@@ -25,6 +26,10 @@ type MockLockService struct {
 	mock.Mock
 }
 
-func (m *MockLockService) ProcessEvent(event payload.Transfer) {
-	m.Called(event)
+func (m *MockLockService) ProcessEvent(ctx context.Context, event payload.Transfer) error {
+	args := m.Called(ctx, event)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
 }
@@ -17,6 +17,7 @@
 package service
 
 import (
+	"context"
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
 	"github.com/limechain/hedera-eth-bridge-validator/proto"
 	"github.com/stretchr/testify/mock"
@@ -26,16 +27,16 @@ type MockMessageService struct {
 	mock.Mock
 }
 
-func (m *MockMessageService) SignFungibleMessage(transfer payload.Transfer) ([]byte, error) {
-	args := m.Called(transfer)
+func (m *MockMessageService) SignFungibleMessage(ctx context.Context, transfer payload.Transfer) ([]byte, error) {
+	args := m.Called(ctx, transfer)
 	if args[1] == nil {
 		return args[0].([]byte), nil
 	}
 	return args[0].([]byte), args[1].(error)
 }
 
-func (m *MockMessageService) SignNftMessage(transfer payload.Transfer) ([]byte, error) {
-	args := m.Called(transfer)
+func (m *MockMessageService) SignNftMessage(ctx context.Context, transfer payload.Transfer) ([]byte, error) {
+	args := m.Called(ctx, transfer)
 	if args[1] == nil {
 		return args[0].([]byte), nil
 	}
@@ -32,10 +32,13 @@ func (mps *MockPrometheusService) CreateGaugeIfNotExists(opts prometheus.GaugeOp
 	return result
 }
 
-// CreateSuccessRateGaugeIfNotExists creates new Gauge Metric for Success Rate and registers it in Prometheus if not exists
-func (mps *MockPrometheusService) CreateSuccessRateGaugeIfNotExists(transactionId string, sourceChainId, targetChainId uint64, asset, metricNameSuffix, metricHelp string) (prometheus.Gauge, error) {
-	args := mps.Called(transactionId, sourceChainId, targetChainId, asset, metricNameSuffix, metricHelp)
-	return args.Get(0).(prometheus.Gauge), args.Error(1)
+// CreateGaugeVecIfNotExists creates new Gauge Vector Metric partitioned by the passed labels and registers it in Prometheus if not exists
+func (mps *MockPrometheusService) CreateGaugeVecIfNotExists(opts prometheus.GaugeOpts, labelNames []string) *prometheus.GaugeVec {
+	args := mps.Called(opts, labelNames)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(*prometheus.GaugeVec)
 }
 
 // GetGauge retrieves Gauge by name with flag for existence
@@ -57,6 +60,24 @@ func (mps *MockPrometheusService) CreateCounterIfNotExists(opts prometheus.Count
 	return result
 }
 
+// CreateCounterVecIfNotExists creates new Counter Vector Metric partitioned by the passed labels and registers it in Prometheus if not exists
+func (mps *MockPrometheusService) CreateCounterVecIfNotExists(opts prometheus.CounterOpts, labelNames []string) *prometheus.CounterVec {
+	args := mps.Called(opts, labelNames)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(*prometheus.CounterVec)
+}
+
+// CreateHistogramVecIfNotExists creates new Histogram Vector Metric partitioned by the passed labels and registers it in Prometheus if not exists
+func (mps *MockPrometheusService) CreateHistogramVecIfNotExists(opts prometheus.HistogramOpts, labelNames []string) *prometheus.HistogramVec {
+	args := mps.Called(opts, labelNames)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(*prometheus.HistogramVec)
+}
+
 // GetCounter retrieves Counter by name with flag for existence
 func (mps *MockPrometheusService) GetCounter(name string) prometheus.Counter {
 	args := mps.Called(name)
@@ -64,12 +85,6 @@ func (mps *MockPrometheusService) GetCounter(name string) prometheus.Counter {
 	return result
 }
 
-// ConstructMetricName constructing name for metric
-func (mps *MockPrometheusService) ConstructMetricName(sourceNetworkId, targetNetworkId uint64, asset, transactionId, metricTarget string) (string, error) {
-	args := mps.Called(sourceNetworkId, targetNetworkId, asset, transactionId, metricTarget)
-	return args.Get(0).(string), args.Error(1)
-}
-
 // DeleteCounter unregisters and deletes Counter with the passed name
 func (mps *MockPrometheusService) DeleteCounter(name string) {
 	_ = mps.Called(name)
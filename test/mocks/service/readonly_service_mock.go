@@ -17,6 +17,8 @@
 package service
 
 import (
+	"context"
+
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	mirrorNodeTransaction "github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/transaction"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
@@ -28,18 +30,18 @@ type MockReadOnlyService struct {
 	mock.Mock
 }
 
-func (m *MockReadOnlyService) FindNftTransfer(transferID string, tokenID string, serialNum int64, sender string, receiver string, save func(transactionID string, scheduleID string, status string) error) {
-	m.Called(transferID, tokenID, serialNum, sender, receiver, save)
+func (m *MockReadOnlyService) FindNftTransfer(ctx context.Context, transferID string, tokenID string, serialNum int64, sender string, receiver string, save func(transactionID string, scheduleID string, status string) error) {
+	m.Called(ctx, transferID, tokenID, serialNum, sender, receiver, save)
 }
 
-func (m *MockReadOnlyService) FindTransfer(transferID string, fetch func() (*mirrorNodeTransaction.Response, error), save func(transactionID, scheduleID, status string) error) {
-	m.Called(transferID, fetch, save)
+func (m *MockReadOnlyService) FindTransfer(ctx context.Context, transferID string, fetch func() (*mirrorNodeTransaction.Response, error), save func(transactionID, scheduleID, status string) error) {
+	m.Called(ctx, transferID, fetch, save)
 }
 
-func (m *MockReadOnlyService) FindAssetTransfer(transferID string, asset string, transfers []transfer.Hedera, fetch func() (*mirrorNodeTransaction.Response, error), save func(transactionID, scheduleID, status string) error) {
-	m.Called(transferID, asset, transfers, fetch, save)
+func (m *MockReadOnlyService) FindAssetTransfer(ctx context.Context, transferID string, asset string, transfers []transfer.Hedera, fetch func() (*mirrorNodeTransaction.Response, error), save func(transactionID, scheduleID, status string) error) {
+	m.Called(ctx, transferID, asset, transfers, fetch, save)
 }
 
-func (m *MockReadOnlyService) FindScheduledNftAllowanceApprove(t *payload.Transfer, sender hedera.AccountID, save func(transactionID string, scheduleID string, status string) error) {
-	m.Called(t, sender, save)
+func (m *MockReadOnlyService) FindScheduledNftAllowanceApprove(ctx context.Context, t *payload.Transfer, sender hedera.AccountID, save func(transactionID string, scheduleID string, status string) error) {
+	m.Called(ctx, t, sender, save)
 }
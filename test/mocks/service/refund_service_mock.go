@@ -0,0 +1,40 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockRefundService struct {
+	mock.Mock
+}
+
+func (m *MockRefundService) OnDeliveryFailed(transferID, transactionID, asset string, amount *big.Int) {
+	m.Called(transferID, transactionID, asset, amount)
+}
+
+func (m *MockRefundService) Refund(ctx context.Context, transferID, asset string, amount *big.Int) error {
+	args := m.Called(ctx, transferID, asset, amount)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
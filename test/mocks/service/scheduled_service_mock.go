@@ -17,6 +17,9 @@
 package service
 
 import (
+	"context"
+	"math/big"
+
 	"github.com/hashgraph/hedera-sdk-go/v2"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 	"github.com/stretchr/testify/mock"
@@ -26,22 +29,30 @@ type MockScheduledService struct {
 	mock.Mock
 }
 
-func (mss *MockScheduledService) ExecuteScheduledNftTransferTransaction(id string, nftID hedera.NftID, sender hedera.AccountID, receiving hedera.AccountID, approved bool, onExecutionSuccess func(transactionID string, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string)) {
-	mss.Called(id, nftID, sender, receiving, approved)
+func (mss *MockScheduledService) ExecuteScheduledNftTransferTransaction(ctx context.Context, id string, nftID hedera.NftID, sender hedera.AccountID, receiving hedera.AccountID, approved bool, onExecutionSuccess func(transactionID string, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string)) {
+	mss.Called(ctx, id, nftID, sender, receiving, approved)
+}
+
+func (mss *MockScheduledService) ExecuteScheduledBurnTransaction(ctx context.Context, id, asset string, amount *big.Int, status *chan string, onExecutionSuccess func(transactionID string, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string)) {
+	mss.Called(ctx, id, asset, amount)
+}
+
+func (mss *MockScheduledService) ExecuteScheduledMintTransaction(ctx context.Context, id, asset string, amount *big.Int, status *chan string, onExecutionSuccess func(transactionID string, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string)) {
+	mss.Called(ctx, id, asset, amount)
 }
 
-func (mss *MockScheduledService) ExecuteScheduledBurnTransaction(id, asset string, amount int64, status *chan string, onExecutionSuccess func(transactionID string, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string)) {
-	mss.Called(id, asset, amount)
+func (mss *MockScheduledService) ExecuteScheduledTransferTransaction(ctx context.Context, id, nativeAsset string, transfers []transfer.Hedera, onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string)) {
+	mss.Called(ctx, id, nativeAsset, transfers)
 }
 
-func (mss *MockScheduledService) ExecuteScheduledMintTransaction(id, asset string, amount int64, status *chan string, onExecutionSuccess func(transactionID string, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string)) {
-	mss.Called(id, asset, amount)
+func (mss *MockScheduledService) ExecuteScheduledNftAllowTransaction(ctx context.Context, id string, nftID hedera.NftID, owner hedera.AccountID, spender hedera.AccountID, onExecutionSuccess func(txId string, scheduleId string), onExecutionFail, onSuccess, onFail func(txId string)) {
+	mss.Called(ctx, id, nftID, owner, spender)
 }
 
-func (mss *MockScheduledService) ExecuteScheduledTransferTransaction(id, nativeAsset string, transfers []transfer.Hedera, onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string)) {
-	mss.Called(id, nativeAsset, transfers)
+func (mss *MockScheduledService) ExecuteScheduledNftMintTransaction(ctx context.Context, id string, tokenID hedera.TokenID, metadata []byte, onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string)) {
+	mss.Called(ctx, id, tokenID, metadata)
 }
 
-func (mss *MockScheduledService) ExecuteScheduledNftAllowTransaction(id string, nftID hedera.NftID, owner hedera.AccountID, spender hedera.AccountID, onExecutionSuccess func(txId string, scheduleId string), onExecutionFail, onSuccess, onFail func(txId string)) {
-	mss.Called(id, nftID, owner, spender)
+func (mss *MockScheduledService) ExecuteScheduledNftBurnTransaction(ctx context.Context, id string, nftID hedera.NftID, onExecutionSuccess func(transactionID, scheduleID string), onExecutionFail, onSuccess, onFail func(transactionID string)) {
+	mss.Called(ctx, id, nftID)
 }
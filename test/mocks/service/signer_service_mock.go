@@ -17,6 +17,7 @@
 package service
 
 import (
+	"context"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/stretchr/testify/mock"
 	"math/big"
@@ -26,8 +27,8 @@ type MockSignerService struct {
 	mock.Mock
 }
 
-func (m *MockSignerService) Sign(msg []byte) ([]byte, error) {
-	args := m.Called(msg)
+func (m *MockSignerService) Sign(ctx context.Context, msg []byte) ([]byte, error) {
+	args := m.Called(ctx, msg)
 	if args.Get(0) == nil && args.Get(1) == nil {
 		return nil, nil
 	}
@@ -17,12 +17,13 @@
 package service
 
 import (
-	"fmt"
+	"context"
 
 	"github.com/limechain/hedera-eth-bridge-validator/app/clients/hedera/mirror-node/model/transaction"
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/service"
 	"github.com/limechain/hedera-eth-bridge-validator/app/model/transfer"
 	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity"
+	"github.com/limechain/hedera-eth-bridge-validator/app/persistence/entity/failure"
 	"github.com/limechain/hedera-eth-bridge-validator/app/process/payload"
 	"github.com/stretchr/testify/mock"
 )
@@ -55,24 +56,32 @@ func (mts *MockTransferService) UpdateStatusFailed(txId string) error {
 	panic("implement me")
 }
 
-func (mts *MockTransferService) ProcessNativeTransfer(tm payload.Transfer) error {
-	args := mts.Called(tm)
+func (mts *MockTransferService) ProcessNativeTransfer(ctx context.Context, tm payload.Transfer) error {
+	args := mts.Called(ctx, tm)
 	if args.Get(0) == nil {
 		return nil
 	}
 	return args.Get(0).(error)
 }
 
-func (mts *MockTransferService) ProcessNativeNftTransfer(tm payload.Transfer) error {
-	args := mts.Called(tm)
+func (mts *MockTransferService) ProcessNativeNftTransfer(ctx context.Context, tm payload.Transfer) error {
+	args := mts.Called(ctx, tm)
 	if args.Get(0) == nil {
 		return nil
 	}
 	return args.Get(0).(error)
 }
 
-func (mts *MockTransferService) ProcessWrappedTransfer(tm payload.Transfer) error {
-	args := mts.Called(tm)
+func (mts *MockTransferService) ProcessWrappedTransfer(ctx context.Context, tm payload.Transfer) error {
+	args := mts.Called(ctx, tm)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
+func (mts *MockTransferService) ProcessWrappedNftTransfer(ctx context.Context, tm payload.Transfer, wrappedSerialNum int64) error {
+	args := mts.Called(ctx, tm, wrappedSerialNum)
 	if args.Get(0) == nil {
 		return nil
 	}
@@ -85,8 +94,8 @@ func (mts *MockTransferService) SanityCheckTransfer(tx transaction.Transaction)
 	return args.Get(0).(transfer.SanityCheckResult)
 }
 
-func (mts *MockTransferService) InitiateNewTransfer(tm payload.Transfer) (*entity.Transfer, error) {
-	args := mts.Called(tm)
+func (mts *MockTransferService) InitiateNewTransfer(ctx context.Context, tm payload.Transfer) (*entity.Transfer, error) {
+	args := mts.Called(ctx, tm)
 	if args.Get(0) == nil && args.Get(1) == nil {
 		return nil, nil
 	}
@@ -99,6 +108,14 @@ func (mts *MockTransferService) InitiateNewTransfer(tm payload.Transfer) (*entit
 	return args.Get(0).(*entity.Transfer), args.Get(1).(error)
 }
 
+func (mts *MockTransferService) RejectTransfer(tm payload.Transfer, code failure.Code, reason string) error {
+	args := mts.Called(tm, code, reason)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(error)
+}
+
 func (mts *MockTransferService) TransferData(txId string) (interface{}, error) {
 	args := mts.Called(txId)
 	if args.Get(0) == nil {
@@ -108,15 +125,24 @@ func (mts *MockTransferService) TransferData(txId string) (interface{}, error) {
 	return args.Get(0).(service.TransferData), args.Error(1)
 }
 
-func (mts *MockTransferService) Paged(filter *transfer.PagedRequest) (*transfer.Paged, error) {
-	panic("implement me")
+func (mts *MockTransferService) Timeline(txId string) (*transfer.Timeline, error) {
+	args := mts.Called(txId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*transfer.Timeline), args.Error(1)
 }
 
-func (mts *MockTransferService) UpdateTransferStatusCompleted(txId string) error {
+func (mts *MockTransferService) StatusHistory(txId string) ([]*transfer.StatusChange, error) {
 	args := mts.Called(txId)
 	if args.Get(0) == nil {
-		return nil
+		return nil, args.Error(1)
 	}
 
-	return fmt.Errorf("error")
+	return args.Get(0).([]*transfer.StatusChange), args.Error(1)
+}
+
+func (mts *MockTransferService) Paged(filter *transfer.PagedRequest) (*transfer.Paged, error) {
+	panic("implement me")
 }
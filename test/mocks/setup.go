@@ -33,6 +33,14 @@ var MTransferService *service.MockTransferService
 var MDistributorService *service.MockDistrubutorService
 var MMessageService *service.MockMessageService
 var MScheduledService *service.MockScheduledService
+var MBatchService *service.MockBatchService
+var MTransferEventsService *service.MockTransferEventsService
+var MWebhooksService *service.MockWebhooksService
+var MAdminService *service.MockAdminService
+var MRedriveService *service.MockRedriveService
+var MHealthService *service.MockHealthService
+var MRefundService *service.MockRefundService
+var MWatcherControl *service.MockWatcherControl
 var MFeeService *service.MockFeeService
 var MBurnService *service.MockBurnService
 var MLockService *service.MockLockService
@@ -42,6 +50,11 @@ var MMessageRepository *repository.MockMessageRepository
 var MFeeRepository *repository.MockFeeRepository
 var MScheduleRepository *repository.MockScheduleRepository
 var MStatusRepository *repository.MockStatusRepository
+var MQueueRepository *repository.MockQueueRepository
+var MWebhookDeliveryRepository *repository.MockWebhookDeliveryRepository
+var MAuditLogRepository *repository.MockAuditLogRepository
+var MBlockRepository *repository.MockBlockRepository
+var MBatchRepository *repository.MockBatchRepository
 var MHederaMirrorClient *client.MockHederaMirror
 var MHederaNodeClient *client.MockHederaNode
 var MEVMCoreClient *client.MockEVMCore
@@ -73,6 +86,14 @@ func Setup() {
 	MExchangeRateProvider = &rate_provider.MockExchangeRateProvider{}
 	MTransferService = &service.MockTransferService{}
 	MScheduledService = &service.MockScheduledService{}
+	MBatchService = &service.MockBatchService{}
+	MTransferEventsService = &service.MockTransferEventsService{}
+	MWebhooksService = &service.MockWebhooksService{}
+	MAdminService = &service.MockAdminService{}
+	MRedriveService = &service.MockRedriveService{}
+	MHealthService = &service.MockHealthService{}
+	MRefundService = &service.MockRefundService{}
+	MWatcherControl = &service.MockWatcherControl{}
 	MFeeService = &service.MockFeeService{}
 	MSignerService = &service.MockSignerService{}
 	MLockService = &service.MockLockService{}
@@ -82,6 +103,11 @@ func Setup() {
 	MMessageRepository = &repository.MockMessageRepository{}
 	MScheduleRepository = &repository.MockScheduleRepository{}
 	MStatusRepository = &repository.MockStatusRepository{}
+	MQueueRepository = &repository.MockQueueRepository{}
+	MWebhookDeliveryRepository = &repository.MockWebhookDeliveryRepository{}
+	MAuditLogRepository = &repository.MockAuditLogRepository{}
+	MBlockRepository = &repository.MockBlockRepository{}
+	MBatchRepository = &repository.MockBatchRepository{}
 	MDistributorService = &service.MockDistrubutorService{}
 	MReadOnlyService = &service.MockReadOnlyService{}
 	MMessageService = &service.MockMessageService{}
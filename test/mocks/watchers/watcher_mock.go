@@ -17,6 +17,8 @@
 package watchers
 
 import (
+	"context"
+
 	"github.com/limechain/hedera-eth-bridge-validator/app/domain/queue"
 	"github.com/stretchr/testify/mock"
 )
@@ -25,6 +27,6 @@ type MockWatcher struct {
 	mock.Mock
 }
 
-func (m *MockWatcher) Watch(queue queue.Queue) {
-	m.Called(queue)
+func (m *MockWatcher) Watch(ctx context.Context, queue queue.Queue) {
+	m.Called(ctx, queue)
 }
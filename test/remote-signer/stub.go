@@ -0,0 +1,168 @@
+/*
+ * Copyright 2022 LimeChain Ltd.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package remote_signer
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const signPath = "/api/v1/eth1/sign/"
+
+type signRequest struct {
+	Data string `json:"data"`
+}
+
+type request struct {
+	JsonRpc string        `json:"jsonrpc"`
+	Method  string        `json:"method"`
+	Params  []interface{} `json:"params"`
+	Id      uint64        `json:"id"`
+}
+
+type response struct {
+	JsonRpc string      `json:"jsonrpc"`
+	Result  interface{} `json:"result,omitempty"`
+	Error   *rpcError   `json:"error,omitempty"`
+	Id      uint64      `json:"id"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// Stub is a minimal Web3Signer compatible signer, holding its keys in memory. Like Web3Signer,
+// `eth_sign` applies the EIP-191 prefix, while the raw signing endpoint signs the Keccak-256 hash
+// of the given data. It is meant for local testing only.
+type Stub struct {
+	keys map[common.Address]*ecdsa.PrivateKey
+}
+
+func NewStub(keys ...*ecdsa.PrivateKey) *Stub {
+	s := &Stub{keys: make(map[common.Address]*ecdsa.PrivateKey)}
+	for _, key := range keys {
+		s.keys[crypto.PubkeyToAddress(key.PublicKey)] = key
+	}
+	return s
+}
+
+func (s *Stub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if strings.HasPrefix(r.URL.Path, signPath) {
+		s.serveSign(w, r)
+		return
+	}
+
+	req := &request{}
+	err := json.NewDecoder(r.Body).Decode(req)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	res := response{JsonRpc: "2.0", Id: req.Id}
+	result, err := s.handle(req)
+	if err != nil {
+		res.Error = &rpcError{Code: -32000, Message: err.Error()}
+	} else {
+		res.Result = result
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(res)
+}
+
+// serveSign signs the Keccak-256 hash of the given data, responding with the hex encoded signature
+func (s *Stub) serveSign(w http.ResponseWriter, r *http.Request) {
+	req := &signRequest{}
+	err := json.NewDecoder(r.Body).Decode(req)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	address := strings.TrimPrefix(r.URL.Path, signPath)
+	key, ok := s.keys[common.HexToAddress(address)]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	data, err := hexutil.Decode(req.Data)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	signature, err := sign(crypto.Keccak256(data), key)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain")
+	_, _ = w.Write([]byte(signature))
+}
+
+func (s *Stub) handle(request *request) (interface{}, error) {
+	switch request.Method {
+	case "eth_accounts":
+		accounts := make([]string, 0, len(s.keys))
+		for address := range s.keys {
+			accounts = append(accounts, address.String())
+		}
+		return accounts, nil
+	case "eth_sign":
+		if len(request.Params) != 2 {
+			return nil, fmt.Errorf("expected 2 params, got [%d]", len(request.Params))
+		}
+		address, _ := request.Params[0].(string)
+		key, ok := s.keys[common.HexToAddress(address)]
+		if !ok {
+			return nil, fmt.Errorf("unknown account [%s]", address)
+		}
+		data, _ := request.Params[1].(string)
+		message, err := hexutil.Decode(data)
+		if err != nil {
+			return nil, err
+		}
+
+		return sign(accounts.TextHash(message), key)
+	default:
+		return nil, fmt.Errorf("method [%s] is not supported", request.Method)
+	}
+}
+
+// sign signs the digest, returning the hex encoded signature with a recovery id of 27 or 28
+func sign(digest []byte, key *ecdsa.PrivateKey) (string, error) {
+	signature, err := crypto.Sign(digest, key)
+	if err != nil {
+		return "", err
+	}
+	signature[64] += 27
+	return hexutil.Encode(signature), nil
+}